@@ -0,0 +1,148 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// AdminOrganizationListOptions filters AdminService.ListOrganizations on the
+// server side. Search (from ListOptions) matches organization names.
+type AdminOrganizationListOptions struct {
+	ListOptions
+	Plan          string     `url:"plan,omitempty"`   // Subscription plan, e.g. starter, pro, enterprise
+	Status        string     `url:"status,omitempty"` // Subscription status, e.g. active, trialing, past_due, canceled
+	CreatedAfter  *time.Time `url:"created_after,omitempty"`
+	CreatedBefore *time.Time `url:"created_before,omitempty"`
+}
+
+// Validate checks the filters locally
+func (o *AdminOrganizationListOptions) Validate() error {
+	if err := validateListPaging(&o.ListOptions); err != nil {
+		return err
+	}
+	return validateFilterRange("created", o.CreatedAfter, o.CreatedBefore)
+}
+
+// ToQuery converts options to query parameters
+func (o *AdminOrganizationListOptions) ToQuery() map[string]string {
+	params := o.ListOptions.ToQuery()
+	if o.Plan != "" {
+		params["plan"] = o.Plan
+	}
+	if o.Status != "" {
+		params["status"] = o.Status
+	}
+	if o.CreatedAfter != nil {
+		params["created_after"] = o.CreatedAfter.UTC().Format(time.RFC3339)
+	}
+	if o.CreatedBefore != nil {
+		params["created_before"] = o.CreatedBefore.UTC().Format(time.RFC3339)
+	}
+	return params
+}
+
+// ListOrganizations retrieves every organization on the platform matching
+// opts, for administrators managing many tenants. Invalid filters are rejected
+// before any request is made.
+// Authentication: JWT Token with admin privileges required
+// Endpoint: GET /v1/admin/organizations
+func (s *AdminService) ListOrganizations(ctx context.Context, opts *AdminOrganizationListOptions) ([]*Organization, *PaginationMeta, error) {
+	if opts == nil {
+		opts = &AdminOrganizationListOptions{}
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var resp PaginatedResponse
+	var orgs []*Organization
+	resp.Data = &orgs
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   "/v1/admin/organizations",
+		Query:  opts.ToQuery(),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return orgs, resp.Meta, nil
+}
+
+// ForEachOrganization calls fn for every organization matching opts, across
+// all pages. The context passed to fn is scoped to the organization with
+// WithOrganization, so calls made with it act within that tenant only.
+// Iteration stops at the first error returned by fn, which is returned wrapped
+// with the organization's UUID; return nil from fn to continue past a failure.
+//
+// Example:
+//
+//	err := client.Admin.ForEachOrganization(ctx, &nexmonyx.AdminOrganizationListOptions{Plan: "enterprise"},
+//	    func(ctx context.Context, org *nexmonyx.Organization) error {
+//	        servers, _, err := client.Servers.List(ctx, nil) // org's servers only
+//	        if err != nil {
+//	            return err
+//	        }
+//	        log.Printf("%s: %d servers", org.Name, len(servers))
+//	        return nil
+//	    })
+func (s *AdminService) ForEachOrganization(ctx context.Context, opts *AdminOrganizationListOptions, fn func(ctx context.Context, org *Organization) error) error {
+	page := AdminOrganizationListOptions{}
+	if opts != nil {
+		page = *opts
+	}
+	if page.Page <= 0 {
+		page.Page = 1
+	}
+
+	for {
+		// Each page is read in full so fn can take as long as it needs
+		orgs, meta, err := s.ListOrganizations(ctx, &page)
+		if err != nil {
+			return err
+		}
+		for _, org := range orgs {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(WithOrganization(ctx, org.UUID), org); err != nil {
+				return fmt.Errorf("organization %s: %w", org.UUID, err)
+			}
+		}
+
+		if meta == nil || len(orgs) == 0 || !(meta.HasMore || page.Page < meta.TotalPages) {
+			return nil
+		}
+		if meta.NextPage != nil && *meta.NextPage > page.Page {
+			page.Page = *meta.NextPage
+		} else {
+			page.Page++
+		}
+	}
+}
+
+// Impersonate returns a client that acts within orgID on behalf of the
+// administrator. Its requests are scoped to orgID like ForOrganization, and
+// its mutating requests are rejected with ErrChangeReasonRequired unless their
+// context carries a reason from WithChangeReason, so every change made in a
+// tenant is attributed in that tenant's audit log.
+//
+// Example:
+//
+//	tenant, err := client.Admin.Impersonate(orgUUID)
+//	ctx = nexmonyx.WithChangeReason(ctx, "SUP-2291: raise probe limit")
+//	err = tenant.Settings.Update(ctx, "", settings)
+func (s *AdminService) Impersonate(orgID string) (*Client, error) {
+	if orgID == "" {
+		return nil, fmt.Errorf("organization ID is required")
+	}
+
+	newConfig := s.client.config.Clone()
+	newConfig.Organization = orgID
+	newConfig.RequireChangeReason = true
+
+	return NewClient(newConfig)
+}
@@ -0,0 +1,132 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminService_ListOrganizations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/admin/organizations", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "enterprise", query.Get("plan"))
+		assert.Equal(t, "active", query.Get("status"))
+		assert.Equal(t, "2026-01-01T00:00:00Z", query.Get("created_after"))
+		assert.Equal(t, "2026-07-01T00:00:00Z", query.Get("created_before"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":[{"uuid":"org-1","name":"Acme","subscription_plan":"enterprise"}],"meta":{"page":1,"total_items":1,"total_pages":1}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "admin-token"}})
+	require.NoError(t, err)
+
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	orgs, meta, err := client.Admin.ListOrganizations(context.Background(), &AdminOrganizationListOptions{
+		Plan:          "enterprise",
+		Status:        "active",
+		CreatedAfter:  &after,
+		CreatedBefore: &before,
+	})
+	require.NoError(t, err)
+	require.Len(t, orgs, 1)
+	assert.Equal(t, "Acme", orgs[0].Name)
+	assert.Equal(t, 1, meta.TotalItems)
+
+	_, _, err = client.Admin.ListOrganizations(context.Background(), &AdminOrganizationListOptions{CreatedAfter: &before, CreatedBefore: &after})
+	assert.Error(t, err, "an inverted range is rejected locally")
+}
+
+func TestAdminService_ForEachOrganization(t *testing.T) {
+	var scoped []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/admin/organizations":
+			assert.Empty(t, r.Header.Get(OrganizationHeader), "listing is not scoped")
+			assert.Equal(t, "pro", r.URL.Query().Get("plan"))
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			fmt.Fprintf(w, `{"status":"success","data":[{"uuid":"org-%d"},{"uuid":"org-%d"}],"meta":{"page":%d,"total_pages":2}}`, 2*page-1, 2*page, page)
+		case "/v2/servers":
+			scoped = append(scoped, r.Header.Get(OrganizationHeader))
+			w.Write([]byte(`{"status":"success","data":[],"meta":{"page":1,"total_pages":1}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "admin-token"}})
+	require.NoError(t, err)
+
+	var visited []string
+	err = client.Admin.ForEachOrganization(context.Background(), &AdminOrganizationListOptions{Plan: "pro"}, func(ctx context.Context, org *Organization) error {
+		visited = append(visited, org.UUID)
+		_, _, err := client.Servers.List(ctx, nil)
+		return err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"org-1", "org-2", "org-3", "org-4"}, visited)
+	assert.Equal(t, visited, scoped, "requests made with fn's context are scoped to the organization")
+
+	stop := errors.New("stop")
+	visited = nil
+	err = client.Admin.ForEachOrganization(context.Background(), &AdminOrganizationListOptions{Plan: "pro"}, func(ctx context.Context, org *Organization) error {
+		visited = append(visited, org.UUID)
+		if org.UUID == "org-2" {
+			return stop
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Contains(t, err.Error(), "org-2")
+	assert.Equal(t, []string{"org-1", "org-2"}, visited)
+}
+
+func TestAdminService_Impersonate(t *testing.T) {
+	var gotOrg, gotReason string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotOrg = r.Header.Get(OrganizationHeader)
+		gotReason = r.Header.Get(ChangeReasonHeader)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "admin-token"}})
+	require.NoError(t, err)
+
+	_, err = client.Admin.Impersonate("")
+	assert.Error(t, err)
+
+	tenant, err := client.Admin.Impersonate("org-7")
+	require.NoError(t, err)
+
+	_, err = tenant.Settings.Get(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, "org-7", gotOrg)
+
+	_, err = tenant.Settings.Update(context.Background(), "", &Settings{})
+	assert.ErrorIs(t, err, ErrChangeReasonRequired, "changes need a reason")
+
+	ctx := WithChangeReason(context.Background(), "SUP-2291: raise probe limit")
+	_, err = tenant.Settings.Update(ctx, "", &Settings{})
+	require.NoError(t, err)
+	assert.Equal(t, "org-7", gotOrg)
+	assert.Equal(t, "SUP-2291: raise probe limit", gotReason)
+
+	// The admin client itself is unaffected
+	_, err = client.Settings.Update(context.Background(), "org-7", &Settings{})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,232 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const defaultIntervalRefresh = 15 * time.Minute
+
+// AgentConfigService handles agent configuration recommended by the platform
+type AgentConfigService struct {
+	client *Client
+}
+
+// CollectionIntervalRecommendation is the platform's suggested collection
+// frequency for one collector, based on plan limits and how much the
+// collector's data has been changing
+type CollectionIntervalRecommendation struct {
+	Collector          string `json:"collector"` // cpu, memory, disk, network, hardware, services, ...
+	RecommendedSeconds int    `json:"recommended_seconds"`
+	MinSeconds         int    `json:"min_seconds,omitempty"`     // Fastest interval the plan allows
+	CurrentSeconds     int    `json:"current_seconds,omitempty"` // Interval observed in recent submissions
+	Reason             string `json:"reason,omitempty"`          // e.g. "low variance", "plan limit"
+}
+
+// IntervalRecommendations holds the recommended collection intervals for a server
+type IntervalRecommendations struct {
+	ServerUUID      string                             `json:"server_uuid"`
+	Recommendations []CollectionIntervalRecommendation `json:"recommendations"`
+	GeneratedAt     *CustomTime                        `json:"generated_at,omitempty"`
+	ValidUntil      *CustomTime                        `json:"valid_until,omitempty"`
+}
+
+// Get returns the recommendation for collector
+func (r *IntervalRecommendations) Get(collector string) (*CollectionIntervalRecommendation, bool) {
+	for i := range r.Recommendations {
+		if r.Recommendations[i].Collector == collector {
+			return &r.Recommendations[i], true
+		}
+	}
+	return nil, false
+}
+
+// GetRecommendedIntervals retrieves the collection intervals the platform recommends for a server
+func (s *AgentConfigService) GetRecommendedIntervals(ctx context.Context, serverUUID string) (*IntervalRecommendations, error) {
+	if serverUUID == "" {
+		serverUUID = s.client.config.Auth.ServerUUID
+	}
+	if serverUUID == "" {
+		return nil, fmt.Errorf("server UUID is required")
+	}
+
+	var resp StandardResponse
+	resp.Data = &IntervalRecommendations{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/servers/%s/agent-config/intervals", serverUUID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if recommendations, ok := resp.Data.(*IntervalRecommendations); ok {
+		return recommendations, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// IntervalBounds limits the collection interval an agent accepts. Zero means no limit.
+type IntervalBounds struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// clamp limits d to the bounds
+func (b IntervalBounds) clamp(d time.Duration) time.Duration {
+	if b.Min > 0 && d < b.Min {
+		d = b.Min
+	}
+	if b.Max > 0 && d > b.Max {
+		d = b.Max
+	}
+	return d
+}
+
+// AdaptiveIntervalOptions configures AdaptiveIntervals
+type AdaptiveIntervalOptions struct {
+	// Defaults are the intervals used per collector until a recommendation is received
+	Defaults map[string]time.Duration
+
+	// Bounds limit the recommended interval per collector. Collectors without
+	// an entry use DefaultBounds.
+	Bounds        map[string]IntervalBounds
+	DefaultBounds IntervalBounds
+
+	// RefreshInterval between recommendation fetches (default: 15m)
+	RefreshInterval time.Duration
+
+	// OnChange is called when a collector's interval changes
+	OnChange func(collector string, interval time.Duration)
+
+	// OnError is called when fetching recommendations fails. The current
+	// intervals are kept.
+	OnError func(error)
+}
+
+// AdaptiveIntervals applies the platform's recommended collection intervals
+// within locally configured bounds. The plan's minimum interval is always
+// respected. It is safe for concurrent use.
+//
+// Example:
+//
+//	intervals := client.AgentConfig.NewAdaptiveIntervals(serverUUID, &nexmonyx.AdaptiveIntervalOptions{
+//	    Defaults:      map[string]time.Duration{"cpu": time.Minute, "hardware": time.Hour},
+//	    DefaultBounds: nexmonyx.IntervalBounds{Min: 15 * time.Second, Max: 10 * time.Minute},
+//	})
+//	go intervals.Run(ctx)
+//
+//	// In each collector loop
+//	time.Sleep(intervals.Interval("cpu"))
+type AdaptiveIntervals struct {
+	service    *AgentConfigService
+	serverUUID string
+	options    AdaptiveIntervalOptions
+
+	mu        sync.RWMutex
+	intervals map[string]time.Duration
+}
+
+// NewAdaptiveIntervals creates adaptive intervals for a server. Call Run or
+// Refresh to fetch recommendations.
+func (s *AgentConfigService) NewAdaptiveIntervals(serverUUID string, opts *AdaptiveIntervalOptions) *AdaptiveIntervals {
+	options := AdaptiveIntervalOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.RefreshInterval <= 0 {
+		options.RefreshInterval = defaultIntervalRefresh
+	}
+
+	intervals := make(map[string]time.Duration, len(options.Defaults))
+	for collector, d := range options.Defaults {
+		intervals[collector] = d
+	}
+
+	return &AdaptiveIntervals{
+		service:    s,
+		serverUUID: serverUUID,
+		options:    options,
+		intervals:  intervals,
+	}
+}
+
+// Interval returns the current interval for collector, or 0 if it has neither
+// a default nor a recommendation
+func (a *AdaptiveIntervals) Interval(collector string) time.Duration {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.intervals[collector]
+}
+
+// Refresh fetches the latest recommendations and applies them
+func (a *AdaptiveIntervals) Refresh(ctx context.Context) error {
+	recommendations, err := a.service.GetRecommendedIntervals(ctx, a.serverUUID)
+	if err != nil {
+		return err
+	}
+
+	type change struct {
+		collector string
+		interval  time.Duration
+	}
+	var changes []change
+
+	a.mu.Lock()
+	for _, rec := range recommendations.Recommendations {
+		if rec.RecommendedSeconds <= 0 {
+			continue
+		}
+
+		bounds, ok := a.options.Bounds[rec.Collector]
+		if !ok {
+			bounds = a.options.DefaultBounds
+		}
+		interval := bounds.clamp(time.Duration(rec.RecommendedSeconds) * time.Second)
+		if planMin := time.Duration(rec.MinSeconds) * time.Second; interval < planMin {
+			interval = planMin
+		}
+
+		if a.intervals[rec.Collector] != interval {
+			a.intervals[rec.Collector] = interval
+			changes = append(changes, change{rec.Collector, interval})
+		}
+	}
+	a.mu.Unlock()
+
+	if a.options.OnChange != nil {
+		for _, c := range changes {
+			a.options.OnChange(c.collector, c.interval)
+		}
+	}
+	return nil
+}
+
+// Run refreshes the recommendations immediately and then every RefreshInterval
+// until ctx is done, returning ctx's error
+func (a *AdaptiveIntervals) Run(ctx context.Context) error {
+	ctx, stop, err := a.service.client.runBackground(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	ticker := time.NewTicker(a.options.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.Refresh(ctx); err != nil && ctx.Err() == nil && a.options.OnError != nil {
+			a.options.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package nexmonyx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAgentConfigService_GetRecommendedIntervals(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/servers/srv-1/agent-config/intervals", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{"server_uuid":"srv-1","recommendations":[
+			{"collector":"cpu","recommended_seconds":30,"min_seconds":60,"reason":"plan limit"},
+			{"collector":"hardware","recommended_seconds":86400,"reason":"low variance"},
+			{"collector":"disk","recommended_seconds":5}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{ServerUUID: "srv-1", ServerSecret: "secret"},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	recommendations, err := client.AgentConfig.GetRecommendedIntervals(ctx, "")
+	require.NoError(t, err)
+	rec, ok := recommendations.Get("hardware")
+	require.True(t, ok)
+	assert.Equal(t, "low variance", rec.Reason)
+
+	changed := map[string]time.Duration{}
+	intervals := client.AgentConfig.NewAdaptiveIntervals("srv-1", &AdaptiveIntervalOptions{
+		Defaults:      map[string]time.Duration{"cpu": time.Minute, "memory": time.Minute},
+		Bounds:        map[string]IntervalBounds{"disk": {Min: 10 * time.Second}},
+		DefaultBounds: IntervalBounds{Min: 15 * time.Second, Max: time.Hour},
+		OnChange:      func(collector string, d time.Duration) { changed[collector] = d },
+	})
+	assert.Equal(t, time.Minute, intervals.Interval("cpu"))
+
+	require.NoError(t, intervals.Refresh(ctx))
+	assert.Equal(t, time.Minute, intervals.Interval("cpu"), "never faster than the plan allows")
+	assert.Equal(t, time.Hour, intervals.Interval("hardware"), "capped at the local maximum")
+	assert.Equal(t, 10*time.Second, intervals.Interval("disk"), "per-collector bounds")
+	assert.Equal(t, time.Minute, intervals.Interval("memory"), "defaults are kept without a recommendation")
+	assert.Equal(t, map[string]time.Duration{"hardware": time.Hour, "disk": 10 * time.Second}, changed)
+}
@@ -0,0 +1,336 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Alert channel types
+const (
+	AlertChannelEmail     = "email"
+	AlertChannelSlack     = "slack"
+	AlertChannelPagerDuty = "pagerduty"
+	AlertChannelWebhook   = "webhook"
+)
+
+// AlertChannelConfig is the typed configuration of an alert channel, stored
+// in AlertChannel.Configuration
+type AlertChannelConfig interface {
+	// ChannelType returns the AlertChannel.Type the configuration belongs to
+	ChannelType() string
+
+	// Validate checks the configuration locally
+	Validate() error
+}
+
+// EmailChannelConfig configures an email alert channel
+type EmailChannelConfig struct {
+	Recipients      []string `json:"recipients"`
+	SubjectTemplate string   `json:"subject_template,omitempty"` // e.g. "[ALERT] {{.Severity}}: {{.Name}}"
+}
+
+// ChannelType implements AlertChannelConfig
+func (c *EmailChannelConfig) ChannelType() string { return AlertChannelEmail }
+
+// Validate implements AlertChannelConfig
+func (c *EmailChannelConfig) Validate() error {
+	if len(c.Recipients) == 0 {
+		return fmt.Errorf("email channel: at least one recipient is required")
+	}
+	for _, recipient := range c.Recipients {
+		if at := strings.LastIndex(recipient, "@"); at < 1 || at == len(recipient)-1 {
+			return fmt.Errorf("email channel: invalid recipient %q", recipient)
+		}
+	}
+	return nil
+}
+
+// SlackChannelConfig configures a Slack alert channel using an incoming webhook
+type SlackChannelConfig struct {
+	WebhookURL string `json:"webhook_url"`
+	Channel    string `json:"channel,omitempty"` // e.g. "#alerts", overrides the webhook's default
+	Username   string `json:"username,omitempty"`
+	IconEmoji  string `json:"icon_emoji,omitempty"`
+}
+
+// ChannelType implements AlertChannelConfig
+func (c *SlackChannelConfig) ChannelType() string { return AlertChannelSlack }
+
+// Validate implements AlertChannelConfig
+func (c *SlackChannelConfig) Validate() error {
+	return validateChannelURL("slack channel: webhook_url", c.WebhookURL)
+}
+
+// PagerDutyChannelConfig configures a PagerDuty Events API v2 alert channel
+type PagerDutyChannelConfig struct {
+	RoutingKey string `json:"routing_key"`        // Integration key of the PagerDuty service
+	Severity   string `json:"severity,omitempty"` // critical, error, warning, info; default: mapped from the alert
+}
+
+// ChannelType implements AlertChannelConfig
+func (c *PagerDutyChannelConfig) ChannelType() string { return AlertChannelPagerDuty }
+
+// Validate implements AlertChannelConfig
+func (c *PagerDutyChannelConfig) Validate() error {
+	if len(c.RoutingKey) != 32 {
+		return fmt.Errorf("pagerduty channel: routing_key must be a 32-character integration key")
+	}
+	switch c.Severity {
+	case "", "critical", "error", "warning", "info":
+		return nil
+	}
+	return fmt.Errorf("pagerduty channel: invalid severity %q", c.Severity)
+}
+
+// WebhookChannelConfig configures a generic HTTP webhook alert channel
+type WebhookChannelConfig struct {
+	URL     string            `json:"url"`
+	Method  string            `json:"method,omitempty"` // POST (default) or PUT
+	Headers map[string]string `json:"headers,omitempty"`
+	Secret  string            `json:"secret,omitempty"` // Signs deliveries like webhook subscriptions
+}
+
+// ChannelType implements AlertChannelConfig
+func (c *WebhookChannelConfig) ChannelType() string { return AlertChannelWebhook }
+
+// Validate implements AlertChannelConfig
+func (c *WebhookChannelConfig) Validate() error {
+	switch strings.ToUpper(c.Method) {
+	case "", "POST", "PUT":
+	default:
+		return fmt.Errorf("webhook channel: method must be POST or PUT")
+	}
+	return validateChannelURL("webhook channel: url", c.URL)
+}
+
+func validateChannelURL(field, raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return fmt.Errorf("%s must be an http or https URL", field)
+	}
+	return nil
+}
+
+// NewAlertChannel creates an enabled alert channel with a typed configuration
+func NewAlertChannel(name string, config AlertChannelConfig) (*AlertChannel, error) {
+	channel := &AlertChannel{Name: name, Enabled: true}
+	if err := channel.SetConfig(config); err != nil {
+		return nil, err
+	}
+	return channel, nil
+}
+
+// SetConfig sets the channel's type and configuration from a typed configuration
+func (c *AlertChannel) SetConfig(config AlertChannelConfig) error {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to encode channel configuration: %w", err)
+	}
+	var configuration map[string]interface{}
+	if err := json.Unmarshal(data, &configuration); err != nil {
+		return fmt.Errorf("failed to encode channel configuration: %w", err)
+	}
+
+	c.Type = config.ChannelType()
+	c.Configuration = configuration
+	return nil
+}
+
+// Config decodes the channel's configuration into the typed configuration
+// for its type. It returns an error for types without one.
+func (c *AlertChannel) Config() (AlertChannelConfig, error) {
+	var config AlertChannelConfig
+	switch c.Type {
+	case AlertChannelEmail:
+		config = &EmailChannelConfig{}
+	case AlertChannelSlack:
+		config = &SlackChannelConfig{}
+	case AlertChannelPagerDuty:
+		config = &PagerDutyChannelConfig{}
+	case AlertChannelWebhook:
+		config = &WebhookChannelConfig{}
+	default:
+		return nil, fmt.Errorf("no typed configuration for channel type %q", c.Type)
+	}
+
+	data, err := json.Marshal(c.Configuration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode channel configuration: %w", err)
+	}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to decode channel configuration: %w", err)
+	}
+	return config, nil
+}
+
+// validate checks the channel's typed configuration, if its type has one
+func (c *AlertChannel) validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("channel name is required")
+	}
+	config, err := c.Config()
+	if err != nil {
+		if c.Type == "" {
+			return fmt.Errorf("channel type is required")
+		}
+		return nil // Types added to the API after this SDK version are passed through
+	}
+	return config.Validate()
+}
+
+// AlertChannelTestResult reports how a test notification was delivered
+type AlertChannelTestResult struct {
+	Success     bool        `json:"success"`
+	ChannelType string      `json:"channel_type"`
+	Destination string      `json:"destination,omitempty"` // Recipient or host, with secrets removed
+	StatusCode  int         `json:"status_code,omitempty"` // HTTP status returned by Slack, PagerDuty, or the webhook
+	Response    string      `json:"response,omitempty"`    // Provider response, truncated by the API
+	Error       string      `json:"error,omitempty"`
+	LatencyMs   int64       `json:"latency_ms"`
+	Attempts    int         `json:"attempts"`
+	DeliveredAt *CustomTime `json:"delivered_at,omitempty"`
+	Diagnostics []string    `json:"diagnostics,omitempty"` // Hints such as "webhook URL returned 404: check the URL"
+}
+
+// AlertChannelsService handles alert notification channels
+type AlertChannelsService struct {
+	client *Client
+}
+
+// Create creates an alert channel. Email, Slack, PagerDuty, and webhook
+// configurations are validated locally first.
+func (s *AlertChannelsService) Create(ctx context.Context, channel *AlertChannel) (*AlertChannel, error) {
+	if channel == nil {
+		return nil, fmt.Errorf("channel is required")
+	}
+	if err := channel.validate(); err != nil {
+		return nil, err
+	}
+
+	var resp StandardResponse
+	resp.Data = &AlertChannel{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v2/alerts/channels",
+		Body:   channel,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if created, ok := resp.Data.(*AlertChannel); ok {
+		return created, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Get retrieves an alert channel by ID
+func (s *AlertChannelsService) Get(ctx context.Context, channelID uint) (*AlertChannel, error) {
+	var resp StandardResponse
+	resp.Data = &AlertChannel{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v2/alerts/channels/%d", channelID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if channel, ok := resp.Data.(*AlertChannel); ok {
+		return channel, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// List retrieves the organization's alert channels
+func (s *AlertChannelsService) List(ctx context.Context, opts *ListOptions) ([]*AlertChannel, *PaginationMeta, error) {
+	var resp PaginatedResponse
+	var channels []*AlertChannel
+	resp.Data = &channels
+
+	req := &Request{
+		Method: "GET",
+		Path:   "/v2/alerts/channels",
+		Result: &resp,
+	}
+
+	if opts != nil {
+		req.Query = opts.ToQuery()
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return channels, resp.Meta, nil
+}
+
+// Update replaces an alert channel's name, configuration, and enabled state
+func (s *AlertChannelsService) Update(ctx context.Context, channelID uint, channel *AlertChannel) (*AlertChannel, error) {
+	if channel == nil {
+		return nil, fmt.Errorf("channel is required")
+	}
+	if err := channel.validate(); err != nil {
+		return nil, err
+	}
+
+	var resp StandardResponse
+	resp.Data = &AlertChannel{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v2/alerts/channels/%d", channelID),
+		Body:   channel,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if updated, ok := resp.Data.(*AlertChannel); ok {
+		return updated, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Delete deletes an alert channel. Alert rules stop notifying it.
+func (s *AlertChannelsService) Delete(ctx context.Context, channelID uint) error {
+	var resp StandardResponse
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v2/alerts/channels/%d", channelID),
+		Result: &resp,
+	})
+	return err
+}
+
+// Test sends a test notification through an alert channel. A failed delivery
+// is reported in the result rather than as an error; the error is only set
+// when the test could not be run.
+func (s *AlertChannelsService) Test(ctx context.Context, channelID uint) (*AlertChannelTestResult, error) {
+	var resp StandardResponse
+	resp.Data = &AlertChannelTestResult{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v2/alerts/channels/%d/test", channelID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result, ok := resp.Data.(*AlertChannelTestResult); ok {
+		return result, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
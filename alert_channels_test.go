@@ -0,0 +1,117 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertChannelConfig(t *testing.T) {
+	channel, err := NewAlertChannel("On-call", &PagerDutyChannelConfig{RoutingKey: strings.Repeat("a", 32), Severity: "critical"})
+	require.NoError(t, err)
+	assert.Equal(t, AlertChannelPagerDuty, channel.Type)
+	assert.True(t, channel.Enabled)
+	assert.Equal(t, map[string]interface{}{"routing_key": strings.Repeat("a", 32), "severity": "critical"}, channel.Configuration)
+
+	config, err := channel.Config()
+	require.NoError(t, err)
+	assert.Equal(t, &PagerDutyChannelConfig{RoutingKey: strings.Repeat("a", 32), Severity: "critical"}, config)
+
+	channel = &AlertChannel{Type: AlertChannelSlack, Configuration: map[string]interface{}{"webhook_url": "https://hooks.slack.com/services/T0/B0/x", "channel": "#alerts"}}
+	config, err = channel.Config()
+	require.NoError(t, err)
+	assert.Equal(t, "#alerts", config.(*SlackChannelConfig).Channel)
+
+	_, err = (&AlertChannel{Type: "teams"}).Config()
+	assert.Error(t, err)
+
+	for _, config := range []AlertChannelConfig{
+		&EmailChannelConfig{},
+		&EmailChannelConfig{Recipients: []string{"oncall"}},
+		&SlackChannelConfig{WebhookURL: "hooks.slack.com/services/x"},
+		&PagerDutyChannelConfig{RoutingKey: "short"},
+		&PagerDutyChannelConfig{RoutingKey: strings.Repeat("a", 32), Severity: "high"},
+		&WebhookChannelConfig{URL: "https://example.com/alerts", Method: "GET"},
+		&WebhookChannelConfig{URL: "ftp://example.com"},
+	} {
+		assert.Error(t, config.Validate(), "%#v", config)
+	}
+	assert.NoError(t, (&EmailChannelConfig{Recipients: []string{"oncall@example.com"}}).Validate())
+	assert.NoError(t, (&WebhookChannelConfig{URL: "https://example.com/alerts", Method: "put"}).Validate())
+}
+
+func TestAlertChannelsService(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v2/alerts/channels", "PUT /v2/alerts/channels/5":
+			var channel AlertChannel
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&channel))
+			channel.ID = 5
+			json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "data": channel})
+		case "GET /v2/alerts/channels":
+			w.Write([]byte(`{"status":"success","data":[{"id":5,"type":"email"}],"meta":{"page":1,"total_pages":1}}`))
+		case "GET /v2/alerts/channels/5":
+			w.Write([]byte(`{"status":"success","data":{"id":5,"name":"Ops","type":"email","configuration":{"recipients":["ops@example.com"]}}}`))
+		case "DELETE /v2/alerts/channels/5":
+			w.Write([]byte(`{"status":"success"}`))
+		case "POST /v2/alerts/channels/5/test":
+			w.Write([]byte(`{"status":"success","data":{"success":false,"channel_type":"webhook","destination":"example.com","status_code":404,"latency_ms":84,"attempts":1,"error":"404 Not Found","diagnostics":["webhook URL returned 404: check the URL"]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	channel, err := NewAlertChannel("Ops", &EmailChannelConfig{Recipients: []string{"ops@example.com"}})
+	require.NoError(t, err)
+	created, err := client.AlertChannels.Create(ctx, channel)
+	require.NoError(t, err)
+	assert.Equal(t, uint(5), created.ID)
+	assert.Equal(t, AlertChannelEmail, created.Type)
+
+	channels, _, err := client.AlertChannels.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, channels, 1)
+
+	fetched, err := client.AlertChannels.Get(ctx, 5)
+	require.NoError(t, err)
+	config, err := fetched.Config()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ops@example.com"}, config.(*EmailChannelConfig).Recipients)
+
+	require.NoError(t, fetched.SetConfig(&WebhookChannelConfig{URL: "https://example.com/hooks/alerts"}))
+	updated, err := client.AlertChannels.Update(ctx, 5, fetched)
+	require.NoError(t, err)
+	assert.Equal(t, AlertChannelWebhook, updated.Type)
+
+	result, err := client.AlertChannels.Test(ctx, 5)
+	require.NoError(t, err, "a failed delivery is not an error")
+	assert.False(t, result.Success)
+	assert.Equal(t, 404, result.StatusCode)
+	assert.Equal(t, []string{"webhook URL returned 404: check the URL"}, result.Diagnostics)
+
+	require.NoError(t, client.AlertChannels.Delete(ctx, 5))
+
+	_, err = client.AlertChannels.Create(ctx, &AlertChannel{Name: "Broken", Type: AlertChannelSlack, Configuration: map[string]interface{}{}})
+	assert.Error(t, err)
+	_, err = client.AlertChannels.Create(ctx, &AlertChannel{Name: "No type"})
+	assert.Error(t, err)
+	assert.Len(t, requests, 6, "invalid channels are rejected locally")
+
+	_, err = client.AlertChannels.Create(ctx, &AlertChannel{Name: "Teams", Type: "msteams", Configuration: map[string]interface{}{"url": "x"}})
+	assert.NoError(t, err, "types without a typed configuration are passed through")
+}
@@ -0,0 +1,259 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// AlertRulesService handles metric alert rules
+type AlertRulesService struct {
+	client *Client
+}
+
+// Validate checks the rule locally for a missing name or metric, an unknown
+// scope or aggregation, and malformed thresholds
+func (r *AlertRule) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("rule name is required")
+	}
+	if r.MetricName == "" {
+		return fmt.Errorf("metric_name is required")
+	}
+	switch r.ScopeType {
+	case "organization":
+	case "server", "tag", "group":
+		if r.ScopeID == nil && r.ScopeValue == "" {
+			return fmt.Errorf("%s scope requires scope_id or scope_value", r.ScopeType)
+		}
+	default:
+		return fmt.Errorf("invalid scope_type %q", r.ScopeType)
+	}
+	switch r.Aggregation {
+	case "avg", "sum", "min", "max", "count":
+	default:
+		return fmt.Errorf("invalid aggregation %q", r.Aggregation)
+	}
+
+	if r.Conditions.TimeWindow <= 0 {
+		return fmt.Errorf("conditions.time_window must be positive")
+	}
+	if len(r.Conditions.Thresholds) == 0 {
+		return fmt.Errorf("at least one threshold is required")
+	}
+	for i, threshold := range r.Conditions.Thresholds {
+		switch threshold.Operator {
+		case ">", ">=", "<", "<=", "==", "!=":
+		default:
+			return fmt.Errorf("thresholds[%d]: invalid operator %q", i, threshold.Operator)
+		}
+		if !threshold.Severity.Valid() {
+			return fmt.Errorf("thresholds[%d]: invalid severity %q", i, threshold.Severity)
+		}
+		if threshold.Duration < 0 {
+			return fmt.Errorf("thresholds[%d]: duration must not be negative", i)
+		}
+	}
+	return nil
+}
+
+// AlertRulePreview is the result of evaluating a rule against historical metrics
+type AlertRulePreview struct {
+	Start       time.Time           `json:"start"`
+	End         time.Time           `json:"end"`
+	Evaluations int                 `json:"evaluations"` // Evaluation points across all servers in scope
+	Servers     int                 `json:"servers"`     // Servers in the rule's scope
+	Events      []AlertPreviewEvent `json:"events"`      // Alerts that would have fired, oldest first
+}
+
+// AlertPreviewEvent is an alert that would have fired during a preview
+type AlertPreviewEvent struct {
+	ServerUUID string        `json:"server_uuid"`
+	Hostname   string        `json:"hostname,omitempty"`
+	Severity   AlertSeverity `json:"severity"`
+	Threshold  float64       `json:"threshold"`
+	PeakValue  float64       `json:"peak_value"` // Furthest value past the threshold while firing
+	StartedAt  time.Time     `json:"started_at"`
+	ResolvedAt *time.Time    `json:"resolved_at,omitempty"` // Nil when still firing at the end of the range
+}
+
+// Duration returns how long the alert would have fired, up to end for alerts
+// still firing at the end of the preview
+func (e *AlertPreviewEvent) Duration(end time.Time) time.Duration {
+	if e.ResolvedAt != nil {
+		end = *e.ResolvedAt
+	}
+	return end.Sub(e.StartedAt)
+}
+
+// CountBySeverity returns the number of would-have-fired alerts per severity
+func (p *AlertRulePreview) CountBySeverity() map[AlertSeverity]int {
+	counts := make(map[AlertSeverity]int)
+	for _, event := range p.Events {
+		counts[event.Severity]++
+	}
+	return counts
+}
+
+// FiringTime returns the total time alerts would have fired, summed over servers
+func (p *AlertRulePreview) FiringTime() time.Duration {
+	var total time.Duration
+	for i := range p.Events {
+		total += p.Events[i].Duration(p.End)
+	}
+	return total
+}
+
+// Create creates an alert rule. The rule is validated locally first.
+func (s *AlertRulesService) Create(ctx context.Context, rule *AlertRule) (*AlertRule, error) {
+	if rule == nil {
+		return nil, fmt.Errorf("rule is required")
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp StandardResponse
+	resp.Data = &AlertRule{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v2/alerts/rules",
+		Body:   rule,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if created, ok := resp.Data.(*AlertRule); ok {
+		return created, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Get retrieves an alert rule by ID
+func (s *AlertRulesService) Get(ctx context.Context, ruleID uint) (*AlertRule, error) {
+	var resp StandardResponse
+	resp.Data = &AlertRule{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v2/alerts/rules/%d", ruleID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rule, ok := resp.Data.(*AlertRule); ok {
+		return rule, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// List retrieves the organization's alert rules
+func (s *AlertRulesService) List(ctx context.Context, opts *ListOptions) ([]*AlertRule, *PaginationMeta, error) {
+	var resp PaginatedResponse
+	var rules []*AlertRule
+	resp.Data = &rules
+
+	req := &Request{
+		Method: "GET",
+		Path:   "/v2/alerts/rules",
+		Result: &resp,
+	}
+
+	if opts != nil {
+		req.Query = opts.ToQuery()
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rules, resp.Meta, nil
+}
+
+// Update replaces an alert rule. The rule is validated locally first.
+func (s *AlertRulesService) Update(ctx context.Context, ruleID uint, rule *AlertRule) (*AlertRule, error) {
+	if rule == nil {
+		return nil, fmt.Errorf("rule is required")
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp StandardResponse
+	resp.Data = &AlertRule{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v2/alerts/rules/%d", ruleID),
+		Body:   rule,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if updated, ok := resp.Data.(*AlertRule); ok {
+		return updated, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Delete deletes an alert rule and resolves its firing alerts
+func (s *AlertRulesService) Delete(ctx context.Context, ruleID uint) error {
+	var resp StandardResponse
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v2/alerts/rules/%d", ruleID),
+		Result: &resp,
+	})
+	return err
+}
+
+// Preview evaluates a rule, saved or not, against the historical metrics in
+// timeRange and returns the alerts it would have fired, so thresholds can be
+// tuned before the rule is enabled. No notifications are sent. A nil
+// timeRange previews the last 7 days.
+func (s *AlertRulesService) Preview(ctx context.Context, rule *AlertRule, timeRange *QueryTimeRange) (*AlertRulePreview, error) {
+	if rule == nil {
+		return nil, fmt.Errorf("rule is required")
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+	if timeRange == nil {
+		timeRange = Last7Days()
+	}
+	if !timeRange.End.After(timeRange.Start) {
+		return nil, fmt.Errorf("time range end must be after start")
+	}
+
+	start, end := timeRange.ToStrings()
+	var resp StandardResponse
+	resp.Data = &AlertRulePreview{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v2/alerts/rules/preview",
+		Body: map[string]interface{}{
+			"rule":  rule,
+			"start": start,
+			"end":   end,
+		},
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if preview, ok := resp.Data.(*AlertRulePreview); ok {
+		return preview, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
@@ -0,0 +1,156 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAlertRule() *AlertRule {
+	return &AlertRule{
+		Name:        "High CPU",
+		ScopeType:   "organization",
+		MetricName:  "cpu.usage_percent",
+		Aggregation: "avg",
+		Conditions: AlertConditions{
+			TimeWindow: 5,
+			Thresholds: []AlertThreshold{
+				{Value: 80, Operator: ">", Duration: 10, Severity: "warning"},
+				{Value: 95, Operator: ">=", Duration: 5, Severity: "critical"},
+			},
+		},
+		ChannelIDs: []uint{5},
+	}
+}
+
+func TestAlertRuleValidate(t *testing.T) {
+	require.NoError(t, testAlertRule().Validate())
+
+	for name, mutate := range map[string]func(*AlertRule){
+		"no name":           func(r *AlertRule) { r.Name = "" },
+		"no metric":         func(r *AlertRule) { r.MetricName = "" },
+		"bad scope":         func(r *AlertRule) { r.ScopeType = "cluster" },
+		"server scope":      func(r *AlertRule) { r.ScopeType = "server" },
+		"bad aggregation":   func(r *AlertRule) { r.Aggregation = "p99" },
+		"no window":         func(r *AlertRule) { r.Conditions.TimeWindow = 0 },
+		"no thresholds":     func(r *AlertRule) { r.Conditions.Thresholds = nil },
+		"bad operator":      func(r *AlertRule) { r.Conditions.Thresholds[0].Operator = "=>" },
+		"bad severity":      func(r *AlertRule) { r.Conditions.Thresholds[1].Severity = "high" },
+		"negative duration": func(r *AlertRule) { r.Conditions.Thresholds[0].Duration = -1 },
+	} {
+		rule := testAlertRule()
+		mutate(rule)
+		assert.Error(t, rule.Validate(), name)
+	}
+
+	rule := testAlertRule()
+	rule.ScopeType, rule.ScopeValue = "tag", "env:prod"
+	assert.NoError(t, rule.Validate())
+}
+
+func TestAlertRulesService_CRUD(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v2/alerts/rules", "PUT /v2/alerts/rules/9":
+			var rule AlertRule
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&rule))
+			rule.ID = 9
+			json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "data": rule})
+		case "GET /v2/alerts/rules":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data":   []AlertRule{{ID: 9, Name: "High CPU"}},
+				"meta":   PaginationMeta{Page: 1, TotalPages: 1},
+			})
+		case "GET /v2/alerts/rules/9":
+			w.Write([]byte(`{"status":"success","data":{"id":9,"name":"High CPU","conditions":{"time_window":5,"thresholds":[{"value":80,"operator":">","duration":10,"severity":"warning"}]}}}`))
+		case "DELETE /v2/alerts/rules/9":
+			w.Write([]byte(`{"status":"success"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	created, err := client.AlertRules.Create(ctx, testAlertRule())
+	require.NoError(t, err)
+	assert.Equal(t, uint(9), created.ID)
+	assert.Len(t, created.Conditions.Thresholds, 2)
+	assert.Equal(t, []uint{5}, created.ChannelIDs)
+
+	rules, _, err := client.AlertRules.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, rules, 1)
+
+	fetched, err := client.AlertRules.Get(ctx, 9)
+	require.NoError(t, err)
+	require.Len(t, fetched.Conditions.Thresholds, 1)
+	assert.Equal(t, 80.0, fetched.Conditions.Thresholds[0].Value)
+
+	rule := testAlertRule()
+	rule.Enabled = true
+	updated, err := client.AlertRules.Update(ctx, 9, rule)
+	require.NoError(t, err)
+	assert.True(t, updated.Enabled)
+
+	require.NoError(t, client.AlertRules.Delete(ctx, 9))
+
+	rule.Aggregation = "median"
+	_, err = client.AlertRules.Create(ctx, rule)
+	assert.Error(t, err)
+	_, err = client.AlertRules.Update(ctx, 9, nil)
+	assert.Error(t, err)
+	assert.Len(t, requests, 5, "invalid rules are rejected locally")
+}
+
+func TestAlertRulesService_Preview(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST /v2/alerts/rules/preview", r.Method+" "+r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{
+			"start":"2026-10-01T00:00:00Z","end":"2026-10-02T00:00:00Z","evaluations":2880,"servers":2,
+			"events":[
+				{"server_uuid":"srv-1","severity":"warning","threshold":80,"peak_value":91.5,"started_at":"2026-10-01T10:00:00Z","resolved_at":"2026-10-01T10:30:00Z"},
+				{"server_uuid":"srv-2","severity":"critical","threshold":95,"peak_value":99,"started_at":"2026-10-01T23:00:00Z"}
+			]}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	preview, err := client.AlertRules.Preview(ctx, testAlertRule(), &QueryTimeRange{Start: start, End: start.Add(24 * time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-01T00:00:00Z", body["start"])
+	assert.Equal(t, "2026-10-02T00:00:00Z", body["end"])
+	assert.Equal(t, "cpu.usage_percent", body["rule"].(map[string]interface{})["metric_name"])
+
+	assert.Equal(t, 2, preview.Servers)
+	require.Len(t, preview.Events, 2)
+	assert.Equal(t, map[AlertSeverity]int{AlertSeverityWarning: 1, AlertSeverityCritical: 1}, preview.CountBySeverity())
+	assert.Equal(t, 30*time.Minute, preview.Events[0].Duration(preview.End))
+	assert.Equal(t, 90*time.Minute, preview.FiringTime(), "unresolved alerts fire until the end of the range")
+
+	_, err = client.AlertRules.Preview(ctx, testAlertRule(), &QueryTimeRange{Start: start, End: start})
+	assert.Error(t, err)
+	_, err = client.AlertRules.Preview(ctx, &AlertRule{Name: "incomplete"}, nil)
+	assert.Error(t, err)
+}
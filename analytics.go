@@ -1,8 +1,11 @@
+//go:build !nexmonyx_agent
+
 package nexmonyx
 
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
 // AnalyticsService handles analytics-related operations
@@ -96,7 +99,7 @@ func (s *AnalyticsService) GetServiceStatus(ctx context.Context) (*AIServiceStat
 //   - serverUUID: Server UUID
 //   - startTime: Start of time range (RFC3339 format)
 //   - endTime: End of time range (RFC3339 format)
-//   - metricTypes: Optional comma-separated metric types (cpu, memory, disk, network)
+//   - metricTypes: Optional metric types to include (cpu, memory, disk, network); all types when omitted
 // Returns: Historical trends with aggregated metrics
 func (s *AnalyticsService) GetHardwareTrends(ctx context.Context, serverUUID, startTime, endTime string, metricTypes ...string) (*HardwareTrends, error) {
 	var resp struct {
@@ -110,7 +113,7 @@ func (s *AnalyticsService) GetHardwareTrends(ctx context.Context, serverUUID, st
 		"end_time":   endTime,
 	}
 	if len(metricTypes) > 0 {
-		query["metric_types"] = metricTypes[0]
+		query["metric_types"] = strings.Join(metricTypes, ",")
 	}
 
 	_, err := s.client.Do(ctx, &Request{
@@ -193,7 +196,6 @@ func (s *AnalyticsService) GetHardwarePredictions(ctx context.Context, serverUUI
 // GetFleetOverview retrieves organization-wide fleet statistics
 // Authentication: JWT Token required
 // Endpoint: GET /v2/analytics/fleet/overview
-// Parameters: Optional query parameters for filtering and aggregation
 // Returns: Fleet-wide statistics including server counts, health distribution, resource utilization
 func (s *AnalyticsService) GetFleetOverview(ctx context.Context) (*FleetOverview, error) {
 	var resp struct {
@@ -270,7 +272,6 @@ func (s *AnalyticsService) AnalyzeCorrelations(ctx context.Context, req *Correla
 // BuildDependencyGraph builds dependency graph for infrastructure
 // Authentication: JWT Token required
 // Endpoint: GET /v2/analytics/graph/dependencies
-// Parameters: Optional query parameters for graph visualization
 // Returns: Dependency graph showing relationships between servers, services, and components
 func (s *AnalyticsService) BuildDependencyGraph(ctx context.Context) (*DependencyGraph, error) {
 	var resp struct {
@@ -1,3 +1,5 @@
+//go:build !nexmonyx_agent
+
 package nexmonyx
 
 import (
@@ -142,6 +144,23 @@ func TestAnalyticsService_GetHardwareTrends(t *testing.T) {
 	assert.Equal(t, "server-123", trends.ServerUUID)
 }
 
+func TestAnalyticsService_GetHardwareTrends_MultipleMetricTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "cpu,memory,disk", r.URL.Query().Get("metric_types"))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data":   map[string]interface{}{"server_uuid": "server-123"},
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(&Config{BaseURL: server.URL})
+	_, err := client.Analytics.GetHardwareTrends(context.Background(), "server-123", "2024-01-01T00:00:00Z", "2024-01-07T00:00:00Z", "cpu", "memory", "disk")
+	assert.NoError(t, err)
+}
+
 func TestAnalyticsService_GetHardwareHealth(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "GET", r.Method)
@@ -9,8 +9,16 @@ import (
 // Unified API Keys Service
 // =============================================================================
 
-// CreateUnified creates a new unified API key
+// CreateUnified creates a new unified API key. If the organization's policies
+// have been fetched with Policies.Get, the request is checked against the API
+// key policy first and a *ValidationError is returned for violations.
 func (s *APIKeysService) CreateUnified(ctx context.Context, req *CreateUnifiedAPIKeyRequest) (*CreateUnifiedAPIKeyResponse, error) {
+	if err := s.client.Policies.checkCached("POST", "/v2/api-keys", func(p *OrganizationPolicies) []ValidationIssue {
+		return p.APIKeys.Check(req)
+	}); err != nil {
+		return nil, err
+	}
+
 	var resp StandardResponse
 	result := &CreateUnifiedAPIKeyResponse{}
 	resp.Data = result
@@ -131,6 +139,27 @@ func (s *APIKeysService) DeleteUnified(ctx context.Context, keyID string) error
 	return err
 }
 
+// UndeleteUnified restores a deleted API key. Deleted keys are listed with
+// ListOptions.OnlyDeleted.
+func (s *APIKeysService) UndeleteUnified(ctx context.Context, keyID string) (*UnifiedAPIKey, error) {
+	var resp StandardResponse
+	resp.Data = &UnifiedAPIKey{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v2/api-keys/%s/undelete", keyID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if key, ok := resp.Data.(*UnifiedAPIKey); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
 // RevokeUnified revokes a unified API key
 func (s *APIKeysService) RevokeUnified(ctx context.Context, keyID string) error {
 	var resp StandardResponse
@@ -185,12 +214,41 @@ func (s *APIKeysService) RegenerateUnified(ctx context.Context, keyID string) (*
 	return result, nil
 }
 
+// UnifiedAPIKeyRotation is returned when a unified API key is rotated
+type UnifiedAPIKeyRotation struct {
+	CreateUnifiedAPIKeyResponse
+	PreviousKeyExpiresAt *CustomTime `json:"previous_key_expires_at,omitempty"` // The old key value keeps working until then
+}
+
+// Rotate issues a new value for a unified API key. Unlike RegenerateUnified,
+// the old value is not revoked immediately but stays valid until
+// PreviousKeyExpiresAt so deployments using it can be updated first.
+func (s *APIKeysService) Rotate(ctx context.Context, keyID string) (*UnifiedAPIKeyRotation, error) {
+	var resp StandardResponse
+	resp.Data = &UnifiedAPIKeyRotation{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v2/api-keys/%s/rotate", keyID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rotation, ok := resp.Data.(*UnifiedAPIKeyRotation); ok {
+		return rotation, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
 // =============================================================================
 // Organization-scoped API Key operations
 // =============================================================================
 
 // CreateForOrganization creates a new API key for a specific organization
 func (s *APIKeysService) CreateForOrganization(ctx context.Context, orgID string, req *CreateUnifiedAPIKeyRequest) (*CreateUnifiedAPIKeyResponse, error) {
+	orgID = s.client.organizationID(ctx, orgID)
 	var resp StandardResponse
 	result := &CreateUnifiedAPIKeyResponse{}
 	resp.Data = result
@@ -210,6 +268,7 @@ func (s *APIKeysService) CreateForOrganization(ctx context.Context, orgID string
 
 // ListForOrganization retrieves API keys for a specific organization
 func (s *APIKeysService) ListForOrganization(ctx context.Context, orgID string, opts *ListUnifiedAPIKeysOptions) ([]*UnifiedAPIKey, *PaginationMeta, error) {
+	orgID = s.client.organizationID(ctx, orgID)
 	var resp PaginatedResponse
 	var keys []*UnifiedAPIKey
 	resp.Data = &keys
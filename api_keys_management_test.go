@@ -775,3 +775,26 @@ func TestAPIKeysService_RegenerateAPIKey(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIKeysService_Rotate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v2/api-keys/key-123/rotate", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{"key_id":"key-123","key_value":"nxm_new","full_token":"nxm_new","previous_key_expires_at":"2026-10-16T12:00:00Z"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	rotation, err := client.APIKeys.Rotate(context.Background(), "key-123")
+	require.NoError(t, err)
+	assert.Equal(t, "key-123", rotation.KeyID)
+	assert.Equal(t, "nxm_new", rotation.KeyValue)
+	require.NotNil(t, rotation.PreviousKeyExpiresAt)
+	assert.Equal(t, 16, rotation.PreviousKeyExpiresAt.Day())
+}
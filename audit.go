@@ -3,6 +3,7 @@ package nexmonyx
 import (
 	"context"
 	"fmt"
+	"io"
 )
 
 // AuditService handles audit log operations and compliance tracking
@@ -15,7 +16,7 @@ type AuditService struct {
 // Endpoint: GET /v1/audit/logs
 // Parameters:
 //   - opts: Optional pagination options
-//   - filters: Optional filters (user_id, action, resource_type, start_date, end_date, severity)
+//   - filters: Optional filters (user_id, action, resource_type, resource_id, start_date, end_date, severity, ip_address, compliance_flag, change_reason)
 // Returns: Array of AuditLog objects with pagination metadata
 func (s *AuditService) GetAuditLogs(ctx context.Context, opts *PaginationOptions, filters map[string]interface{}) ([]AuditLog, *PaginationMeta, error) {
 	var resp struct {
@@ -59,6 +60,12 @@ func (s *AuditService) GetAuditLogs(ctx context.Context, opts *PaginationOptions
 		if ipAddress, ok := filters["ip_address"].(string); ok && ipAddress != "" {
 			queryParams["ip_address"] = ipAddress
 		}
+		if complianceFlag, ok := filters["compliance_flag"].(string); ok && complianceFlag != "" {
+			queryParams["compliance_flag"] = complianceFlag
+		}
+		if changeReason, ok := filters["change_reason"].(string); ok && changeReason != "" {
+			queryParams["change_reason"] = changeReason
+		}
 	}
 
 	req := &Request{
@@ -111,11 +118,59 @@ func (s *AuditService) GetAuditLog(ctx context.Context, id uint) (*AuditLog, err
 //   - filters: Optional filters (same as GetAuditLogs)
 // Returns: Exported audit logs as byte array
 func (s *AuditService) ExportAuditLogs(ctx context.Context, format string, filters map[string]interface{}) ([]byte, error) {
+	resp, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v1/audit/logs/export",
+		Body:   auditExportBody(format, filters),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// ExportAuditLogsTo streams exported audit logs to w without buffering the export in memory
+// Authentication: JWT Token required
+// Endpoint: POST /v1/audit/logs/export
+// Parameters:
+//   - format: Export format (csv, json, pdf)
+//   - filters: Optional filters (same as GetAuditLogs)
+//   - w: Destination for the exported data (e.g. an *os.File for compliance archiving)
+// Returns: Number of bytes written to w
+func (s *AuditService) ExportAuditLogsTo(ctx context.Context, format string, filters map[string]interface{}, w io.Writer) (int64, error) {
+	n, err := s.client.download(ctx, &Request{
+		Method: "POST",
+		Path:   "/v1/audit/logs/export",
+		Body:   auditExportBody(format, filters),
+	}, w)
+	if err != nil {
+		return n, fmt.Errorf("failed to export audit logs: %w", err)
+	}
+
+	return n, nil
+}
+
+// ExportAuditLogsCSV streams audit logs matching filters to w as CSV
+// Authentication: JWT Token required
+// Endpoint: POST /v1/audit/logs/export
+func (s *AuditService) ExportAuditLogsCSV(ctx context.Context, filters map[string]interface{}, w io.Writer) (int64, error) {
+	return s.ExportAuditLogsTo(ctx, "csv", filters, w)
+}
+
+// ExportAuditLogsJSON streams audit logs matching filters to w as JSON
+// Authentication: JWT Token required
+// Endpoint: POST /v1/audit/logs/export
+func (s *AuditService) ExportAuditLogsJSON(ctx context.Context, filters map[string]interface{}, w io.Writer) (int64, error) {
+	return s.ExportAuditLogsTo(ctx, "json", filters, w)
+}
+
+// auditExportBody builds the export request body from the GetAuditLogs filter map
+func auditExportBody(format string, filters map[string]interface{}) map[string]interface{} {
 	body := map[string]interface{}{
 		"format": format,
 	}
 
-	// Add filters to request body
 	if filters != nil {
 		if userID, ok := filters["user_id"].(uint); ok && userID > 0 {
 			body["user_id"] = userID
@@ -126,6 +181,9 @@ func (s *AuditService) ExportAuditLogs(ctx context.Context, format string, filte
 		if resourceType, ok := filters["resource_type"].(string); ok && resourceType != "" {
 			body["resource_type"] = resourceType
 		}
+		if resourceID, ok := filters["resource_id"].(string); ok && resourceID != "" {
+			body["resource_id"] = resourceID
+		}
 		if startDate, ok := filters["start_date"].(string); ok && startDate != "" {
 			body["start_date"] = startDate
 		}
@@ -135,18 +193,18 @@ func (s *AuditService) ExportAuditLogs(ctx context.Context, format string, filte
 		if severity, ok := filters["severity"].(string); ok && severity != "" {
 			body["severity"] = severity
 		}
+		if ipAddress, ok := filters["ip_address"].(string); ok && ipAddress != "" {
+			body["ip_address"] = ipAddress
+		}
+		if complianceFlag, ok := filters["compliance_flag"].(string); ok && complianceFlag != "" {
+			body["compliance_flag"] = complianceFlag
+		}
+		if changeReason, ok := filters["change_reason"].(string); ok && changeReason != "" {
+			body["change_reason"] = changeReason
+		}
 	}
 
-	resp, err := s.client.Do(ctx, &Request{
-		Method: "POST",
-		Path:   "/v1/audit/logs/export",
-		Body:   body,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	return resp.Body, nil
+	return body
 }
 
 // GetAuditStatistics retrieves comprehensive audit activity statistics
@@ -1,6 +1,7 @@
 package nexmonyx
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"net/http"
@@ -104,6 +105,7 @@ func TestAuditService_GetAuditLogs_WithFilters(t *testing.T) {
 		assert.Equal(t, "delete", r.URL.Query().Get("action"))
 		assert.Equal(t, "critical", r.URL.Query().Get("severity"))
 		assert.Equal(t, "2024-01-01T00:00:00Z", r.URL.Query().Get("start_date"))
+		assert.Equal(t, "gdpr", r.URL.Query().Get("compliance_flag"))
 
 		userID := uint(10)
 		response := struct {
@@ -148,10 +150,11 @@ func TestAuditService_GetAuditLogs_WithFilters(t *testing.T) {
 	logs, meta, err := client.Audit.GetAuditLogs(context.Background(),
 		nil,
 		map[string]interface{}{
-			"user_id":    uint(10),
-			"action":     "delete",
-			"severity":   "critical",
-			"start_date": "2024-01-01T00:00:00Z",
+			"user_id":         uint(10),
+			"action":          "delete",
+			"severity":        "critical",
+			"start_date":      "2024-01-01T00:00:00Z",
+			"compliance_flag": "gdpr",
 		})
 	require.NoError(t, err)
 	assert.Len(t, logs, 1)
@@ -255,6 +258,94 @@ func TestAuditService_ExportAuditLogs(t *testing.T) {
 	assert.Contains(t, string(data), "ID,Action,Resource")
 }
 
+func TestAuditService_ExportAuditLogsCSV(t *testing.T) {
+	csvData := "ID,Action,Resource,User,Timestamp\n1,update,server,admin@example.com,2024-01-01T00:00:00Z\n"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/audit/logs/export", r.URL.Path)
+		assert.NotEmpty(t, r.Header.Get("Authorization"))
+
+		var reqBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&reqBody)
+		assert.Equal(t, "csv", reqBody["format"])
+		assert.Equal(t, "soc2", reqBody["compliance_flag"])
+		assert.Equal(t, "2024-01-01T00:00:00Z", reqBody["start_date"])
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(csvData))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	n, err := client.Audit.ExportAuditLogsCSV(context.Background(),
+		map[string]interface{}{
+			"compliance_flag": "soc2",
+			"start_date":      "2024-01-01T00:00:00Z",
+		}, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(csvData)), n)
+	assert.Equal(t, csvData, buf.String())
+}
+
+func TestAuditService_ExportAuditLogsJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&reqBody)
+		assert.Equal(t, "json", reqBody["format"])
+		assert.Equal(t, float64(10), reqBody["user_id"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"id":1,"action":"create"}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	_, err = client.Audit.ExportAuditLogsJSON(context.Background(),
+		map[string]interface{}{"user_id": uint(10)}, &buf)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"id":1,"action":"create"}]`, buf.String())
+}
+
+func TestAuditService_ExportAuditLogsTo_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(StandardResponse{
+			Status:  "error",
+			Message: "Audit export requires admin access",
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	_, err = client.Audit.ExportAuditLogsTo(context.Background(), "csv", nil, &buf)
+	require.Error(t, err)
+	var forbidden *ForbiddenError
+	assert.ErrorAs(t, err, &forbidden)
+	assert.Zero(t, buf.Len())
+}
+
 func TestAuditService_GetAuditStatistics(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "GET", r.Method)
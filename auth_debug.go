@@ -17,7 +17,9 @@ func (c *Client) DebugAuthHeaders(ctx context.Context) error {
 
 	// Check current authentication configuration
 	fmt.Println("Current Authentication Configuration:")
-	if c.config.Auth.Token != "" {
+	if c.config.Auth.WorkloadIdentity != nil {
+		fmt.Println("  Auth Type: Workload Identity")
+	} else if c.config.Auth.Token != "" {
 		fmt.Println("  Auth Type: JWT Token")
 	} else if c.config.Auth.APIKey != "" && c.config.Auth.APISecret != "" {
 		fmt.Println("  Auth Type: API Key/Secret")
@@ -86,9 +88,10 @@ func (c *Client) DebugAuthHeaders(ctx context.Context) error {
 
 // testAuthRequest performs a test request with custom headers
 func (c *Client) testAuthRequest(ctx context.Context, headers map[string]string) error {
-	// Create a custom HTTP client for this test
+	// Create a custom HTTP client for this test, keeping the TLS and proxy settings
 	client := &http.Client{
-		Timeout: 10 * time.Second,
+		Transport: c.client.GetClient().Transport,
+		Timeout:   10 * time.Second,
 	}
 
 	// Create request
@@ -100,7 +103,7 @@ func (c *Client) testAuthRequest(ctx context.Context, headers map[string]string)
 	// Set standard headers
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("User-Agent", c.config.userAgent())
 
 	// Set custom auth headers
 	for k, v := range headers {
@@ -402,3 +402,28 @@ func (o *GetPendingJobsOptions) ToQuery() map[string]string {
 	}
 	return params
 }
+
+// JobOperation returns an Operation that tracks a background job until it completes
+//
+// Example:
+//
+//	job, _, _ := client.BackgroundJobs.CreateDataExportJob(ctx, orgID, "csv", []string{"servers"})
+//	finished, err := client.BackgroundJobs.JobOperation(job.ID, nil).Wait(ctx)
+func (s *BackgroundJobsService) JobOperation(jobID uint, opts *OperationOptions) *Operation[BackgroundJob] {
+	return NewOperation(fmt.Sprintf("background-job-%d", jobID), func(ctx context.Context) (*BackgroundJob, OperationStatus, error) {
+		job, _, err := s.Get(ctx, jobID)
+		if err != nil {
+			return nil, OperationStatus{}, err
+		}
+
+		status := OperationStatus{
+			State:    normalizeOperationState(job.Status),
+			Progress: job.Progress,
+			Message:  job.ProgressText,
+		}
+		if job.Error != "" {
+			status.Message = job.Error
+		}
+		return job, status, nil
+	}, opts)
+}
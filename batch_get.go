@@ -0,0 +1,178 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+const defaultBatchGetConcurrency = 10
+
+// BatchGetOptions configures GetMany lookups
+type BatchGetOptions struct {
+	// Concurrency limits parallel single-item requests when the batch endpoint
+	// is unavailable (default: 10)
+	Concurrency int
+
+	// DisableBatchEndpoint skips the batch endpoint and always fans out
+	// single-item requests
+	DisableBatchEndpoint bool
+}
+
+// BatchGetResult holds the resources found by a GetMany call keyed by UUID,
+// along with the error for every UUID that could not be retrieved
+type BatchGetResult[T any] struct {
+	Items  map[string]*T
+	Errors map[string]error
+}
+
+// batchGetResponse is the response envelope returned by batch-get endpoints
+type batchGetResponse[T any] struct {
+	Status string `json:"status"`
+	Data   []*T   `json:"data"`
+}
+
+// GetMany retrieves multiple servers by UUID in as few requests as possible.
+// It uses the batch endpoint when available and falls back to bounded concurrent
+// GetByUUID calls otherwise. Per-server failures are reported in Errors rather
+// than failing the whole call.
+// Authentication: JWT Token or API key required
+// Endpoint: POST /v1/servers/batch-get
+func (s *ServersService) GetMany(ctx context.Context, uuids []string, opts *BatchGetOptions) (*BatchGetResult[Server], error) {
+	batch := func(ctx context.Context, ids []string) ([]*Server, error) {
+		var resp batchGetResponse[Server]
+		_, err := s.client.Do(ctx, &Request{
+			Method: "POST",
+			Path:   "/v1/servers/batch-get",
+			Body:   map[string]interface{}{"uuids": ids},
+			Result: &resp,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	}
+	key := func(server *Server) string { return server.ServerUUID }
+
+	return batchGet(ctx, "server", uuids, opts, batch, s.GetByUUID, key)
+}
+
+// GetMany retrieves multiple probes by UUID in as few requests as possible.
+// It uses the batch endpoint when available and falls back to bounded concurrent
+// Get calls otherwise. Per-probe failures are reported in Errors rather than
+// failing the whole call.
+// Authentication: JWT Token or API key required
+// Endpoint: POST /v2/probes/batch-get
+func (s *ProbesService) GetMany(ctx context.Context, uuids []string, opts *BatchGetOptions) (*BatchGetResult[MonitoringProbe], error) {
+	batch := func(ctx context.Context, ids []string) ([]*MonitoringProbe, error) {
+		var resp batchGetResponse[MonitoringProbe]
+		_, err := s.client.Do(ctx, &Request{
+			Method: "POST",
+			Path:   "/v2/probes/batch-get",
+			Body:   map[string]interface{}{"uuids": ids},
+			Result: &resp,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	}
+	key := func(probe *MonitoringProbe) string { return probe.ProbeUUID }
+
+	return batchGet(ctx, "probe", uuids, opts, batch, s.Get, key)
+}
+
+// batchGet resolves uuids through the batch function, falling back to fanning
+// out single lookups when the API does not support batch retrieval
+func batchGet[T any](
+	ctx context.Context,
+	resource string,
+	uuids []string,
+	opts *BatchGetOptions,
+	batch func(context.Context, []string) ([]*T, error),
+	single func(context.Context, string) (*T, error),
+	key func(*T) string,
+) (*BatchGetResult[T], error) {
+	if opts == nil {
+		opts = &BatchGetOptions{}
+	}
+
+	ids := make([]string, 0, len(uuids))
+	seen := make(map[string]bool, len(uuids))
+	for _, id := range uuids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	result := &BatchGetResult[T]{
+		Items:  make(map[string]*T, len(ids)),
+		Errors: make(map[string]error),
+	}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	if !opts.DisableBatchEndpoint {
+		items, err := batch(ctx, ids)
+		if err == nil {
+			for _, item := range items {
+				if item != nil {
+					result.Items[key(item)] = item
+				}
+			}
+			for _, id := range ids {
+				if _, ok := result.Items[id]; !ok {
+					result.Errors[id] = &NotFoundError{Resource: resource, ID: id}
+				}
+			}
+			return result, nil
+		}
+		if !isUnsupportedEndpoint(err) {
+			return nil, fmt.Errorf("failed to batch get %ss: %w", resource, err)
+		}
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBatchGetConcurrency
+	}
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+	for _, id := range ids {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return result, ctx.Err()
+		}
+
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			item, err := single(ctx, id)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err != nil:
+				result.Errors[id] = err
+			case item == nil:
+				result.Errors[id] = &NotFoundError{Resource: resource, ID: id}
+			default:
+				result.Items[id] = item
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	return result, ctx.Err()
+}
@@ -0,0 +1,133 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServersService_GetMany_BatchEndpoint(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/servers/batch-get", r.URL.Path)
+
+		var body struct {
+			UUIDs []string `json:"uuids"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"srv-1", "srv-2", "srv-3"}, body.UUIDs)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data": []Server{
+				{ServerUUID: "srv-1", Hostname: "web-01"},
+				{ServerUUID: "srv-2", Hostname: "web-02"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	result, err := client.Servers.GetMany(context.Background(), []string{"srv-1", "srv-2", "srv-2", "", "srv-3"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	require.Len(t, result.Items, 2)
+	assert.Equal(t, "web-01", result.Items["srv-1"].Hostname)
+	assert.Equal(t, "web-02", result.Items["srv-2"].Hostname)
+	require.Len(t, result.Errors, 1)
+	assert.True(t, IsNotFound(result.Errors["srv-3"]))
+}
+
+func TestServersService_GetMany_FallbackFanOut(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/servers/batch-get" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			prev := atomic.LoadInt32(&maxInFlight)
+			if current <= prev || atomic.CompareAndSwapInt32(&maxInFlight, prev, current) {
+				break
+			}
+		}
+
+		uuid := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/server/"), "/details")
+		if uuid == "srv-forbidden" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data":   Server{ServerUUID: uuid, Hostname: "host-" + uuid},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	uuids := []string{"srv-1", "srv-2", "srv-3", "srv-4", "srv-forbidden"}
+	result, err := client.Servers.GetMany(context.Background(), uuids, &BatchGetOptions{Concurrency: 2})
+	require.NoError(t, err)
+	assert.Len(t, result.Items, 4)
+	assert.Equal(t, "host-srv-3", result.Items["srv-3"].Hostname)
+	require.Len(t, result.Errors, 1)
+	assert.True(t, IsForbidden(result.Errors["srv-forbidden"]))
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+}
+
+func TestServersService_GetMany_BatchError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	_, err = client.Servers.GetMany(context.Background(), []string{"srv-1"}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to batch get servers")
+}
+
+func TestProbesService_GetMany(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		assert.Equal(t, "/v2/probes/probe-1", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data":   MonitoringProbe{ProbeUUID: "probe-1", Name: "API health"},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	result, err := client.Probes.GetMany(context.Background(), []string{"probe-1"}, &BatchGetOptions{DisableBatchEndpoint: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/v2/probes/probe-1"}, paths)
+	assert.Equal(t, "API health", result.Items["probe-1"].Name)
+	assert.Empty(t, result.Errors)
+}
@@ -7,6 +7,7 @@ import (
 
 // GetBillingInfo retrieves billing information for an organization
 func (s *BillingService) GetBillingInfo(ctx context.Context, organizationID string) (*BillingInfo, error) {
+	organizationID = s.client.organizationID(ctx, organizationID)
 	var resp StandardResponse
 	resp.Data = &BillingInfo{}
 
@@ -27,6 +28,7 @@ func (s *BillingService) GetBillingInfo(ctx context.Context, organizationID stri
 
 // GetSubscription retrieves subscription details
 func (s *BillingService) GetSubscription(ctx context.Context, organizationID string) (*Subscription, error) {
+	organizationID = s.client.organizationID(ctx, organizationID)
 	var resp StandardResponse
 	resp.Data = &Subscription{}
 
@@ -47,6 +49,7 @@ func (s *BillingService) GetSubscription(ctx context.Context, organizationID str
 
 // ListInvoices retrieves invoices for an organization
 func (s *BillingService) ListInvoices(ctx context.Context, organizationID string, opts *ListOptions) ([]*Invoice, *PaginationMeta, error) {
+	organizationID = s.client.organizationID(ctx, organizationID)
 	var resp PaginatedResponse
 	var invoices []*Invoice
 	resp.Data = &invoices
@@ -69,8 +72,11 @@ func (s *BillingService) ListInvoices(ctx context.Context, organizationID string
 	return invoices, resp.Meta, nil
 }
 
-// UpdatePaymentMethod updates the payment method for an organization
+// UpdatePaymentMethod updates the payment method for an organization. To
+// switch to a payment method already attached in Stripe, pass only its ID:
+// &PaymentMethod{ID: "pm_..."}.
 func (s *BillingService) UpdatePaymentMethod(ctx context.Context, organizationID string, paymentMethod *PaymentMethod) error {
+	organizationID = s.client.organizationID(ctx, organizationID)
 	var resp StandardResponse
 
 	_, err := s.client.Do(ctx, &Request{
@@ -82,6 +88,111 @@ func (s *BillingService) UpdatePaymentMethod(ctx context.Context, organizationID
 	return err
 }
 
+// ProrationBehavior decides how ChangePlan bills a change made mid-period
+type ProrationBehavior string
+
+const (
+	// ProrationCreate credits unused time on the old plan and charges for the
+	// new plan on the next invoice
+	ProrationCreate ProrationBehavior = "create_prorations"
+	// ProrationAlwaysInvoice prorates and invoices the difference immediately
+	ProrationAlwaysInvoice ProrationBehavior = "always_invoice"
+	// ProrationNone switches plans without crediting or charging for the
+	// current period
+	ProrationNone ProrationBehavior = "none"
+)
+
+// GetUpcomingInvoice previews the next invoice for an organization, including
+// prorations from plan changes made since the last one
+// Authentication: JWT Token required
+// Endpoint: GET /v1/organizations/:id/invoices/upcoming
+func (s *BillingService) GetUpcomingInvoice(ctx context.Context, organizationID string) (*Invoice, error) {
+	organizationID = s.client.organizationID(ctx, organizationID)
+	var resp StandardResponse
+	resp.Data = &Invoice{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/organizations/%s/invoices/upcoming", organizationID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice, ok := resp.Data.(*Invoice); ok {
+		return invoice, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// ApplyCoupon applies a coupon or promotion code to an organization's
+// subscription, replacing any discount already applied
+// Authentication: JWT Token required
+// Endpoint: POST /v1/organizations/:id/subscription/coupon
+func (s *BillingService) ApplyCoupon(ctx context.Context, organizationID, couponCode string) (*Subscription, error) {
+	if couponCode == "" {
+		return nil, fmt.Errorf("coupon code is required")
+	}
+	organizationID = s.client.organizationID(ctx, organizationID)
+	var resp StandardResponse
+	resp.Data = &Subscription{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/organizations/%s/subscription/coupon", organizationID),
+		Body:   map[string]string{"coupon_code": couponCode},
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if sub, ok := resp.Data.(*Subscription); ok {
+		return sub, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// ChangePlan moves an organization's subscription to planID. proration decides
+// how the rest of the current period is billed; an empty value uses
+// ProrationCreate. Use GetUpcomingInvoice afterwards to see the prorations.
+// Authentication: JWT Token required
+// Endpoint: PUT /v1/organizations/:id/subscription/plan
+func (s *BillingService) ChangePlan(ctx context.Context, organizationID, planID string, proration ProrationBehavior) (*Subscription, error) {
+	if planID == "" {
+		return nil, fmt.Errorf("plan ID is required")
+	}
+	switch proration {
+	case "":
+		proration = ProrationCreate
+	case ProrationCreate, ProrationAlwaysInvoice, ProrationNone:
+	default:
+		return nil, fmt.Errorf("invalid proration behavior %q: must be create_prorations, always_invoice or none", proration)
+	}
+	organizationID = s.client.organizationID(ctx, organizationID)
+	var resp StandardResponse
+	resp.Data = &Subscription{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v1/organizations/%s/subscription/plan", organizationID),
+		Body: map[string]string{
+			"plan_id":            planID,
+			"proration_behavior": string(proration),
+		},
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if sub, ok := resp.Data.(*Subscription); ok {
+		return sub, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
 // BillingInfo represents billing information
 type BillingInfo struct {
 	OrganizationID   uint                   `json:"organization_id"`
@@ -112,6 +223,8 @@ type Subscription struct {
 	Quantity           int                    `json:"quantity"`
 	AddOns             []SubscriptionAddOn    `json:"add_ons,omitempty"`
 	Metadata           map[string]interface{} `json:"metadata,omitempty"`
+
+	Discount *Discount `json:"discount,omitempty"` // Applied with ApplyCoupon
 }
 
 // Invoice represents an invoice
@@ -129,6 +242,17 @@ type Invoice struct {
 	LineItems      []InvoiceLineItem      `json:"line_items"`
 	PDFURL         string                 `json:"pdf_url,omitempty"`
 	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+
+	// Totals, in the same unit as Amount. Total is Subtotal less discounts
+	// plus Tax; AmountDue is what remains after credits and payments.
+	Subtotal           float64     `json:"subtotal,omitempty"`
+	Tax                float64     `json:"tax,omitempty"`
+	Total              float64     `json:"total,omitempty"`
+	AmountDue          float64     `json:"amount_due,omitempty"`
+	AmountPaid         float64     `json:"amount_paid,omitempty"`
+	Discount           *Discount   `json:"discount,omitempty"`
+	HostedInvoiceURL   string      `json:"hosted_invoice_url,omitempty"`
+	NextPaymentAttempt *CustomTime `json:"next_payment_attempt,omitempty"` // Upcoming and unpaid invoices only
 }
 
 // PaymentMethod represents a payment method
@@ -178,6 +302,23 @@ type InvoiceLineItem struct {
 	UnitPrice   float64 `json:"unit_price"`
 	Amount      float64 `json:"amount"`
 	Type        string  `json:"type"` // subscription, usage, add_on
+
+	ID          string      `json:"id,omitempty"`
+	PlanID      string      `json:"plan_id,omitempty"`
+	PeriodStart *CustomTime `json:"period_start,omitempty"`
+	PeriodEnd   *CustomTime `json:"period_end,omitempty"`
+	Proration   bool        `json:"proration,omitempty"` // Credit or charge from a mid-period plan change
+}
+
+// Discount is a coupon applied to a subscription or invoice
+type Discount struct {
+	CouponCode string      `json:"coupon_code"`
+	Name       string      `json:"name,omitempty"`
+	PercentOff float64     `json:"percent_off,omitempty"`
+	AmountOff  float64     `json:"amount_off,omitempty"`
+	Duration   string      `json:"duration,omitempty"` // once, repeating, forever
+	Start      *CustomTime `json:"start,omitempty"`
+	End        *CustomTime `json:"end,omitempty"`
 }
 
 // ============================================================================
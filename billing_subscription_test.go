@@ -0,0 +1,99 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBillingService_GetUpcomingInvoice(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/organizations/org-1/invoices/upcoming", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{
+			"id":"upcoming","status":"draft","currency":"usd","subtotal":120,"tax":12,"total":102,"amount_due":102,
+			"discount":{"coupon_code":"LAUNCH25","percent_off":25,"duration":"repeating"},
+			"next_payment_attempt":"2026-11-01T00:00:00Z",
+			"line_items":[
+				{"id":"li_1","description":"Unused time on Starter","amount":-30,"type":"subscription","plan_id":"starter","proration":true},
+				{"id":"li_2","description":"Pro","quantity":1,"unit_price":150,"amount":150,"type":"subscription","plan_id":"pro",
+				 "period_start":"2026-10-15T00:00:00Z","period_end":"2026-11-01T00:00:00Z"}
+			]}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	invoice, err := client.Billing.GetUpcomingInvoice(WithOrganization(context.Background(), "org-1"), "")
+	require.NoError(t, err)
+	assert.Equal(t, 102.0, invoice.Total)
+	assert.Equal(t, 102.0, invoice.AmountDue)
+	require.NotNil(t, invoice.Discount)
+	assert.Equal(t, "LAUNCH25", invoice.Discount.CouponCode)
+	assert.Equal(t, 25.0, invoice.Discount.PercentOff)
+	require.NotNil(t, invoice.NextPaymentAttempt)
+	require.Len(t, invoice.LineItems, 2)
+	assert.True(t, invoice.LineItems[0].Proration)
+	assert.Equal(t, "pro", invoice.LineItems[1].PlanID)
+	require.NotNil(t, invoice.LineItems[1].PeriodEnd)
+}
+
+func TestBillingService_ChangePlan(t *testing.T) {
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/v1/organizations/org-1/subscription/plan", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{"id":"sub_1","plan_id":"pro","status":"active"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	sub, err := client.Billing.ChangePlan(context.Background(), "org-1", "pro", ProrationAlwaysInvoice)
+	require.NoError(t, err)
+	assert.Equal(t, "pro", sub.PlanID)
+	assert.Equal(t, map[string]string{"plan_id": "pro", "proration_behavior": "always_invoice"}, body)
+
+	_, err = client.Billing.ChangePlan(context.Background(), "org-1", "pro", "")
+	require.NoError(t, err)
+	assert.Equal(t, "create_prorations", body["proration_behavior"], "prorations are created by default")
+
+	_, err = client.Billing.ChangePlan(context.Background(), "org-1", "", ProrationNone)
+	assert.Error(t, err)
+	_, err = client.Billing.ChangePlan(context.Background(), "org-1", "pro", "sometimes")
+	assert.Error(t, err)
+}
+
+func TestBillingService_ApplyCoupon(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/organizations/org-1/subscription/coupon", r.URL.Path)
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "LAUNCH25", body["coupon_code"])
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{"id":"sub_1","plan_id":"pro","discount":{"coupon_code":"LAUNCH25","percent_off":25}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	sub, err := client.Billing.ApplyCoupon(context.Background(), "org-1", "LAUNCH25")
+	require.NoError(t, err)
+	require.NotNil(t, sub.Discount)
+	assert.Equal(t, 25.0, sub.Discount.PercentOff)
+
+	_, err = client.Billing.ApplyCoupon(context.Background(), "org-1", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,421 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// BMC types
+const (
+	BMCTypeIDRAC   = "idrac" // Dell iDRAC
+	BMCTypeILO     = "ilo"   // HPE iLO
+	BMCTypeXCC     = "xcc"   // Lenovo XClarity Controller
+	BMCTypeGeneric = "generic"
+)
+
+// BMC user privilege levels
+const (
+	BMCPrivilegeAdministrator = "administrator"
+	BMCPrivilegeOperator      = "operator"
+	BMCPrivilegeUser          = "user"
+	BMCPrivilegeNoAccess      = "no_access"
+)
+
+// PSU redundancy states
+const (
+	PSURedundancyFull     = "full"     // Every supply can fail individually
+	PSURedundancyDegraded = "degraded" // Redundant, but with reduced margin
+	PSURedundancyLost     = "lost"     // A supply failed; the next failure loses power
+	PSURedundancyNone     = "none"     // Not configured for redundancy
+	PSURedundancyUnknown  = "unknown"
+)
+
+// BMCInventoryInfo describes a server's baseboard management controller
+type BMCInventoryInfo struct {
+	Type              string            `json:"type,omitempty"` // idrac, ilo, xcc, generic
+	Manufacturer      string            `json:"manufacturer,omitempty"`
+	Model             string            `json:"model,omitempty"` // e.g. "iDRAC9", "iLO 5"
+	FirmwareVersion   string            `json:"firmware_version,omitempty"`
+	FirmwareBuildDate string            `json:"firmware_build_date,omitempty"`
+	IPMIVersion       string            `json:"ipmi_version,omitempty"`
+	RedfishVersion    string            `json:"redfish_version,omitempty"`
+	License           string            `json:"license,omitempty"` // e.g. "Enterprise", "Advanced"
+	Network           *BMCNetworkConfig `json:"network,omitempty"`
+	Users             []BMCUser         `json:"users,omitempty"`
+}
+
+// BMCNetworkConfig is the BMC's management network configuration
+type BMCNetworkConfig struct {
+	IPAddress     string   `json:"ip_address,omitempty"`
+	Netmask       string   `json:"netmask,omitempty"`
+	Gateway       string   `json:"gateway,omitempty"`
+	MACAddress    string   `json:"mac_address,omitempty"`
+	DHCP          bool     `json:"dhcp"`
+	VLANID        int      `json:"vlan_id,omitempty"`
+	Hostname      string   `json:"hostname,omitempty"`
+	IPv6Addresses []string `json:"ipv6_addresses,omitempty"`
+	NICMode       string   `json:"nic_mode,omitempty"` // dedicated, shared
+}
+
+// BMCUser is a local BMC user account
+type BMCUser struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Privilege string `json:"privilege"` // administrator, operator, user, no_access
+	Enabled   bool   `json:"enabled"`
+	IPMI      bool   `json:"ipmi"` // IPMI-over-LAN access
+}
+
+// defaultBMCUsers are the factory accounts of common BMCs
+var defaultBMCUsers = map[string]bool{"root": true, "admin": true, "administrator": true}
+
+// EnabledAdministrators returns the enabled users with administrator privilege
+func (b *BMCInventoryInfo) EnabledAdministrators() []BMCUser {
+	var admins []BMCUser
+	for _, user := range b.Users {
+		if user.Enabled && user.Privilege == BMCPrivilegeAdministrator {
+			admins = append(admins, user)
+		}
+	}
+	return admins
+}
+
+// DefaultUsers returns enabled factory accounts such as iDRAC's "root",
+// Supermicro's "ADMIN" and iLO's "Administrator", which are often left with
+// their default password
+func (b *BMCInventoryInfo) DefaultUsers() []BMCUser {
+	var users []BMCUser
+	for _, user := range b.Users {
+		if user.Enabled && defaultBMCUsers[strings.ToLower(user.Name)] {
+			users = append(users, user)
+		}
+	}
+	return users
+}
+
+// BMCSensorReadings are fan, voltage, temperature and power supply readings
+// from a server's BMC
+type BMCSensorReadings struct {
+	CollectedAt   time.Time               `json:"collected_at"`
+	Fans          []BMCFanReading         `json:"fans,omitempty"`
+	Voltages      []BMCVoltageReading     `json:"voltages,omitempty"`
+	Temperatures  []TemperatureSensorData `json:"temperatures,omitempty"`
+	PowerSupplies []BMCPowerSupply        `json:"power_supplies,omitempty"`
+	PSURedundancy string                  `json:"psu_redundancy,omitempty"` // full, degraded, lost, none, unknown
+	FanRedundancy string                  `json:"fan_redundancy,omitempty"`
+}
+
+// BMCFanReading is a fan speed reading
+type BMCFanReading struct {
+	Name          string `json:"name"`
+	RPM           int    `json:"rpm"`
+	Status        string `json:"status"` // ok, warning, critical
+	LowerCritical int    `json:"lower_critical,omitempty"`
+}
+
+// BMCVoltageReading is a voltage rail reading
+type BMCVoltageReading struct {
+	Name          string  `json:"name"`
+	Volts         float64 `json:"volts"`
+	Status        string  `json:"status"` // ok, warning, critical
+	LowerCritical float64 `json:"lower_critical,omitempty"`
+	UpperCritical float64 `json:"upper_critical,omitempty"`
+}
+
+// BMCPowerSupply is a power supply's state as reported by the BMC
+type BMCPowerSupply struct {
+	Name          string  `json:"name"`
+	Present       bool    `json:"present"`
+	Status        string  `json:"status"` // ok, warning, critical, failed
+	InputWatts    float64 `json:"input_watts,omitempty"`
+	OutputWatts   float64 `json:"output_watts,omitempty"`
+	CapacityWatts float64 `json:"capacity_watts,omitempty"`
+	InputVolts    float64 `json:"input_volts,omitempty"`
+	Temperature   float64 `json:"temperature,omitempty"`
+}
+
+// PowerSupplyMetrics converts the reading to the power supply metrics
+// submitted with comprehensive metrics
+func (p *BMCPowerSupply) PowerSupplyMetrics() PowerSupplyMetrics {
+	metrics := PowerSupplyMetrics{
+		ID:            p.Name,
+		Name:          p.Name,
+		Status:        p.Status,
+		PowerWatts:    p.InputWatts,
+		MaxPowerWatts: p.CapacityWatts,
+		Voltage:       p.InputVolts,
+		Temperature:   p.Temperature,
+	}
+	if p.InputWatts > 0 && p.OutputWatts > 0 {
+		metrics.Efficiency = p.OutputWatts / p.InputWatts * 100
+	}
+	if !p.Present {
+		metrics.Status = "failed"
+	}
+	return metrics
+}
+
+// ParsePSURedundancy maps a vendor's redundancy state, e.g. iDRAC's "Fully
+// Redundant" or iLO's "Redundant", to a PSURedundancy constant
+func ParsePSURedundancy(state string) string {
+	s := strings.ToLower(strings.TrimSpace(state))
+	switch {
+	case s == "":
+		return PSURedundancyUnknown
+	case strings.Contains(s, "lost") || strings.Contains(s, "failed"):
+		return PSURedundancyLost
+	case strings.Contains(s, "degraded"):
+		return PSURedundancyDegraded
+	case strings.HasPrefix(s, "non") || strings.HasPrefix(s, "not") || s == "disabled":
+		return PSURedundancyNone
+	case strings.Contains(s, "redundant") || s == "full" || s == "ok":
+		return PSURedundancyFull
+	}
+	return PSURedundancyUnknown
+}
+
+// SubmitBMCSensors submits fan, voltage, temperature and power supply
+// readings from a server's BMC
+func (s *IPMIService) SubmitBMCSensors(ctx context.Context, serverUUID string, readings *BMCSensorReadings) error {
+	if serverUUID == "" {
+		return fmt.Errorf("server UUID is required")
+	}
+	if readings == nil {
+		return fmt.Errorf("readings are required")
+	}
+
+	var resp StandardResponse
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v2/ipmi/%s/sensors", serverUUID),
+		Body:   readings,
+		Result: &resp,
+	})
+	return err
+}
+
+// IPMI sensor kinds returned by ClassifyIPMISensor
+const (
+	IPMISensorKindTemperature = "temperature"
+	IPMISensorKindFan         = "fan"
+	IPMISensorKindVoltage     = "voltage"
+	IPMISensorKindCurrent     = "current"
+	IPMISensorKindPower       = "power"
+	IPMISensorKindPSUStatus   = "psu_status"
+	IPMISensorKindRedundancy  = "redundancy"
+	IPMISensorKindOther       = "other"
+)
+
+var psuIndexPattern = regexp.MustCompile(`(?i)\b(?:ps|psu|pwr ?supply|power ?supply) ?(\d+)`)
+
+// ClassifyIPMISensor returns the kind of an IPMI sensor from its unit, type
+// and name, e.g. "CPU1 Temp", "FAN1A RPM", "PS1 Voltage", "PS Redundancy"
+func ClassifyIPMISensor(sensor *IPMISensor) string {
+	name := strings.ToLower(sensor.Name)
+	unit := strings.ToLower(sensor.Unit)
+	sensorType := strings.ToLower(sensor.Type)
+
+	switch {
+	case strings.Contains(name, "redundan"):
+		return IPMISensorKindRedundancy
+	case strings.Contains(unit, "degrees") || unit == "c" || sensorType == "temperature" || strings.Contains(name, "temp"):
+		return IPMISensorKindTemperature
+	case unit == "rpm" || sensorType == "fan" || strings.HasPrefix(name, "fan"):
+		return IPMISensorKindFan
+	case strings.Contains(unit, "volt") || unit == "v" || sensorType == "voltage":
+		return IPMISensorKindVoltage
+	case strings.Contains(unit, "amp") || unit == "a" || sensorType == "current":
+		return IPMISensorKindCurrent
+	case strings.Contains(unit, "watt") || unit == "w":
+		return IPMISensorKindPower
+	case sensorType == "power supply" || (psuIndexPattern.MatchString(name) && strings.Contains(name, "status")):
+		return IPMISensorKindPSUStatus
+	}
+	return IPMISensorKindOther
+}
+
+// ipmiStatus maps ipmitool sensor states (ok, nc, cr, nr, ns) to ok, warning
+// and critical
+func ipmiStatus(status string) string {
+	switch strings.ToLower(strings.TrimSpace(status)) {
+	case "ok", "normal", "":
+		return "ok"
+	case "nc", "warning", "non-critical":
+		return "warning"
+	case "ns", "na", "n/a", "not available":
+		return "unknown"
+	}
+	return "critical"
+}
+
+// temperatureSensorType guesses TemperatureSensorData.Type from a sensor name
+func temperatureSensorType(name string) string {
+	name = strings.ToLower(name)
+	for _, candidate := range []struct{ match, kind string }{
+		{"cpu", "cpu"}, {"proc", "cpu"},
+		{"dimm", "memory"}, {"mem", "memory"},
+		{"inlet", "inlet"}, {"ambient", "inlet"},
+		{"exhaust", "exhaust"}, {"outlet", "exhaust"},
+		{"gpu", "gpu"},
+		{"hdd", "disk"}, {"drive", "disk"}, {"nvme", "disk"},
+		{"pch", "chipset"}, {"chipset", "chipset"},
+		{"ps", "psu"}, {"power supply", "psu"},
+	} {
+		if strings.Contains(name, candidate.match) {
+			return candidate.kind
+		}
+	}
+	return "system"
+}
+
+// IPMITemperatures converts the temperature sensors among IPMI sensors to
+// TemperatureSensorData
+func IPMITemperatures(sensors []IPMISensor) []TemperatureSensorData {
+	var temperatures []TemperatureSensorData
+	for i := range sensors {
+		sensor := &sensors[i]
+		if ClassifyIPMISensor(sensor) != IPMISensorKindTemperature {
+			continue
+		}
+		id := sensor.ID
+		if id == "" {
+			id = sensor.Name
+		}
+		temperatures = append(temperatures, TemperatureSensorData{
+			SensorID:      id,
+			SensorName:    sensor.Name,
+			Temperature:   sensor.Reading,
+			Status:        ipmiStatus(sensor.Status),
+			Type:          temperatureSensorType(sensor.Name),
+			UpperCritical: sensor.UpperBound,
+		})
+	}
+	return temperatures
+}
+
+// IPMIPowerSupplies groups IPMI sensors named after a power supply, e.g.
+// "PS1 Input Power", "PS1 Voltage" and "PS1 Status", into one
+// PowerSupplyMetrics per supply, ordered by supply number
+func IPMIPowerSupplies(sensors []IPMISensor) []PowerSupplyMetrics {
+	supplies := make(map[string]*PowerSupplyMetrics)
+	for i := range sensors {
+		sensor := &sensors[i]
+		match := psuIndexPattern.FindStringSubmatch(sensor.Name)
+		if match == nil {
+			continue
+		}
+		psu, ok := supplies[match[1]]
+		if !ok {
+			psu = &PowerSupplyMetrics{ID: "PSU" + match[1], Name: "PSU " + match[1], Status: "ok"}
+			supplies[match[1]] = psu
+		}
+
+		switch ClassifyIPMISensor(sensor) {
+		case IPMISensorKindPower:
+			psu.PowerWatts = sensor.Reading
+			psu.MaxPowerWatts = sensor.UpperBound
+		case IPMISensorKindVoltage:
+			psu.Voltage = sensor.Reading
+		case IPMISensorKindCurrent:
+			psu.Current = sensor.Reading
+		case IPMISensorKindTemperature:
+			psu.Temperature = sensor.Reading
+		case IPMISensorKindPSUStatus:
+			if status := ipmiStatus(sensor.Status); status != "ok" {
+				psu.Status = status
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(supplies))
+	for key := range supplies {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	metrics := make([]PowerSupplyMetrics, 0, len(keys))
+	for _, key := range keys {
+		metrics = append(metrics, *supplies[key])
+	}
+	return metrics
+}
+
+// NewBMCSensorReadings builds sensor readings from IPMI sensors, e.g. parsed
+// from "ipmitool sensor" output. Power supplies are grouped with
+// IPMIPowerSupplies.
+func NewBMCSensorReadings(sensors []IPMISensor) *BMCSensorReadings {
+	readings := &BMCSensorReadings{
+		CollectedAt:  time.Now().UTC(),
+		Temperatures: IPMITemperatures(sensors),
+	}
+
+	for i := range sensors {
+		sensor := &sensors[i]
+		switch ClassifyIPMISensor(sensor) {
+		case IPMISensorKindFan:
+			readings.Fans = append(readings.Fans, BMCFanReading{
+				Name:          sensor.Name,
+				RPM:           int(sensor.Reading),
+				Status:        ipmiStatus(sensor.Status),
+				LowerCritical: int(sensor.LowerBound),
+			})
+		case IPMISensorKindVoltage:
+			if psuIndexPattern.MatchString(sensor.Name) {
+				continue
+			}
+			readings.Voltages = append(readings.Voltages, BMCVoltageReading{
+				Name:          sensor.Name,
+				Volts:         sensor.Reading,
+				Status:        ipmiStatus(sensor.Status),
+				LowerCritical: sensor.LowerBound,
+				UpperCritical: sensor.UpperBound,
+			})
+		case IPMISensorKindRedundancy:
+			state := ParsePSURedundancy(sensor.Description)
+			if state == PSURedundancyUnknown {
+				state = redundancyFromStatus(sensor.Status)
+			}
+			if strings.Contains(strings.ToLower(sensor.Name), "fan") {
+				readings.FanRedundancy = state
+			} else {
+				readings.PSURedundancy = state
+			}
+		}
+	}
+
+	for _, psu := range IPMIPowerSupplies(sensors) {
+		readings.PowerSupplies = append(readings.PowerSupplies, BMCPowerSupply{
+			Name:          psu.Name,
+			Present:       true,
+			Status:        psu.Status,
+			InputWatts:    psu.PowerWatts,
+			CapacityWatts: psu.MaxPowerWatts,
+			InputVolts:    psu.Voltage,
+			Temperature:   psu.Temperature,
+		})
+	}
+	return readings
+}
+
+// redundancyFromStatus maps a redundancy sensor's ipmitool state when the BMC
+// gives no description
+func redundancyFromStatus(status string) string {
+	switch ipmiStatus(status) {
+	case "ok":
+		return PSURedundancyFull
+	case "warning":
+		return PSURedundancyDegraded
+	case "critical":
+		return PSURedundancyLost
+	}
+	return PSURedundancyUnknown
+}
@@ -0,0 +1,128 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testIPMISensors resembles "ipmitool sensor" output from a Dell server
+var testIPMISensors = []IPMISensor{
+	{Name: "Inlet Temp", Reading: 22, Unit: "degrees C", Status: "ok", UpperBound: 42},
+	{Name: "CPU1 Temp", Reading: 71, Unit: "degrees C", Status: "nc", UpperBound: 90},
+	{Name: "Fan1A", Reading: 6840, Unit: "RPM", Status: "ok", LowerBound: 600},
+	{Name: "Fan2A", Reading: 0, Unit: "RPM", Status: "cr", LowerBound: 600},
+	{Name: "3.3V", Reading: 3.31, Unit: "Volts", Status: "ok", LowerBound: 3.0, UpperBound: 3.6},
+	{Name: "PS1 Input Power", Reading: 240, Unit: "Watts", Status: "ok", UpperBound: 750},
+	{Name: "PS1 Voltage 1", Reading: 230, Unit: "Volts", Status: "ok"},
+	{Name: "PS1 Current 1", Reading: 1.2, Unit: "Amps", Status: "ok"},
+	{Name: "PS2 Status", Type: "Power Supply", Status: "cr"},
+	{Name: "PS10 Temp", Reading: 35, Unit: "degrees C", Status: "ok"},
+	{Name: "PS Redundancy", Status: "cr", Description: "Redundancy Lost"},
+	{Name: "Intrusion", Status: "ok"},
+}
+
+func TestClassifyIPMISensor(t *testing.T) {
+	kinds := make([]string, len(testIPMISensors))
+	for i := range testIPMISensors {
+		kinds[i] = ClassifyIPMISensor(&testIPMISensors[i])
+	}
+	assert.Equal(t, []string{
+		IPMISensorKindTemperature, IPMISensorKindTemperature,
+		IPMISensorKindFan, IPMISensorKindFan,
+		IPMISensorKindVoltage,
+		IPMISensorKindPower, IPMISensorKindVoltage, IPMISensorKindCurrent, IPMISensorKindPSUStatus,
+		IPMISensorKindTemperature, IPMISensorKindRedundancy, IPMISensorKindOther,
+	}, kinds)
+}
+
+func TestIPMISensorMapping(t *testing.T) {
+	temperatures := IPMITemperatures(testIPMISensors)
+	require.Len(t, temperatures, 3)
+	assert.Equal(t, TemperatureSensorData{
+		SensorID: "CPU1 Temp", SensorName: "CPU1 Temp", Temperature: 71, Status: "warning", Type: "cpu", UpperCritical: 90,
+	}, temperatures[1])
+	assert.Equal(t, "inlet", temperatures[0].Type)
+	assert.Equal(t, "psu", temperatures[2].Type)
+
+	supplies := IPMIPowerSupplies(testIPMISensors)
+	require.Len(t, supplies, 3)
+	assert.Equal(t, PowerSupplyMetrics{
+		ID: "PSU1", Name: "PSU 1", Status: "ok", PowerWatts: 240, MaxPowerWatts: 750, Voltage: 230, Current: 1.2,
+	}, supplies[0])
+	assert.Equal(t, "critical", supplies[1].Status)
+	assert.Equal(t, "PSU10", supplies[2].ID)
+	assert.Equal(t, 35.0, supplies[2].Temperature)
+
+	readings := NewBMCSensorReadings(testIPMISensors)
+	assert.Len(t, readings.Fans, 2)
+	assert.Equal(t, "critical", readings.Fans[1].Status)
+	require.Len(t, readings.Voltages, 1, "PSU voltages belong to their supply")
+	assert.Equal(t, "3.3V", readings.Voltages[0].Name)
+	assert.Equal(t, PSURedundancyLost, readings.PSURedundancy)
+	assert.Len(t, readings.PowerSupplies, 3)
+}
+
+func TestParsePSURedundancy(t *testing.T) {
+	for state, want := range map[string]string{
+		"Fully Redundant":                     PSURedundancyFull,
+		"Redundant":                           PSURedundancyFull,
+		"Redundancy Lost":                     PSURedundancyLost,
+		"Redundancy Degraded":                 PSURedundancyDegraded,
+		"Non-redundant: Sufficient Resources": PSURedundancyNone,
+		"Not Redundant":                       PSURedundancyNone,
+		"":                                    PSURedundancyUnknown,
+		"something else":                      PSURedundancyUnknown,
+	} {
+		assert.Equal(t, want, ParsePSURedundancy(state), state)
+	}
+}
+
+func TestBMCInventoryInfo_Users(t *testing.T) {
+	bmc := &BMCInventoryInfo{
+		Type:  BMCTypeIDRAC,
+		Model: "iDRAC9",
+		Users: []BMCUser{
+			{ID: 2, Name: "root", Privilege: BMCPrivilegeAdministrator, Enabled: true, IPMI: true},
+			{ID: 3, Name: "ops", Privilege: BMCPrivilegeAdministrator, Enabled: true},
+			{ID: 4, Name: "ADMIN", Privilege: BMCPrivilegeAdministrator, Enabled: false},
+			{ID: 5, Name: "monitor", Privilege: BMCPrivilegeUser, Enabled: true},
+		},
+	}
+	assert.Len(t, bmc.EnabledAdministrators(), 2)
+	require.Len(t, bmc.DefaultUsers(), 1)
+	assert.Equal(t, "root", bmc.DefaultUsers()[0].Name)
+
+	psu := BMCPowerSupply{Name: "PS1", Present: true, Status: "ok", InputWatts: 250, OutputWatts: 230, CapacityWatts: 750}
+	assert.InDelta(t, 92.0, psu.PowerSupplyMetrics().Efficiency, 1e-9)
+	psu.Present = false
+	assert.Equal(t, "failed", psu.PowerSupplyMetrics().Status)
+}
+
+func TestIPMIService_SubmitBMCSensors(t *testing.T) {
+	readings := NewBMCSensorReadings(testIPMISensors)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v2/ipmi/srv-1/sensors", r.URL.Path)
+		var received BMCSensorReadings
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		assert.Equal(t, readings.PSURedundancy, received.PSURedundancy)
+		assert.Equal(t, readings.Fans, received.Fans)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{ServerUUID: "srv-1", ServerSecret: "secret"}})
+	require.NoError(t, err)
+
+	require.NoError(t, client.IPMI.SubmitBMCSensors(context.Background(), "srv-1", readings))
+	assert.Error(t, client.IPMI.SubmitBMCSensors(context.Background(), "", readings))
+	assert.Error(t, client.IPMI.SubmitBMCSensors(context.Background(), "srv-1", nil))
+}
@@ -0,0 +1,231 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ProbeTypeBrowser identifies probes whose checks run in a headless browser
+// outside the SDK's own probe agents
+const ProbeTypeBrowser ProbeType = "browser"
+
+// BrowserProbeResult is the result of a full-page check run by an external
+// headless browser runner, such as Playwright or Puppeteer
+type BrowserProbeResult struct {
+	ProbeID    uint      `json:"probe_id,omitempty"`
+	ProbeUUID  string    `json:"probe_uuid"`
+	ExecutedAt time.Time `json:"executed_at"`
+	Region     string    `json:"region"`
+	Status     string    `json:"status"` // success, failed, timeout, error
+	Error      string    `json:"error,omitempty"`
+
+	URL        string `json:"url"`                   // Requested URL
+	FinalURL   string `json:"final_url,omitempty"`   // URL after redirects
+	StatusCode int    `json:"status_code,omitempty"` // Status of the main document
+	Runner     string `json:"runner,omitempty"`      // e.g. "playwright/1.48"
+	Browser    string `json:"browser,omitempty"`     // e.g. "chromium/130"
+	UserAgent  string `json:"user_agent,omitempty"`
+
+	Timings        BrowserPageTimings      `json:"timings"`
+	ConsoleErrors  []BrowserConsoleMessage `json:"console_errors,omitempty"`
+	FailedRequests []BrowserFailedRequest  `json:"failed_requests,omitempty"`
+	Screenshot     *BrowserScreenshot      `json:"screenshot,omitempty"`
+}
+
+// BrowserPageTimings holds navigation and paint timings in milliseconds,
+// measured from the start of navigation
+type BrowserPageTimings struct {
+	DNS                    int     `json:"dns,omitempty"`
+	Connect                int     `json:"connect,omitempty"`
+	TLS                    int     `json:"tls,omitempty"`
+	TimeToFirstByte        int     `json:"time_to_first_byte,omitempty"`
+	FirstContentfulPaint   int     `json:"first_contentful_paint,omitempty"`
+	LargestContentfulPaint int     `json:"largest_contentful_paint,omitempty"`
+	DOMContentLoaded       int     `json:"dom_content_loaded,omitempty"`
+	Load                   int     `json:"load,omitempty"`
+	TotalBlockingTime      int     `json:"total_blocking_time,omitempty"`
+	CumulativeLayoutShift  float64 `json:"cumulative_layout_shift,omitempty"` // Unitless
+	Requests               int     `json:"requests,omitempty"`                // Resources fetched by the page
+	TransferBytes          int64   `json:"transfer_bytes,omitempty"`
+}
+
+// BrowserConsoleMessage is a message the page logged to the browser console
+type BrowserConsoleMessage struct {
+	Level   string `json:"level"` // error, warning
+	Message string `json:"message"`
+	Source  string `json:"source,omitempty"` // Script URL and line, if known
+}
+
+// BrowserFailedRequest is a resource the page failed to load
+type BrowserFailedRequest struct {
+	URL        string `json:"url"`
+	StatusCode int    `json:"status_code,omitempty"`
+	Error      string `json:"error,omitempty"` // e.g. "net::ERR_NAME_NOT_RESOLVED"
+}
+
+// BrowserScreenshot references a screenshot stored by the runner. The image
+// itself is not uploaded through the SDK.
+type BrowserScreenshot struct {
+	URL         string    `json:"url"` // Where the image can be retrieved
+	ContentType string    `json:"content_type,omitempty"`
+	Width       int       `json:"width,omitempty"`
+	Height      int       `json:"height,omitempty"`
+	TakenAt     time.Time `json:"taken_at,omitempty"`
+}
+
+// BrowserResultsSubmission represents a submission of browser probe results
+type BrowserResultsSubmission struct {
+	Results []BrowserProbeResult `json:"results"`
+}
+
+// Validate checks the result locally for problems the API would reject
+func (r *BrowserProbeResult) Validate() []ValidationIssue {
+	var v validationIssues
+
+	if r.ProbeID == 0 && r.ProbeUUID == "" {
+		v.addError("probe_uuid", "probe_uuid or probe_id is required")
+	}
+	if r.Region == "" {
+		v.addError("region", "is required")
+	}
+	switch r.Status {
+	case "success", "failed", "timeout", "error":
+	default:
+		v.addError("status", "must be one of: success, failed, timeout, error")
+	}
+	if r.ExecutedAt.IsZero() {
+		v.addError("executed_at", "is required")
+	} else if time.Until(r.ExecutedAt) > 5*time.Minute {
+		v.addWarning("executed_at", "is more than 5 minutes in the future")
+	}
+	if r.URL == "" {
+		v.addError("url", "is required")
+	}
+	if r.StatusCode != 0 && (r.StatusCode < 100 || r.StatusCode > 599) {
+		v.addError("status_code", "must be a valid HTTP status code")
+	}
+
+	t := r.Timings
+	v.nonNegative("timings.dns", int64(t.DNS))
+	v.nonNegative("timings.connect", int64(t.Connect))
+	v.nonNegative("timings.tls", int64(t.TLS))
+	v.nonNegative("timings.time_to_first_byte", int64(t.TimeToFirstByte))
+	v.nonNegative("timings.first_contentful_paint", int64(t.FirstContentfulPaint))
+	v.nonNegative("timings.largest_contentful_paint", int64(t.LargestContentfulPaint))
+	v.nonNegative("timings.dom_content_loaded", int64(t.DOMContentLoaded))
+	v.nonNegative("timings.load", int64(t.Load))
+	v.nonNegative("timings.total_blocking_time", int64(t.TotalBlockingTime))
+	v.nonNegative("timings.requests", int64(t.Requests))
+	v.nonNegative("timings.transfer_bytes", t.TransferBytes)
+	if t.CumulativeLayoutShift < 0 {
+		v.addError("timings.cumulative_layout_shift", "must not be negative")
+	}
+	if t.Load > 0 && t.DOMContentLoaded > t.Load {
+		v.addWarning("timings.dom_content_loaded", "is later than load")
+	}
+
+	if r.Screenshot != nil && r.Screenshot.URL == "" {
+		v.addError("screenshot.url", "is required")
+	}
+
+	return v
+}
+
+// ExecutionResult converts the result to a ProbeExecutionResult, so that it
+// can be submitted with SubmitResults alongside native probe results. The
+// browser-specific fields are kept in Details.
+func (r *BrowserProbeResult) ExecutionResult() ProbeExecutionResult {
+	details := map[string]interface{}{
+		"probe_type": ProbeTypeBrowser,
+		"url":        r.URL,
+		"timings":    r.Timings,
+	}
+	if r.FinalURL != "" {
+		details["final_url"] = r.FinalURL
+	}
+	if r.Runner != "" {
+		details["runner"] = r.Runner
+	}
+	if r.Browser != "" {
+		details["browser"] = r.Browser
+	}
+	if len(r.ConsoleErrors) > 0 {
+		details["console_errors"] = r.ConsoleErrors
+	}
+	if len(r.FailedRequests) > 0 {
+		details["failed_requests"] = r.FailedRequests
+	}
+	if r.Screenshot != nil {
+		details["screenshot"] = r.Screenshot
+	}
+
+	total := r.Timings.Load
+	if total == 0 {
+		total = r.Timings.DOMContentLoaded
+	}
+
+	return ProbeExecutionResult{
+		ProbeID:       r.ProbeID,
+		ProbeUUID:     r.ProbeUUID,
+		ExecutedAt:    r.ExecutedAt,
+		Region:        r.Region,
+		Status:        r.Status,
+		ResponseTime:  total,
+		StatusCode:    r.StatusCode,
+		Error:         r.Error,
+		Details:       details,
+		DNSTime:       r.Timings.DNS,
+		ConnectTime:   r.Timings.Connect,
+		TLSTime:       r.Timings.TLS,
+		FirstByteTime: r.Timings.TimeToFirstByte,
+		TotalTime:     total,
+		ResponseSize:  int(r.Timings.TransferBytes),
+	}
+}
+
+// SubmitBrowserResults submits results from an external headless browser runner
+// Authentication: Monitoring key required
+// Endpoint: POST /v1/monitoring/browser-results
+// Parameters:
+//   - results: Browser check results; each is validated locally first
+//
+// Returns: *ValidationError without contacting the API if a result is invalid
+//
+// Example:
+//
+//	err := client.Monitoring.SubmitBrowserResults(ctx, []nexmonyx.BrowserProbeResult{{
+//	    ProbeUUID:  probe.ProbeUUID,
+//	    ExecutedAt: start,
+//	    Region:     "us-east-1",
+//	    Status:     "success",
+//	    URL:        "https://shop.example.com/checkout",
+//	    Runner:     "playwright/1.48",
+//	    Timings:    nexmonyx.BrowserPageTimings{TimeToFirstByte: 180, LargestContentfulPaint: 1250, Load: 1900},
+//	    Screenshot: &nexmonyx.BrowserScreenshot{URL: "s3://probe-artifacts/run-123.png"},
+//	}})
+func (s *MonitoringService) SubmitBrowserResults(ctx context.Context, results []BrowserProbeResult) error {
+	if len(results) == 0 {
+		return fmt.Errorf("at least one result is required")
+	}
+
+	var issues []ValidationIssue
+	for i := range results {
+		for _, issue := range results[i].Validate() {
+			issue.Field = fmt.Sprintf("results[%d].%s", i, issue.Field)
+			issues = append(issues, issue)
+		}
+	}
+	if err := validationResult(issues).Err(); err != nil {
+		return err
+	}
+
+	var resp StandardResponse
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v1/monitoring/browser-results",
+		Body:   &BrowserResultsSubmission{Results: results},
+		Result: &resp,
+	})
+	return err
+}
@@ -0,0 +1,87 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitoringService_SubmitBrowserResults(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/monitoring/browser-results", r.URL.Path)
+
+		var submission BrowserResultsSubmission
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&submission))
+		require.Len(t, submission.Results, 1)
+		result := submission.Results[0]
+		assert.Equal(t, 1250, result.Timings.LargestContentfulPaint)
+		require.Len(t, result.ConsoleErrors, 1)
+		assert.Equal(t, "s3://probe-artifacts/run-1.png", result.Screenshot.URL)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{MonitoringKey: "MON_test"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	result := BrowserProbeResult{
+		ProbeUUID:     "probe-1",
+		ExecutedAt:    time.Now(),
+		Region:        "us-east-1",
+		Status:        "success",
+		URL:           "https://shop.example.com/checkout",
+		StatusCode:    200,
+		Runner:        "playwright/1.48",
+		Timings:       BrowserPageTimings{TimeToFirstByte: 180, LargestContentfulPaint: 1250, DOMContentLoaded: 900, Load: 1900},
+		ConsoleErrors: []BrowserConsoleMessage{{Level: "error", Message: "Uncaught TypeError"}},
+		Screenshot:    &BrowserScreenshot{URL: "s3://probe-artifacts/run-1.png"},
+	}
+	require.NoError(t, client.Monitoring.SubmitBrowserResults(ctx, []BrowserProbeResult{result}))
+
+	invalid := result
+	invalid.Status = "ok"
+	invalid.Timings.Load = -1
+	err = client.Monitoring.SubmitBrowserResults(ctx, []BrowserProbeResult{result, invalid})
+	var validation *ValidationError
+	require.True(t, errors.As(err, &validation))
+	assert.Contains(t, validation.Errors, "results[1].status")
+	assert.Contains(t, validation.Errors, "results[1].timings.load")
+	assert.Equal(t, int32(1), requests.Load(), "invalid results are not sent")
+
+	assert.Error(t, client.Monitoring.SubmitBrowserResults(ctx, nil))
+}
+
+func TestBrowserProbeResult_ExecutionResult(t *testing.T) {
+	result := &BrowserProbeResult{
+		ProbeID:    7,
+		Region:     "eu-west-1",
+		Status:     "failed",
+		Error:      "checkout button not found",
+		URL:        "https://shop.example.com",
+		Timings:    BrowserPageTimings{DNS: 5, TimeToFirstByte: 120, DOMContentLoaded: 800, TransferBytes: 2048},
+		Screenshot: &BrowserScreenshot{URL: "https://artifacts.example.com/1.png"},
+	}
+
+	exec := result.ExecutionResult()
+	assert.Equal(t, uint(7), exec.ProbeID)
+	assert.Equal(t, "failed", exec.Status)
+	assert.Equal(t, 800, exec.ResponseTime, "falls back to DOMContentLoaded without a load time")
+	assert.Equal(t, 120, exec.FirstByteTime)
+	assert.Equal(t, 2048, exec.ResponseSize)
+	assert.Equal(t, ProbeTypeBrowser, exec.Details["probe_type"])
+	assert.Equal(t, result.Screenshot, exec.Details["screenshot"])
+}
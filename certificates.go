@@ -0,0 +1,168 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// CertificatesService handles TLS certificates discovered by HTTPS probes
+type CertificatesService struct {
+	client *Client
+}
+
+// Certificate is a TLS certificate served by an endpoint that HTTPS probes
+// check. The API tracks each certificate until the endpoint serves a new one.
+type Certificate struct {
+	ID                 uint                    `json:"id"`
+	OrganizationID     uint                    `json:"organization_id,omitempty"`
+	Hostname           string                  `json:"hostname"`
+	Port               int                     `json:"port,omitempty"`
+	ProbeUUIDs         []string                `json:"probe_uuids,omitempty"` // Probes that observed the certificate
+	Subject            string                  `json:"subject"`
+	Issuer             string                  `json:"issuer"`
+	SerialNumber       string                  `json:"serial_number"`
+	SANs               []string                `json:"sans,omitempty"` // DNS names and IP addresses
+	NotBefore          time.Time               `json:"not_before"`
+	NotAfter           time.Time               `json:"not_after"`
+	FingerprintSHA256  string                  `json:"fingerprint_sha256"`
+	SignatureAlgorithm string                  `json:"signature_algorithm,omitempty"`
+	KeyAlgorithm       string                  `json:"key_algorithm,omitempty"`
+	KeySize            int                     `json:"key_size,omitempty"`
+	Chain              []CertificateChainEntry `json:"chain,omitempty"`             // Intermediates and root; only returned by GetCertificate
+	ExpiryAlertDays    *int                    `json:"expiry_alert_days,omitempty"` // Alert this many days before NotAfter; nil when disabled
+	FirstSeenAt        *time.Time              `json:"first_seen_at,omitempty"`
+	LastSeenAt         *time.Time              `json:"last_seen_at,omitempty"`
+}
+
+// CertificateChainEntry is one certificate in the chain presented with a leaf,
+// ordered from the issuer of the leaf towards the root
+type CertificateChainEntry struct {
+	Subject           string    `json:"subject"`
+	Issuer            string    `json:"issuer"`
+	SerialNumber      string    `json:"serial_number"`
+	NotBefore         time.Time `json:"not_before"`
+	NotAfter          time.Time `json:"not_after"`
+	FingerprintSHA256 string    `json:"fingerprint_sha256"`
+	IsCA              bool      `json:"is_ca"`
+}
+
+// ProbeCertificate is the certificate metadata an agent reports with a probe
+// result. The API uses it to discover and track certificates.
+type ProbeCertificate struct {
+	Subject           string                  `json:"subject"`
+	Issuer            string                  `json:"issuer"`
+	SerialNumber      string                  `json:"serial_number"`
+	SANs              []string                `json:"sans,omitempty"`
+	NotBefore         time.Time               `json:"not_before"`
+	NotAfter          time.Time               `json:"not_after"`
+	FingerprintSHA256 string                  `json:"fingerprint_sha256"`
+	Chain             []CertificateChainEntry `json:"chain,omitempty"`
+}
+
+// DaysUntilExpiry returns the whole days left before the certificate expires
+// at now, or a negative number when it has already expired
+func (c *Certificate) DaysUntilExpiry(now time.Time) int {
+	return int(math.Floor(c.NotAfter.Sub(now).Hours() / 24))
+}
+
+// IsExpired reports whether the certificate has expired at now
+func (c *Certificate) IsExpired(now time.Time) bool {
+	return !now.Before(c.NotAfter)
+}
+
+// CertificateListOptions filters the certificates returned by ListCertificates
+type CertificateListOptions struct {
+	ListOptions
+	Hostname           string // Exact hostname
+	ExpiringWithinDays int    // Only certificates expiring within this many days, including expired ones
+}
+
+// ToQuery converts options to query parameters
+func (o *CertificateListOptions) ToQuery() map[string]string {
+	query := o.ListOptions.ToQuery()
+	if o.Hostname != "" {
+		query["hostname"] = o.Hostname
+	}
+	if o.ExpiringWithinDays > 0 {
+		query["expiring_within_days"] = fmt.Sprintf("%d", o.ExpiringWithinDays)
+	}
+	return query
+}
+
+// ListCertificates retrieves the certificates discovered by an organization's
+// HTTPS probes. Chain details are omitted; use GetCertificate for them.
+func (s *CertificatesService) ListCertificates(ctx context.Context, orgID string, opts *CertificateListOptions) ([]*Certificate, *PaginationMeta, error) {
+	orgID = s.client.organizationID(ctx, orgID)
+	if orgID == "" {
+		return nil, nil, fmt.Errorf("organization ID is required")
+	}
+
+	var resp PaginatedResponse
+	var certificates []*Certificate
+	resp.Data = &certificates
+
+	req := &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v2/organizations/%s/certificates", orgID),
+		Result: &resp,
+	}
+
+	if opts != nil {
+		req.Query = opts.ToQuery()
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return certificates, resp.Meta, nil
+}
+
+// GetCertificate retrieves a certificate with its chain
+func (s *CertificatesService) GetCertificate(ctx context.Context, certificateID uint) (*Certificate, error) {
+	var resp StandardResponse
+	resp.Data = &Certificate{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v2/certificates/%d", certificateID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if certificate, ok := resp.Data.(*Certificate); ok {
+		return certificate, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// SetExpiryAlert raises an alert daysBefore days before the certificate
+// expires. A daysBefore of zero disables the alert.
+func (s *CertificatesService) SetExpiryAlert(ctx context.Context, certificateID uint, daysBefore int) (*Certificate, error) {
+	if daysBefore < 0 {
+		return nil, fmt.Errorf("daysBefore must not be negative")
+	}
+
+	var resp StandardResponse
+	resp.Data = &Certificate{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v2/certificates/%d/expiry-alert", certificateID),
+		Body:   map[string]int{"days_before": daysBefore},
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if certificate, ok := resp.Data.(*Certificate); ok {
+		return certificate, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
@@ -0,0 +1,84 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertificatesService(t *testing.T) {
+	notAfter := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/v2/organizations/org-1/certificates":
+			assert.Equal(t, "30", r.URL.Query().Get("expiring_within_days"))
+			assert.Equal(t, "shop.example.com", r.URL.Query().Get("hostname"))
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data":   []Certificate{{ID: 7, Hostname: "shop.example.com", Issuer: "CN=R11", NotAfter: notAfter}},
+				"meta":   PaginationMeta{Page: 1, TotalPages: 1},
+			})
+		case r.Method == "GET" && r.URL.Path == "/v2/certificates/7":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data": Certificate{ID: 7, Hostname: "shop.example.com", SANs: []string{"shop.example.com", "www.shop.example.com"},
+					NotAfter: notAfter, Chain: []CertificateChainEntry{{Subject: "CN=R11", Issuer: "CN=ISRG Root X1", IsCA: true}}},
+			})
+		case r.Method == "PUT" && r.URL.Path == "/v2/certificates/7/expiry-alert":
+			var body map[string]int
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, 14, body["days_before"])
+			days := body["days_before"]
+			json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "data": Certificate{ID: 7, ExpiryAlertDays: &days}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	certificates, meta, err := client.Certificates.ListCertificates(ctx, "org-1", &CertificateListOptions{Hostname: "shop.example.com", ExpiringWithinDays: 30})
+	require.NoError(t, err)
+	require.Len(t, certificates, 1)
+	assert.Equal(t, "CN=R11", certificates[0].Issuer)
+	assert.Equal(t, 1, meta.TotalPages)
+
+	certificate, err := client.Certificates.GetCertificate(ctx, 7)
+	require.NoError(t, err)
+	assert.Len(t, certificate.SANs, 2)
+	require.Len(t, certificate.Chain, 1)
+	assert.True(t, certificate.Chain[0].IsCA)
+
+	updated, err := client.Certificates.SetExpiryAlert(ctx, 7, 14)
+	require.NoError(t, err)
+	require.NotNil(t, updated.ExpiryAlertDays)
+	assert.Equal(t, 14, *updated.ExpiryAlertDays)
+
+	_, err = client.Certificates.SetExpiryAlert(ctx, 7, -1)
+	assert.Error(t, err)
+	_, _, err = client.Certificates.ListCertificates(ctx, "", nil)
+	assert.Error(t, err)
+}
+
+func TestCertificate_DaysUntilExpiry(t *testing.T) {
+	cert := &Certificate{NotAfter: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)}
+
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, 16, cert.DaysUntilExpiry(now))
+	assert.False(t, cert.IsExpired(now))
+
+	now = time.Date(2026, 11, 1, 6, 0, 0, 0, time.UTC)
+	assert.Equal(t, -1, cert.DaysUntilExpiry(now))
+	assert.True(t, cert.IsExpired(now))
+}
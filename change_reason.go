@@ -0,0 +1,62 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ChangeReasonHeader carries the reason for a mutating request. The API records
+// it as the ChangeReason of the resulting audit log entries.
+const ChangeReasonHeader = "X-Nexmonyx-Change-Reason"
+
+type changeReasonKey struct{}
+
+// WithChangeReason returns a context whose mutating requests (POST, PUT, PATCH,
+// and DELETE) are sent with reason in the ChangeReasonHeader, for example a
+// change ticket reference. Line breaks and repeated whitespace are collapsed.
+//
+// Example:
+//
+//	ctx = nexmonyx.WithChangeReason(ctx, "CHG-1042: decommission rack 7")
+//	err := client.Servers.Delete(ctx, serverID)
+func WithChangeReason(ctx context.Context, reason string) context.Context {
+	return context.WithValue(ctx, changeReasonKey{}, strings.Join(strings.Fields(reason), " "))
+}
+
+// ChangeReasonFromContext returns the reason set with WithChangeReason
+func ChangeReasonFromContext(ctx context.Context) (string, bool) {
+	reason, ok := ctx.Value(changeReasonKey{}).(string)
+	return reason, ok && reason != ""
+}
+
+// isMutatingMethod reports whether requests with method change server state
+func isMutatingMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// setChangeReason adds the context's change reason to mutating requests and
+// enforces Config.RequireChangeReason
+func (c *Client) setChangeReason(ctx context.Context, req *Request, r *resty.Request) error {
+	if !isMutatingMethod(req.Method) {
+		return nil
+	}
+
+	reason, ok := ChangeReasonFromContext(ctx)
+	if !ok {
+		if c.config.RequireChangeReason {
+			return fmt.Errorf("%w: %s %s", ErrChangeReasonRequired, req.Method, req.Path)
+		}
+		return nil
+	}
+
+	r.SetHeader(ChangeReasonHeader, reason)
+	return nil
+}
@@ -0,0 +1,77 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithChangeReason(t *testing.T) {
+	var reasons []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reasons = append(reasons, r.Method+" "+r.Header.Get(ChangeReasonHeader))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	ctx := WithChangeReason(context.Background(), "  CHG-1042:\ndecommission   rack 7 ")
+	reason, ok := ChangeReasonFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, "CHG-1042: decommission rack 7", reason)
+
+	for _, method := range []string{"GET", "POST", "DELETE"} {
+		_, err := client.Do(ctx, &Request{Method: method, Path: "/v1/servers"})
+		require.NoError(t, err)
+	}
+	_, err = client.Do(context.Background(), &Request{Method: "PUT", Path: "/v1/servers"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"GET ",
+		"POST CHG-1042: decommission rack 7",
+		"DELETE CHG-1042: decommission rack 7",
+		"PUT ",
+	}, reasons)
+}
+
+func TestRequireChangeReason(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:             server.URL,
+		Auth:                AuthConfig{Token: "test-token"},
+		RequireChangeReason: true,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	err = client.Servers.Delete(ctx, "srv-1")
+	assert.True(t, errors.Is(err, ErrChangeReasonRequired))
+	assert.Equal(t, int32(0), requests.Load(), "rejected before contacting the API")
+
+	_, err = client.Do(ctx, &Request{Method: "GET", Path: "/v1/servers"})
+	assert.NoError(t, err, "reads do not need a reason")
+
+	err = client.Servers.Delete(WithChangeReason(ctx, "CHG-7"), "srv-1")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), requests.Load())
+
+	_, err = client.Do(WithChangeReason(ctx, "   "), &Request{Method: "PATCH", Path: "/v1/servers"})
+	assert.True(t, errors.Is(err, ErrChangeReasonRequired), "blank reasons do not count")
+}
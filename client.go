@@ -2,9 +2,14 @@ package nexmonyx
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -16,7 +21,7 @@ const (
 
 	defaultTimeout = 30 * time.Second
 	defaultBaseURL = "https://api.nexmonyx.com"
-	userAgent      = "nexmonyx-go-sdk/" + Version
+	userAgent      = SDKName + "/" + Version
 )
 
 // Client is the main entry point for the Nexmonyx SDK
@@ -27,6 +32,42 @@ type Client struct {
 	// Configuration
 	config *Config
 
+	// Instrumentation event bus
+	events *EventBus
+
+	// Recent request summaries, nil when disabled
+	history *requestHistory
+
+	// Platform notices from the most recent response
+	notices *platformNotices
+
+	// Circuit breaker states reported by HealthScore
+	circuits circuitStates
+
+	// Exchanged workload identity credentials, nil when not configured
+	workload *workloadIdentity
+
+	// Multi-endpoint routing, nil when Config.Endpoints is not set
+	router *endpointRouter
+
+	// Offset between the API's clock and the local clock
+	skew clockSkew
+
+	// In-flight requests and background loops drained by Close
+	lifecycle lifecycle
+
+	// Drops the client's reference to its shared transport, nil when
+	// Config.ShareTransport is not set
+	releaseTransport func()
+
+	// Whether requests other than heartbeats fail with ErrSpoolOnly
+	spoolOnly atomic.Bool
+
+	// Prometheus collector, created on first use by MetricsCollector in
+	// builds with the nexmonyx_prometheus tag
+	collectorOnce sync.Once
+	collector     interface{}
+
 	// Service clients
 	Organizations         *OrganizationsService
 	Servers               *ServersService
@@ -35,6 +76,7 @@ type Client struct {
 	Monitoring            *MonitoringService
 	Billing               *BillingService
 	BillingUsage          *BillingUsageService
+	Usage                 *UsageService
 	QuotaHistory          *QuotaHistoryService
 	Settings              *SettingsService
 	Alerts                *AlertsService
@@ -88,6 +130,14 @@ type Client struct {
 	AccessRules           *AccessRulesService
 	Schedules             *SchedulesService
 	MaintenanceWindows    *MaintenanceWindowsService
+	Webhooks              *WebhooksService
+	AgentConfig           *AgentConfigService
+	Policies              *PoliciesService
+	Logs                  *LogsService
+	AlertChannels         *AlertChannelsService
+	AlertRules            *AlertRulesService
+	ProbeTargetGroups     *ProbeTargetGroupsService
+	Certificates          *CertificatesService
 }
 
 // Config holds the configuration for the client
@@ -95,18 +145,67 @@ type Config struct {
 	// Base URL of the Nexmonyx API
 	BaseURL string
 
+	// Endpoints routes requests to one of several API endpoints with
+	// failover, replacing BaseURL. See EndpointConfig.
+	Endpoints *EndpointConfig
+
 	// Authentication configuration
 	Auth AuthConfig
 
 	// HTTP client configuration
 	HTTPClient *http.Client
 
-	// Request timeout
+	// Transport replaces the HTTP client's transport, e.g. to add tracing or
+	// route requests through a custom dialer
+	Transport http.RoundTripper
+
+	// TLSConfig is used for HTTPS connections, e.g. to trust a corporate CA
+	// or present a client certificate for mutual TLS. See NewTLSConfig.
+	// Requires Transport, if set, to be an *http.Transport.
+	TLSConfig *tls.Config
+
+	// ProxyURL routes requests through an HTTP, HTTPS, or SOCKS5 proxy. When
+	// empty, the HTTP_PROXY, HTTPS_PROXY, and NO_PROXY environment variables
+	// apply. Requires Transport, if set, to be an *http.Transport.
+	ProxyURL string
+
+	// Connection pool tuning, applied to a clone of the transport. Zero
+	// values keep the transport's defaults; net/http keeps only 2 idle
+	// connections per host, so raise MaxIdleConnsPerHost for busy clients.
+	// Requires Transport, if set, to be an *http.Transport.
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	MaxConnsPerHost     int
+	IdleConnTimeout     time.Duration
+
+	// KeepAlive is the interval between TCP keep-alive probes (default: 30s);
+	// a negative value disables them. DisableKeepAlives opens a new
+	// connection for every request.
+	KeepAlive         time.Duration
+	DisableKeepAlives bool
+
+	// ForceHTTP2 attempts HTTP/2 even with a custom Transport, dialer, or TLS
+	// configuration, which otherwise disable it
+	ForceHTTP2 bool
+
+	// ShareTransport makes clients with the same transport settings use one
+	// transport and connection pool, including clients derived with
+	// WithToken or ForOrganization, instead of each opening its own
+	// connections. The pool is kept until the last client using it is
+	// closed with Close.
+	ShareTransport bool
+
+	// Request timeout per attempt. Override it for a single request with
+	// WithRequestTimeout.
 	Timeout time.Duration
 
 	// Custom headers to add to all requests
 	Headers map[string]string
 
+	// Organization scopes every request to an organization; see
+	// WithOrganization and ForOrganization
+	Organization string
+
 	// Debug mode enables request/response logging
 	Debug bool
 
@@ -114,6 +213,118 @@ type Config struct {
 	RetryCount    int
 	RetryWaitTime time.Duration
 	RetryMaxWait  time.Duration
+
+	// RetryPolicy, when set, decides which requests are retried and how long
+	// to wait, replacing RetryCount, RetryWaitTime, and RetryMaxWait. See
+	// StandardRetryPolicy for per-method and per-endpoint overrides.
+	RetryPolicy RequestRetryPolicy
+
+	// SplitDeadlineAcrossRetries gives each attempt a share of the time left
+	// before the context deadline, so a slow first attempt cannot use the whole
+	// deadline and leave nothing for retries. Contexts without a deadline are
+	// unaffected.
+	SplitDeadlineAcrossRetries bool
+
+	// MinAttemptTimeout is the smallest timeout an attempt gets when the
+	// deadline is split (default: 1s)
+	MinAttemptTimeout time.Duration
+
+	// Events receives request, retry, rate-limit, and circuit breaker events.
+	// A new bus is created when nil; derived clients share the parent's bus.
+	Events *EventBus
+
+	// RequestHistorySize is the number of recent requests kept for History and
+	// support bundles (default: 50). A negative value disables the history.
+	RequestHistorySize int
+
+	// TolerantDecoding accepts responses whose values have the wrong JSON type
+	// for the SDK models, such as IDs or counts sent as strings, converting them
+	// instead of failing. Each conversion is reported to EventBus.OnDecodeWarning.
+	TolerantDecoding bool
+
+	// StrictDecoding rejects responses with fields the SDK models do not
+	// know, returning a *SchemaMismatchError that lists them, so integration
+	// tests catch drift between the SDK and the API. The response is still
+	// decoded. A request's context can override it with WithStrictDecoding.
+	StrictDecoding bool
+
+	// RequireChangeReason rejects POST, PUT, PATCH, and DELETE requests whose
+	// context has no reason set with WithChangeReason, returning
+	// ErrChangeReasonRequired without contacting the API
+	RequireChangeReason bool
+
+	// DryRun applies to every POST, PUT, PATCH, and DELETE request unless the
+	// request's context overrides it with WithDryRun
+	DryRun DryRunMode
+
+	// SpoolBacklog, when set, reports how many metric submissions the
+	// embedding agent has spooled for a later Metrics.Backfill. HealthScore
+	// includes it and treats a non-empty spool as degraded.
+	SpoolBacklog func() int
+
+	// AppName and AppVersion identify the application built on the SDK and
+	// are added to the User-Agent, e.g. "nexmonyx-go-sdk/2.13.0 my-agent/1.2.3"
+	AppName    string
+	AppVersion string
+
+	// ClientMetadata is sent as a JSON object in the X-Client-Metadata
+	// header, e.g. the agent type or deployment, to attribute API traffic
+	ClientMetadata map[string]string
+
+	// ClockSkewCorrection shifts collected_at and timestamp values in metric
+	// and heartbeat submissions by the offset between the local clock and the
+	// API's, measured from response Date headers, once it exceeds
+	// ClockSkewTolerance (default: 5s). EventBus.OnClockSkew reports the skew
+	// either way.
+	ClockSkewCorrection bool
+	ClockSkewTolerance  time.Duration
+
+	// TimeLayouts are extra layouts, in time.Parse form, that this client
+	// accepts when decoding CustomTime values in responses, after those
+	// registered process-wide with RegisterTimeLayouts. TimeFormat is the
+	// layout CustomTime values in this client's request bodies are encoded
+	// with (default: the SetTimeFormat layout, time.RFC3339 unless changed).
+	TimeLayouts []string
+	TimeFormat  string
+}
+
+// Clone returns a copy of the configuration that shares no mutable state with c.
+// The HTTPClient, Transport, TLSConfig, Events bus, and RetryPolicy are shared
+// rather than copied, since all are safe for concurrent use.
+//
+// Cloning a nil Config returns an empty Config.
+func (c *Config) Clone() *Config {
+	if c == nil {
+		return &Config{}
+	}
+
+	clone := *c
+	if c.Headers != nil {
+		clone.Headers = make(map[string]string, len(c.Headers))
+		for k, v := range c.Headers {
+			clone.Headers[k] = v
+		}
+	}
+	if c.ClientMetadata != nil {
+		clone.ClientMetadata = make(map[string]string, len(c.ClientMetadata))
+		for k, v := range c.ClientMetadata {
+			clone.ClientMetadata[k] = v
+		}
+	}
+	clone.TimeLayouts = append([]string(nil), c.TimeLayouts...)
+	if c.Endpoints != nil {
+		endpoints := *c.Endpoints
+		endpoints.Fallbacks = append([]string(nil), c.Endpoints.Fallbacks...)
+		if c.Endpoints.Regions != nil {
+			endpoints.Regions = make(map[string]string, len(c.Endpoints.Regions))
+			for k, v := range c.Endpoints.Regions {
+				endpoints.Regions[k] = v
+			}
+		}
+		clone.Endpoints = &endpoints
+	}
+
+	return &clone
 }
 
 // AuthConfig holds authentication configuration
@@ -138,13 +349,17 @@ type AuthConfig struct {
 
 	// Registration key authentication (for server registration)
 	RegistrationKey string
+
+	// Workload identity federation: exchanges an OIDC token for short-lived
+	// credentials (takes precedence over all other methods)
+	WorkloadIdentity *WorkloadIdentityConfig
 }
 
-// NewClient creates a new Nexmonyx API client
+// NewClient creates a new Nexmonyx API client.
+// The client keeps its own snapshot of config, so changing config after
+// NewClient returns does not affect the client.
 func NewClient(config *Config) (*Client, error) {
-	if config == nil {
-		config = &Config{}
-	}
+	config = config.Clone()
 
 	// Set defaults
 	if config.BaseURL == "" {
@@ -162,25 +377,48 @@ func NewClient(config *Config) (*Client, error) {
 	if config.RetryMaxWait == 0 {
 		config.RetryMaxWait = 30 * time.Second
 	}
+	if config.Events == nil {
+		config.Events = &EventBus{}
+	}
+	if config.RequestHistorySize == 0 {
+		config.RequestHistorySize = defaultRequestHistorySize
+	}
 
-	// Create HTTP client if not provided
-	httpClient := config.HTTPClient
-	if httpClient == nil {
-		httpClient = &http.Client{
-			Timeout: config.Timeout,
+	// Create HTTP client, applying the transport, TLS, and proxy settings
+	httpClient, releaseTransport, err := newHTTPClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Route each attempt to the active endpoint, inside the per-attempt timeout
+	var router *endpointRouter
+	if config.Endpoints != nil {
+		timeouts := httpClient.Transport.(*timeoutTransport)
+		router, err = newEndpointRouter(config.Endpoints, timeouts.base, config.Events)
+		if err != nil {
+			if releaseTransport != nil {
+				releaseTransport()
+			}
+			return nil, err
 		}
+		timeouts.base = router
+		config.BaseURL = router.primaryURL()
 	}
 
 	// Create resty client
 	restyClient := resty.NewWithClient(httpClient)
 	restyClient.SetBaseURL(config.BaseURL)
-	restyClient.SetTimeout(config.Timeout)
-	restyClient.SetHeader("User-Agent", userAgent)
+	restyClient.SetHeader("User-Agent", config.userAgent())
 	restyClient.SetHeader("Content-Type", "application/json")
 	restyClient.SetHeader("Accept", "application/json")
+	if metadata := config.clientMetadataHeader(); metadata != "" {
+		restyClient.SetHeader(ClientMetadataHeader, metadata)
+	}
 
-	// Set authentication headers (priority order: JWT Token, Unified API Key, Legacy methods)
-	if config.Auth.Token != "" {
+	// Set authentication headers (priority order: Workload Identity, JWT Token, Unified API Key, Legacy methods)
+	if config.Auth.WorkloadIdentity != nil {
+		// Workload identity credentials are exchanged and set per request below
+	} else if config.Auth.Token != "" {
 		// JWT Token authentication (highest priority)
 		restyClient.SetAuthToken(config.Auth.Token)
 	} else if config.Auth.UnifiedAPIKey != "" {
@@ -220,9 +458,10 @@ func NewClient(config *Config) (*Client, error) {
 	restyClient.SetRetryCount(config.RetryCount)
 	restyClient.SetRetryWaitTime(config.RetryWaitTime)
 	restyClient.SetRetryMaxWaitTime(config.RetryMaxWait)
-	restyClient.AddRetryCondition(func(r *resty.Response, err error) bool {
-		return err != nil || r.StatusCode() >= 500 || r.StatusCode() == 429
-	})
+	if config.SplitDeadlineAcrossRetries {
+		restyClient.OnBeforeRequest(trackAttempt)
+		restyClient.GetClient().Transport = &budgetTransport{base: restyClient.GetClient().Transport}
+	}
 
 	// Set debug mode
 	restyClient.SetDebug(config.Debug)
@@ -230,7 +469,38 @@ func NewClient(config *Config) (*Client, error) {
 	// Create client
 	client := &Client{
 		client: restyClient,
-		config: config,
+		config:  config,
+		events:  config.Events,
+		history: newRequestHistory(config.RequestHistorySize),
+		notices: &platformNotices{},
+		router:  router,
+
+		releaseTransport: releaseTransport,
+	}
+	if router != nil {
+		router.circuits = &client.circuits
+	}
+	restyClient.AddRetryCondition(client.shouldRetry)
+	restyClient.AddRetryHook(client.onRetryHook)
+	restyClient.OnAfterResponse(client.observeNotices)
+	restyClient.OnAfterResponse(client.observeClock)
+	restyClient.SetRetryAfter(client.retryAfter)
+	if config.TolerantDecoding {
+		restyClient.SetJSONUnmarshaler(client.tolerantUnmarshal)
+	}
+	if len(config.TimeLayouts) > 0 {
+		restyClient.SetJSONUnmarshaler(client.timeLayoutUnmarshal)
+	}
+	if config.Auth.WorkloadIdentity != nil {
+		client.workload = newWorkloadIdentity(client, config.Auth.WorkloadIdentity)
+		restyClient.OnBeforeRequest(client.workload.authenticate)
+		restyClient.OnAfterResponse(client.workload.invalidate)
+	}
+	if config.RetryPolicy != nil {
+		// The policy decides how often and how long to wait; lift resty's limits
+		restyClient.SetRetryCount(policyRetryLimit)
+		restyClient.SetRetryWaitTime(0)
+		restyClient.SetRetryMaxWaitTime(math.MaxInt64)
 	}
 
 	// Initialize service clients
@@ -241,6 +511,7 @@ func NewClient(config *Config) (*Client, error) {
 	client.Monitoring = &MonitoringService{client: client}
 	client.Billing = &BillingService{client: client}
 	client.BillingUsage = &BillingUsageService{client: client}
+	client.Usage = &UsageService{client: client}
 	client.QuotaHistory = &QuotaHistoryService{client: client}
 	client.Settings = &SettingsService{client: client}
 	client.Alerts = &AlertsService{client: client}
@@ -293,6 +564,14 @@ func NewClient(config *Config) (*Client, error) {
 	client.AccessRules = &AccessRulesService{client: client}
 	client.Schedules = &SchedulesService{client: client}
 	client.MaintenanceWindows = &MaintenanceWindowsService{client: client}
+	client.Webhooks = &WebhooksService{client: client}
+	client.AgentConfig = &AgentConfigService{client: client}
+	client.Policies = &PoliciesService{client: client}
+	client.Logs = &LogsService{client: client}
+	client.AlertChannels = &AlertChannelsService{client: client}
+	client.AlertRules = &AlertRulesService{client: client}
+	client.ProbeTargetGroups = &ProbeTargetGroupsService{client: client}
+	client.Certificates = &CertificatesService{client: client}
 
 	// Note: WebSocket service requires separate initialization via NewWebSocketService()
 	// to ensure proper server credentials validation and connection management
@@ -303,7 +582,7 @@ func NewClient(config *Config) (*Client, error) {
 
 // WithToken creates a new client with the specified authentication token
 func (c *Client) WithToken(token string) *Client {
-	newConfig := *c.config
+	newConfig := c.config.Clone()
 	newConfig.Auth.Token = token
 	newConfig.Auth.UnifiedAPIKey = ""
 	newConfig.Auth.APIKeySecret = ""
@@ -313,14 +592,15 @@ func (c *Client) WithToken(token string) *Client {
 	newConfig.Auth.ServerSecret = ""
 	newConfig.Auth.MonitoringKey = ""
 	newConfig.Auth.RegistrationKey = ""
+	newConfig.Auth.WorkloadIdentity = nil
 
-	newClient, _ := NewClient(&newConfig)
+	newClient, _ := NewClient(newConfig)
 	return newClient
 }
 
 // WithUnifiedAPIKey creates a new client with unified API key authentication (bearer token)
 func (c *Client) WithUnifiedAPIKey(key string) *Client {
-	newConfig := *c.config
+	newConfig := c.config.Clone()
 	newConfig.Auth.Token = ""
 	newConfig.Auth.UnifiedAPIKey = key
 	newConfig.Auth.APIKeySecret = ""
@@ -330,14 +610,15 @@ func (c *Client) WithUnifiedAPIKey(key string) *Client {
 	newConfig.Auth.ServerSecret = ""
 	newConfig.Auth.MonitoringKey = ""
 	newConfig.Auth.RegistrationKey = ""
+	newConfig.Auth.WorkloadIdentity = nil
 
-	newClient, _ := NewClient(&newConfig)
+	newClient, _ := NewClient(newConfig)
 	return newClient
 }
 
 // WithUnifiedAPIKeyAndSecret creates a new client with unified API key authentication (key/secret)
 func (c *Client) WithUnifiedAPIKeyAndSecret(key, secret string) *Client {
-	newConfig := *c.config
+	newConfig := c.config.Clone()
 	newConfig.Auth.Token = ""
 	newConfig.Auth.UnifiedAPIKey = key
 	newConfig.Auth.APIKeySecret = secret
@@ -347,14 +628,15 @@ func (c *Client) WithUnifiedAPIKeyAndSecret(key, secret string) *Client {
 	newConfig.Auth.ServerSecret = ""
 	newConfig.Auth.MonitoringKey = ""
 	newConfig.Auth.RegistrationKey = ""
+	newConfig.Auth.WorkloadIdentity = nil
 
-	newClient, _ := NewClient(&newConfig)
+	newClient, _ := NewClient(newConfig)
 	return newClient
 }
 
 // WithRegistrationKey creates a new client with registration key authentication
 func (c *Client) WithRegistrationKey(key string) *Client {
-	newConfig := *c.config
+	newConfig := c.config.Clone()
 	newConfig.Auth.Token = ""
 	newConfig.Auth.UnifiedAPIKey = ""
 	newConfig.Auth.APIKeySecret = ""
@@ -364,14 +646,15 @@ func (c *Client) WithRegistrationKey(key string) *Client {
 	newConfig.Auth.ServerSecret = ""
 	newConfig.Auth.MonitoringKey = ""
 	newConfig.Auth.RegistrationKey = key
+	newConfig.Auth.WorkloadIdentity = nil
 
-	newClient, _ := NewClient(&newConfig)
+	newClient, _ := NewClient(newConfig)
 	return newClient
 }
 
 // WithAPIKey creates a new client with API key authentication (legacy method)
 func (c *Client) WithAPIKey(key, secret string) *Client {
-	newConfig := *c.config
+	newConfig := c.config.Clone()
 	newConfig.Auth.Token = ""
 	newConfig.Auth.UnifiedAPIKey = ""
 	newConfig.Auth.APIKeySecret = ""
@@ -381,14 +664,15 @@ func (c *Client) WithAPIKey(key, secret string) *Client {
 	newConfig.Auth.ServerSecret = ""
 	newConfig.Auth.MonitoringKey = ""
 	newConfig.Auth.RegistrationKey = ""
+	newConfig.Auth.WorkloadIdentity = nil
 
-	newClient, _ := NewClient(&newConfig)
+	newClient, _ := NewClient(newConfig)
 	return newClient
 }
 
 // WithServerCredentials creates a new client with server authentication
 func (c *Client) WithServerCredentials(uuid, secret string) *Client {
-	newConfig := *c.config
+	newConfig := c.config.Clone()
 	newConfig.Auth.Token = ""
 	newConfig.Auth.UnifiedAPIKey = ""
 	newConfig.Auth.APIKeySecret = ""
@@ -398,14 +682,15 @@ func (c *Client) WithServerCredentials(uuid, secret string) *Client {
 	newConfig.Auth.ServerSecret = secret
 	newConfig.Auth.MonitoringKey = ""
 	newConfig.Auth.RegistrationKey = ""
+	newConfig.Auth.WorkloadIdentity = nil
 
-	newClient, _ := NewClient(&newConfig)
+	newClient, _ := NewClient(newConfig)
 	return newClient
 }
 
 // WithMonitoringKey creates a new client with monitoring key authentication (legacy method)
 func (c *Client) WithMonitoringKey(key string) *Client {
-	newConfig := *c.config
+	newConfig := c.config.Clone()
 	newConfig.Auth.Token = ""
 	newConfig.Auth.UnifiedAPIKey = ""
 	newConfig.Auth.APIKeySecret = ""
@@ -415,16 +700,15 @@ func (c *Client) WithMonitoringKey(key string) *Client {
 	newConfig.Auth.ServerSecret = ""
 	newConfig.Auth.MonitoringKey = key
 	newConfig.Auth.RegistrationKey = ""
+	newConfig.Auth.WorkloadIdentity = nil
 
-	newClient, _ := NewClient(&newConfig)
+	newClient, _ := NewClient(newConfig)
 	return newClient
 }
 
 // NewMonitoringAgentClient creates a new client specifically for monitoring agents
 func NewMonitoringAgentClient(config *Config) (*Client, error) {
-	if config == nil {
-		config = &Config{}
-	}
+	config = config.Clone()
 
 	// Validate that monitoring key is provided
 	if config.Auth.MonitoringKey == "" {
@@ -441,14 +725,48 @@ func NewMonitoringAgentClient(config *Config) (*Client, error) {
 	return NewClient(config)
 }
 
+// Config returns a snapshot of the client's configuration, including applied defaults.
+// Modifying the returned Config does not affect the client; pass it to NewClient
+// to create a new client with different settings.
+func (c *Client) Config() *Config {
+	return c.config.Clone()
+}
+
+// requestBody returns the body to send for req. Its CustomTime values are
+// encoded with Config.TimeFormat, and the timestamps of metric and heartbeat
+// submissions are corrected for clock skew.
+func (c *Client) requestBody(req *Request) interface{} {
+	switch req.Body.(type) {
+	case []byte, string, io.Reader:
+		// Sent as is
+		return req.Body
+	}
+	body := req.Body
+	if c.config.TimeFormat != "" {
+		body = timeFormattedBody{body: body, layout: c.config.TimeFormat}
+	}
+	if c.config.ClockSkewCorrection && skewCorrectedPath(req.Path) {
+		body = skewCorrectedBody{client: c, body: body}
+	}
+	return body
+}
+
 // Do performs a raw HTTP request
 func (c *Client) Do(ctx context.Context, req *Request) (*Response, error) {
+	if err := c.beginRequest(); err != nil {
+		return nil, err
+	}
+	defer c.endRequest()
+	if c.spoolOnlyBlocks(ctx, req) {
+		return nil, ErrSpoolOnly
+	}
+
 	// Build resty request
-	r := c.client.R().SetContext(ctx)
+	r := c.client.R().SetContext(c.requestContext(ctx, req))
 
 	// Set body if provided
 	if req.Body != nil {
-		r.SetBody(req.Body)
+		r.SetBody(c.requestBody(req))
 	}
 
 	// Set query parameters
@@ -460,6 +778,14 @@ func (c *Client) Do(ctx context.Context, req *Request) (*Response, error) {
 	for k, v := range req.Headers {
 		r.SetHeader(k, v)
 	}
+	c.setOrganization(ctx, r)
+	if err := c.setChangeReason(ctx, req, r); err != nil {
+		return nil, err
+	}
+	setFencingToken(ctx, req, r)
+	if err := c.applyDryRun(ctx, req, r); err != nil {
+		return nil, err
+	}
 
 	// Set result and error objects
 	if req.Result != nil {
@@ -487,15 +813,28 @@ func (c *Client) Do(ctx context.Context, req *Request) (*Response, error) {
 	}
 
 	// Execute request
+	start := time.Now()
+	c.events.emitRequestStart(RequestEvent{Method: req.Method, Path: req.Path})
+
 	resp, err := r.Execute(req.Method, req.Path)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		err = requestError(req, resp, err)
+		c.emitRequestEnd(req, resp, start, err)
+		return nil, err
 	}
 
 	// Handle errors
 	if resp.IsError() {
-		return nil, c.handleError(resp)
+		err = withRequestInfo(c.handleError(resp), req, resp)
+		c.emitRequestEnd(req, resp, start, err)
+		return nil, err
 	}
+	if err := c.checkSchema(ctx, resp.Body(), req.Result); err != nil {
+		err = withRequestInfo(err, req, resp)
+		c.emitRequestEnd(req, resp, start, err)
+		return nil, err
+	}
+	c.emitRequestEnd(req, resp, start, nil)
 
 	return &Response{
 		StatusCode: resp.StatusCode(),
@@ -506,28 +845,33 @@ func (c *Client) Do(ctx context.Context, req *Request) (*Response, error) {
 
 // handleError converts HTTP errors to SDK error types
 func (c *Client) handleError(resp *resty.Response) error {
+	return c.errorFromResponse(resp.StatusCode(), resp.Header(), resp.Body())
+}
+
+// errorFromResponse converts an HTTP status, headers, and body to SDK error types
+func (c *Client) errorFromResponse(statusCode int, header http.Header, body []byte) error {
 	// Debug logging for error responses
 	if c.config.Debug {
-		fmt.Printf("[DEBUG] Error Response: Status=%d\n", resp.StatusCode())
-		fmt.Printf("[DEBUG] Error Body: %s\n", string(resp.Body()))
+		fmt.Printf("[DEBUG] Error Response: Status=%d\n", statusCode)
+		fmt.Printf("[DEBUG] Error Body: %s\n", string(body))
 		fmt.Printf("[DEBUG] Response Headers:\n")
-		for k, v := range resp.Header() {
+		for k, v := range header {
 			fmt.Printf("[DEBUG]   %s: %v\n", k, v)
 		}
 	}
 
 	var apiErr APIError
-	if err := json.Unmarshal(resp.Body(), &apiErr); err == nil && apiErr.ErrorType != "" {
+	if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.ErrorType != "" {
 		return &apiErr
 	}
 
 	// Try to parse error message from response body
-	errorMessage := string(resp.Body())
+	errorMessage := string(body)
 
-	switch resp.StatusCode() {
+	switch statusCode {
 	case 400:
 		return &ValidationError{
-			StatusCode: resp.StatusCode(),
+			StatusCode: statusCode,
 			Message:    errorMessage,
 		}
 	case 401:
@@ -555,24 +899,128 @@ func (c *Client) handleError(resp *resty.Response) error {
 		}
 	case 429:
 		return &RateLimitError{
-			RetryAfter: resp.Header().Get("Retry-After"),
+			RetryAfter: header.Get("Retry-After"),
 			Message:    "rate limit exceeded",
 		}
 	case 500, 502, 503, 504:
 		return &InternalServerError{
-			StatusCode: resp.StatusCode(),
+			StatusCode: statusCode,
 			Message:    "internal server error",
-			RequestID:  resp.Header().Get("X-Request-ID"),
+			RequestID:  header.Get(RequestIDHeader),
 		}
 	default:
 		return &APIError{
 			Status:    "error",
-			ErrorCode: fmt.Sprintf("HTTP_%d", resp.StatusCode()),
+			ErrorCode: fmt.Sprintf("HTTP_%d", statusCode),
 			Message:   errorMessage,
 		}
 	}
 }
 
+// download streams a raw (non-JSON) response body to w without buffering it in memory.
+// Error responses are converted to SDK error types the same way as Do.
+func (c *Client) download(ctx context.Context, req *Request, w io.Writer) (int64, error) {
+	var written int64
+	err := c.stream(ctx, req, func(body io.Reader) error {
+		var err error
+		written, err = io.Copy(w, body)
+		if err != nil {
+			return fmt.Errorf("failed to write response body: %w", err)
+		}
+		return nil
+	})
+	return written, err
+}
+
+// stream executes req and passes the unbuffered response body to fn. Error
+// responses are converted to SDK error types the same way as Do and fn is
+// not called. The error returned by fn is returned as is.
+func (c *Client) stream(ctx context.Context, req *Request, fn func(body io.Reader) error) error {
+	if err := c.beginRequest(); err != nil {
+		return err
+	}
+	defer c.endRequest()
+	if c.spoolOnlyBlocks(ctx, req) {
+		return ErrSpoolOnly
+	}
+
+	r := c.client.R().SetContext(c.requestContext(ctx, req)).SetDoNotParseResponse(true)
+	if req.Body != nil {
+		r.SetBody(c.requestBody(req))
+	}
+	if req.Query != nil {
+		r.SetQueryParams(req.Query)
+	}
+	for k, v := range req.Headers {
+		r.SetHeader(k, v)
+	}
+	c.setOrganization(ctx, r)
+	if err := c.setChangeReason(ctx, req, r); err != nil {
+		return err
+	}
+	setFencingToken(ctx, req, r)
+	if err := c.applyDryRun(ctx, req, r); err != nil {
+		return err
+	}
+
+	start := time.Now()
+	c.events.emitRequestStart(RequestEvent{Method: req.Method, Path: req.Path})
+
+	resp, err := r.Execute(req.Method, req.Path)
+	if err != nil {
+		err = requestError(req, resp, err)
+		c.emitRequestEnd(req, resp, start, err)
+		return err
+	}
+
+	body := resp.RawBody()
+	defer body.Close()
+
+	// resty skips response middleware for unparsed responses
+	c.observeNotices(c.client, resp)
+	c.observeClock(c.client, resp)
+	if c.workload != nil {
+		c.workload.invalidate(c.client, resp)
+	}
+
+	if resp.IsError() {
+		errBody, _ := io.ReadAll(io.LimitReader(body, 64*1024))
+		err = withRequestInfo(c.errorFromResponse(resp.StatusCode(), resp.Header(), errBody), req, resp)
+		c.emitRequestEnd(req, resp, start, err)
+		return err
+	}
+
+	err = fn(body)
+	c.emitRequestEnd(req, resp, start, err)
+
+	return err
+}
+
+// requestContext attaches the request identity, attempt budget, and retry
+// decision state used by the resty hooks
+func (c *Client) requestContext(ctx context.Context, req *Request) context.Context {
+	ctx = c.withRetryDecision(c.withAttemptBudget(ctx, req))
+	return withRequestTrace(ctx, req.Method, req.Path)
+}
+
+// emitRequestEnd publishes the outcome of a request on the event bus
+func (c *Client) emitRequestEnd(req *Request, resp *resty.Response, start time.Time, err error) {
+	event := RequestEvent{
+		Method:   req.Method,
+		Path:     req.Path,
+		Duration: time.Since(start),
+		Err:      err,
+	}
+	if resp != nil {
+		event.StatusCode = resp.StatusCode()
+		if resp.Request != nil {
+			event.Attempt = resp.Request.Attempt
+		}
+	}
+	c.events.emitRequestEnd(event)
+	c.recordRequest(event)
+}
+
 // HealthCheck performs a lightweight health check on the API
 // This is a convenience method that calls Health.GetHealth() and returns only the error.
 // It's designed for use in readiness probes and health checks where you only need to know
@@ -656,6 +1104,9 @@ type AgentVersionsService struct{ client *Client }
 
 // getAuthMethod returns a string describing the authentication method being used
 func (c *Client) getAuthMethod() string {
+	if c.config.Auth.WorkloadIdentity != nil {
+		return "Workload Identity"
+	}
 	if c.config.Auth.Token != "" {
 		return "JWT Token"
 	}
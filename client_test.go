@@ -2,8 +2,10 @@ package nexmonyx
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -347,6 +349,106 @@ func TestClient_WithMonitoringKey(t *testing.T) {
 }
 
 
+func TestConfig_Clone(t *testing.T) {
+	assert.Equal(t, &Config{}, (*Config)(nil).Clone())
+
+	httpClient := &http.Client{}
+	events := &EventBus{}
+	original := &Config{
+		BaseURL:    "https://api.example.com",
+		Auth:       AuthConfig{Token: "token"},
+		HTTPClient: httpClient,
+		Headers:    map[string]string{"X-App": "one"},
+		RetryCount: 2,
+		Events:     events,
+	}
+
+	clone := original.Clone()
+	assert.Equal(t, original, clone)
+	assert.NotSame(t, original, clone)
+
+	clone.Headers["X-App"] = "two"
+	clone.Auth.Token = "other"
+	assert.Equal(t, "one", original.Headers["X-App"])
+	assert.Equal(t, "token", original.Auth.Token)
+
+	assert.Same(t, httpClient, clone.HTTPClient)
+	assert.Same(t, events, clone.Events)
+}
+
+func TestNewClient_SnapshotsConfig(t *testing.T) {
+	var gotHeader, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get("X-App")
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "original-token"},
+		Headers: map[string]string{"X-App": "original"},
+	}
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// Defaults are applied to the client's snapshot, not the caller's Config
+	assert.Zero(t, config.Timeout)
+	assert.Equal(t, defaultTimeout, client.Config().Timeout)
+
+	config.Auth.Token = "mutated-token"
+	config.Headers["X-App"] = "mutated"
+	config.BaseURL = "http://mutated.invalid"
+
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/ping"})
+	require.NoError(t, err)
+	assert.Equal(t, "original", gotHeader)
+	assert.Equal(t, "Bearer original-token", gotAuth)
+	assert.Equal(t, server.URL, client.Config().BaseURL)
+}
+
+func TestClient_Config_ReturnsSnapshot(t *testing.T) {
+	client, err := NewClient(&Config{Headers: map[string]string{"X-App": "one"}})
+	require.NoError(t, err)
+
+	snapshot := client.Config()
+	snapshot.Headers["X-App"] = "two"
+	snapshot.Auth.Token = "token"
+
+	assert.Equal(t, "one", client.Config().Headers["X-App"])
+	assert.Empty(t, client.Config().Auth.Token)
+}
+
+func TestClient_DerivedClientsAreIsolated(t *testing.T) {
+	parent, err := NewClient(&Config{
+		Auth:    AuthConfig{Token: "parent-token"},
+		Headers: map[string]string{"X-App": "parent"},
+	})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	derived := make([]*Client, 10)
+	for i := range derived {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			derived[i] = parent.WithUnifiedAPIKey(fmt.Sprintf("key-%d", i))
+		}(i)
+	}
+	wg.Wait()
+
+	for i, child := range derived {
+		assert.Equal(t, fmt.Sprintf("key-%d", i), child.config.Auth.UnifiedAPIKey)
+		assert.Empty(t, child.config.Auth.Token)
+		assert.NotSame(t, parent.config, child.config)
+		child.config.Headers["X-App"] = "child"
+	}
+
+	assert.Equal(t, "parent-token", parent.config.Auth.Token)
+	assert.Equal(t, "parent", parent.config.Headers["X-App"])
+}
+
 func TestClient_Do(t *testing.T) {
 	// Create test server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1397,3 +1499,35 @@ func TestClient_Do_EnhancedCoverage(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+// newTestClient creates a client for config that authenticates with a test
+// token and waits a millisecond between retries, unless config sets these.
+// When handler is not nil, the client talks to a test server running it.
+func newTestClient(t *testing.T, handler http.Handler, config Config) *Client {
+	t.Helper()
+	if handler != nil {
+		server := httptest.NewServer(handler)
+		t.Cleanup(server.Close)
+		config.BaseURL = server.URL
+	}
+	if config.Auth == (AuthConfig{}) {
+		config.Auth = AuthConfig{Token: "test-token"}
+	}
+	if config.RetryWaitTime == 0 {
+		config.RetryWaitTime = time.Millisecond
+	}
+	if config.RetryMaxWait == 0 {
+		config.RetryMaxWait = time.Millisecond
+	}
+	client, err := NewClient(&config)
+	require.NoError(t, err)
+	return client
+}
+
+// jsonResponse returns a handler that responds to every request with body
+func jsonResponse(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}
+}
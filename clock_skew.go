@@ -0,0 +1,210 @@
+package nexmonyx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// defaultClockSkewTolerance is the clock offset accepted before timestamps are
+// corrected and OnClockSkew reports the skew. The Date header has one-second
+// resolution, so smaller offsets cannot be measured reliably.
+const defaultClockSkewTolerance = 5 * time.Second
+
+// skewCorrectedFields are the request body fields shifted by clock skew correction
+var skewCorrectedFields = map[string]bool{
+	"collected_at": true,
+	"timestamp":    true,
+}
+
+// skewCorrectedPaths are the metric submission endpoints whose request
+// timestamps are corrected for clock skew. Heartbeats and BMC sensor readings
+// are matched by skewCorrectedPath.
+var skewCorrectedPaths = map[string]bool{
+	"/v1/metrics":                        true,
+	"/v2/metrics/comprehensive":          true,
+	"/v2/metrics/comprehensive/backfill": true,
+	"/v2/metrics/containers":             true,
+	"/v2/metrics/disk-io":                true,
+	"/v2/metrics/filesystem":             true,
+	"/v2/metrics/smart-health":           true,
+	"/v1/admin/usage-metrics/record":     true,
+}
+
+// ClockSkewEvent reports that the offset between the local clock and the
+// API's has moved beyond or back within Config.ClockSkewTolerance
+type ClockSkewEvent struct {
+	Offset    time.Duration // Server time minus local time; positive when the local clock is behind
+	Tolerance time.Duration
+	Exceeded  bool // Whether Offset is beyond Tolerance
+	Corrected bool // Whether request timestamps are being corrected (Config.ClockSkewCorrection)
+}
+
+// clockSkew tracks the offset between the local clock and the API's, measured
+// from the Date header of each response
+type clockSkew struct {
+	mu       sync.Mutex
+	offset   time.Duration
+	measured bool
+	exceeded bool
+}
+
+// observe records the offset measured from a response and reports whether it
+// moved beyond or back within tolerance
+func (s *clockSkew) observe(offset, tolerance time.Duration) (changed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.offset = offset
+	s.measured = true
+	exceeded := offset > tolerance || offset < -tolerance
+	changed = exceeded != s.exceeded
+	s.exceeded = exceeded
+	return changed
+}
+
+func (s *clockSkew) get() (offset time.Duration, measured, exceeded bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.offset, s.measured, s.exceeded
+}
+
+// ClockSkew returns the latest measured offset between the API's clock and the
+// local clock (server minus local), and false until a response with a Date
+// header has been received
+func (c *Client) ClockSkew() (time.Duration, bool) {
+	offset, measured, _ := c.skew.get()
+	return offset, measured
+}
+
+// GetServerTime asks the API for its current time, estimated from the Date
+// header of a health check and half the round trip, and updates the measured
+// clock skew
+//
+// Example:
+//
+//	serverTime, err := client.GetServerTime(ctx)
+//	if err == nil && time.Until(serverTime).Abs() > time.Minute {
+//	    log.Printf("local clock is off by %s", time.Until(serverTime))
+//	}
+func (c *Client) GetServerTime(ctx context.Context) (time.Time, error) {
+	resp, err := c.Do(ctx, &Request{Method: "GET", Path: "/v1/healthz"})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if _, err := http.ParseTime(resp.Headers.Get("Date")); err != nil {
+		return time.Time{}, fmt.Errorf("API response has no valid Date header: %w", err)
+	}
+	offset, _ := c.ClockSkew()
+	return time.Now().Add(offset), nil
+}
+
+// skewCorrection returns the offset applied to request timestamps, or 0 when
+// correction is disabled or the clocks agree within tolerance
+func (c *Client) skewCorrection() time.Duration {
+	if !c.config.ClockSkewCorrection {
+		return 0
+	}
+	offset, _, exceeded := c.skew.get()
+	if !exceeded {
+		return 0
+	}
+	return offset
+}
+
+func (c *Client) clockSkewTolerance() time.Duration {
+	if c.config.ClockSkewTolerance > 0 {
+		return c.config.ClockSkewTolerance
+	}
+	return defaultClockSkewTolerance
+}
+
+// observeClock measures the clock offset from the response's Date header.
+// The server's clock read somewhere in the second after Date, at about the
+// midpoint of the attempt.
+func (c *Client) observeClock(_ *resty.Client, resp *resty.Response) error {
+	date, err := http.ParseTime(resp.Header().Get("Date"))
+	if err != nil || resp.Request == nil || resp.Request.Time.IsZero() {
+		return nil
+	}
+	sent, received := resp.Request.Time, resp.ReceivedAt()
+	midpoint := sent.Add(received.Sub(sent) / 2)
+	offset := date.Add(500 * time.Millisecond).Sub(midpoint).Round(time.Millisecond)
+
+	tolerance := c.clockSkewTolerance()
+	if c.skew.observe(offset, tolerance) {
+		_, _, exceeded := c.skew.get()
+		c.events.emitClockSkew(ClockSkewEvent{
+			Offset:    offset,
+			Tolerance: tolerance,
+			Exceeded:  exceeded,
+			Corrected: exceeded && c.config.ClockSkewCorrection,
+		})
+	}
+	return nil
+}
+
+// skewCorrectedPath reports whether requests to path submit metrics or a
+// heartbeat, whose timestamps were taken from the local clock
+func skewCorrectedPath(path string) bool {
+	return skewCorrectedPaths[path] || strings.HasSuffix(path, "/heartbeat") ||
+		strings.HasPrefix(path, "/v2/ipmi/") && strings.HasSuffix(path, "/sensors")
+}
+
+// skewCorrectedBody encodes a request body like json.Marshal, shifting
+// collected_at and timestamp values by the measured clock skew so the API does
+// not reject them as being in the future or too old
+type skewCorrectedBody struct {
+	client *Client
+	body   interface{}
+}
+
+// MarshalJSON implements json.Marshaler
+func (b skewCorrectedBody) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(b.body)
+	correction := b.client.skewCorrection()
+	if err != nil || correction == 0 || !bytes.Contains(data, []byte(`"collected_at"`)) && !bytes.Contains(data, []byte(`"timestamp"`)) {
+		return data, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var tree interface{}
+	if dec.Decode(&tree) != nil {
+		return data, nil
+	}
+	return json.Marshal(shiftTimestamps(tree, correction))
+}
+
+// shiftTimestamps adds correction to every RFC 3339 value of a skew-corrected
+// field in node, keeping the value's precision
+func shiftTimestamps(node interface{}, correction time.Duration) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for key, value := range n {
+			if s, ok := value.(string); ok && skewCorrectedFields[key] {
+				if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+					layout := time.RFC3339
+					if t.Nanosecond() != 0 {
+						layout = time.RFC3339Nano
+					}
+					n[key] = t.Add(correction).Format(layout)
+				}
+				continue
+			}
+			n[key] = shiftTimestamps(value, correction)
+		}
+	case []interface{}:
+		for i, value := range n {
+			n[i] = shiftTimestamps(value, correction)
+		}
+	}
+	return node
+}
@@ -0,0 +1,164 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// skewedServer answers like an API whose clock is offset from the local clock
+// and records the last request body
+func skewedServer(t *testing.T, offset time.Duration, body *map[string]interface{}) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if data, _ := io.ReadAll(r.Body); len(data) > 0 && body != nil {
+			require.NoError(t, json.Unmarshal(data, body))
+		}
+		w.Header().Set("Date", time.Now().Add(offset).UTC().Format(http.TimeFormat))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_GetServerTime(t *testing.T) {
+	server := skewedServer(t, time.Hour, nil)
+	client, err := NewClient(&Config{BaseURL: server.URL})
+	require.NoError(t, err)
+
+	_, measured := client.ClockSkew()
+	assert.False(t, measured)
+
+	serverTime, err := client.GetServerTime(context.Background())
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), serverTime, 2*time.Second)
+
+	offset, measured := client.ClockSkew()
+	assert.True(t, measured)
+	assert.InDelta(t, time.Hour.Seconds(), offset.Seconds(), 2)
+}
+
+func TestClient_ClockSkewCorrection(t *testing.T) {
+	var body map[string]interface{}
+	server := skewedServer(t, -10*time.Minute, &body)
+	client, err := NewClient(&Config{BaseURL: server.URL, ClockSkewCorrection: true})
+	require.NoError(t, err)
+
+	var events []ClockSkewEvent
+	client.Events().OnClockSkew(func(e ClockSkewEvent) { events = append(events, e) })
+
+	collectedAt := time.Now().UTC().Truncate(time.Second)
+	req := map[string]interface{}{
+		"server_uuid":  "server-1",
+		"collected_at": collectedAt.Format(time.RFC3339),
+		"metrics":      []interface{}{map[string]interface{}{"timestamp": collectedAt.Format(time.RFC3339), "value": 1.5}},
+	}
+
+	// The first request measures the skew
+	_, err = client.Do(context.Background(), &Request{Method: "POST", Path: "/v2/metrics/comprehensive", Body: req})
+	require.NoError(t, err)
+	assert.Equal(t, collectedAt.Format(time.RFC3339), body["collected_at"])
+	require.Len(t, events, 1)
+	assert.True(t, events[0].Exceeded)
+	assert.True(t, events[0].Corrected)
+	assert.InDelta(t, (-10 * time.Minute).Seconds(), events[0].Offset.Seconds(), 2)
+
+	// Later requests are corrected
+	_, err = client.Do(context.Background(), &Request{Method: "POST", Path: "/v2/metrics/comprehensive", Body: req})
+	require.NoError(t, err)
+	corrected, err := time.Parse(time.RFC3339, body["collected_at"].(string))
+	require.NoError(t, err)
+	assert.WithinDuration(t, collectedAt.Add(-10*time.Minute), corrected, 2*time.Second)
+	nested, err := time.Parse(time.RFC3339, body["metrics"].([]interface{})[0].(map[string]interface{})["timestamp"].(string))
+	require.NoError(t, err)
+	assert.Equal(t, corrected, nested)
+	assert.Equal(t, 1.5, body["metrics"].([]interface{})[0].(map[string]interface{})["value"])
+	assert.Equal(t, collectedAt.Format(time.RFC3339), req["collected_at"], "the caller's body is not modified")
+	assert.Len(t, events, 1, "events are only sent when the skew changes state")
+
+	// Only metric and heartbeat submissions are corrected
+	_, err = client.Do(context.Background(), &Request{Method: "POST", Path: "/v2/metrics/query", Body: req})
+	require.NoError(t, err)
+	assert.Equal(t, collectedAt.Format(time.RFC3339), body["collected_at"])
+	assert.Equal(t, collectedAt.Format(time.RFC3339), body["metrics"].([]interface{})[0].(map[string]interface{})["timestamp"])
+}
+
+func TestClient_ClockSkewCorrection_SubmitComprehensive(t *testing.T) {
+	var body map[string]interface{}
+	server := skewedServer(t, -10*time.Minute, &body)
+	client, err := NewClient(&Config{BaseURL: server.URL, ClockSkewCorrection: true})
+	require.NoError(t, err)
+
+	// Measure the skew, then send a timestamp taken from the local clock
+	require.NoError(t, client.Metrics.SubmitComprehensive(context.Background(), &ComprehensiveMetricsRequest{ServerUUID: "server-1"}))
+	err = client.Metrics.SubmitComprehensive(context.Background(), &ComprehensiveMetricsRequest{
+		ServerUUID:  "server-1",
+		CollectedAt: time.Now().UTC().Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+
+	sent, err := time.Parse(time.RFC3339, body["collected_at"].(string))
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(-10*time.Minute), sent, 2*time.Second, "corrected exactly once")
+}
+
+func TestClient_ClockSkew_StreamedRequests(t *testing.T) {
+	server := skewedServer(t, time.Hour, nil)
+	client, err := NewClient(&Config{BaseURL: server.URL})
+	require.NoError(t, err)
+
+	err = client.stream(context.Background(), &Request{Method: "GET", Path: "/v1/servers"}, func(body io.Reader) error {
+		_, err := io.Copy(io.Discard, body)
+		return err
+	})
+	require.NoError(t, err)
+
+	offset, measured := client.ClockSkew()
+	assert.True(t, measured, "streamed responses are observed too")
+	assert.InDelta(t, time.Hour.Seconds(), offset.Seconds(), 2)
+}
+
+func TestClient_ClockSkewWithinTolerance(t *testing.T) {
+	var body map[string]interface{}
+	server := skewedServer(t, 10*time.Minute, &body)
+	client, err := NewClient(&Config{BaseURL: server.URL, ClockSkewCorrection: true, ClockSkewTolerance: time.Hour})
+	require.NoError(t, err)
+
+	var events int
+	client.Events().OnClockSkew(func(ClockSkewEvent) { events++ })
+
+	collectedAt := time.Now().UTC().Format(time.RFC3339)
+	for i := 0; i < 2; i++ {
+		_, err = client.Do(context.Background(), &Request{Method: "POST", Path: "/v2/metrics/comprehensive", Body: map[string]string{"collected_at": collectedAt}})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, collectedAt, body["collected_at"])
+	assert.Zero(t, events)
+}
+
+func TestSkewCorrectedPath(t *testing.T) {
+	assert.True(t, skewCorrectedPath("/v2/metrics/comprehensive"))
+	assert.True(t, skewCorrectedPath("/v1/controllers/ingest/heartbeat"))
+	assert.True(t, skewCorrectedPath("/v2/ipmi/server-1/sensors"))
+	assert.False(t, skewCorrectedPath("/v2/metrics/query"))
+	assert.False(t, skewCorrectedPath("/v1/alerts"))
+}
+
+func TestShiftTimestamps(t *testing.T) {
+	node := map[string]interface{}{
+		"collected_at": "2026-01-02T03:04:05.123456Z",
+		"timestamp":    "not a time",
+		"created_at":   "2026-01-02T03:04:05Z",
+	}
+	shiftTimestamps(node, time.Minute)
+	assert.Equal(t, "2026-01-02T03:05:05.123456Z", node["collected_at"])
+	assert.Equal(t, "not a time", node["timestamp"])
+	assert.Equal(t, "2026-01-02T03:04:05Z", node["created_at"])
+}
@@ -150,3 +150,76 @@ func (s *ClustersService) DeleteCluster(ctx context.Context, clusterID uint) err
 
 	return nil
 }
+
+// TestClusterConnection tests connectivity to a cluster's API server
+// Authentication: JWT Token required (admin)
+// Endpoint: POST /v1/admin/clusters/{id}/test
+// Parameters:
+//   - clusterID: Cluster ID
+// Returns: ClusterConnectionTestResult with reachability, latency, and version details
+func (s *ClustersService) TestClusterConnection(ctx context.Context, clusterID uint) (*ClusterConnectionTestResult, error) {
+	var resp struct {
+		Data    *ClusterConnectionTestResult `json:"data"`
+		Status  string                       `json:"status"`
+		Message string                       `json:"message"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/admin/clusters/%d/test", clusterID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// GetClusterStatistics retrieves aggregate statistics across all monitored clusters
+// Authentication: JWT Token required (admin)
+// Endpoint: GET /v1/admin/clusters/statistics
+// Returns: ClusterStatistics with status counts and node/pod totals
+func (s *ClustersService) GetClusterStatistics(ctx context.Context) (*ClusterStatistics, error) {
+	var resp struct {
+		Data    *ClusterStatistics `json:"data"`
+		Status  string             `json:"status"`
+		Message string             `json:"message"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   "/v1/admin/clusters/statistics",
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// RefreshClusterStatus triggers a connectivity check for a cluster and returns its updated state
+// Authentication: JWT Token required (admin)
+// Endpoint: POST /v1/admin/clusters/{id}/refresh
+// Parameters:
+//   - clusterID: Cluster ID
+// Returns: Cluster object with refreshed status, node/pod counts, and check timestamps
+func (s *ClustersService) RefreshClusterStatus(ctx context.Context, clusterID uint) (*Cluster, error) {
+	var resp struct {
+		Data    *Cluster `json:"data"`
+		Status  string   `json:"status"`
+		Message string   `json:"message"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/admin/clusters/%d/refresh", clusterID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
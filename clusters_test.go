@@ -279,6 +279,138 @@ func TestClustersService_DeleteCluster(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestClustersService_TestClusterConnection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/admin/clusters/123/test", r.URL.Path)
+		assert.NotEmpty(t, r.Header.Get("Authorization"))
+
+		response := struct {
+			Data    *ClusterConnectionTestResult `json:"data"`
+			Status  string                       `json:"status"`
+			Message string                       `json:"message"`
+		}{
+			Data: &ClusterConnectionTestResult{
+				Success:           true,
+				Status:            "online",
+				LatencyMs:         42,
+				KubernetesVersion: "v1.29.2",
+				NodeCount:         5,
+				TestedAt:          &CustomTime{Time: time.Now()},
+			},
+			Status:  "success",
+			Message: "Connection test completed",
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	result, err := client.Clusters.TestClusterConnection(context.Background(), 123)
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, "online", result.Status)
+	assert.Equal(t, int64(42), result.LatencyMs)
+	assert.Equal(t, "v1.29.2", result.KubernetesVersion)
+	assert.Equal(t, 5, result.NodeCount)
+	assert.NotNil(t, result.TestedAt)
+}
+
+func TestClustersService_GetClusterStatistics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/admin/clusters/statistics", r.URL.Path)
+		assert.NotEmpty(t, r.Header.Get("Authorization"))
+
+		response := struct {
+			Data    *ClusterStatistics `json:"data"`
+			Status  string             `json:"status"`
+			Message string             `json:"message"`
+		}{
+			Data: &ClusterStatistics{
+				TotalClusters:    3,
+				OnlineClusters:   2,
+				OfflineClusters:  1,
+				TotalNodes:       8,
+				TotalPods:        225,
+				AverageNodeCount: 2.67,
+				ClustersByStatus: map[string]int{"online": 2, "offline": 1},
+				LastCheckTime:    CustomTime{Time: time.Now()},
+			},
+			Status: "success",
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	stats, err := client.Clusters.GetClusterStatistics(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.TotalClusters)
+	assert.Equal(t, 2, stats.OnlineClusters)
+	assert.Equal(t, 1, stats.OfflineClusters)
+	assert.Equal(t, 8, stats.TotalNodes)
+	assert.Equal(t, 2, stats.ClustersByStatus["online"])
+}
+
+func TestClustersService_RefreshClusterStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/admin/clusters/321/refresh", r.URL.Path)
+		assert.NotEmpty(t, r.Header.Get("Authorization"))
+
+		response := struct {
+			Data    *Cluster `json:"data"`
+			Status  string   `json:"status"`
+			Message string   `json:"message"`
+		}{
+			Data: &Cluster{
+				ID:           321,
+				Name:         "Edge Cluster",
+				Status:       "error",
+				ErrorMessage: "x509: certificate signed by unknown authority",
+				LastChecked:  &CustomTime{Time: time.Now()},
+				IsActive:     true,
+				CreatedAt:    CustomTime{Time: time.Now()},
+				UpdatedAt:    CustomTime{Time: time.Now()},
+			},
+			Status:  "success",
+			Message: "Cluster status refreshed",
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	cluster, err := client.Clusters.RefreshClusterStatus(context.Background(), 321)
+	require.NoError(t, err)
+	assert.Equal(t, uint(321), cluster.ID)
+	assert.Equal(t, "error", cluster.Status)
+	assert.Contains(t, cluster.ErrorMessage, "x509")
+	assert.NotNil(t, cluster.LastChecked)
+}
+
 func TestClustersService_ErrorHandling(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -0,0 +1,383 @@
+// Package collect gathers the local system's inventory and metrics into a
+// nexmonyx.ComprehensiveMetricsRequest, so agents do not each have to
+// re-implement collection of system information, CPU, memory, disk, and
+// network metrics.
+//
+// Example:
+//
+//	req, err := collect.CollectAll(ctx, collect.CollectOptions{
+//	    ServerUUID:        serverUUID,
+//	    CPUSampleInterval: 2 * time.Second,
+//	})
+//	if err != nil {
+//	    log.Printf("partial collection: %v", err) // req holds every section that succeeded
+//	}
+//	err = client.Metrics.SubmitComprehensive(ctx, req)
+package collect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"slices"
+	"sync"
+	"time"
+
+	nexmonyx "github.com/nexmonyx/go-sdk/v2"
+	"github.com/shirou/gopsutil/v4/cpu"
+	"github.com/shirou/gopsutil/v4/disk"
+	"github.com/shirou/gopsutil/v4/host"
+	"github.com/shirou/gopsutil/v4/load"
+	"github.com/shirou/gopsutil/v4/mem"
+	"github.com/shirou/gopsutil/v4/net"
+)
+
+const defaultCPUSampleInterval = time.Second
+
+// CollectOptions configures CollectAll. The zero value collects every section
+// with a one second CPU sample.
+type CollectOptions struct {
+	ServerUUID string
+
+	// Sections to leave out of the request
+	DisableSystemInfo  bool
+	DisableCPU         bool
+	DisableMemory      bool
+	DisableDisks       bool
+	DisableNetwork     bool
+	DisableTemperature bool
+	DisablePower       bool
+
+	// CPUSampleInterval is how long CPU times are sampled to compute usage
+	// percentages (default: 1s). CollectAll takes at least this long unless
+	// the CPU section is disabled.
+	CPUSampleInterval time.Duration
+
+	// PowerSampleInterval is how long RAPL energy counters are sampled to
+	// compute power draw (default: 1s). It runs alongside the CPU sample.
+	PowerSampleInterval time.Duration
+
+	// AllFilesystems includes pseudo and virtual filesystems such as tmpfs
+	// and overlay mounts, which are skipped by default
+	AllFilesystems bool
+
+	// IncludeLoopback reports loopback interfaces, which are skipped by default
+	IncludeLoopback bool
+
+	// Services, when set, adds systemd services, their metrics and new
+	// journal entries to the request
+	Services *ServiceCollector
+}
+
+// CollectAll collects the enabled sections concurrently. A section that
+// fails is left out and its error is included in the returned error; the
+// request holds every section that succeeded and is nil only if ctx ends
+// before collection finishes.
+func CollectAll(ctx context.Context, opts CollectOptions) (*nexmonyx.ComprehensiveMetricsRequest, error) {
+	req := &nexmonyx.ComprehensiveMetricsRequest{ServerUUID: opts.ServerUUID}
+
+	type section struct {
+		name    string
+		enabled bool
+		collect func(context.Context) error
+	}
+	sections := []section{
+		{"system info", !opts.DisableSystemInfo, func(ctx context.Context) (err error) {
+			req.SystemInfo, err = SystemInfo(ctx)
+			return err
+		}},
+		{"cpu", !opts.DisableCPU, func(ctx context.Context) (err error) {
+			req.CPU, err = CPU(ctx, opts.CPUSampleInterval)
+			return err
+		}},
+		{"memory", !opts.DisableMemory, func(ctx context.Context) (err error) {
+			req.Memory, err = Memory(ctx)
+			return err
+		}},
+		{"disks", !opts.DisableDisks, func(ctx context.Context) (err error) {
+			req.Disks, err = Disks(ctx, opts.AllFilesystems)
+			return err
+		}},
+		{"network", !opts.DisableNetwork, func(ctx context.Context) (err error) {
+			req.Network, err = Network(ctx, opts.IncludeLoopback)
+			return err
+		}},
+		{"temperature", !opts.DisableTemperature, func(ctx context.Context) error {
+			temperature, err := Temperatures(ctx)
+			if err == nil && len(temperature.Sensors) > 0 {
+				req.Temperature = temperature
+			}
+			return err
+		}},
+		{"power", !opts.DisablePower, func(ctx context.Context) error {
+			power, err := Power(ctx, opts.PowerSampleInterval)
+			if err == nil && len(power.PowerSupplies) > 0 {
+				req.Power = power
+			}
+			return err
+		}},
+		{"services", opts.Services != nil, func(ctx context.Context) (err error) {
+			req.Services, err = opts.Services.Collect(ctx)
+			return err
+		}},
+	}
+
+	errs := make([]error, len(sections))
+	var wg sync.WaitGroup
+	for i, s := range sections {
+		if !s.enabled {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, s section) {
+			defer wg.Done()
+			if err := s.collect(ctx); err != nil {
+				errs[i] = fmt.Errorf("%s: %w", s.name, err)
+			}
+		}(i, s)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(req.Disks) > 0 {
+		req.DiskUsageAggregate = nexmonyx.AggregateDiskUsage(req.Disks)
+	}
+	req.CollectedAt = time.Now().UTC().Format(time.RFC3339)
+	return req, errors.Join(errs...)
+}
+
+// SystemInfo describes the host, its operating system, and its kernel
+func SystemInfo(ctx context.Context) (*nexmonyx.SystemInfo, error) {
+	info, err := host.InfoWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Logged-in users are unavailable in many containers; report none
+	users, _ := host.UsersWithContext(ctx)
+
+	return &nexmonyx.SystemInfo{
+		Hostname:        info.Hostname,
+		OS:              info.OS,
+		OSVersion:       info.PlatformVersion,
+		Kernel:          info.KernelVersion,
+		KernelVersion:   info.KernelVersion,
+		Architecture:    info.KernelArch,
+		CPUArchitecture: runtime.GOARCH,
+		Uptime:          int64(info.Uptime),
+		BootTime:        int64(info.BootTime),
+		Processes:       int(info.Procs),
+		UsersLoggedIn:   len(users),
+		Platform:        info.Platform,
+		PlatformFamily:  info.PlatformFamily,
+	}, nil
+}
+
+// CPU samples CPU times over interval (default: 1s) and reports usage
+// percentages overall and per core, along with load averages and core counts
+func CPU(ctx context.Context, interval time.Duration) (*nexmonyx.CPUMetrics, error) {
+	if interval <= 0 {
+		interval = defaultCPUSampleInterval
+	}
+
+	before, err := cpuTimes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case <-time.After(interval):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	after, err := cpuTimes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := cpuUsage(before.total, after.total)
+	if len(before.cores) == len(after.cores) {
+		for i := range after.cores {
+			metrics.PerCoreUsage = append(metrics.PerCoreUsage, cpuUsage(before.cores[i], after.cores[i]).UsagePercent)
+		}
+	}
+
+	if avg, err := load.AvgWithContext(ctx); err == nil {
+		metrics.LoadAverage1 = avg.Load1
+		metrics.LoadAverage5 = avg.Load5
+		metrics.LoadAverage15 = avg.Load15
+	}
+	if cores, err := cpu.CountsWithContext(ctx, false); err == nil {
+		metrics.CoreCount = cores
+	}
+	if threads, err := cpu.CountsWithContext(ctx, true); err == nil {
+		metrics.ThreadCount = threads
+	}
+	if metrics.CoreCount == 0 {
+		metrics.CoreCount = metrics.ThreadCount
+	}
+	return metrics, nil
+}
+
+type cpuSample struct {
+	total cpu.TimesStat
+	cores []cpu.TimesStat
+}
+
+func cpuTimes(ctx context.Context) (*cpuSample, error) {
+	total, err := cpu.TimesWithContext(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(total) == 0 {
+		return nil, fmt.Errorf("no CPU times reported")
+	}
+	// Per-core times are optional; overall usage is still useful without them
+	cores, _ := cpu.TimesWithContext(ctx, true)
+	return &cpuSample{total: total[0], cores: cores}, nil
+}
+
+// cpuUsage converts the CPU time spent between two samples into percentages
+func cpuUsage(before, after cpu.TimesStat) *nexmonyx.CPUMetrics {
+	// Guest time is already counted in user time
+	total := (after.Total() - after.Guest - after.GuestNice) - (before.Total() - before.Guest - before.GuestNice)
+	if total <= 0 {
+		return &nexmonyx.CPUMetrics{IdlePercent: 100}
+	}
+	percent := func(b, a float64) float64 {
+		return round2(math.Max(0, a-b) / total * 100)
+	}
+
+	metrics := &nexmonyx.CPUMetrics{
+		UserPercent:   percent(before.User+before.Nice, after.User+after.Nice),
+		SystemPercent: percent(before.System+before.Irq+before.Softirq, after.System+after.Irq+after.Softirq),
+		IdlePercent:   percent(before.Idle, after.Idle),
+		IOWaitPercent: percent(before.Iowait, after.Iowait),
+		StealPercent:  percent(before.Steal, after.Steal),
+	}
+	metrics.UsagePercent = round2(math.Max(0, 100-metrics.IdlePercent-metrics.IOWaitPercent))
+	return metrics
+}
+
+// Memory reports physical memory and swap usage
+func Memory(ctx context.Context) (*nexmonyx.MemoryMetrics, error) {
+	vm, err := mem.VirtualMemoryWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := &nexmonyx.MemoryMetrics{
+		TotalBytes:     int64(vm.Total),
+		UsedBytes:      int64(vm.Used),
+		FreeBytes:      int64(vm.Free),
+		AvailableBytes: int64(vm.Available),
+		UsagePercent:   round2(vm.UsedPercent),
+		BuffersBytes:   int64(vm.Buffers),
+		CachedBytes:    int64(vm.Cached),
+	}
+	// Hosts without swap report an error on some platforms
+	if swap, err := mem.SwapMemoryWithContext(ctx); err == nil {
+		metrics.SwapTotalBytes = int64(swap.Total)
+		metrics.SwapUsedBytes = int64(swap.Used)
+		metrics.SwapFreeBytes = int64(swap.Free)
+		metrics.SwapUsagePercent = round2(swap.UsedPercent)
+	}
+	return metrics, nil
+}
+
+// Disks reports usage for each mounted filesystem. Unless all is set, pseudo
+// and virtual filesystems are skipped. A device mounted more than once is
+// reported for its first mount point only.
+func Disks(ctx context.Context, all bool) ([]nexmonyx.DiskMetrics, error) {
+	partitions, err := disk.PartitionsWithContext(ctx, all)
+	if err != nil {
+		return nil, err
+	}
+
+	var disks []nexmonyx.DiskMetrics
+	seen := make(map[string]bool)
+	for _, partition := range partitions {
+		if !all && (seen[partition.Device] || virtualFilesystems[partition.Fstype]) {
+			continue
+		}
+		usage, err := disk.UsageWithContext(ctx, partition.Mountpoint)
+		if err != nil || usage.Total == 0 {
+			// Unreadable mounts, such as ones the agent may not access, are skipped
+			continue
+		}
+		seen[partition.Device] = true
+
+		disks = append(disks, nexmonyx.DiskMetrics{
+			Device:             partition.Device,
+			Mountpoint:         partition.Mountpoint,
+			Filesystem:         partition.Fstype,
+			TotalBytes:         int64(usage.Total),
+			UsedBytes:          int64(usage.Used),
+			FreeBytes:          int64(usage.Free),
+			UsagePercent:       round2(usage.UsedPercent),
+			InodesTotal:        int64(usage.InodesTotal),
+			InodesUsed:         int64(usage.InodesUsed),
+			InodesFree:         int64(usage.InodesFree),
+			InodesUsagePercent: round2(usage.InodesUsedPercent),
+		})
+	}
+	return disks, nil
+}
+
+// virtualFilesystems are skipped by Disks unless all filesystems are requested
+var virtualFilesystems = map[string]bool{
+	"tmpfs": true, "devtmpfs": true, "overlay": true, "squashfs": true,
+	"proc": true, "sysfs": true, "cgroup": true, "cgroup2": true,
+	"devpts": true, "mqueue": true, "debugfs": true, "tracefs": true,
+	"securityfs": true, "pstore": true, "bpf": true, "autofs": true,
+	"hugetlbfs": true, "fusectl": true, "configfs": true, "nsfs": true,
+	"ramfs": true, "binfmt_misc": true, "rpc_pipefs": true,
+}
+
+// Network reports cumulative traffic counters for each interface. Unless
+// includeLoopback is set, loopback interfaces are skipped.
+func Network(ctx context.Context, includeLoopback bool) ([]nexmonyx.NetworkMetrics, error) {
+	counters, err := net.IOCountersWithContext(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	loopback := make(map[string]bool)
+	if !includeLoopback {
+		interfaces, err := net.InterfacesWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, iface := range interfaces {
+			if slices.Contains(iface.Flags, "loopback") {
+				loopback[iface.Name] = true
+			}
+		}
+	}
+
+	var metrics []nexmonyx.NetworkMetrics
+	for _, c := range counters {
+		if loopback[c.Name] {
+			continue
+		}
+		metrics = append(metrics, nexmonyx.NetworkMetrics{
+			Interface:   c.Name,
+			BytesRecv:   int64(c.BytesRecv),
+			BytesSent:   int64(c.BytesSent),
+			PacketsRecv: int64(c.PacketsRecv),
+			PacketsSent: int64(c.PacketsSent),
+			ErrorsIn:    int64(c.Errin),
+			ErrorsOut:   int64(c.Errout),
+			DropsIn:     int64(c.Dropin),
+			DropsOut:    int64(c.Dropout),
+		})
+	}
+	return metrics, nil
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
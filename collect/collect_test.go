@@ -0,0 +1,72 @@
+package collect
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shirou/gopsutil/v4/cpu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectAll(t *testing.T) {
+	req, err := CollectAll(context.Background(), CollectOptions{
+		ServerUUID:        "srv-123",
+		CPUSampleInterval: 50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "srv-123", req.ServerUUID)
+	assert.NotEmpty(t, req.CollectedAt)
+	require.NotNil(t, req.SystemInfo)
+	assert.NotEmpty(t, req.SystemInfo.Hostname)
+	require.NotNil(t, req.CPU)
+	assert.GreaterOrEqual(t, req.CPU.UsagePercent, 0.0)
+	assert.LessOrEqual(t, req.CPU.UsagePercent, 100.0)
+	assert.Positive(t, req.CPU.ThreadCount)
+	require.NotNil(t, req.Memory)
+	assert.Positive(t, req.Memory.TotalBytes)
+	for _, iface := range req.Network {
+		assert.NotEqual(t, "lo", iface.Interface)
+	}
+}
+
+func TestCollectAll_DisabledSections(t *testing.T) {
+	req, err := CollectAll(context.Background(), CollectOptions{
+		DisableCPU:     true,
+		DisableDisks:   true,
+		DisableNetwork: true,
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, req.SystemInfo)
+	assert.NotNil(t, req.Memory)
+	assert.Nil(t, req.CPU)
+	assert.Nil(t, req.Disks)
+	assert.Nil(t, req.DiskUsageAggregate)
+	assert.Nil(t, req.Network)
+}
+
+func TestCollectAll_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := CollectAll(ctx, CollectOptions{CPUSampleInterval: time.Minute})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestCPUUsage(t *testing.T) {
+	before := cpu.TimesStat{User: 100, System: 50, Idle: 800, Iowait: 50}
+	after := cpu.TimesStat{User: 130, Nice: 10, System: 60, Irq: 5, Softirq: 5, Idle: 920, Iowait: 60, Steal: 10, Guest: 20}
+
+	// 200 ticks elapsed once the guest time folded into user is excluded
+	metrics := cpuUsage(before, after)
+	assert.Equal(t, 20.0, metrics.UserPercent)
+	assert.Equal(t, 10.0, metrics.SystemPercent)
+	assert.Equal(t, 60.0, metrics.IdlePercent)
+	assert.Equal(t, 5.0, metrics.IOWaitPercent)
+	assert.Equal(t, 5.0, metrics.StealPercent)
+	assert.Equal(t, 35.0, metrics.UsagePercent)
+
+	// No elapsed time reports an idle CPU rather than dividing by zero
+	assert.Equal(t, 0.0, cpuUsage(after, after).UsagePercent)
+}
@@ -0,0 +1,793 @@
+package collect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	nexmonyx "github.com/nexmonyx/go-sdk/v2"
+	"github.com/shirou/gopsutil/v4/cpu"
+	"github.com/shirou/gopsutil/v4/host"
+	"github.com/shirou/gopsutil/v4/mem"
+)
+
+// HardwareCollector builds a hardware inventory from dmidecode (system,
+// motherboard, BIOS, CPUs, DIMMs, power supplies), lshw (NICs, GPUs, RAID
+// controllers, disks), and smartctl or nvme-cli (drive details and health).
+//
+// Tools that are not installed are skipped and listed under "missing_tools"
+// in the inventory's AdditionalInfo. Without dmidecode, the system and BIOS
+// come from /sys/class/dmi/id and CPUs and memory from the kernel; without
+// smartctl and nvme-cli, drives come from lshw. dmidecode and smartctl need
+// root for complete results.
+type HardwareCollector struct {
+	// SkipSMART leaves out smartctl and nvme-cli, which can wake idle disks
+	SkipSMART bool
+
+	run commandRunner
+}
+
+// NewHardwareCollector creates a collector that runs the tools found on PATH
+func NewHardwareCollector() *HardwareCollector {
+	return &HardwareCollector{run: runCommand}
+}
+
+// Collect builds a HardwareInventoryRequest for serverUUID. Failures of
+// installed tools, such as dmidecode run without root, are returned alongside
+// the inventory collected from the remaining sources.
+func (c *HardwareCollector) Collect(ctx context.Context, serverUUID string) (*nexmonyx.HardwareInventoryRequest, error) {
+	hw := &nexmonyx.HardwareInventoryInfo{CollectionMethod: "agent"}
+	var used, missing []string
+	var errs []error
+	record := func(tool string, err error) bool {
+		switch {
+		case err == nil:
+			used = append(used, tool)
+			return true
+		case errors.Is(err, exec.ErrNotFound):
+			missing = append(missing, tool)
+		case ctx.Err() == nil:
+			errs = append(errs, err)
+		}
+		return false
+	}
+
+	if !record("dmidecode", c.collectDMI(ctx, hw)) {
+		dmiFromSysfs(hw)
+	}
+	if len(hw.CPUs) == 0 {
+		hw.CPUs = cpusFromKernel(ctx)
+	}
+	if hw.Memory == nil {
+		if vm, err := mem.VirtualMemoryWithContext(ctx); err == nil {
+			hw.Memory = &nexmonyx.MemoryInfo{TotalCapacity: int64(vm.Total), TotalSizeGB: bytesToGB(int64(vm.Total))}
+		}
+	}
+	if arch, err := host.KernelArch(); err == nil {
+		for i := range hw.CPUs {
+			hw.CPUs[i].Architecture = arch
+		}
+	}
+
+	var disks []nexmonyx.StorageDeviceInfo
+	record("lshw", c.collectLSHW(ctx, hw, &disks))
+
+	storage := newStorageSet()
+	if !c.SkipSMART {
+		record("smartctl", c.collectSmartctl(ctx, storage))
+		record("nvme", c.collectNVMe(ctx, storage))
+	}
+	// lshw only fills in drives the SMART tools did not report
+	for _, disk := range disks {
+		storage.add(disk)
+	}
+	hw.Storage = storage.devices
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if hw.System != nil {
+		hw.Manufacturer = hw.System.Manufacturer
+		hw.Model = hw.System.ProductName
+		hw.SerialNumber = hw.System.SerialNumber
+	}
+	hw.DetectionTool = strings.Join(used, ",")
+	if len(missing) > 0 {
+		hw.AdditionalInfo = map[string]interface{}{"missing_tools": missing}
+	}
+
+	return &nexmonyx.HardwareInventoryRequest{
+		ServerUUID:       serverUUID,
+		CollectedAt:      time.Now().UTC(),
+		CollectionMethod: "agent",
+		Hardware:         *hw,
+	}, errors.Join(errs...)
+}
+
+// dmiRecord is one structure from dmidecode output
+type dmiRecord struct {
+	dmiType int
+	fields  map[string]string
+}
+
+var dmiHandle = regexp.MustCompile(`^Handle 0x[0-9A-Fa-f]+, DMI type (\d+)`)
+
+// dmiPlaceholders are values vendors leave in unset DMI fields
+var dmiPlaceholders = map[string]bool{
+	"not specified": true, "to be filled by o.e.m.": true, "default string": true,
+	"unknown": true, "none": true, "not provided": true, "not available": true,
+	"n/a": true, "no asset tag": true, "system serial number": true, "0123456789": true,
+}
+
+func dmiValue(value string) string {
+	value = strings.TrimSpace(value)
+	if dmiPlaceholders[strings.ToLower(value)] {
+		return ""
+	}
+	return value
+}
+
+// parseDMI parses dmidecode text output. Multi-line list values, such as
+// BIOS characteristics, are skipped.
+func parseDMI(out []byte) []dmiRecord {
+	var records []dmiRecord
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if match := dmiHandle.FindStringSubmatch(line); match != nil {
+			dmiType, _ := strconv.Atoi(match[1])
+			records = append(records, dmiRecord{dmiType: dmiType, fields: make(map[string]string)})
+			continue
+		}
+		if len(records) == 0 || !strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "\t\t") {
+			continue
+		}
+		if key, value, ok := strings.Cut(strings.TrimSpace(line), ":"); ok {
+			records[len(records)-1].fields[key] = dmiValue(value)
+		}
+	}
+	return records
+}
+
+func (c *HardwareCollector) collectDMI(ctx context.Context, hw *nexmonyx.HardwareInventoryInfo) error {
+	out, err := c.run(ctx, "dmidecode", "--type", "0,1,2,4,16,17,39")
+	if err != nil {
+		return err
+	}
+	applyDMI(hw, parseDMI(out))
+	return nil
+}
+
+// applyDMI fills hw from BIOS (0), system (1), baseboard (2), processor (4),
+// memory array (16), memory device (17), and power supply (39) structures
+func applyDMI(hw *nexmonyx.HardwareInventoryInfo, records []dmiRecord) {
+	var bios *nexmonyx.BIOSInfo
+	memory := &nexmonyx.MemoryInfo{}
+	var devices int
+
+	for _, record := range records {
+		f := record.fields
+		switch record.dmiType {
+		case 0:
+			bios = &nexmonyx.BIOSInfo{
+				Vendor:      f["Vendor"],
+				Version:     f["Version"],
+				ReleaseDate: f["Release Date"],
+				Revision:    f["BIOS Revision"],
+			}
+		case 1:
+			hw.System = &nexmonyx.SystemHardwareInfo{
+				Manufacturer: f["Manufacturer"],
+				ProductName:  f["Product Name"],
+				Version:      f["Version"],
+				SerialNumber: f["Serial Number"],
+				UUID:         f["UUID"],
+				SKU:          f["SKU Number"],
+			}
+		case 2:
+			hw.Motherboard = &nexmonyx.MotherboardInfo{
+				Manufacturer: f["Manufacturer"],
+				ProductName:  f["Product Name"],
+				Version:      f["Version"],
+				SerialNumber: f["Serial Number"],
+				AssetTag:     f["Asset Tag"],
+			}
+		case 4:
+			if strings.Contains(f["Status"], "Unpopulated") {
+				continue
+			}
+			cores, _ := strconv.Atoi(f["Core Count"])
+			threads, _ := strconv.Atoi(f["Thread Count"])
+			hw.CPUs = append(hw.CPUs, nexmonyx.CPUInfo{
+				Manufacturer: cpuVendor(f["Manufacturer"]),
+				Model:        f["Version"],
+				Cores:        cores,
+				Threads:      threads,
+				BaseSpeedMHz: float64(parseDMIInt(f["Current Speed"])),
+				MaxSpeedMHz:  float64(parseDMIInt(f["Max Speed"])),
+				Socket:       f["Socket Designation"],
+			})
+		case 16:
+			if f["Use"] != "System Memory" {
+				continue
+			}
+			slots, _ := strconv.Atoi(f["Number Of Devices"])
+			memory.TotalSlots += slots
+			memory.MaxCapacityGB += bytesToGB(parseDMISize(f["Maximum Capacity"]))
+			if f["Error Correction Type"] != "" {
+				memory.ECCSupported = true
+			}
+		case 17:
+			devices++
+			size := parseDMISize(f["Size"])
+			if size == 0 {
+				continue
+			}
+			speed := parseDMIInt(f["Configured Memory Speed"])
+			if speed == 0 {
+				speed = parseDMIInt(f["Speed"])
+			}
+			totalWidth, _ := strconv.Atoi(strings.TrimSuffix(f["Total Width"], " bits"))
+			dataWidth, _ := strconv.Atoi(strings.TrimSuffix(f["Data Width"], " bits"))
+			memory.Modules = append(memory.Modules, nexmonyx.MemoryModuleInfo{
+				Size:         size,
+				SizeGB:       bytesToGB(size),
+				Type:         f["Type"],
+				Speed:        speed,
+				SpeedMHz:     speed,
+				Manufacturer: f["Manufacturer"],
+				SerialNumber: f["Serial Number"],
+				PartNumber:   f["Part Number"],
+				Slot:         f["Locator"],
+				FormFactor:   f["Form Factor"],
+				ECC:          totalWidth > dataWidth && dataWidth > 0,
+				Registered:   strings.Contains(f["Type Detail"], "Registered"),
+			})
+			memory.TotalCapacity += size
+		case 39:
+			if strings.Contains(f["Status"], "Not Present") {
+				continue
+			}
+			status := f["Status"]
+			if _, after, ok := strings.Cut(status, ","); ok {
+				status = strings.TrimSpace(after) // "Present, OK"
+			}
+			name := f["Model Part Number"]
+			if name == "" {
+				name = f["Name"]
+			}
+			hw.PowerSupplies = append(hw.PowerSupplies, nexmonyx.PowerSupplyInfo{
+				Model:         name,
+				Manufacturer:  f["Manufacturer"],
+				SerialNumber:  f["Serial Number"],
+				MaxPowerWatts: parseDMIInt(f["Max Power Capacity"]),
+				Type:          f["Type"],
+				Status:        status,
+			})
+		}
+	}
+
+	if hw.Motherboard == nil && bios != nil {
+		hw.Motherboard = &nexmonyx.MotherboardInfo{}
+	}
+	if hw.Motherboard != nil {
+		hw.Motherboard.BIOS = bios
+	}
+
+	if devices > 0 {
+		if memory.TotalSlots == 0 {
+			memory.TotalSlots = devices
+		}
+		memory.UsedSlots = len(memory.Modules)
+		memory.AvailableSlots = memory.TotalSlots - memory.UsedSlots
+		if memory.AvailableSlots < 0 {
+			memory.AvailableSlots = 0
+		}
+		memory.TotalSizeGB = bytesToGB(memory.TotalCapacity)
+		hw.Memory = memory
+		hw.MemoryModules = memory.Modules
+	}
+}
+
+// parseDMISize parses sizes such as "16 GB" or "16384 MB" into bytes
+func parseDMISize(value string) int64 {
+	fields := strings.Fields(value)
+	if len(fields) != 2 {
+		return 0
+	}
+	n, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0
+	}
+	switch strings.ToUpper(fields[1]) {
+	case "B":
+		return int64(n)
+	case "KB":
+		return int64(n * (1 << 10))
+	case "MB":
+		return int64(n * (1 << 20))
+	case "GB":
+		return int64(n * (1 << 30))
+	case "TB":
+		return int64(n * (1 << 40))
+	}
+	return 0
+}
+
+// parseDMIInt parses values with a unit, such as "3200 MT/s" or "750 W"
+func parseDMIInt(value string) int {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return 0
+	}
+	n, _ := strconv.Atoi(fields[0])
+	return n
+}
+
+func cpuVendor(vendor string) string {
+	lower := strings.ToLower(vendor)
+	switch {
+	case strings.Contains(lower, "intel"):
+		return "Intel"
+	case strings.Contains(lower, "amd") || strings.Contains(lower, "advanced micro devices"):
+		return "AMD"
+	}
+	return vendor
+}
+
+func bytesToGB(bytes int64) float64 {
+	return round2(float64(bytes) / (1 << 30))
+}
+
+// dmiFromSysfs reads the system, baseboard, and BIOS identity the kernel
+// exposes in /sys/class/dmi/id. Serial numbers and the UUID are readable
+// only by root.
+func dmiFromSysfs(hw *nexmonyx.HardwareInventoryInfo) {
+	read := func(name string) string {
+		return dmiValue(readSysfsString(sysfsRoot + "/class/dmi/id/" + name))
+	}
+	system := &nexmonyx.SystemHardwareInfo{
+		Manufacturer: read("sys_vendor"),
+		ProductName:  read("product_name"),
+		Version:      read("product_version"),
+		SerialNumber: read("product_serial"),
+		UUID:         read("product_uuid"),
+		SKU:          read("product_sku"),
+	}
+	if *system != (nexmonyx.SystemHardwareInfo{}) {
+		hw.System = system
+	}
+
+	board := &nexmonyx.MotherboardInfo{
+		Manufacturer: read("board_vendor"),
+		ProductName:  read("board_name"),
+		Version:      read("board_version"),
+		SerialNumber: read("board_serial"),
+		AssetTag:     read("board_asset_tag"),
+	}
+	bios := &nexmonyx.BIOSInfo{
+		Vendor:      read("bios_vendor"),
+		Version:     read("bios_version"),
+		ReleaseDate: read("bios_date"),
+		Revision:    read("bios_release"),
+	}
+	if *bios != (nexmonyx.BIOSInfo{}) {
+		board.BIOS = bios
+	}
+	if *board != (nexmonyx.MotherboardInfo{}) {
+		hw.Motherboard = board
+	}
+}
+
+// cpusFromKernel describes each CPU socket from the kernel's per-CPU
+// information, for hosts without dmidecode
+func cpusFromKernel(ctx context.Context) []nexmonyx.CPUInfo {
+	infos, err := cpu.InfoWithContext(ctx)
+	if err != nil {
+		return nil
+	}
+
+	type socket struct {
+		info    nexmonyx.CPUInfo
+		coreIDs map[string]bool
+	}
+	var order []string
+	sockets := make(map[string]*socket)
+	for _, info := range infos {
+		s, ok := sockets[info.PhysicalID]
+		if !ok {
+			s = &socket{
+				info: nexmonyx.CPUInfo{
+					Manufacturer: cpuVendor(info.VendorID),
+					Model:        info.ModelName,
+					BaseSpeedMHz: info.Mhz,
+					CacheSizeKB:  int(info.CacheSize),
+				},
+				coreIDs: make(map[string]bool),
+			}
+			if info.PhysicalID != "" {
+				s.info.Socket = "Socket " + info.PhysicalID
+			}
+			sockets[info.PhysicalID] = s
+			order = append(order, info.PhysicalID)
+		}
+		s.info.Threads += int(info.Cores)
+		if info.CoreID != "" {
+			s.coreIDs[info.CoreID] = true
+		}
+	}
+
+	cpus := make([]nexmonyx.CPUInfo, 0, len(order))
+	for _, id := range order {
+		s := sockets[id]
+		s.info.Cores = len(s.coreIDs)
+		if s.info.Cores == 0 {
+			s.info.Cores = s.info.Threads
+		}
+		cpus = append(cpus, s.info)
+	}
+	return cpus
+}
+
+// lshwNode is a device in lshw's JSON output
+type lshwNode struct {
+	Class         string                 `json:"class"`
+	Description   string                 `json:"description"`
+	Product       string                 `json:"product"`
+	Vendor        string                 `json:"vendor"`
+	BusInfo       string                 `json:"businfo"`
+	Serial        string                 `json:"serial"`
+	Version       string                 `json:"version"`
+	LogicalName   json.RawMessage        `json:"logicalname"`
+	Size          float64                `json:"size"`
+	Capacity      float64                `json:"capacity"`
+	Configuration map[string]string      `json:"configuration"`
+	Capabilities  map[string]interface{} `json:"capabilities"`
+	Children      []lshwNode             `json:"children"`
+}
+
+// logicalNames returns the node's logical names, which lshw prints as a
+// string or, for devices with several, an array
+func (n *lshwNode) logicalNames() []string {
+	var name string
+	if err := json.Unmarshal(n.LogicalName, &name); err == nil {
+		return []string{name}
+	}
+	var names []string
+	json.Unmarshal(n.LogicalName, &names)
+	return names
+}
+
+// raidDrivers are kernel drivers for hardware RAID controllers
+var raidDrivers = map[string]bool{
+	"megaraid_sas": true, "hpsa": true, "smartpqi": true, "aacraid": true, "3w-9xxx": true, "3w-sas": true,
+}
+
+// parseLSHW decodes lshw -json output. Newer lshw versions print an array
+// when filtering by class; older ones print comma-separated objects.
+func parseLSHW(out []byte) ([]lshwNode, error) {
+	trimmed := strings.TrimSpace(string(out))
+	if !strings.HasPrefix(trimmed, "[") {
+		trimmed = "[" + strings.TrimSuffix(trimmed, ",") + "]"
+	}
+	var roots []lshwNode
+	if err := json.Unmarshal([]byte(trimmed), &roots); err != nil {
+		return nil, fmt.Errorf("lshw: decoding output: %w", err)
+	}
+
+	var nodes []lshwNode
+	var walk func([]lshwNode)
+	walk = func(list []lshwNode) {
+		for _, node := range list {
+			nodes = append(nodes, node)
+			walk(node.Children)
+		}
+	}
+	walk(roots)
+	return nodes, nil
+}
+
+func (c *HardwareCollector) collectLSHW(ctx context.Context, hw *nexmonyx.HardwareInventoryInfo, disks *[]nexmonyx.StorageDeviceInfo) error {
+	out, err := c.run(ctx, "lshw", "-json", "-quiet", "-class", "network", "-class", "display", "-class", "storage", "-class", "disk")
+	if err != nil {
+		return err
+	}
+	nodes, err := parseLSHW(out)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		switch node.Class {
+		case "network":
+			// Virtual interfaces have no bus
+			if node.BusInfo == "" {
+				continue
+			}
+			speed := node.Size
+			if speed == 0 {
+				speed = node.Capacity
+			}
+			capabilities := make([]string, 0, len(node.Capabilities))
+			for capability := range node.Capabilities {
+				capabilities = append(capabilities, capability)
+			}
+			sort.Strings(capabilities)
+			hw.Network = append(hw.Network, nexmonyx.NetworkCardInfo{
+				Model:         node.Product,
+				Vendor:        node.Vendor,
+				MACAddress:    node.Serial,
+				SpeedMbps:     int(speed / 1e6),
+				PortCount:     1,
+				Capabilities:  capabilities,
+				Driver:        node.Configuration["driver"],
+				DriverVersion: node.Configuration["driverversion"],
+			})
+		case "display":
+			hw.GPUs = append(hw.GPUs, nexmonyx.GPUInfo{
+				Model:        node.Product,
+				Vendor:       node.Vendor,
+				Manufacturer: node.Vendor,
+				Driver:       node.Configuration["driver"],
+				BusID:        strings.TrimPrefix(node.BusInfo, "pci@"),
+			})
+		case "storage":
+			if !raidDrivers[node.Configuration["driver"]] &&
+				!strings.Contains(node.Description, "RAID") && !strings.Contains(node.Product, "RAID") {
+				continue
+			}
+			firmware := node.Configuration["firmware"]
+			if firmware == "" {
+				firmware = node.Version
+			}
+			hw.RAIDControllers = append(hw.RAIDControllers, nexmonyx.RAIDControllerInfo{
+				Manufacturer:    node.Vendor,
+				Model:           node.Product,
+				FirmwareVersion: firmware,
+			})
+		case "disk":
+			names := node.logicalNames()
+			// Empty optical drives and card readers report no size
+			if len(names) == 0 || !strings.HasPrefix(names[0], "/dev/") || node.Size == 0 {
+				continue
+			}
+			disk := nexmonyx.StorageDeviceInfo{
+				DeviceName:      names[0],
+				Model:           node.Product,
+				Vendor:          node.Vendor,
+				SerialNumber:    node.Serial,
+				Capacity:        int64(node.Size),
+				SizeGB:          bytesToGB(int64(node.Size)),
+				FirmwareVersion: node.Version,
+			}
+			if strings.HasPrefix(disk.DeviceName, "/dev/nvme") {
+				disk.Type, disk.Interface = "NVMe", "NVMe"
+			}
+			*disks = append(*disks, disk)
+		}
+	}
+	return nil
+}
+
+// storageSet collects drives, reporting each physical drive once
+type storageSet struct {
+	devices []nexmonyx.StorageDeviceInfo
+	seen    map[string]bool
+}
+
+func newStorageSet() *storageSet {
+	return &storageSet{seen: make(map[string]bool)}
+}
+
+func (s *storageSet) has(device nexmonyx.StorageDeviceInfo) bool {
+	return (device.SerialNumber != "" && s.seen["serial:"+device.SerialNumber]) ||
+		s.seen["name:"+nvmeController.ReplaceAllString(device.DeviceName, "$1")]
+}
+
+func (s *storageSet) add(device nexmonyx.StorageDeviceInfo) {
+	if s.has(device) {
+		return
+	}
+	if device.SerialNumber != "" {
+		s.seen["serial:"+device.SerialNumber] = true
+	}
+	s.seen["name:"+nvmeController.ReplaceAllString(device.DeviceName, "$1")] = true
+	s.devices = append(s.devices, device)
+}
+
+// nvmeController matches an NVMe namespace (/dev/nvme0n1), which smartctl
+// reports by its controller (/dev/nvme0)
+var nvmeController = regexp.MustCompile(`^(/dev/nvme\d+)n\d+$`)
+
+// smartctlReport holds the fields used from smartctl --json output
+type smartctlReport struct {
+	Smartctl struct {
+		ExitStatus int `json:"exit_status"`
+	} `json:"smartctl"`
+	Device struct {
+		Name     string `json:"name"`
+		Protocol string `json:"protocol"`
+	} `json:"device"`
+	ModelName       string `json:"model_name"`
+	Vendor          string `json:"vendor"`
+	Product         string `json:"product"`
+	SerialNumber    string `json:"serial_number"`
+	FirmwareVersion string `json:"firmware_version"`
+	Revision        string `json:"revision"`
+	UserCapacity    struct {
+		Bytes int64 `json:"bytes"`
+	} `json:"user_capacity"`
+	NVMeTotalCapacity int64 `json:"nvme_total_capacity"`
+	RotationRate      *int  `json:"rotation_rate"`
+	FormFactor        struct {
+		Name string `json:"name"`
+	} `json:"form_factor"`
+	SmartStatus *struct {
+		Passed bool `json:"passed"`
+	} `json:"smart_status"`
+	Temperature struct {
+		Current int `json:"current"`
+	} `json:"temperature"`
+	PowerOnTime struct {
+		Hours int64 `json:"hours"`
+	} `json:"power_on_time"`
+	NVMeHealth *struct {
+		PercentageUsed float64 `json:"percentage_used"`
+	} `json:"nvme_smart_health_information_log"`
+}
+
+// smartctl exit status bits for a bad command line or an unopenable device;
+// the higher bits report drive findings and the output is still complete
+const smartctlFatalStatus = 0x3
+
+func (c *HardwareCollector) collectSmartctl(ctx context.Context, storage *storageSet) error {
+	out, err := c.run(ctx, "smartctl", "--scan", "--json")
+	if err != nil {
+		return err
+	}
+	var scan struct {
+		Devices []struct {
+			Name string `json:"name"`
+			Type string `json:"type"`
+		} `json:"devices"`
+	}
+	if err := json.Unmarshal(out, &scan); err != nil {
+		return fmt.Errorf("smartctl: decoding scan: %w", err)
+	}
+
+	var errs []error
+	for _, device := range scan.Devices {
+		out, runErr := c.run(ctx, "smartctl", "--info", "--health", "--attributes", "--json", "--device="+device.Type, device.Name)
+		var report smartctlReport
+		if err := json.Unmarshal(out, &report); err != nil || report.Smartctl.ExitStatus&smartctlFatalStatus != 0 {
+			if runErr == nil {
+				runErr = fmt.Errorf("smartctl: reading %s failed", device.Name)
+			}
+			errs = append(errs, runErr)
+			continue
+		}
+		if report.Device.Name == "" {
+			report.Device.Name = device.Name
+		}
+		storage.add(report.storageDevice())
+	}
+	return errors.Join(errs...)
+}
+
+func (r *smartctlReport) storageDevice() nexmonyx.StorageDeviceInfo {
+	device := nexmonyx.StorageDeviceInfo{
+		DeviceName:      r.Device.Name,
+		Model:           r.ModelName,
+		Vendor:          r.Vendor,
+		SerialNumber:    r.SerialNumber,
+		Capacity:        r.UserCapacity.Bytes,
+		FirmwareVersion: r.FirmwareVersion,
+		Temperature:     r.Temperature.Current,
+		PowerOnHours:    r.PowerOnTime.Hours,
+		FormFactor:      r.FormFactor.Name,
+	}
+	if device.Model == "" {
+		device.Model = r.Product
+	}
+	if device.FirmwareVersion == "" {
+		device.FirmwareVersion = r.Revision
+	}
+	if device.Capacity == 0 {
+		device.Capacity = r.NVMeTotalCapacity
+	}
+	device.SizeGB = bytesToGB(device.Capacity)
+
+	switch r.Device.Protocol {
+	case "NVMe":
+		device.Type, device.Interface = "NVMe", "NVMe"
+	case "ATA":
+		device.Interface = "SATA"
+	case "SCSI":
+		device.Interface = "SAS"
+	}
+	if device.Type == "" && r.RotationRate != nil {
+		device.Type = "HDD"
+		if *r.RotationRate == 0 {
+			device.Type = "SSD"
+		}
+	}
+	if r.SmartStatus != nil {
+		device.SmartStatus = smartStatus(r.SmartStatus.Passed)
+	}
+	if r.NVMeHealth != nil {
+		// Percentage of the rated write endurance used, as the drive reports it
+		device.WriteEndurance = r.NVMeHealth.PercentageUsed
+	}
+	return device
+}
+
+func smartStatus(passed bool) string {
+	if passed {
+		return "Good"
+	}
+	return "Failed"
+}
+
+// collectNVMe adds NVMe drives that smartctl did not report, using nvme-cli
+func (c *HardwareCollector) collectNVMe(ctx context.Context, storage *storageSet) error {
+	out, err := c.run(ctx, "nvme", "list", "--output-format=json")
+	if err != nil {
+		return err
+	}
+	var list struct {
+		Devices []struct {
+			DevicePath   string `json:"DevicePath"`
+			Firmware     string `json:"Firmware"`
+			ModelNumber  string `json:"ModelNumber"`
+			SerialNumber string `json:"SerialNumber"`
+			PhysicalSize int64  `json:"PhysicalSize"`
+		} `json:"Devices"`
+	}
+	if err := json.Unmarshal(out, &list); err != nil {
+		return fmt.Errorf("nvme: decoding list: %w", err)
+	}
+
+	for _, drive := range list.Devices {
+		device := nexmonyx.StorageDeviceInfo{
+			DeviceName:      drive.DevicePath,
+			Model:           strings.TrimSpace(drive.ModelNumber),
+			SerialNumber:    strings.TrimSpace(drive.SerialNumber),
+			Capacity:        drive.PhysicalSize,
+			SizeGB:          bytesToGB(drive.PhysicalSize),
+			Type:            "NVMe",
+			Interface:       "NVMe",
+			FirmwareVersion: strings.TrimSpace(drive.Firmware),
+		}
+		if storage.has(device) {
+			continue
+		}
+
+		// Health is best effort; the drive is still reported without it
+		if out, err := c.run(ctx, "nvme", "smart-log", drive.DevicePath, "--output-format=json"); err == nil {
+			var health struct {
+				CriticalWarning int     `json:"critical_warning"`
+				Temperature     float64 `json:"temperature"` // Kelvin
+				PercentUsed     float64 `json:"percent_used"`
+				PowerOnHours    int64   `json:"power_on_hours"`
+			}
+			if json.Unmarshal(out, &health) == nil {
+				device.SmartStatus = smartStatus(health.CriticalWarning == 0)
+				if health.Temperature > 0 {
+					device.Temperature = int(math.Round(health.Temperature - 273.15))
+				}
+				device.WriteEndurance = health.PercentUsed
+				device.PowerOnHours = health.PowerOnHours
+			}
+		}
+		storage.add(device)
+	}
+	return nil
+}
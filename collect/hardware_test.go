@@ -0,0 +1,313 @@
+package collect
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"testing"
+
+	nexmonyx "github.com/nexmonyx/go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dmidecodeOutput = `# dmidecode 3.5
+Getting SMBIOS data from sysfs.
+SMBIOS 3.2.0 present.
+
+Handle 0x0000, DMI type 0, 26 bytes
+BIOS Information
+	Vendor: Dell Inc.
+	Version: 2.12.2
+	Release Date: 05/07/2021
+	Characteristics:
+		PCI is supported
+		BIOS is upgradeable
+	BIOS Revision: 2.12
+
+Handle 0x0100, DMI type 1, 27 bytes
+System Information
+	Manufacturer: Dell Inc.
+	Product Name: PowerEdge R640
+	Version: Not Specified
+	Serial Number: 7XQ1ZQ2
+	UUID: 4c4c4544-0058-5110-8031-b7c04f5a5132
+	SKU Number: SKU=NotProvided;ModelName=PowerEdge R640
+
+Handle 0x0200, DMI type 2, 8 bytes
+Base Board Information
+	Manufacturer: Dell Inc.
+	Product Name: 0Y7WYT
+	Version: A00
+	Serial Number: .7XQ1ZQ2.CNFCP0019B0123.
+	Asset Tag: Not Specified
+
+Handle 0x0400, DMI type 4, 48 bytes
+Processor Information
+	Socket Designation: CPU1
+	Manufacturer: Intel
+	Version: Intel(R) Xeon(R) Gold 6230 CPU @ 2.10GHz
+	Max Speed: 4000 MHz
+	Current Speed: 2100 MHz
+	Status: Populated, Enabled
+	Core Count: 20
+	Thread Count: 40
+
+Handle 0x0401, DMI type 4, 48 bytes
+Processor Information
+	Socket Designation: CPU2
+	Manufacturer: Not Specified
+	Version: Not Specified
+	Status: Unpopulated
+
+Handle 0x1000, DMI type 16, 23 bytes
+Physical Memory Array
+	Location: System Board Or Motherboard
+	Use: System Memory
+	Error Correction Type: Multi-bit ECC
+	Maximum Capacity: 3 TB
+	Number Of Devices: 4
+
+Handle 0x1100, DMI type 17, 84 bytes
+Memory Device
+	Total Width: 72 bits
+	Data Width: 64 bits
+	Size: 32 GB
+	Form Factor: DIMM
+	Locator: A1
+	Type: DDR4
+	Type Detail: Synchronous Registered (Buffered)
+	Speed: 2933 MT/s
+	Manufacturer: 00CE00B300CE
+	Serial Number: 40C4AB12
+	Part Number: M393A4K40CB2-CVF
+	Configured Memory Speed: 2666 MT/s
+
+Handle 0x1101, DMI type 17, 84 bytes
+Memory Device
+	Size: No Module Installed
+	Locator: A2
+
+Handle 0x2700, DMI type 39, 22 bytes
+System Power Supply
+	Power Unit Group: 1
+	Location: Not Specified
+	Name: PWR SPLY,750W,RDNT,LTON
+	Manufacturer: DELL
+	Serial Number: CNLOD0089N3KJ6
+	Model Part Number: 0PJMDNA05
+	Max Power Capacity: 750 W
+	Status: Present, OK
+	Type: Switching
+`
+
+const lshwOutput = `[
+{"id":"network","class":"network","description":"Ethernet interface","product":"Ethernet Controller X710 for 10GbE SFP+","vendor":"Intel Corporation","businfo":"pci@0000:3b:00.0","logicalname":"eno1","serial":"f8:f2:1e:aa:bb:cc","size":10000000000,"capacity":10000000000,"configuration":{"driver":"i40e","driverversion":"2.20.12","link":"yes"},"capabilities":{"ethernet":true,"physical":"Physical interface","autonegotiation":"Auto-negotiation"}},
+{"id":"network:1","class":"network","description":"Ethernet interface","logicalname":"docker0","serial":"02:42:ac:11:00:01","configuration":{"driver":"bridge"}},
+{"id":"display","class":"display","description":"VGA compatible controller","product":"Integrated Matrox G200eW3 Graphics Controller","vendor":"Matrox Electronics Systems Ltd.","businfo":"pci@0000:03:00.0","configuration":{"driver":"mgag200"}},
+{"id":"raid","class":"storage","description":"RAID bus controller","product":"MegaRAID SAS-3 3108 [Invader]","vendor":"Broadcom / LSI","businfo":"pci@0000:18:00.0","version":"02","configuration":{"driver":"megaraid_sas"}},
+{"id":"sata","class":"storage","description":"SATA controller","product":"C620 Series Chipset Family SATA Controller [AHCI mode]","vendor":"Intel Corporation","businfo":"pci@0000:00:17.0","configuration":{"driver":"ahci"}},
+{"id":"disk","class":"disk","description":"ATA Disk","product":"Samsung SSD 860","businfo":"scsi@0:0.0.0","logicalname":"/dev/sda","serial":"S3Z1NB0K123456","size":500107862016,"version":"RVT04B6Q"},
+{"id":"disk:1","class":"disk","description":"SCSI Disk","product":"PERC H730P Mini","vendor":"DELL","businfo":"scsi@1:2.0.0","logicalname":["/dev/sdb","/dev/sdb1"],"serial":"00a1b2c3d4","size":1999844147200,"version":"4.30"},
+{"id":"cdrom","class":"disk","description":"DVD reader","logicalname":"/dev/sr0"}
+]`
+
+const smartctlScanOutput = `{"devices":[{"name":"/dev/sda","type":"sat","protocol":"ATA"},{"name":"/dev/nvme0","type":"nvme","protocol":"NVMe"}]}`
+
+const smartctlSDAOutput = `{"smartctl":{"exit_status":0},"device":{"name":"/dev/sda","protocol":"ATA"},"model_name":"Samsung SSD 860 EVO 500GB","serial_number":"S3Z1NB0K123456","firmware_version":"RVT04B6Q","user_capacity":{"bytes":500107862016},"rotation_rate":0,"form_factor":{"name":"2.5 inches"},"smart_status":{"passed":true},"temperature":{"current":31},"power_on_time":{"hours":8760}}`
+
+// Exit status 8: the SMART status check reported a failing drive
+const smartctlNVMeOutput = `{"smartctl":{"exit_status":8},"device":{"name":"/dev/nvme0","protocol":"NVMe"},"model_name":"Samsung SSD 970 EVO Plus 1TB","serial_number":"S4EWNX0R123456","firmware_version":"2B2QEXM7","nvme_total_capacity":1000204886016,"smart_status":{"passed":false},"temperature":{"current":44},"power_on_time":{"hours":1200},"nvme_smart_health_information_log":{"percentage_used":3}}`
+
+const nvmeListOutput = `{"Devices":[
+{"DevicePath":"/dev/nvme0n1","Firmware":"2B2QEXM7","ModelNumber":"Samsung SSD 970 EVO Plus 1TB","SerialNumber":"S4EWNX0R123456","PhysicalSize":1000204886016},
+{"DevicePath":"/dev/nvme1n1","Firmware":"GPJA0B3Q","ModelNumber":"KIOXIA KCD6XLUL960G","SerialNumber":"X1Y0A00ZTBM8","PhysicalSize":960197124096}
+]}`
+
+const nvmeSmartLogOutput = `{"critical_warning":0,"temperature":311,"percent_used":1,"power_on_hours":420}`
+
+// fakeTools answers commands from canned output; commands without output
+// behave as if the tool were not installed
+type fakeTools map[string]string
+
+func (f fakeTools) run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	command := strings.Join(append([]string{name}, args...), " ")
+	out, ok := f[command]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", name, &exec.Error{Name: name, Err: exec.ErrNotFound})
+	}
+	if strings.Contains(out, `"exit_status":8`) {
+		return []byte(out), fmt.Errorf("%s: exit status 8", name)
+	}
+	return []byte(out), nil
+}
+
+func TestHardwareCollector_Collect(t *testing.T) {
+	collector := NewHardwareCollector()
+	collector.run = fakeTools{
+		"dmidecode --type 0,1,2,4,16,17,39":                                          dmidecodeOutput,
+		"lshw -json -quiet -class network -class display -class storage -class disk": lshwOutput,
+		"smartctl --scan --json":                                                     smartctlScanOutput,
+		"smartctl --info --health --attributes --json --device=sat /dev/sda":         smartctlSDAOutput,
+		"smartctl --info --health --attributes --json --device=nvme /dev/nvme0":      smartctlNVMeOutput,
+		"nvme list --output-format=json":                                             nvmeListOutput,
+		"nvme smart-log /dev/nvme1n1 --output-format=json":                           nvmeSmartLogOutput,
+	}.run
+
+	req, err := collector.Collect(context.Background(), "srv-123")
+	require.NoError(t, err)
+	assert.Equal(t, "srv-123", req.ServerUUID)
+	assert.Equal(t, "agent", req.CollectionMethod)
+	assert.False(t, nexmonyx.HasValidationErrors(req.Validate()))
+
+	hw := req.Hardware
+	assert.Equal(t, "dmidecode,lshw,smartctl,nvme", hw.DetectionTool)
+	assert.Nil(t, hw.AdditionalInfo)
+	assert.Equal(t, "PowerEdge R640", hw.Model)
+	assert.Equal(t, "7XQ1ZQ2", hw.SerialNumber)
+	require.NotNil(t, hw.System)
+	assert.Empty(t, hw.System.Version) // "Not Specified"
+	assert.Equal(t, "4c4c4544-0058-5110-8031-b7c04f5a5132", hw.System.UUID)
+
+	require.NotNil(t, hw.Motherboard)
+	assert.Equal(t, "0Y7WYT", hw.Motherboard.ProductName)
+	require.NotNil(t, hw.Motherboard.BIOS)
+	assert.Equal(t, "2.12.2", hw.Motherboard.BIOS.Version)
+	assert.Equal(t, "2.12", hw.Motherboard.BIOS.Revision)
+
+	require.Len(t, hw.CPUs, 1) // The empty socket is skipped
+	assert.Equal(t, nexmonyx.CPUInfo{
+		Manufacturer: "Intel",
+		Model:        "Intel(R) Xeon(R) Gold 6230 CPU @ 2.10GHz",
+		Architecture: hw.CPUs[0].Architecture,
+		Cores:        20,
+		Threads:      40,
+		BaseSpeedMHz: 2100,
+		MaxSpeedMHz:  4000,
+		Socket:       "CPU1",
+	}, hw.CPUs[0])
+
+	require.NotNil(t, hw.Memory)
+	assert.Equal(t, 4, hw.Memory.TotalSlots)
+	assert.Equal(t, 1, hw.Memory.UsedSlots)
+	assert.Equal(t, 3, hw.Memory.AvailableSlots)
+	assert.Equal(t, 3072.0, hw.Memory.MaxCapacityGB)
+	assert.Equal(t, 32.0, hw.Memory.TotalSizeGB)
+	assert.True(t, hw.Memory.ECCSupported)
+	require.Len(t, hw.MemoryModules, 1)
+	dimm := hw.MemoryModules[0]
+	assert.Equal(t, int64(32<<30), dimm.Size)
+	assert.Equal(t, "A1", dimm.Slot)
+	assert.Equal(t, 2666, dimm.SpeedMHz)
+	assert.True(t, dimm.ECC)
+	assert.True(t, dimm.Registered)
+
+	require.Len(t, hw.PowerSupplies, 1)
+	assert.Equal(t, 750, hw.PowerSupplies[0].MaxPowerWatts)
+	assert.Equal(t, "OK", hw.PowerSupplies[0].Status)
+	assert.Equal(t, "0PJMDNA05", hw.PowerSupplies[0].Model)
+
+	require.Len(t, hw.Network, 1) // docker0 has no bus
+	nic := hw.Network[0]
+	assert.Equal(t, "f8:f2:1e:aa:bb:cc", nic.MACAddress)
+	assert.Equal(t, 10000, nic.SpeedMbps)
+	assert.Equal(t, "i40e", nic.Driver)
+	assert.Equal(t, "2.20.12", nic.DriverVersion)
+	assert.Equal(t, []string{"autonegotiation", "ethernet", "physical"}, nic.Capabilities)
+
+	require.Len(t, hw.GPUs, 1)
+	assert.Equal(t, "0000:03:00.0", hw.GPUs[0].BusID)
+	require.Len(t, hw.RAIDControllers, 1)
+	assert.Equal(t, "MegaRAID SAS-3 3108 [Invader]", hw.RAIDControllers[0].Model)
+
+	// smartctl drives, then the NVMe drive only nvme-cli saw, then the RAID
+	// volume only lshw saw
+	require.Len(t, hw.Storage, 4)
+	sda := hw.Storage[0]
+	assert.Equal(t, "/dev/sda", sda.DeviceName)
+	assert.Equal(t, "SSD", sda.Type)
+	assert.Equal(t, "SATA", sda.Interface)
+	assert.Equal(t, "Good", sda.SmartStatus)
+	assert.Equal(t, int64(8760), sda.PowerOnHours)
+	assert.Equal(t, 31, sda.Temperature)
+
+	nvme0 := hw.Storage[1]
+	assert.Equal(t, "NVMe", nvme0.Type)
+	assert.Equal(t, "Failed", nvme0.SmartStatus)
+	assert.Equal(t, int64(1000204886016), nvme0.Capacity)
+	assert.Equal(t, 3.0, nvme0.WriteEndurance)
+
+	nvme1 := hw.Storage[2]
+	assert.Equal(t, "/dev/nvme1n1", nvme1.DeviceName)
+	assert.Equal(t, "Good", nvme1.SmartStatus)
+	assert.Equal(t, 38, nvme1.Temperature)
+	assert.Equal(t, int64(420), nvme1.PowerOnHours)
+
+	volume := hw.Storage[3]
+	assert.Equal(t, "/dev/sdb", volume.DeviceName)
+	assert.Equal(t, "PERC H730P Mini", volume.Model)
+}
+
+func TestHardwareCollector_MissingTools(t *testing.T) {
+	root := useSysfs(t)
+	writeSysfs(t, root, map[string]string{
+		"class/dmi/id/sys_vendor":   "QEMU",
+		"class/dmi/id/product_name": "Standard PC (Q35 + ICH9, 2009)",
+		"class/dmi/id/board_vendor": "Default string",
+		"class/dmi/id/bios_vendor":  "SeaBIOS",
+		"class/dmi/id/bios_version": "1.16.3",
+	})
+
+	collector := NewHardwareCollector()
+	collector.run = fakeTools{}.run
+	req, err := collector.Collect(context.Background(), "srv-123")
+	require.NoError(t, err)
+
+	hw := req.Hardware
+	assert.Empty(t, hw.DetectionTool)
+	assert.Equal(t, []string{"dmidecode", "lshw", "smartctl", "nvme"}, hw.AdditionalInfo["missing_tools"])
+	require.NotNil(t, hw.System)
+	assert.Equal(t, "QEMU", hw.Manufacturer)
+	require.NotNil(t, hw.Motherboard)
+	assert.Empty(t, hw.Motherboard.Manufacturer)
+	assert.Equal(t, "SeaBIOS", hw.Motherboard.BIOS.Vendor)
+
+	// CPUs and memory come from the kernel instead
+	assert.NotEmpty(t, hw.CPUs)
+	require.NotNil(t, hw.Memory)
+	assert.Positive(t, hw.Memory.TotalCapacity)
+}
+
+func TestHardwareCollector_ToolFailure(t *testing.T) {
+	collector := NewHardwareCollector()
+	collector.SkipSMART = true
+	collector.run = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		if name == "dmidecode" {
+			return nil, fmt.Errorf("dmidecode: exit status 1: /sys/firmware/dmi/tables/smbios_entry_point: Permission denied")
+		}
+		return fakeTools{}.run(ctx, name, args...)
+	}
+
+	req, err := collector.Collect(context.Background(), "srv-123")
+	assert.ErrorContains(t, err, "Permission denied")
+	require.NotNil(t, req)
+	assert.Equal(t, []string{"lshw"}, req.Hardware.AdditionalInfo["missing_tools"])
+}
+
+func TestParseLSHW_LegacyOutput(t *testing.T) {
+	// lshw before B.02.19 prints comma-separated objects instead of an array
+	nodes, err := parseLSHW([]byte(`{"id":"network","class":"network","businfo":"pci@0000:00:03.0"},
+{"id":"disk","class":"disk","logicalname":"/dev/vda","size":21474836480}`))
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+	assert.Equal(t, []string{"/dev/vda"}, nodes[1].logicalNames())
+}
+
+func TestParseDMISize(t *testing.T) {
+	assert.Equal(t, int64(16<<30), parseDMISize("16 GB"))
+	assert.Equal(t, int64(16384<<20), parseDMISize("16384 MB"))
+	assert.Equal(t, int64(0), parseDMISize("No Module Installed"))
+	assert.Equal(t, 750, parseDMIInt("750 W"))
+	assert.Equal(t, 3200, parseDMIInt("3200 MT/s"))
+}
@@ -0,0 +1,326 @@
+package collect
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	nexmonyx "github.com/nexmonyx/go-sdk/v2"
+)
+
+// sysfsRoot is where sysfs is mounted; tests point it at a fixture tree
+var sysfsRoot = "/sys"
+
+const defaultPowerSampleInterval = time.Second
+
+var (
+	hwmonTempInput  = regexp.MustCompile(`^temp(\d+)_input$`)
+	hwmonPowerInput = regexp.MustCompile(`^power(\d+)_input$`)
+	coreLabel       = regexp.MustCompile(`^Core (\d+)$`)
+	packageLabel    = regexp.MustCompile(`^Package id (\d+)$`)
+	sensorNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// Temperatures reads every temperature sensor exposed through
+// /sys/class/hwmon on Linux, including CPU package and core sensors, NVMe and
+// SATA drives, and ACPI thermal zones. Sensors are named and classified the
+// way CreateCPUTemperatureSensor, CreateDiskTemperatureSensor, and
+// CreateSystemTemperatureSensor name them, and use those defaults unless the
+// hardware reports its own max and crit thresholds. Hosts without hwmon
+// report no sensors.
+func Temperatures(ctx context.Context) (*nexmonyx.TemperatureMetrics, error) {
+	chips, err := hwmonChips()
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := nexmonyx.NewTemperatureMetrics()
+	seen := make(map[string]int)
+	for _, chip := range chips {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for _, input := range chip.inputs(hwmonTempInput) {
+			milli, ok := readSysfsInt(filepath.Join(chip.dir, input.file))
+			if !ok {
+				continue
+			}
+			sensor := chip.temperatureSensor(input.index, float64(milli)/1000)
+
+			// Multi-socket hosts have a "Core 0" on every package
+			if seen[sensor.SensorID]++; seen[sensor.SensorID] > 1 {
+				sensor.SensorName = fmt.Sprintf("%s (%d)", sensor.SensorName, seen[sensor.SensorID])
+				sensor.SensorID = fmt.Sprintf("%s_%d", sensor.SensorID, seen[sensor.SensorID])
+			}
+			metrics.AddTemperatureSensor(sensor)
+		}
+	}
+	return metrics, nil
+}
+
+// Power measures power draw on Linux from Intel RAPL package energy counters
+// in /sys/class/powercap, sampled over interval (default: 1s), and from hwmon
+// power sensors. Each RAPL package and hwmon sensor is reported as a power
+// supply; TotalPowerW is their sum. RAPL counters are readable only by root
+// on most kernels and are skipped when unreadable.
+func Power(ctx context.Context, interval time.Duration) (*nexmonyx.PowerMetrics, error) {
+	if interval <= 0 {
+		interval = defaultPowerSampleInterval
+	}
+	metrics := nexmonyx.NewPowerMetrics()
+
+	domains := raplDomains()
+	if len(domains) > 0 {
+		start := time.Now()
+		before := make([]int64, len(domains))
+		for i, domain := range domains {
+			before[i], _ = readSysfsInt(filepath.Join(domain.dir, "energy_uj"))
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		elapsed := time.Since(start).Seconds()
+
+		for i, domain := range domains {
+			after, ok := readSysfsInt(filepath.Join(domain.dir, "energy_uj"))
+			if !ok {
+				continue
+			}
+			delta := after - before[i]
+			if delta < 0 {
+				// The counter wrapped around
+				delta += domain.maxEnergy
+			}
+			metrics.AddPowerSupply(nexmonyx.PowerSupplyMetrics{
+				ID:            domain.id,
+				Name:          domain.label,
+				Status:        "ok",
+				PowerWatts:    round2(float64(delta) / 1e6 / elapsed),
+				MaxPowerWatts: domain.maxWatts,
+			})
+		}
+	}
+
+	chips, err := hwmonChips()
+	if err != nil {
+		return nil, err
+	}
+	for _, chip := range chips {
+		for _, input := range chip.inputs(hwmonPowerInput) {
+			micro, ok := readSysfsInt(filepath.Join(chip.dir, input.file))
+			if !ok {
+				continue
+			}
+			supply := nexmonyx.PowerSupplyMetrics{
+				ID:         fmt.Sprintf("%s_power%d", normalizeSensorName(chip.name), input.index),
+				Name:       chip.label(fmt.Sprintf("power%d", input.index)),
+				Status:     "ok",
+				PowerWatts: round2(float64(micro) / 1e6),
+			}
+			if capacity, ok := readSysfsInt(filepath.Join(chip.dir, fmt.Sprintf("power%d_cap", input.index))); ok {
+				supply.MaxPowerWatts = round2(float64(capacity) / 1e6)
+			}
+			metrics.AddPowerSupply(supply)
+		}
+	}
+
+	metrics.TotalPowerW = round2(metrics.CalculateTotalPower())
+	return metrics, nil
+}
+
+// hwmonChip is one /sys/class/hwmon/hwmonN directory
+type hwmonChip struct {
+	dir    string
+	name   string // Driver name, e.g. coretemp, k10temp, nvme, drivetemp, acpitz
+	device string // Owning device, e.g. nvme0 or sda, for drive sensors
+	files  []string
+}
+
+type hwmonInput struct {
+	file  string
+	index int
+}
+
+func hwmonChips() ([]*hwmonChip, error) {
+	root := filepath.Join(sysfsRoot, "class", "hwmon")
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var chips []*hwmonChip
+	for _, entry := range entries {
+		dir := filepath.Join(root, entry.Name())
+		chip := &hwmonChip{dir: dir, name: readSysfsString(filepath.Join(dir, "name"))}
+		if chip.name == "" {
+			continue
+		}
+		files, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, file := range files {
+			chip.files = append(chip.files, file.Name())
+		}
+		chip.device = hwmonDevice(dir, chip.name)
+		chips = append(chips, chip)
+	}
+	return chips, nil
+}
+
+// hwmonDevice returns the block device name for drive sensors
+func hwmonDevice(dir, name string) string {
+	device := filepath.Join(dir, "device")
+	switch name {
+	case "nvme":
+		// The device link points at the controller, e.g. .../nvme/nvme0
+		if target, err := filepath.EvalSymlinks(device); err == nil {
+			return filepath.Base(target)
+		}
+	case "drivetemp":
+		// The device link points at the SCSI device, which holds block/sdX
+		if blocks, err := os.ReadDir(filepath.Join(device, "block")); err == nil && len(blocks) > 0 {
+			return blocks[0].Name()
+		}
+	}
+	return ""
+}
+
+// inputs returns the chip's input files matching pattern, ordered by index
+func (c *hwmonChip) inputs(pattern *regexp.Regexp) []hwmonInput {
+	var inputs []hwmonInput
+	for _, file := range c.files {
+		if match := pattern.FindStringSubmatch(file); match != nil {
+			index, _ := strconv.Atoi(match[1])
+			inputs = append(inputs, hwmonInput{file: file, index: index})
+		}
+	}
+	sort.Slice(inputs, func(i, j int) bool { return inputs[i].index < inputs[j].index })
+	return inputs
+}
+
+// label returns the sensor's label file, or the sensor prefix if it has none
+func (c *hwmonChip) label(prefix string) string {
+	if label := readSysfsString(filepath.Join(c.dir, prefix+"_label")); label != "" {
+		return label
+	}
+	return prefix
+}
+
+// temperatureSensor names and classifies temperature input index of the chip
+func (c *hwmonChip) temperatureSensor(index int, temp float64) nexmonyx.TemperatureSensorData {
+	prefix := fmt.Sprintf("temp%d", index)
+	label := c.label(prefix)
+
+	var sensor nexmonyx.TemperatureSensorData
+	switch {
+	case c.name == "coretemp" && coreLabel.MatchString(label):
+		core, _ := strconv.Atoi(coreLabel.FindStringSubmatch(label)[1])
+		sensor = nexmonyx.CreateCPUTemperatureSensor(core, temp)
+	case c.name == "coretemp" && packageLabel.MatchString(label):
+		pkg := packageLabel.FindStringSubmatch(label)[1]
+		sensor = nexmonyx.CreateCPUTemperatureSensor(0, temp)
+		sensor.SensorID = "cpu_package_" + pkg
+		sensor.SensorName = "CPU Package " + pkg
+	case c.name == "coretemp" || c.name == "k10temp" || c.name == "zenpower" || c.name == "cpu_thermal":
+		// AMD labels sensors Tctl, Tdie and Tccd1..n
+		sensor = nexmonyx.CreateCPUTemperatureSensor(0, temp)
+		sensor.SensorID = "cpu_" + normalizeSensorName(label)
+		sensor.SensorName = "CPU " + label
+	case (c.name == "nvme" || c.name == "drivetemp") && c.device != "":
+		sensor = nexmonyx.CreateDiskTemperatureSensor(c.device, temp)
+		if label != "Composite" && label != prefix {
+			sensor.SensorID += "_" + normalizeSensorName(label)
+			sensor.SensorName += " " + label
+		}
+	default:
+		name := normalizeSensorName(c.name)
+		if label != prefix {
+			name += "_" + normalizeSensorName(label)
+		} else {
+			name += "_" + prefix
+		}
+		sensor = nexmonyx.CreateSystemTemperatureSensor(name, temp)
+	}
+
+	// Thresholds reported by the hardware take precedence over the defaults
+	if warning, ok := readSysfsInt(filepath.Join(c.dir, prefix+"_max")); ok && warning > 0 {
+		sensor.UpperWarning = float64(warning) / 1000
+	}
+	if crit, ok := readSysfsInt(filepath.Join(c.dir, prefix+"_crit")); ok && crit > 0 {
+		sensor.UpperCritical = float64(crit) / 1000
+	}
+	sensor.Status = nexmonyx.DetermineTemperatureStatus(temp, sensor.UpperWarning, sensor.UpperCritical)
+	return sensor
+}
+
+// normalizeSensorName lowercases name and replaces runs of other characters
+// with underscores, e.g. "Package id 0" becomes "package_id_0"
+func normalizeSensorName(name string) string {
+	return strings.Trim(sensorNameChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+}
+
+// raplDomain is a top-level Intel RAPL package domain
+type raplDomain struct {
+	dir       string
+	id        string
+	label     string
+	maxEnergy int64
+	maxWatts  float64
+}
+
+// raplDomains lists the top-level RAPL domains. Subdomains (core, uncore,
+// dram) are part of their package's energy and are left out so the total is
+// not counted twice.
+func raplDomains() []raplDomain {
+	matches, _ := filepath.Glob(filepath.Join(sysfsRoot, "class", "powercap", "intel-rapl:*"))
+	var domains []raplDomain
+	for _, dir := range matches {
+		if strings.Count(filepath.Base(dir), ":") != 1 {
+			continue
+		}
+		name := readSysfsString(filepath.Join(dir, "name"))
+		if !strings.HasPrefix(name, "package-") {
+			continue
+		}
+		pkg := strings.TrimPrefix(name, "package-")
+		domain := raplDomain{
+			dir:   dir,
+			id:    "rapl_package_" + pkg,
+			label: "CPU Package " + pkg,
+		}
+		domain.maxEnergy, _ = readSysfsInt(filepath.Join(dir, "max_energy_range_uj"))
+		if maxPower, ok := readSysfsInt(filepath.Join(dir, "constraint_0_max_power_uw")); ok && maxPower > 0 {
+			domain.maxWatts = round2(float64(maxPower) / 1e6)
+		} else if limit, ok := readSysfsInt(filepath.Join(dir, "constraint_0_power_limit_uw")); ok {
+			domain.maxWatts = round2(float64(limit) / 1e6)
+		}
+		domains = append(domains, domain)
+	}
+	sort.Slice(domains, func(i, j int) bool { return domains[i].id < domains[j].id })
+	return domains
+}
+
+func readSysfsString(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func readSysfsInt(path string) (int64, bool) {
+	n, err := strconv.ParseInt(readSysfsString(path), 10, 64)
+	return n, err == nil
+}
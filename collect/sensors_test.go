@@ -0,0 +1,157 @@
+package collect
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeSysfs creates files (path relative to root -> contents) in a fake sysfs tree
+func writeSysfs(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for path, contents := range files {
+		path = filepath.Join(root, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(contents+"\n"), 0o644))
+	}
+}
+
+func useSysfs(t *testing.T) string {
+	root := t.TempDir()
+	previous := sysfsRoot
+	sysfsRoot = root
+	t.Cleanup(func() { sysfsRoot = previous })
+	return root
+}
+
+func TestTemperatures(t *testing.T) {
+	root := useSysfs(t)
+	writeSysfs(t, root, map[string]string{
+		"class/hwmon/hwmon0/name":        "coretemp",
+		"class/hwmon/hwmon0/temp1_input": "62000",
+		"class/hwmon/hwmon0/temp1_label": "Package id 0",
+		"class/hwmon/hwmon0/temp1_max":   "80000",
+		"class/hwmon/hwmon0/temp1_crit":  "100000",
+		"class/hwmon/hwmon0/temp2_input": "78000",
+		"class/hwmon/hwmon0/temp2_label": "Core 0",
+		"class/hwmon/hwmon0/temp3_input": "55000",
+		"class/hwmon/hwmon0/temp3_label": "Core 1",
+
+		// Second socket
+		"class/hwmon/hwmon1/name":        "coretemp",
+		"class/hwmon/hwmon1/temp2_input": "91500",
+		"class/hwmon/hwmon1/temp2_label": "Core 0",
+
+		"class/hwmon/hwmon2/name":        "nvme",
+		"class/hwmon/hwmon2/temp1_input": "41850",
+		"class/hwmon/hwmon2/temp1_label": "Composite",
+		"class/hwmon/hwmon2/temp2_input": "52000",
+		"class/hwmon/hwmon2/temp2_label": "Sensor 1",
+
+		"class/hwmon/hwmon3/name":        "acpitz",
+		"class/hwmon/hwmon3/temp1_input": "27800",
+		"class/hwmon/hwmon3/temp2_input": "not-a-number",
+
+		"devices/pci0000:00/nvme/nvme0/model": "Samsung SSD 980",
+	})
+	require.NoError(t, os.Symlink(filepath.Join(root, "devices/pci0000:00/nvme/nvme0"), filepath.Join(root, "class/hwmon/hwmon2/device")))
+
+	metrics, err := Temperatures(context.Background())
+	require.NoError(t, err)
+	require.Len(t, metrics.Sensors, 7)
+
+	pkg := metrics.GetSensorByID("cpu_package_0")
+	require.NotNil(t, pkg)
+	assert.Equal(t, "CPU Package 0", pkg.SensorName)
+	assert.Equal(t, 62.0, pkg.Temperature)
+	assert.Equal(t, 80.0, pkg.UpperWarning) // Hardware thresholds win
+	assert.Equal(t, 100.0, pkg.UpperCritical)
+	assert.Equal(t, "ok", pkg.Status)
+
+	core := metrics.GetSensorByID("cpu_core_0")
+	require.NotNil(t, core)
+	assert.Equal(t, "CPU Core 0", core.SensorName)
+	assert.Equal(t, "cpu", core.Type)
+	assert.Equal(t, "warning", core.Status) // 78 against the 75/90 CPU defaults
+
+	other := metrics.GetSensorByID("cpu_core_0_2")
+	require.NotNil(t, other)
+	assert.Equal(t, "critical", other.Status)
+
+	disk := metrics.GetSensorByID("disk_nvme0")
+	require.NotNil(t, disk)
+	assert.Equal(t, 41.85, disk.Temperature)
+	assert.Equal(t, "disk", disk.Type)
+	require.NotNil(t, metrics.GetSensorByID("disk_nvme0_sensor_1"))
+	assert.Equal(t, "warning", metrics.GetSensorByID("disk_nvme0_sensor_1").Status)
+
+	zone := metrics.GetSensorByID("system_acpitz_temp1")
+	require.NotNil(t, zone)
+	assert.Equal(t, "system", zone.Type)
+
+	maxTemp, maxName := metrics.GetMaxTemperature()
+	assert.Equal(t, 91.5, maxTemp)
+	assert.Equal(t, "CPU Core 0 (2)", maxName)
+}
+
+func TestTemperatures_NoHwmon(t *testing.T) {
+	useSysfs(t)
+	metrics, err := Temperatures(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, metrics.Sensors)
+}
+
+func TestPower(t *testing.T) {
+	root := useSysfs(t)
+	writeSysfs(t, root, map[string]string{
+		"class/powercap/intel-rapl:0/name":                      "package-0",
+		"class/powercap/intel-rapl:0/energy_uj":                 "1000000",
+		"class/powercap/intel-rapl:0/max_energy_range_uj":       "262143328850",
+		"class/powercap/intel-rapl:0/constraint_0_max_power_uw": "125000000",
+		"class/powercap/intel-rapl:0:0/name":                    "core",
+		"class/powercap/intel-rapl:0:0/energy_uj":               "500000",
+		"class/powercap/intel-rapl:1/name":                      "psys",
+		"class/powercap/intel-rapl:1/energy_uj":                 "0",
+
+		"class/hwmon/hwmon0/name":         "acpi_power_meter",
+		"class/hwmon/hwmon0/power1_input": "182500000",
+		"class/hwmon/hwmon0/power1_cap":   "750000000",
+	})
+
+	// Advance the package counter halfway through the sample
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		os.WriteFile(filepath.Join(root, "class/powercap/intel-rapl:0/energy_uj"), []byte("6000000\n"), 0o644)
+	}()
+
+	metrics, err := Power(context.Background(), 100*time.Millisecond)
+	require.NoError(t, err)
+	require.Len(t, metrics.PowerSupplies, 2)
+
+	pkg := metrics.GetPowerSupplyByID("rapl_package_0")
+	require.NotNil(t, pkg)
+	assert.Equal(t, "CPU Package 0", pkg.Name)
+	assert.Equal(t, 125.0, pkg.MaxPowerWatts)
+	// 5 J over at least 0.1 s
+	assert.Positive(t, pkg.PowerWatts)
+	assert.LessOrEqual(t, pkg.PowerWatts, 50.0)
+
+	meter := metrics.GetPowerSupplyByID("acpi_power_meter_power1")
+	require.NotNil(t, meter)
+	assert.Equal(t, 182.5, meter.PowerWatts)
+	assert.Equal(t, 750.0, meter.MaxPowerWatts)
+
+	assert.InDelta(t, pkg.PowerWatts+meter.PowerWatts, metrics.TotalPowerW, 0.01)
+}
+
+func TestNormalizeSensorName(t *testing.T) {
+	assert.Equal(t, "package_id_0", normalizeSensorName("Package id 0"))
+	assert.Equal(t, "tccd1", normalizeSensorName("Tccd1"))
+	assert.Equal(t, "pch_cannonlake", normalizeSensorName("pch_cannonlake"))
+	assert.Equal(t, "sensor_1", normalizeSensorName(" Sensor #1 "))
+}
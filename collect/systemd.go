@@ -0,0 +1,430 @@
+package collect
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	nexmonyx "github.com/nexmonyx/go-sdk/v2"
+)
+
+// systemdUnset is the value systemd reports for counters it does not track
+const systemdUnset = "18446744073709551615"
+
+// serviceProperties are read for each unit with systemctl show
+var serviceProperties = []string{
+	"Id", "ActiveState", "SubState", "LoadState", "Description", "MainPID",
+	"MemoryCurrent", "CPUUsageNSec", "TasksCurrent", "NRestarts",
+	"ActiveEnterTimestamp", "ControlGroup",
+}
+
+// commandRunner runs an external command and returns its standard output.
+// Output is returned even when the command exits with an error, since tools
+// such as smartctl use the exit status to report findings.
+type commandRunner func(ctx context.Context, name string, args ...string) ([]byte, error)
+
+func runCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return out, fmt.Errorf("%s: %w: %s", name, err, msg)
+		}
+		return out, fmt.Errorf("%s: %w", name, err)
+	}
+	return out, nil
+}
+
+// ServiceCollector reads systemd services on Linux with systemctl and tails
+// their journals with journalctl. Services are filtered by the include and
+// exclude rules of its ServiceMonitoringConfig.
+//
+// A collector is stateful: CPU percentages in ServiceMetrics are computed
+// from the CPU time used since the previous Collect, and logs are read from
+// where the previous Collect stopped. Reuse one collector for the lifetime of
+// the agent.
+type ServiceCollector struct {
+	config *nexmonyx.ServiceMonitoringConfig
+	run    commandRunner
+
+	// cgroupRoot is where the cgroup v2 hierarchy is mounted
+	cgroupRoot string
+
+	mu         sync.Mutex
+	cpuSamples map[string]serviceCPUSample
+	cursors    map[string]string
+	loaded     bool
+}
+
+type serviceCPUSample struct {
+	usageNSec uint64
+	at        time.Time
+}
+
+// NewServiceCollector creates a collector for config. A nil config uses
+// nexmonyx.NewServiceMonitoringConfig.
+func NewServiceCollector(config *nexmonyx.ServiceMonitoringConfig) *ServiceCollector {
+	if config == nil {
+		config = nexmonyx.NewServiceMonitoringConfig()
+	}
+	return &ServiceCollector{
+		config:     config,
+		run:        runCommand,
+		cgroupRoot: "/sys/fs/cgroup",
+		cpuSamples: make(map[string]serviceCPUSample),
+		cursors:    make(map[string]string),
+	}
+}
+
+// Collect returns the state of every monitored service, along with resource
+// metrics and new journal entries when the config enables them. Log
+// collection errors are returned alongside the collected services.
+func (c *ServiceCollector) Collect(ctx context.Context) (*nexmonyx.ServiceInfo, error) {
+	if runtime.GOOS != "linux" {
+		return nil, fmt.Errorf("systemd service collection is only supported on Linux")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	units, err := c.listUnits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info := nexmonyx.NewServiceInfo()
+	if len(units) == 0 {
+		return info, nil
+	}
+
+	properties, err := c.showUnits(ctx, units)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, props := range properties {
+		service := parseServiceProperties(props)
+		if service.Name == "" || service.LoadState == "not-found" {
+			continue
+		}
+		info.AddService(service)
+		if c.config.CollectMetrics {
+			info.AddMetrics(c.serviceMetrics(service, props["ControlGroup"], now))
+		}
+	}
+
+	if c.config.CollectLogs {
+		if err := c.collectLogs(ctx, info); err != nil {
+			return info, err
+		}
+	}
+	return info, nil
+}
+
+// listUnits returns the loaded service units that the config monitors
+func (c *ServiceCollector) listUnits(ctx context.Context) ([]string, error) {
+	out, err := c.run(ctx, "systemctl", "list-units", "--type=service", "--all", "--no-legend", "--no-pager", "--plain")
+	if err != nil {
+		return nil, err
+	}
+
+	var units []string
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		// Failed units are prefixed with a marker on some systemd versions
+		name := strings.TrimLeft(fields[0], "●* ")
+		if name != "" && c.config.ShouldMonitorService(name) {
+			units = append(units, name)
+		}
+	}
+	return units, scanner.Err()
+}
+
+// showUnits reads the properties of units in one systemctl call
+func (c *ServiceCollector) showUnits(ctx context.Context, units []string) ([]map[string]string, error) {
+	args := append([]string{"show", "--no-pager", "--property=" + strings.Join(serviceProperties, ",")}, units...)
+	out, err := c.run(ctx, "systemctl", args...)
+	if err != nil {
+		return nil, err
+	}
+	return parseShowOutput(out), nil
+}
+
+// parseShowOutput splits systemctl show output, which separates units with a
+// blank line, into one property map per unit
+func parseShowOutput(out []byte) []map[string]string {
+	var units []map[string]string
+	current := make(map[string]string)
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if line == "" {
+			if len(current) > 0 {
+				units = append(units, current)
+				current = make(map[string]string)
+			}
+			continue
+		}
+		if key, value, ok := strings.Cut(line, "="); ok {
+			current[key] = value
+		}
+	}
+	if len(current) > 0 {
+		units = append(units, current)
+	}
+	return units
+}
+
+func parseServiceProperties(props map[string]string) *nexmonyx.ServiceMonitoringInfo {
+	service := &nexmonyx.ServiceMonitoringInfo{
+		Name:          props["Id"],
+		State:         props["ActiveState"],
+		SubState:      props["SubState"],
+		LoadState:     props["LoadState"],
+		Description:   props["Description"],
+		MemoryCurrent: parseSystemdUint(props["MemoryCurrent"]),
+		CPUUsageNSec:  parseSystemdUint(props["CPUUsageNSec"]),
+		TasksCurrent:  parseSystemdUint(props["TasksCurrent"]),
+	}
+	service.MainPID, _ = strconv.Atoi(props["MainPID"])
+	service.RestartCount, _ = strconv.Atoi(props["NRestarts"])
+	if since, ok := parseSystemdTimestamp(props["ActiveEnterTimestamp"]); ok && service.State == "active" {
+		service.ActiveSince = &since
+	}
+	return service
+}
+
+// parseSystemdUint parses a counter, treating "[not set]" and the unset
+// sentinel as zero
+func parseSystemdUint(value string) uint64 {
+	if value == "" || value == systemdUnset {
+		return 0
+	}
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// parseSystemdTimestamp parses timestamps such as "Wed 2026-10-14 09:30:12 UTC"
+// and the "@1760434212" form printed with --timestamp=unix
+func parseSystemdTimestamp(value string) (time.Time, bool) {
+	if value == "" || value == "n/a" {
+		return time.Time{}, false
+	}
+	if strings.HasPrefix(value, "@") {
+		secs, err := strconv.ParseInt(value[1:], 10, 64)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return time.Unix(secs, 0).UTC(), true
+	}
+	t, err := time.ParseInLocation("Mon 2006-01-02 15:04:05 MST", value, time.Local)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t.UTC(), true
+}
+
+// serviceMetrics builds resource metrics for service. CPUPercent is zero on
+// the first collection of a service, when there is no previous sample.
+func (c *ServiceCollector) serviceMetrics(service *nexmonyx.ServiceMonitoringInfo, cgroup string, now time.Time) *nexmonyx.ServiceMetrics {
+	var cpuPercent float64
+	if prev, ok := c.cpuSamples[service.Name]; ok && service.CPUUsageNSec >= prev.usageNSec {
+		if elapsed := now.Sub(prev.at); elapsed > 0 {
+			cpuPercent = round2(float64(service.CPUUsageNSec-prev.usageNSec) / float64(elapsed.Nanoseconds()) * 100)
+		}
+	}
+	c.cpuSamples[service.Name] = serviceCPUSample{usageNSec: service.CPUUsageNSec, at: now}
+
+	processes := c.cgroupProcessCount(cgroup)
+	if processes == 0 && service.MainPID > 0 {
+		processes = 1
+	}
+
+	metrics := nexmonyx.CreateServiceMetrics(service.Name, cpuPercent, service.MemoryCurrent, processes, int(service.TasksCurrent))
+	metrics.Timestamp = now
+	return metrics
+}
+
+// cgroupProcessCount counts the processes in a unit's cgroup, or returns
+// zero when the cgroup v2 hierarchy is unavailable
+func (c *ServiceCollector) cgroupProcessCount(cgroup string) int {
+	if cgroup == "" {
+		return 0
+	}
+	data, err := os.ReadFile(filepath.Join(c.cgroupRoot, cgroup, "cgroup.procs"))
+	if err != nil {
+		return 0
+	}
+	return len(strings.Fields(string(data)))
+}
+
+// journalEntry holds the journal export fields used for ServiceLogEntry
+type journalEntry struct {
+	Cursor           string          `json:"__CURSOR"`
+	RealtimeUsec     string          `json:"__REALTIME_TIMESTAMP"`
+	Priority         string          `json:"PRIORITY"`
+	Message          json.RawMessage `json:"MESSAGE"`
+	SyslogIdentifier string          `json:"SYSLOG_IDENTIFIER"`
+	PID              string          `json:"_PID"`
+}
+
+// journalLevels maps syslog priorities to the level names used in ServiceLogEntry
+var journalLevels = []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"}
+
+// collectLogs reads journal entries written since the previous collection.
+// The first collection of a service reads its last LogLines entries.
+func (c *ServiceCollector) collectLogs(ctx context.Context, info *nexmonyx.ServiceInfo) error {
+	if err := c.loadCursors(); err != nil {
+		return err
+	}
+
+	lines := c.config.LogLines
+	if lines <= 0 {
+		lines = 100
+	}
+
+	var errs []error
+	for _, service := range info.Services {
+		args := []string{"--unit=" + service.Name, "--output=json", "--no-pager", "--lines=" + strconv.Itoa(lines)}
+		if cursor := c.cursors[service.Name]; cursor != "" {
+			args = append(args, "--after-cursor="+cursor)
+		}
+		out, err := c.run(ctx, "journalctl", args...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("logs for %s: %w", service.Name, err))
+			continue
+		}
+
+		entries, cursor := parseJournal(out, service.Name)
+		for _, entry := range entries {
+			info.AddLogEntry(service.Name, entry)
+		}
+		if cursor != "" {
+			c.cursors[service.Name] = cursor
+		}
+	}
+
+	if err := c.saveCursors(); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("collecting service logs: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// parseJournal decodes journalctl --output=json lines and returns the entries
+// with the cursor of the last one
+func parseJournal(out []byte, unit string) ([]nexmonyx.ServiceLogEntry, string) {
+	var entries []nexmonyx.ServiceLogEntry
+	var cursor string
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var raw journalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &raw); err != nil {
+			continue
+		}
+		cursor = raw.Cursor
+
+		entry := nexmonyx.ServiceLogEntry{
+			Level:   "info",
+			Message: journalMessage(raw.Message),
+			Fields:  map[string]string{"unit": unit},
+		}
+		if usec, err := strconv.ParseInt(raw.RealtimeUsec, 10, 64); err == nil {
+			entry.Timestamp = time.UnixMicro(usec).UTC()
+		}
+		if priority, err := strconv.Atoi(raw.Priority); err == nil && priority >= 0 && priority < len(journalLevels) {
+			entry.Level = journalLevels[priority]
+		}
+		if raw.PID != "" {
+			entry.Fields["pid"] = raw.PID
+		}
+		if raw.SyslogIdentifier != "" {
+			entry.Fields["identifier"] = raw.SyslogIdentifier
+		}
+		entries = append(entries, entry)
+	}
+	return entries, cursor
+}
+
+// journalMessage decodes MESSAGE, which journalctl prints as an array of
+// bytes when the message is not valid UTF-8
+func journalMessage(raw json.RawMessage) string {
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text
+	}
+	var data []byte
+	var ints []int
+	if err := json.Unmarshal(raw, &ints); err == nil {
+		for _, b := range ints {
+			data = append(data, byte(b))
+		}
+	}
+	return strings.ToValidUTF8(string(data), "�")
+}
+
+// loadCursors reads journal cursors saved by a previous run from the
+// config's LogStateFile, so logs are neither lost nor repeated on restart
+func (c *ServiceCollector) loadCursors() error {
+	if c.loaded || c.config.LogStateFile == "" {
+		c.loaded = true
+		return nil
+	}
+	data, err := os.ReadFile(c.config.LogStateFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			c.loaded = true
+			return nil
+		}
+		return fmt.Errorf("reading log state: %w", err)
+	}
+	if err := json.Unmarshal(data, &c.cursors); err != nil {
+		return fmt.Errorf("reading log state: %w", err)
+	}
+	c.loaded = true
+	return nil
+}
+
+// saveCursors writes the journal cursors to the config's LogStateFile
+func (c *ServiceCollector) saveCursors() error {
+	if c.config.LogStateFile == "" {
+		return nil
+	}
+	data, err := json.Marshal(c.cursors)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.config.LogStateFile), 0o755); err != nil {
+		return fmt.Errorf("writing log state: %w", err)
+	}
+	tmp := c.config.LogStateFile + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("writing log state: %w", err)
+	}
+	if err := os.Rename(tmp, c.config.LogStateFile); err != nil {
+		return fmt.Errorf("writing log state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package collect
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	nexmonyx "github.com/nexmonyx/go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const listUnitsOutput = `ssh.service          loaded    active   running OpenBSD Secure Shell server
+nginx.service        loaded    failed   failed  A high performance web server
+nginx-debug.service  loaded    inactive dead    Debug nginx
+cron.service         loaded    active   running Regular background program processing daemon
+`
+
+const showOutput = `Id=ssh.service
+ActiveState=active
+SubState=running
+LoadState=loaded
+Description=OpenBSD Secure Shell server
+MainPID=812
+MemoryCurrent=4308992
+CPUUsageNSec=890000000
+TasksCurrent=1
+NRestarts=0
+ActiveEnterTimestamp=@1760434212
+ControlGroup=/system.slice/ssh.service
+
+Id=nginx.service
+ActiveState=failed
+SubState=failed
+LoadState=loaded
+Description=A high performance web server
+MainPID=0
+MemoryCurrent=[not set]
+CPUUsageNSec=18446744073709551615
+TasksCurrent=18446744073709551615
+NRestarts=3
+ActiveEnterTimestamp=
+ControlGroup=
+`
+
+// fakeSystemd answers systemctl and journalctl calls with canned output
+type fakeSystemd struct {
+	calls   [][]string
+	journal map[string]string
+}
+
+func (f *fakeSystemd) run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	f.calls = append(f.calls, append([]string{name}, args...))
+	switch {
+	case name == "systemctl" && args[0] == "list-units":
+		return []byte(listUnitsOutput), nil
+	case name == "systemctl" && args[0] == "show":
+		var blocks []string
+		for _, block := range strings.Split(showOutput, "\n\n") {
+			for _, unit := range args[3:] {
+				if strings.HasPrefix(block, "Id="+unit+"\n") {
+					blocks = append(blocks, block)
+				}
+			}
+		}
+		return []byte(strings.Join(blocks, "\n\n")), nil
+	case name == "journalctl":
+		return []byte(f.journal[strings.TrimPrefix(args[0], "--unit=")]), nil
+	}
+	return nil, nil
+}
+
+func newTestServiceCollector(t *testing.T, config *nexmonyx.ServiceMonitoringConfig) (*ServiceCollector, *fakeSystemd) {
+	fake := &fakeSystemd{journal: map[string]string{
+		"ssh.service": `{"__CURSOR":"s=1;i=10","__REALTIME_TIMESTAMP":"1760434212000000","PRIORITY":"6","MESSAGE":"Accepted publickey for deploy","_PID":"9001","SYSLOG_IDENTIFIER":"sshd"}
+{"__CURSOR":"s=1;i=11","__REALTIME_TIMESTAMP":"1760434213000000","PRIORITY":"3","MESSAGE":[104,105,255]}
+`,
+	}}
+	collector := NewServiceCollector(config)
+	collector.run = fake.run
+	collector.cgroupRoot = t.TempDir()
+	return collector, fake
+}
+
+func TestServiceCollector_Collect(t *testing.T) {
+	config := &nexmonyx.ServiceMonitoringConfig{
+		IncludePatterns: []string{"ssh*", "nginx*"},
+		ExcludePatterns: []string{"*-debug.service"},
+		CollectMetrics:  true,
+		CollectLogs:     true,
+		LogLines:        50,
+		LogStateFile:    filepath.Join(t.TempDir(), "state", "cursors.json"),
+	}
+	collector, fake := newTestServiceCollector(t, config)
+	cgroup := filepath.Join(collector.cgroupRoot, "system.slice", "ssh.service")
+	require.NoError(t, os.MkdirAll(cgroup, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(cgroup, "cgroup.procs"), []byte("812\n9001\n"), 0o644))
+
+	info, err := collector.Collect(context.Background())
+	require.NoError(t, err)
+
+	// Only included, non-excluded units are queried
+	assert.Equal(t, []string{"systemctl", "show", "--no-pager", "--property=" + strings.Join(serviceProperties, ","), "ssh.service", "nginx.service"}, fake.calls[1])
+
+	require.Len(t, info.Services, 2)
+	ssh := info.GetServiceByName("ssh.service")
+	require.NotNil(t, ssh)
+	assert.Equal(t, 812, ssh.MainPID)
+	assert.Equal(t, uint64(4308992), ssh.MemoryCurrent)
+	assert.Equal(t, uint64(890000000), ssh.CPUUsageNSec)
+	require.NotNil(t, ssh.ActiveSince)
+	assert.Equal(t, int64(1760434212), ssh.ActiveSince.Unix())
+
+	nginx := info.GetServiceByName("nginx.service")
+	require.NotNil(t, nginx)
+	assert.Equal(t, "failed", nginx.State)
+	assert.Equal(t, 3, nginx.RestartCount)
+	assert.Zero(t, nginx.MemoryCurrent)
+	assert.Zero(t, nginx.CPUUsageNSec)
+	assert.Nil(t, nginx.ActiveSince)
+
+	metrics := info.GetServiceMetrics("ssh.service")
+	require.NotNil(t, metrics)
+	assert.Equal(t, 2, metrics.ProcessCount)
+	assert.Equal(t, 1, metrics.ThreadCount)
+	assert.Zero(t, metrics.CPUPercent) // No previous sample yet
+
+	logs := info.GetServiceLogs("ssh.service")
+	require.Len(t, logs, 2)
+	assert.Equal(t, "info", logs[0].Level)
+	assert.Equal(t, "Accepted publickey for deploy", logs[0].Message)
+	assert.Equal(t, "sshd", logs[0].Fields["identifier"])
+	assert.Equal(t, "9001", logs[0].Fields["pid"])
+	assert.Equal(t, int64(1760434212), logs[0].Timestamp.Unix())
+	assert.Equal(t, "err", logs[1].Level)
+	assert.Equal(t, "hi�", logs[1].Message)
+	assert.Len(t, info.GetErrorLogs()["ssh.service"], 1)
+
+	// A new collector resumes the journal after the saved cursor
+	resumed, fake := newTestServiceCollector(t, config)
+	_, err = resumed.Collect(context.Background())
+	require.NoError(t, err)
+	var journalArgs []string
+	for _, call := range fake.calls {
+		if call[0] == "journalctl" && call[1] == "--unit=ssh.service" {
+			journalArgs = call
+		}
+	}
+	assert.Contains(t, journalArgs, "--after-cursor=s=1;i=11")
+	assert.Contains(t, journalArgs, "--lines=50")
+}
+
+func TestServiceCollector_CPUPercent(t *testing.T) {
+	collector, _ := newTestServiceCollector(t, &nexmonyx.ServiceMonitoringConfig{CollectMetrics: true})
+	start := time.Now()
+	service := &nexmonyx.ServiceMonitoringInfo{Name: "app.service", CPUUsageNSec: 1e9, TasksCurrent: 4}
+
+	assert.Zero(t, collector.serviceMetrics(service, "", start).CPUPercent)
+
+	// Half a second of CPU time over two seconds
+	service.CPUUsageNSec += 5e8
+	metrics := collector.serviceMetrics(service, "", start.Add(2*time.Second))
+	assert.Equal(t, 25.0, metrics.CPUPercent)
+	assert.Equal(t, 4, metrics.ThreadCount)
+
+	// A restarted service resets its counter rather than reporting a negative rate
+	service.CPUUsageNSec = 1e6
+	assert.Zero(t, collector.serviceMetrics(service, "", start.Add(4*time.Second)).CPUPercent)
+}
+
+func TestParseSystemdTimestamp(t *testing.T) {
+	ts, ok := parseSystemdTimestamp("Wed 2026-10-14 09:30:12 UTC")
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2026, 10, 14, 9, 30, 12, 0, time.UTC), ts)
+
+	_, ok = parseSystemdTimestamp("n/a")
+	assert.False(t, ok)
+}
+
+func TestCollectAll_Services(t *testing.T) {
+	collector, _ := newTestServiceCollector(t, &nexmonyx.ServiceMonitoringConfig{IncludeServices: []string{"ssh.service"}})
+	req, err := CollectAll(context.Background(), CollectOptions{
+		DisableSystemInfo: true,
+		DisableCPU:        true,
+		DisableMemory:     true,
+		DisableDisks:      true,
+		DisableNetwork:    true,
+		Services:          collector,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, req.Services)
+	require.Len(t, req.Services.Services, 1)
+	assert.Equal(t, "ssh.service", req.Services.Services[0].Name)
+}
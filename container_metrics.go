@@ -0,0 +1,125 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Container runtimes
+const (
+	ContainerRuntimeDocker     = "docker"
+	ContainerRuntimeContainerd = "containerd"
+	ContainerRuntimeCRIO       = "cri-o"
+	ContainerRuntimePodman     = "podman"
+)
+
+// Container states, as reported by Docker and the CRI
+const (
+	ContainerStateCreated    = "created"
+	ContainerStateRunning    = "running"
+	ContainerStatePaused     = "paused"
+	ContainerStateRestarting = "restarting"
+	ContainerStateExited     = "exited"
+	ContainerStateDead       = "dead"
+)
+
+// Labels set on containers started by the kubelet
+const (
+	KubernetesPodNameLabel       = "io.kubernetes.pod.name"
+	KubernetesPodNamespaceLabel  = "io.kubernetes.pod.namespace"
+	KubernetesContainerNameLabel = "io.kubernetes.container.name"
+)
+
+// ContainerMetrics represents the runtime metrics of one container
+type ContainerMetrics struct {
+	ID        string `json:"id"`
+	Name      string `json:"name,omitempty"`
+	Image     string `json:"image,omitempty"`    // e.g. "nginx:1.25"
+	ImageID   string `json:"image_id,omitempty"` // e.g. "sha256:..."
+	Runtime   string `json:"runtime,omitempty"`  // docker, containerd, cri-o, podman
+	State     string `json:"state"`              // created, running, paused, restarting, exited, dead
+	StartedAt string `json:"started_at,omitempty"`
+	ExitCode  int    `json:"exit_code,omitempty"`
+
+	RestartCount int `json:"restart_count"`
+
+	// CPU percent is of one core and may exceed 100
+	CPUPercent       float64 `json:"cpu_percent"`
+	CPULimitCores    float64 `json:"cpu_limit_cores,omitempty"`
+	MemoryUsageBytes int64   `json:"memory_usage_bytes"`
+	MemoryLimitBytes int64   `json:"memory_limit_bytes,omitempty"` // 0 when unlimited
+
+	NetworkRxBytes  int64 `json:"network_rx_bytes"`
+	NetworkTxBytes  int64 `json:"network_tx_bytes"`
+	BlockReadBytes  int64 `json:"block_read_bytes,omitempty"`
+	BlockWriteBytes int64 `json:"block_write_bytes,omitempty"`
+
+	// Kubernetes workload, empty for containers not managed by the kubelet
+	PodName      string `json:"pod_name,omitempty"`
+	PodNamespace string `json:"pod_namespace,omitempty"`
+
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// NewContainerMetrics creates a new ContainerMetrics instance
+func NewContainerMetrics(id, name, image string) *ContainerMetrics {
+	return &ContainerMetrics{
+		ID:     id,
+		Name:   name,
+		Image:  image,
+		State:  ContainerStateRunning,
+		Labels: make(map[string]string),
+	}
+}
+
+// SetLabels sets the container labels and fills PodName and PodNamespace from
+// the kubelet's labels when they are not already set
+func (c *ContainerMetrics) SetLabels(labels map[string]string) {
+	c.Labels = labels
+	if c.PodName == "" {
+		c.PodName = labels[KubernetesPodNameLabel]
+	}
+	if c.PodNamespace == "" {
+		c.PodNamespace = labels[KubernetesPodNamespaceLabel]
+	}
+}
+
+// MemoryUsedPercent returns the memory usage as a percentage of the limit, or
+// 0 when the container has no memory limit
+func (c *ContainerMetrics) MemoryUsedPercent() float64 {
+	if c.MemoryLimitBytes <= 0 {
+		return 0
+	}
+	return float64(c.MemoryUsageBytes) / float64(c.MemoryLimitBytes) * 100
+}
+
+// SubmitContainers submits container metrics for a server without the rest of
+// a ComprehensiveMetricsRequest, for agents that collect containers on their
+// own interval. The server UUID defaults to the one used for authentication.
+func (s *MetricsService) SubmitContainers(ctx context.Context, serverUUID string, containers []ContainerMetrics) error {
+	if serverUUID == "" {
+		serverUUID = s.client.config.Auth.ServerUUID
+	}
+	if serverUUID == "" {
+		return fmt.Errorf("server UUID is required")
+	}
+	for i, container := range containers {
+		if container.ID == "" {
+			return fmt.Errorf("containers[%d]: id is required", i)
+		}
+	}
+
+	var resp StandardResponse
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v2/metrics/containers",
+		Body: map[string]interface{}{
+			"server_uuid":  serverUUID,
+			"collected_at": time.Now().UTC().Format(time.RFC3339),
+			"containers":   containers,
+		},
+		Result: &resp,
+	})
+	return err
+}
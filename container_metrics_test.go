@@ -0,0 +1,59 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerMetrics(t *testing.T) {
+	container := NewContainerMetrics(testContainerID, "api", "registry.example.com/api:2.1")
+	container.SetLabels(map[string]string{
+		KubernetesPodNameLabel:      "api-7d9f8-x2k4z",
+		KubernetesPodNamespaceLabel: "payments",
+	})
+	assert.Equal(t, "api-7d9f8-x2k4z", container.PodName)
+	assert.Equal(t, "payments", container.PodNamespace)
+
+	assert.Zero(t, container.MemoryUsedPercent(), "unlimited containers have no usage percent")
+	container.MemoryUsageBytes, container.MemoryLimitBytes = 256<<20, 1<<30
+	assert.Equal(t, 25.0, container.MemoryUsedPercent())
+
+	metrics := ComprehensiveMetricsRequest{ServerUUID: "srv-1", CollectedAt: "2026-03-01T00:00:00Z", Containers: []ContainerMetrics{*container}}
+	assert.Empty(t, metrics.Validate())
+
+	metrics.Containers = append(metrics.Containers, ContainerMetrics{RestartCount: -1, MemoryUsageBytes: 2 << 30, MemoryLimitBytes: 1 << 30})
+	assert.ElementsMatch(t, []string{"containers[1].id", "containers[1].restart_count", "containers[1].memory_usage_bytes"},
+		issueFields(metrics.Validate(), ValidationSeverityError))
+}
+
+func TestMetricsService_SubmitContainers(t *testing.T) {
+	var body struct {
+		ServerUUID string             `json:"server_uuid"`
+		Containers []ContainerMetrics `json:"containers"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v2/metrics/containers", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{ServerUUID: "srv-agent", ServerSecret: "secret"}})
+	require.NoError(t, err)
+
+	containers := []ContainerMetrics{{ID: testContainerID, Image: "nginx:1.25", State: ContainerStateRunning, RestartCount: 2, CPUPercent: 12.5}}
+	require.NoError(t, client.Metrics.SubmitContainers(context.Background(), "", containers))
+	assert.Equal(t, "srv-agent", body.ServerUUID)
+	assert.Equal(t, containers, body.Containers)
+
+	err = client.Metrics.SubmitContainers(context.Background(), "srv-1", []ContainerMetrics{{Image: "nginx"}})
+	assert.Error(t, err)
+}
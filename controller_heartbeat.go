@@ -0,0 +1,216 @@
+package nexmonyx
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const defaultControllerHeartbeatInterval = 30 * time.Second
+
+// ControllerHeartbeatOptions configures a ControllerHeartbeatLoop
+type ControllerHeartbeatOptions struct {
+	// ControllerID is the identity returned by RegisterController, if any
+	ControllerID   string
+	ControllerType string
+	Version        string
+
+	// Interval between heartbeats (default: 30s)
+	Interval time.Duration
+
+	// SampleResourceUsage is called before every heartbeat to report the
+	// controller's current resource usage
+	SampleResourceUsage func() *ResourceUsageInfo
+
+	// SampleHealth is called before every heartbeat to report detailed health.
+	// Status, Version, and Uptime are filled in when left empty.
+	SampleHealth func() *ControllerHealthInfo
+
+	// OnError is called when a heartbeat fails. The loop keeps running.
+	OnError func(error)
+}
+
+// ControllerHeartbeatLoop sends controller heartbeats on a fixed interval and
+// immediately whenever the leader flag or status changes. It is safe for
+// concurrent use.
+//
+// Example:
+//
+//	loop := client.Controllers.NewHeartbeatLoop("monitoring-controller", &nexmonyx.ControllerHeartbeatOptions{
+//	    Version:             version,
+//	    SampleResourceUsage: sampleUsage,
+//	})
+//	go loop.Run(ctx)
+//
+//	// From the leader election callbacks
+//	loop.SetLeader(true)
+type ControllerHeartbeatLoop struct {
+	service *ControllersService
+	name    string
+	options ControllerHeartbeatOptions
+	started time.Time
+	wake    chan struct{}
+
+	mu            sync.Mutex
+	isLeader      bool
+	status        string
+	lastHeartbeat time.Time
+	lastErr       error
+}
+
+// NewHeartbeatLoop creates a heartbeat loop for controllerName. Call Run to start it.
+func (s *ControllersService) NewHeartbeatLoop(controllerName string, opts *ControllerHeartbeatOptions) *ControllerHeartbeatLoop {
+	options := ControllerHeartbeatOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.Interval <= 0 {
+		options.Interval = defaultControllerHeartbeatInterval
+	}
+
+	return &ControllerHeartbeatLoop{
+		service: s,
+		name:    controllerName,
+		options: options,
+		started: time.Now(),
+		wake:    make(chan struct{}, 1),
+		status:  "healthy",
+	}
+}
+
+// Run sends a heartbeat immediately and then every interval until ctx is done,
+// returning ctx's error. Failed heartbeats are reported to OnError and retried
+// at the next interval.
+func (l *ControllerHeartbeatLoop) Run(ctx context.Context) error {
+	ctx, stop, err := l.service.client.runBackground(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		case <-l.wake:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+		}
+
+		if err := l.Beat(ctx); err != nil && ctx.Err() == nil && l.options.OnError != nil {
+			l.options.OnError(err)
+		}
+		timer.Reset(l.options.Interval)
+	}
+}
+
+// Beat sends a single heartbeat with the current leader flag, status, and samples
+func (l *ControllerHeartbeatLoop) Beat(ctx context.Context) error {
+	now := time.Now()
+
+	l.mu.Lock()
+	isLeader, status := l.isLeader, l.status
+	l.mu.Unlock()
+
+	var usage *ResourceUsageInfo
+	if l.options.SampleResourceUsage != nil {
+		usage = l.options.SampleResourceUsage()
+	}
+
+	health := &ControllerHealthInfo{}
+	if l.options.SampleHealth != nil {
+		if sampled := l.options.SampleHealth(); sampled != nil {
+			health = sampled
+		}
+	}
+	if health.Status == "" {
+		health.Status = status
+	}
+	if health.Version == "" {
+		health.Version = l.options.Version
+	}
+	if health.Uptime == 0 {
+		health.Uptime = now.Sub(l.started)
+	}
+	if health.ResourceUsage == nil {
+		health.ResourceUsage = usage
+	}
+
+	err := l.service.SubmitControllerHeartbeat(ctx, l.name, &ControllerHeartbeatRequest{
+		ControllerID:      l.options.ControllerID,
+		Status:            status,
+		Version:           l.options.Version,
+		Health:            health,
+		ResourceUsage:     usage,
+		Timestamp:         now.UTC(),
+		ControllerName:    l.name,
+		ControllerType:    l.options.ControllerType,
+		HeartbeatInterval: int(l.options.Interval / time.Second),
+		IsLeader:          isLeader,
+	})
+
+	l.mu.Lock()
+	l.lastErr = err
+	if err == nil {
+		l.lastHeartbeat = now
+	}
+	l.mu.Unlock()
+
+	return err
+}
+
+// SetLeader updates the leader flag. A change is reported with an immediate heartbeat.
+func (l *ControllerHeartbeatLoop) SetLeader(isLeader bool) {
+	l.mu.Lock()
+	changed := l.isLeader != isLeader
+	l.isLeader = isLeader
+	l.mu.Unlock()
+
+	if changed {
+		l.trigger()
+	}
+}
+
+// IsLeader returns the leader flag sent with heartbeats
+func (l *ControllerHeartbeatLoop) IsLeader() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.isLeader
+}
+
+// SetStatus updates the status sent with heartbeats (default: "healthy"). A
+// change is reported with an immediate heartbeat.
+func (l *ControllerHeartbeatLoop) SetStatus(status string) {
+	l.mu.Lock()
+	changed := l.status != status
+	l.status = status
+	l.mu.Unlock()
+
+	if changed {
+		l.trigger()
+	}
+}
+
+// LastHeartbeat returns the time of the last successful heartbeat and the
+// error from the most recent attempt
+func (l *ControllerHeartbeatLoop) LastHeartbeat() (time.Time, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lastHeartbeat, l.lastErr
+}
+
+// trigger wakes Run for an early heartbeat without blocking
+func (l *ControllerHeartbeatLoop) trigger() {
+	select {
+	case l.wake <- struct{}{}:
+	default:
+	}
+}
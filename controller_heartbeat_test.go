@@ -0,0 +1,115 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestControllerHeartbeatLoop(t *testing.T) {
+	beats := make(chan ControllerHeartbeatRequest, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/controllers/monitoring-controller/heartbeat", r.URL.Path)
+		var req ControllerHeartbeatRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		beats <- req
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	loop := client.Controllers.NewHeartbeatLoop("monitoring-controller", &ControllerHeartbeatOptions{
+		ControllerID:   "ctrl-1",
+		ControllerType: "monitoring",
+		Version:        "1.4.0",
+		Interval:       time.Hour,
+		SampleResourceUsage: func() *ResourceUsageInfo {
+			return &ResourceUsageInfo{CPUUsage: 12.5, MemoryUsage: 256}
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- loop.Run(ctx) }()
+
+	receive := func() ControllerHeartbeatRequest {
+		select {
+		case beat := <-beats:
+			return beat
+		case <-time.After(5 * time.Second):
+			t.Fatal("heartbeat not sent")
+			return ControllerHeartbeatRequest{}
+		}
+	}
+
+	first := receive()
+	assert.Equal(t, "ctrl-1", first.ControllerID)
+	assert.Equal(t, "healthy", first.Status)
+	assert.False(t, first.IsLeader)
+	assert.Equal(t, 3600, first.HeartbeatInterval)
+	require.NotNil(t, first.ResourceUsage)
+	assert.Equal(t, 12.5, first.ResourceUsage.CPUUsage)
+	require.NotNil(t, first.Health)
+	assert.Equal(t, "1.4.0", first.Health.Version)
+
+	loop.SetLeader(true)
+	assert.True(t, receive().IsLeader, "leader change is sent immediately")
+
+	loop.SetLeader(true)
+	loop.SetStatus("degraded")
+	second := receive()
+	assert.Equal(t, "degraded", second.Status)
+	assert.True(t, second.IsLeader)
+
+	last, err := loop.LastHeartbeat()
+	assert.NoError(t, err)
+	assert.False(t, last.IsZero())
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.Empty(t, beats, "unchanged leader flag does not trigger a heartbeat")
+}
+
+func TestControllerHeartbeatLoop_OnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	errs := make(chan error, 10)
+	loop := client.Controllers.NewHeartbeatLoop("monitoring-controller", &ControllerHeartbeatOptions{
+		Interval: 10 * time.Millisecond,
+		OnError:  func(err error) { errs <- err },
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go loop.Run(ctx)
+
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-errs:
+			var forbidden *ForbiddenError
+			assert.True(t, errors.As(err, &forbidden))
+		case <-time.After(5 * time.Second):
+			t.Fatal("OnError not called")
+		}
+	}
+
+	last, err := loop.LastHeartbeat()
+	assert.True(t, last.IsZero())
+	assert.Error(t, err)
+}
@@ -15,6 +15,72 @@ func (s *ControllersService) SubmitControllerHeartbeat(ctx context.Context, cont
 	return err
 }
 
+// RegisterController registers a controller instance and returns the identity
+// and heartbeat interval assigned by the API
+func (s *ControllersService) RegisterController(ctx context.Context, req *ControllerRegistrationRequest) (*ControllerRegistration, error) {
+	var resp StandardResponse
+	resp.Data = &ControllerRegistration{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v1/controllers/register",
+		Body:   req,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if registration, ok := resp.Data.(*ControllerRegistration); ok {
+		return registration, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// ListControllers retrieves the status of every registered controller
+func (s *ControllersService) ListControllers(ctx context.Context, opts *ListOptions) ([]*ControllerStatusResponse, *PaginationMeta, error) {
+	var resp PaginatedResponse
+	var controllers []*ControllerStatusResponse
+	resp.Data = &controllers
+
+	req := &Request{
+		Method: "GET",
+		Path:   "/v1/controllers",
+		Result: &resp,
+	}
+
+	if opts != nil {
+		req.Query = opts.ToQuery()
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return controllers, resp.Meta, nil
+}
+
+// GetControllerHealth retrieves the health reported in a controller's latest heartbeat
+func (s *ControllersService) GetControllerHealth(ctx context.Context, controllerName string) (*ControllerHealthInfo, error) {
+	var resp StandardResponse
+	resp.Data = &ControllerHealthInfo{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/controllers/%s/health", controllerName),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if health, ok := resp.Data.(*ControllerHealthInfo); ok {
+		return health, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
 // GetControllersSummary retrieves a summary of all controllers
 func (s *ControllersService) GetControllersSummary(ctx context.Context) (*ControllersSummaryResponse, error) {
 	var resp StandardResponse
@@ -104,6 +170,24 @@ func (s *ControllersService) GetAlertControllerLeaderStatus(ctx context.Context)
 
 // Controller Management Types
 
+// ControllerRegistrationRequest represents a request to register a controller instance
+type ControllerRegistrationRequest struct {
+	ControllerName    string                 `json:"controller_name"`
+	ControllerType    string                 `json:"controller_type"`
+	Version           string                 `json:"version"`
+	Hostname          string                 `json:"hostname"`
+	HeartbeatInterval int                    `json:"heartbeat_interval,omitempty"` // Seconds
+	Metadata          map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// ControllerRegistration is returned when a controller instance registers
+type ControllerRegistration struct {
+	ControllerID      string      `json:"controller_id"`
+	ControllerName    string      `json:"controller_name"`
+	HeartbeatInterval int         `json:"heartbeat_interval"` // Seconds the API expects between heartbeats
+	RegisteredAt      *CustomTime `json:"registered_at,omitempty"`
+}
+
 // ControllersSummaryResponse represents the summary of all controllers
 type ControllersSummaryResponse struct {
 	TotalControllers   int                              `json:"total_controllers"`
@@ -326,3 +326,57 @@ func TestControllersService_NetworkError(t *testing.T) {
 	_, err = client.Controllers.GetAlertControllerLeaderStatus(context.Background())
 	assert.Error(t, err)
 }
+
+func TestControllersService_RegisterListAndHealth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/v1/controllers/register":
+			var req ControllerRegistrationRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			assert.Equal(t, "monitoring-controller", req.ControllerName)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data":   map[string]interface{}{"controller_id": "ctrl-1", "controller_name": req.ControllerName, "heartbeat_interval": 15},
+			})
+		case r.Method == "GET" && r.URL.Path == "/v1/controllers":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data":   []map[string]interface{}{{"controller_name": "monitoring-controller", "status": "healthy", "is_leader": true}},
+				"meta":   map[string]interface{}{"page": 1, "total_items": 1},
+			})
+		case r.Method == "GET" && r.URL.Path == "/v1/controllers/monitoring-controller/health":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data":   map[string]interface{}{"status": "healthy", "version": "1.4.0", "region_health": map[string]string{"us-east-1": "ok"}},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(&Config{BaseURL: server.URL})
+	ctx := context.Background()
+
+	registration, err := client.Controllers.RegisterController(ctx, &ControllerRegistrationRequest{
+		ControllerName: "monitoring-controller",
+		ControllerType: "monitoring",
+		Version:        "1.4.0",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ctrl-1", registration.ControllerID)
+	assert.Equal(t, 15, registration.HeartbeatInterval)
+
+	controllers, meta, err := client.Controllers.ListControllers(ctx, nil)
+	assert.NoError(t, err)
+	assert.Len(t, controllers, 1)
+	assert.True(t, controllers[0].IsLeader)
+	assert.Equal(t, 1, meta.TotalItems)
+
+	health, err := client.Controllers.GetControllerHealth(ctx, "monitoring-controller")
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", health.RegionHealth["us-east-1"])
+}
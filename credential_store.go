@@ -0,0 +1,348 @@
+package nexmonyx
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// DefaultCredentialService is the keyring service name used when none is given
+	DefaultCredentialService = "nexmonyx"
+
+	credentialKeySize       = 32 // AES-256
+	credentialPBKDF2Rounds  = 600000
+	credentialFileExtension = ".cred"
+	credentialSaltFile      = ".salt"
+	credentialKeyFile       = ".key"
+)
+
+// CredentialStore persists secrets such as server credentials and tokens
+// outside of plaintext configuration files. Get returns ErrCredentialNotFound
+// when nothing is stored under name.
+type CredentialStore interface {
+	Get(name string) ([]byte, error)
+	Set(name string, secret []byte) error
+	Delete(name string) error
+}
+
+// CredentialStoreOptions configures NewCredentialStore
+type CredentialStoreOptions struct {
+	// Service groups the credentials in the OS keyring (default: "nexmonyx")
+	Service string
+
+	// Dir holds the encrypted files used when the OS keyring is unavailable
+	// (default: "nexmonyx/credentials" under os.UserConfigDir)
+	Dir string
+
+	// Passphrase protects the encrypted files. When empty, a random key is
+	// generated and kept in Dir, readable only by the current user.
+	Passphrase []byte
+
+	// DisableKeyring always uses the encrypted files, e.g. for agents that
+	// must keep credentials across reboots on Linux
+	DisableKeyring bool
+}
+
+// NewCredentialStore returns the OS keyring (macOS Keychain, Windows Credential
+// Manager, or the Linux kernel keyring via keyctl) when it is usable, and an
+// EncryptedFileStore otherwise.
+//
+// The Linux kernel keyring does not survive a reboot; set DisableKeyring where
+// credentials must persist.
+//
+// Example:
+//
+//	store, err := nexmonyx.NewCredentialStore(nil)
+//	if err != nil {
+//	    return err
+//	}
+//	resp, err := client.Servers.RegisterWithKeyFull(ctx, registrationKey, req)
+//	...
+//	err = nexmonyx.SaveAuth(store, "agent", &nexmonyx.AuthConfig{
+//	    ServerUUID:   resp.ServerUUID,
+//	    ServerSecret: resp.ServerSecret,
+//	})
+func NewCredentialStore(opts *CredentialStoreOptions) (CredentialStore, error) {
+	options := CredentialStoreOptions{}
+	if opts != nil {
+		options = *opts
+	}
+
+	if !options.DisableKeyring {
+		if store, err := NewKeyringStore(options.Service); err == nil {
+			return store, nil
+		}
+	}
+	return NewEncryptedFileStore(options.Dir, options.Passphrase)
+}
+
+// KeyringStore stores credentials in the OS keyring
+type KeyringStore struct {
+	service string
+}
+
+// NewKeyringStore returns a store for the OS keyring, or ErrKeyringUnavailable
+// when this system has no usable keyring
+func NewKeyringStore(service string) (*KeyringStore, error) {
+	if service == "" {
+		service = DefaultCredentialService
+	}
+	if !keyringAvailable() {
+		return nil, ErrKeyringUnavailable
+	}
+	return &KeyringStore{service: service}, nil
+}
+
+// Get returns the secret stored under name
+func (s *KeyringStore) Get(name string) ([]byte, error) {
+	encoded, err := keyringGet(s.service, name)
+	if err != nil {
+		return nil, err
+	}
+	// Secrets are stored base64 encoded, as some keyrings only hold text
+	return base64.StdEncoding.DecodeString(encoded)
+}
+
+// Set stores secret under name, replacing any existing value
+func (s *KeyringStore) Set(name string, secret []byte) error {
+	return keyringSet(s.service, name, base64.StdEncoding.EncodeToString(secret))
+}
+
+// Delete removes the secret stored under name
+func (s *KeyringStore) Delete(name string) error {
+	return keyringDelete(s.service, name)
+}
+
+// EncryptedFileStore stores each credential in its own file, encrypted with
+// AES-256-GCM. The key is derived from a passphrase with PBKDF2-SHA256 or, when
+// no passphrase is given, generated randomly and kept in the directory with
+// owner-only permissions. The credential name is authenticated with the
+// ciphertext, so files cannot be swapped between names.
+type EncryptedFileStore struct {
+	dir  string
+	aead cipher.AEAD
+}
+
+// encryptedCredential is the on-disk format of an EncryptedFileStore entry
+type encryptedCredential struct {
+	Version    int    `json:"version"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// NewEncryptedFileStore returns a store that keeps encrypted credentials in dir,
+// creating it if needed
+func NewEncryptedFileStore(dir string, passphrase []byte) (*EncryptedFileStore, error) {
+	if dir == "" {
+		configDir, err := os.UserConfigDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine credential directory: %w", err)
+		}
+		dir = filepath.Join(configDir, "nexmonyx", "credentials")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create credential directory: %w", err)
+	}
+
+	var key []byte
+	if len(passphrase) > 0 {
+		salt, err := readOrCreateSecret(filepath.Join(dir, credentialSaltFile), 16)
+		if err != nil {
+			return nil, err
+		}
+		key, err = pbkdf2.Key(sha256.New, string(passphrase), salt, credentialPBKDF2Rounds, credentialKeySize)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		key, err = readOrCreateSecret(filepath.Join(dir, credentialKeyFile), credentialKeySize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedFileStore{dir: dir, aead: aead}, nil
+}
+
+// Get returns the secret stored under name. A wrong passphrase or a modified
+// file is reported as an error rather than returning corrupted data.
+func (s *EncryptedFileStore) Get(name string) ([]byte, error) {
+	data, err := os.ReadFile(s.path(name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrCredentialNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entry encryptedCredential
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("invalid credential file for %q: %w", name, err)
+	}
+	if len(entry.Nonce) != s.aead.NonceSize() {
+		return nil, fmt.Errorf("invalid credential file for %q", name)
+	}
+
+	secret, err := s.aead.Open(nil, entry.Nonce, entry.Ciphertext, []byte(name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt credential %q: %w", name, err)
+	}
+	return secret, nil
+}
+
+// Set encrypts secret and stores it under name, replacing any existing value
+func (s *EncryptedFileStore) Set(name string, secret []byte) error {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(&encryptedCredential{
+		Version:    1,
+		Nonce:      nonce,
+		Ciphertext: s.aead.Seal(nil, nonce, secret, []byte(name)),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so a crash never leaves a partial credential
+	tmp, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(name))
+}
+
+// Delete removes the secret stored under name
+func (s *EncryptedFileStore) Delete(name string) error {
+	err := os.Remove(s.path(name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrCredentialNotFound
+	}
+	return err
+}
+
+// path returns the file for name; names are encoded so any string is a safe file name
+func (s *EncryptedFileStore) path(name string) string {
+	return filepath.Join(s.dir, base64.RawURLEncoding.EncodeToString([]byte(name))+credentialFileExtension)
+}
+
+// readOrCreateSecret returns the contents of path, creating it with size
+// random bytes and owner-only permissions if it does not exist
+func readOrCreateSecret(path string, size int) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		if len(data) != size {
+			return nil, fmt.Errorf("invalid key material in %s", path)
+		}
+		return data, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	data = make([]byte, size)
+	if _, err := rand.Read(data); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if errors.Is(err, fs.ErrExist) {
+		// Created concurrently by another process
+		return readOrCreateSecret(path, size)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return data, f.Close()
+}
+
+// storedAuth is the persisted form of the secret fields of an AuthConfig
+type storedAuth struct {
+	Token           string `json:"token,omitempty"`
+	UnifiedAPIKey   string `json:"unified_api_key,omitempty"`
+	APIKeySecret    string `json:"api_key_secret,omitempty"`
+	APIKey          string `json:"api_key,omitempty"`
+	APISecret       string `json:"api_secret,omitempty"`
+	ServerUUID      string `json:"server_uuid,omitempty"`
+	ServerSecret    string `json:"server_secret,omitempty"`
+	MonitoringKey   string `json:"monitoring_key,omitempty"`
+	RegistrationKey string `json:"registration_key,omitempty"`
+}
+
+// SaveAuth stores the credentials in auth under name. WorkloadIdentity is not
+// stored, as it holds no secret.
+func SaveAuth(store CredentialStore, name string, auth *AuthConfig) error {
+	data, err := json.Marshal(&storedAuth{
+		Token:           auth.Token,
+		UnifiedAPIKey:   auth.UnifiedAPIKey,
+		APIKeySecret:    auth.APIKeySecret,
+		APIKey:          auth.APIKey,
+		APISecret:       auth.APISecret,
+		ServerUUID:      auth.ServerUUID,
+		ServerSecret:    auth.ServerSecret,
+		MonitoringKey:   auth.MonitoringKey,
+		RegistrationKey: auth.RegistrationKey,
+	})
+	if err != nil {
+		return err
+	}
+	return store.Set(name, data)
+}
+
+// LoadAuth returns the credentials stored under name with SaveAuth
+func LoadAuth(store CredentialStore, name string) (*AuthConfig, error) {
+	data, err := store.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var stored storedAuth
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("invalid stored credentials for %q: %w", name, err)
+	}
+	return &AuthConfig{
+		Token:           stored.Token,
+		UnifiedAPIKey:   stored.UnifiedAPIKey,
+		APIKeySecret:    stored.APIKeySecret,
+		APIKey:          stored.APIKey,
+		APISecret:       stored.APISecret,
+		ServerUUID:      stored.ServerUUID,
+		ServerSecret:    stored.ServerSecret,
+		MonitoringKey:   stored.MonitoringKey,
+		RegistrationKey: stored.RegistrationKey,
+	}, nil
+}
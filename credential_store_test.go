@@ -0,0 +1,113 @@
+package nexmonyx
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedFileStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewEncryptedFileStore(dir, nil)
+	require.NoError(t, err)
+
+	_, err = store.Get("agent")
+	assert.True(t, errors.Is(err, ErrCredentialNotFound))
+
+	require.NoError(t, store.Set("agent", []byte("server-secret-value")))
+	require.NoError(t, store.Set("agent/backup", []byte("other")))
+
+	secret, err := store.Get("agent")
+	require.NoError(t, err)
+	assert.Equal(t, "server-secret-value", string(secret))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "server-secret-value", entry.Name())
+
+		info, err := entry.Info()
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm(), entry.Name())
+	}
+
+	// A second store over the same directory reuses the generated key
+	reopened, err := NewEncryptedFileStore(dir, nil)
+	require.NoError(t, err)
+	secret, err = reopened.Get("agent")
+	require.NoError(t, err)
+	assert.Equal(t, "server-secret-value", string(secret))
+
+	require.NoError(t, store.Delete("agent"))
+	_, err = store.Get("agent")
+	assert.True(t, errors.Is(err, ErrCredentialNotFound))
+	assert.True(t, errors.Is(store.Delete("agent"), ErrCredentialNotFound))
+}
+
+func TestEncryptedFileStore_Passphrase(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewEncryptedFileStore(dir, []byte("correct horse"))
+	require.NoError(t, err)
+	require.NoError(t, store.Set("token", []byte("jwt")))
+
+	wrong, err := NewEncryptedFileStore(dir, []byte("battery staple"))
+	require.NoError(t, err)
+	_, err = wrong.Get("token")
+	assert.Error(t, err, "a wrong passphrase fails to decrypt")
+
+	// Files are bound to their credential name
+	require.NoError(t, store.Set("other", []byte("x")))
+	data, err := os.ReadFile(store.path("token"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(store.path("other"), data, 0o600))
+	_, err = store.Get("other")
+	assert.Error(t, err)
+}
+
+func TestSaveAndLoadAuth(t *testing.T) {
+	store, err := NewCredentialStore(&CredentialStoreOptions{Dir: t.TempDir(), DisableKeyring: true})
+	require.NoError(t, err)
+	require.IsType(t, &EncryptedFileStore{}, store)
+
+	err = SaveAuth(store, "agent", &AuthConfig{
+		ServerUUID:       "srv-1",
+		ServerSecret:     "s3cret",
+		WorkloadIdentity: &WorkloadIdentityConfig{},
+	})
+	require.NoError(t, err)
+
+	auth, err := LoadAuth(store, "agent")
+	require.NoError(t, err)
+	assert.Equal(t, &AuthConfig{ServerUUID: "srv-1", ServerSecret: "s3cret"}, auth)
+
+	_, err = LoadAuth(store, "missing")
+	assert.True(t, errors.Is(err, ErrCredentialNotFound))
+}
+
+func TestKeyringStore(t *testing.T) {
+	store, err := NewKeyringStore("nexmonyx-sdk-test")
+	if errors.Is(err, ErrKeyringUnavailable) {
+		t.Skip("no OS keyring on this system")
+	}
+	require.NoError(t, err)
+
+	name := strings.ReplaceAll(t.Name(), "/", "-")
+	t.Cleanup(func() { store.Delete(name) })
+
+	require.NoError(t, store.Set(name, []byte("secret\x00bytes")))
+	require.NoError(t, store.Set(name, []byte("replaced")))
+	secret, err := store.Get(name)
+	require.NoError(t, err)
+	assert.Equal(t, "replaced", string(secret))
+
+	require.NoError(t, store.Delete(name))
+	_, err = store.Get(name)
+	assert.True(t, errors.Is(err, ErrCredentialNotFound))
+}
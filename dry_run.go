@@ -0,0 +1,169 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// DryRunHeader asks the API to validate a mutating request without applying it
+const DryRunHeader = "X-Nexmonyx-Dry-Run"
+
+// DryRunMode selects how mutating requests (POST, PUT, PATCH, and DELETE) are
+// handled in dry-run mode. Reads are always sent normally.
+type DryRunMode int
+
+const (
+	// DryRunDisabled sends mutating requests normally
+	DryRunDisabled DryRunMode = iota
+
+	// DryRunServer sends mutating requests with the DryRunHeader, so the API
+	// validates them against its schema and returns the would-be result
+	// without persisting anything
+	DryRunServer
+
+	// DryRunLocal does not send mutating requests. The body is encoded and
+	// validated locally, and a *DryRunError describing the request is returned.
+	DryRunLocal
+)
+
+// RequestValidator is implemented by request bodies that can validate
+// themselves. DryRunLocal calls Validate before returning a DryRunError.
+// Bodies whose Validate method returns []ValidationIssue are checked too.
+type RequestValidator interface {
+	Validate() error
+}
+
+// DryRunError is returned for mutating requests in DryRunLocal mode. It holds
+// what would have been sent, with credentials redacted, and matches ErrDryRun
+// with errors.Is.
+type DryRunError struct {
+	Method  string
+	Path    string
+	Query   map[string]string
+	Headers http.Header
+	Body    json.RawMessage
+}
+
+// Error implements the error interface
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("dry run: %s %s not sent", e.Method, e.Path)
+}
+
+// Is reports whether target is ErrDryRun
+func (e *DryRunError) Is(target error) bool {
+	return target == ErrDryRun
+}
+
+type dryRunKey struct{}
+
+// WithDryRun returns a context whose mutating requests use mode, overriding
+// Config.DryRun. Pass DryRunDisabled to send a request normally from a dry-run client.
+//
+// Example:
+//
+//	ctx = nexmonyx.WithDryRun(ctx, nexmonyx.DryRunLocal)
+//	_, err := client.Servers.Update(ctx, serverID, update)
+//	var dryRun *nexmonyx.DryRunError
+//	if errors.As(err, &dryRun) {
+//	    fmt.Printf("%s %s\n%s\n", dryRun.Method, dryRun.Path, dryRun.Body)
+//	}
+func WithDryRun(ctx context.Context, mode DryRunMode) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, mode)
+}
+
+// DryRunFromContext returns the mode set with WithDryRun
+func DryRunFromContext(ctx context.Context) (DryRunMode, bool) {
+	mode, ok := ctx.Value(dryRunKey{}).(DryRunMode)
+	return mode, ok
+}
+
+// applyDryRun handles mutating requests according to the context's or the
+// client's dry-run mode
+func (c *Client) applyDryRun(ctx context.Context, req *Request, r *resty.Request) error {
+	if !isMutatingMethod(req.Method) {
+		return nil
+	}
+
+	mode := c.config.DryRun
+	if m, ok := DryRunFromContext(ctx); ok {
+		mode = m
+	}
+
+	switch mode {
+	case DryRunServer:
+		r.SetHeader(DryRunHeader, "true")
+	case DryRunLocal:
+		return c.localDryRun(req, r)
+	}
+	return nil
+}
+
+// localDryRun validates and encodes the request and returns it as a *DryRunError
+func (c *Client) localDryRun(req *Request, r *resty.Request) error {
+	if v, ok := req.Body.(RequestValidator); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Message: fmt.Sprintf("dry run: %s %s: %v", req.Method, req.Path, err)}
+		}
+	}
+	if v, ok := req.Body.(issueValidator); ok {
+		if result := validationResult(v.Validate()); !result.Valid {
+			validationErr := result.Err().(*ValidationError)
+			validationErr.Message = fmt.Sprintf("dry run: %s %s: %s", req.Method, req.Path, validationErr.Message)
+			return validationErr
+		}
+	}
+
+	var body json.RawMessage
+	switch b := req.Body.(type) {
+	case nil:
+	case []byte:
+		body = b
+	case string:
+		body = json.RawMessage(b)
+	default:
+		encoded, err := json.Marshal(b)
+		if err != nil {
+			return &ValidationError{Message: fmt.Sprintf("dry run: %s %s: cannot encode body: %v", req.Method, req.Path, err)}
+		}
+		body = encoded
+	}
+
+	headers := c.client.Header.Clone()
+	for k, v := range r.Header.Clone() {
+		headers[k] = v
+	}
+	// Bearer tokens are only added to the headers when the request is sent
+	if r.Token != "" || c.client.Token != "" || c.workload != nil {
+		headers.Set("Authorization", "Bearer")
+	}
+	for k, values := range headers {
+		if isCredentialHeader(k) {
+			headers[k] = []string{"[REDACTED]"}
+			continue
+		}
+		for i, v := range values {
+			values[i] = c.config.Auth.redact(v)
+		}
+	}
+
+	return &DryRunError{
+		Method:  req.Method,
+		Path:    req.Path,
+		Query:   req.Query,
+		Headers: headers,
+		Body:    body,
+	}
+}
+
+// isCredentialHeader reports whether the header carries authentication
+func isCredentialHeader(name string) bool {
+	switch http.CanonicalHeaderKey(name) {
+	case "Authorization", "Access-Key", "Access-Secret", "X-Server-Uuid", "X-Server-Secret", "X-Registration-Key", "X-Api-Key", "X-Api-Secret":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type validatedBody struct {
+	Name string `json:"name"`
+}
+
+func (b *validatedBody) Validate() error {
+	if b.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	return nil
+}
+
+func TestDryRun_Local(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "secret-token"},
+		DryRun:  DryRunLocal,
+	})
+	require.NoError(t, err)
+	ctx := WithChangeReason(context.Background(), "CHG-1")
+
+	_, err = client.Do(ctx, &Request{
+		Method:  "POST",
+		Path:    "/v1/tags",
+		Body:    &validatedBody{Name: "env"},
+		Query:   map[string]string{"force": "true"},
+		Headers: map[string]string{"X-Custom": "contains secret-token"},
+	})
+	require.True(t, errors.Is(err, ErrDryRun))
+	var dryRun *DryRunError
+	require.True(t, errors.As(err, &dryRun))
+	assert.Equal(t, "POST", dryRun.Method)
+	assert.Equal(t, "/v1/tags", dryRun.Path)
+	assert.Equal(t, "true", dryRun.Query["force"])
+	assert.JSONEq(t, `{"name":"env"}`, string(dryRun.Body))
+	assert.Equal(t, "[REDACTED]", dryRun.Headers.Get("Authorization"))
+	assert.Equal(t, "contains [REDACTED]", dryRun.Headers.Get("X-Custom"))
+	assert.Equal(t, "CHG-1", dryRun.Headers.Get(ChangeReasonHeader))
+
+	_, err = client.Do(ctx, &Request{Method: "PUT", Path: "/v1/tags/1", Body: &validatedBody{}})
+	var validation *ValidationError
+	require.True(t, errors.As(err, &validation))
+	assert.Contains(t, validation.Message, "name is required")
+
+	_, err = client.Do(ctx, &Request{Method: "GET", Path: "/v1/tags"})
+	require.NoError(t, err, "reads are sent")
+
+	_, err = client.Do(WithDryRun(ctx, DryRunDisabled), &Request{Method: "DELETE", Path: "/v1/tags/1"})
+	require.NoError(t, err, "the context overrides the client setting")
+
+	assert.Equal(t, []string{"GET", "DELETE"}, requests)
+}
+
+func TestDryRun_Server(t *testing.T) {
+	var headers []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Method+" "+r.Header.Get(DryRunHeader))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := WithDryRun(context.Background(), DryRunServer)
+
+	for _, method := range []string{"GET", "PATCH"} {
+		_, err := client.Do(ctx, &Request{Method: method, Path: "/v1/servers"})
+		require.NoError(t, err)
+	}
+	_, err = client.Do(context.Background(), &Request{Method: "PATCH", Path: "/v1/servers"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"GET ", "PATCH true", "PATCH "}, headers)
+}
@@ -0,0 +1,366 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultEndpointFailureThreshold = 3
+	defaultEndpointCooldown         = time.Minute
+	defaultEndpointHealthCheckPath  = "/v1/healthz"
+	endpointHealthCheckTimeout      = 5 * time.Second
+)
+
+// EndpointConfig routes requests to the nearest of several API endpoints and
+// fails over to the next one during an outage.
+//
+// Endpoints are tried in order of preference: Primary, or the Regions entry for
+// Region when Primary is empty, then Fallbacks, then the remaining Regions
+// entries sorted by region. After FailureThreshold consecutive connection
+// errors or 502, 503, or 504 responses, requests move to the next endpoint
+// that is not down and stay there. Once the Cooldown of a preferred endpoint
+// has passed, a health check is sent to it in the background and requests
+// return to it when it answers.
+//
+// Example:
+//
+//	client, err := nexmonyx.NewClient(&nexmonyx.Config{
+//	    Auth: nexmonyx.AuthConfig{UnifiedAPIKey: key},
+//	    Endpoints: &nexmonyx.EndpointConfig{
+//	        Region: "eu-central",
+//	        Regions: map[string]string{
+//	            "eu-central": "https://eu-central.ingest.nexmonyx.com",
+//	            "eu-west":    "https://eu-west.ingest.nexmonyx.com",
+//	        },
+//	        Fallbacks: []string{"https://api.nexmonyx.com"},
+//	    },
+//	})
+type EndpointConfig struct {
+	// Primary is the preferred endpoint's base URL
+	Primary string
+
+	// Fallbacks are tried in order when the preferred endpoint is down
+	Fallbacks []string
+
+	// Regions maps region names to endpoint base URLs
+	Regions map[string]string
+
+	// Region selects the preferred Regions entry when Primary is empty
+	Region string
+
+	// FailureThreshold is the number of consecutive failures after which an
+	// endpoint is considered down (default: 3)
+	FailureThreshold int
+
+	// Cooldown is how long a down endpoint is skipped before it is health
+	// checked again (default: 1m)
+	Cooldown time.Duration
+
+	// HealthCheckPath is requested to check whether a down endpoint has
+	// recovered (default: /v1/healthz)
+	HealthCheckPath string
+}
+
+// urls returns the endpoint base URLs in order of preference, without duplicates
+func (e *EndpointConfig) urls() ([]string, error) {
+	var ordered []string
+	seen := make(map[string]bool)
+	add := func(raw string) {
+		raw = strings.TrimRight(raw, "/")
+		if raw != "" && !seen[raw] {
+			seen[raw] = true
+			ordered = append(ordered, raw)
+		}
+	}
+
+	switch {
+	case e.Primary != "":
+		add(e.Primary)
+	case e.Region != "":
+		regional, ok := e.Regions[e.Region]
+		if !ok {
+			return nil, fmt.Errorf("no endpoint configured for region %q", e.Region)
+		}
+		add(regional)
+	}
+	for _, fallback := range e.Fallbacks {
+		add(fallback)
+	}
+	regions := make([]string, 0, len(e.Regions))
+	for region := range e.Regions {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	for _, region := range regions {
+		add(e.Regions[region])
+	}
+
+	if len(ordered) == 0 {
+		return nil, fmt.Errorf("at least one endpoint is required")
+	}
+	for _, raw := range ordered {
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid endpoint URL %q", raw)
+		}
+	}
+	return ordered, nil
+}
+
+// EndpointStatus reports the state of one configured endpoint
+type EndpointStatus struct {
+	URL                 string    `json:"url"`
+	Active              bool      `json:"active"` // Requests are currently sent to this endpoint
+	Down                bool      `json:"down"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	DownSince           time.Time `json:"down_since,omitempty"`
+	RetryAt             time.Time `json:"retry_at,omitempty"` // When a down endpoint is next health checked
+	LastError           string    `json:"last_error,omitempty"`
+}
+
+// Endpoints returns the state of the endpoints configured with
+// Config.Endpoints, in order of preference. It returns nil when the client
+// uses a single BaseURL.
+func (c *Client) Endpoints() []EndpointStatus {
+	if c.router == nil {
+		return nil
+	}
+	return c.router.snapshot()
+}
+
+// endpoint is one API endpoint and its health
+type endpoint struct {
+	url      *url.URL
+	failures int
+	down     bool
+	since    time.Time
+	retryAt  time.Time
+	lastErr  string
+	checking bool
+}
+
+// endpointRouter rewrites requests to the active endpoint and tracks endpoint
+// health. Requests are built against the first endpoint; when another one is
+// active, their scheme, host, and path prefix are replaced.
+type endpointRouter struct {
+	base      http.RoundTripper
+	threshold int
+	cooldown  time.Duration
+	checkPath string
+	circuits  *circuitStates // Set by NewClient once the client exists
+	events    *EventBus
+
+	mu        sync.Mutex
+	endpoints []*endpoint
+	active    int
+}
+
+func newEndpointRouter(config *EndpointConfig, base http.RoundTripper, events *EventBus) (*endpointRouter, error) {
+	urls, err := config.urls()
+	if err != nil {
+		return nil, err
+	}
+
+	r := &endpointRouter{
+		base:      base,
+		threshold: config.FailureThreshold,
+		cooldown:  config.Cooldown,
+		checkPath: config.HealthCheckPath,
+		circuits:  &circuitStates{},
+		events:    events,
+	}
+	if r.threshold <= 0 {
+		r.threshold = defaultEndpointFailureThreshold
+	}
+	if r.cooldown <= 0 {
+		r.cooldown = defaultEndpointCooldown
+	}
+	if r.checkPath == "" {
+		r.checkPath = defaultEndpointHealthCheckPath
+	}
+	for _, raw := range urls {
+		u, _ := url.Parse(raw) // Validated by urls
+		r.endpoints = append(r.endpoints, &endpoint{url: u})
+	}
+	return r, nil
+}
+
+// primaryURL is the base URL requests are built against
+func (r *endpointRouter) primaryURL() string {
+	return r.endpoints[0].url.String()
+}
+
+func (r *endpointRouter) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Requests to absolute URLs on other hosts, such as presigned uploads
+	// and token exchanges, are neither rerouted nor counted
+	primary := r.endpoints[0].url
+	if req.URL.Scheme != primary.Scheme || !strings.EqualFold(req.URL.Host, primary.Host) {
+		return r.base.RoundTrip(req)
+	}
+
+	r.mu.Lock()
+	index := r.active
+	target := r.endpoints[index].url
+	r.recheckLocked()
+	r.mu.Unlock()
+
+	if index != 0 {
+		req = req.Clone(req.Context())
+		req.URL.Scheme = target.Scheme
+		req.URL.Host = target.Host
+		req.URL.Path = target.Path + strings.TrimPrefix(req.URL.Path, primary.Path)
+		req.URL.RawPath = ""
+		req.Host = ""
+	}
+
+	resp, err := r.base.RoundTrip(req)
+	switch {
+	case errors.Is(req.Context().Err(), context.Canceled):
+		// Abandoned by the caller, not a failure of the endpoint
+	case err != nil:
+		r.recordFailure(index, err.Error())
+	case resp.StatusCode == http.StatusBadGateway, resp.StatusCode == http.StatusServiceUnavailable,
+		resp.StatusCode == http.StatusGatewayTimeout:
+		r.recordFailure(index, resp.Status)
+	default:
+		r.recordSuccess(index)
+	}
+	return resp, err
+}
+
+// recordFailure counts a failed request and fails over once the endpoint
+// reaches the failure threshold
+func (r *endpointRouter) recordFailure(index int, reason string) {
+	r.mu.Lock()
+	ep := r.endpoints[index]
+	ep.failures++
+	ep.lastErr = reason
+	if ep.down || ep.failures < r.threshold {
+		r.mu.Unlock()
+		return
+	}
+	ep.down = true
+	ep.since = time.Now()
+	ep.retryAt = ep.since.Add(r.cooldown)
+	if index == r.active {
+		r.active = r.nextLocked()
+	}
+	r.mu.Unlock()
+
+	r.circuits.open(r.events, CircuitEvent{
+		Component: "endpoint " + ep.url.String(),
+		Reason:    fmt.Sprintf("%d consecutive failures: %s", r.threshold, reason),
+	})
+}
+
+func (r *endpointRouter) recordSuccess(index int) {
+	r.mu.Lock()
+	ep := r.endpoints[index]
+	ep.failures = 0
+	ep.lastErr = ""
+	wasDown := ep.down
+	ep.down = false
+	r.mu.Unlock()
+
+	if wasDown {
+		r.circuits.close("endpoint " + ep.url.String())
+	}
+}
+
+// nextLocked returns the most preferred endpoint that is not down, or the
+// current one when all are down
+func (r *endpointRouter) nextLocked() int {
+	for i, ep := range r.endpoints {
+		if !ep.down {
+			return i
+		}
+	}
+	return r.active
+}
+
+// recheckLocked starts a health check of the first down endpoint preferred
+// over the active one whose cooldown has passed. Requests stay on the active
+// endpoint until the check succeeds.
+func (r *endpointRouter) recheckLocked() {
+	now := time.Now()
+	for i := 0; i < r.active; i++ {
+		ep := r.endpoints[i]
+		if !ep.down || ep.checking || now.Before(ep.retryAt) {
+			continue
+		}
+		ep.checking = true
+		go r.healthCheck(i)
+		return
+	}
+}
+
+// healthCheck requests the endpoint's health check path and routes requests
+// back to it when it answers
+func (r *endpointRouter) healthCheck(index int) {
+	ep := r.endpoints[index]
+	ctx, cancel := context.WithTimeout(context.Background(), endpointHealthCheckTimeout)
+	defer cancel()
+
+	var healthy bool
+	var reason string
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(ep.url.String(), "/")+r.checkPath, nil)
+	if err == nil {
+		var resp *http.Response
+		resp, err = r.base.RoundTrip(req)
+		if err == nil {
+			resp.Body.Close()
+			healthy = resp.StatusCode < http.StatusInternalServerError
+			reason = resp.Status
+		}
+	}
+	if err != nil {
+		reason = err.Error()
+	}
+
+	r.mu.Lock()
+	ep.checking = false
+	if !healthy {
+		ep.lastErr = reason
+		ep.retryAt = time.Now().Add(r.cooldown)
+		r.mu.Unlock()
+		return
+	}
+	ep.down = false
+	ep.failures = 0
+	ep.lastErr = ""
+	if index < r.active || r.endpoints[r.active].down {
+		r.active = index
+	}
+	r.mu.Unlock()
+
+	r.circuits.close("endpoint " + ep.url.String())
+}
+
+func (r *endpointRouter) snapshot() []EndpointStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := make([]EndpointStatus, len(r.endpoints))
+	for i, ep := range r.endpoints {
+		out[i] = EndpointStatus{
+			URL:                 ep.url.String(),
+			Active:              i == r.active,
+			Down:                ep.down,
+			ConsecutiveFailures: ep.failures,
+			LastError:           ep.lastErr,
+		}
+		if ep.down {
+			out[i].DownSince = ep.since
+			out[i].RetryAt = ep.retryAt
+		}
+	}
+	return out
+}
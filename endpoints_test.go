@@ -0,0 +1,197 @@
+package nexmonyx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointRouter_FailsOverAndStays(t *testing.T) {
+	var primaryHits atomic.Int32
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primaryHits.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer primary.Close()
+
+	var fallbackPaths []string
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fallbackPaths = append(fallbackPaths, r.URL.Path)
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		healthHandler(w, r)
+	}))
+	defer fallback.Close()
+
+	client := newTestClient(t, nil, Config{Endpoints: &EndpointConfig{
+		Primary:          primary.URL,
+		Fallbacks:        []string{fallback.URL + "/"},
+		FailureThreshold: 2,
+		Cooldown:         time.Hour,
+	}})
+
+	_, err := client.Do(context.Background(), &Request{Method: "POST", Path: "/v2/metrics", Body: map[string]int{"cpu": 1}})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), primaryHits.Load())
+	assert.Equal(t, []string{"/v2/metrics"}, fallbackPaths)
+
+	// Requests stick to the fallback while the primary cools down
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), primaryHits.Load())
+
+	status := client.Endpoints()
+	require.Len(t, status, 2)
+	assert.True(t, status[0].Down)
+	assert.False(t, status[0].Active)
+	assert.Equal(t, "503 Service Unavailable", status[0].LastError)
+	assert.True(t, status[1].Active)
+	assert.Equal(t, fallback.URL, status[1].URL)
+
+	health := client.HealthScore()
+	require.Len(t, health.Circuits, 1)
+	assert.Equal(t, "endpoint "+primary.URL, health.Circuits[0].Component)
+	assert.True(t, health.Circuits[0].Open)
+}
+
+func TestEndpointRouter_ReturnsToRecoveredEndpoint(t *testing.T) {
+	var healthy atomic.Bool
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		healthHandler(w, r)
+	}))
+	defer primary.Close()
+	fallback := httptest.NewServer(http.HandlerFunc(healthHandler))
+	defer fallback.Close()
+
+	client := newTestClient(t, nil, Config{Endpoints: &EndpointConfig{
+		Primary:          primary.URL,
+		Fallbacks:        []string{fallback.URL},
+		FailureThreshold: 1,
+		Cooldown:         10 * time.Millisecond,
+	}})
+
+	_, err := client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	require.NoError(t, err)
+	require.True(t, client.Endpoints()[1].Active)
+
+	// A failed health check keeps requests on the fallback
+	time.Sleep(20 * time.Millisecond)
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return client.Endpoints()[0].RetryAt.After(time.Now()) }, time.Second, 5*time.Millisecond)
+	assert.True(t, client.Endpoints()[1].Active)
+
+	healthy.Store(true)
+	time.Sleep(20 * time.Millisecond)
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return client.Endpoints()[0].Active }, time.Second, 5*time.Millisecond)
+	assert.False(t, client.Endpoints()[0].Down)
+	assert.False(t, client.HealthScore().Circuits[0].Open)
+}
+
+func TestEndpointRouter_ConnectionErrorAndRegions(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(healthHandler))
+	downURL := down.URL
+	down.Close()
+
+	var paths []string
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		healthHandler(w, r)
+	}))
+	defer up.Close()
+
+	client := newTestClient(t, nil, Config{Endpoints: &EndpointConfig{
+		Region: "eu-central",
+		Regions: map[string]string{
+			"eu-central": downURL + "/api",
+			"eu-west":    up.URL + "/ingest",
+		},
+		FailureThreshold: 1,
+	}})
+
+	_, err := client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/ingest/v1/healthz"}, paths)
+	assert.Equal(t, downURL+"/api", client.Config().BaseURL)
+	assert.NotEmpty(t, client.Endpoints()[0].LastError)
+}
+
+func TestEndpointRouter_OtherHostsNotRerouted(t *testing.T) {
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer primary.Close()
+	var fallbackPaths []string
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fallbackPaths = append(fallbackPaths, r.URL.Path)
+		healthHandler(w, r)
+	}))
+	defer fallback.Close()
+	var uploads atomic.Int32
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uploads.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer storage.Close()
+
+	client := newTestClient(t, nil, Config{Endpoints: &EndpointConfig{
+		Primary:          primary.URL,
+		Fallbacks:        []string{fallback.URL},
+		FailureThreshold: 1,
+		Cooldown:         time.Hour,
+	}})
+
+	_, err := client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	require.NoError(t, err)
+	require.True(t, client.Endpoints()[1].Active)
+
+	// A presigned upload goes to its own host, and its failures do not
+	// count against the active endpoint
+	_, err = client.Do(context.Background(), &Request{Method: "PUT", Path: storage.URL + "/bucket/object?signature=abc", Body: []byte("data")})
+	assert.Error(t, err)
+	assert.NotZero(t, uploads.Load())
+	assert.Equal(t, []string{"/v1/healthz"}, fallbackPaths)
+	assert.True(t, client.Endpoints()[1].Active)
+	assert.Zero(t, client.Endpoints()[1].ConsecutiveFailures)
+}
+
+func TestEndpointConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoints *EndpointConfig
+	}{
+		{"empty", &EndpointConfig{}},
+		{"unknown region", &EndpointConfig{Region: "ap-south", Regions: map[string]string{"eu-west": "https://eu.example.com"}}},
+		{"invalid URL", &EndpointConfig{Primary: "eu.example.com"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient(&Config{Endpoints: tt.endpoints})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestConfig_Clone_Endpoints(t *testing.T) {
+	config := &Config{Endpoints: &EndpointConfig{
+		Fallbacks: []string{"https://a.example.com"},
+		Regions:   map[string]string{"eu": "https://eu.example.com"},
+	}}
+	clone := config.Clone()
+	clone.Endpoints.Fallbacks[0] = "https://b.example.com"
+	clone.Endpoints.Regions["eu"] = "https://other.example.com"
+
+	assert.Equal(t, "https://a.example.com", config.Endpoints.Fallbacks[0])
+	assert.Equal(t, "https://eu.example.com", config.Endpoints.Regions["eu"])
+}
@@ -0,0 +1,143 @@
+package nexmonyx
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The enums below are strings on the wire, so they encode to and decode from
+// JSON exactly as the plain strings they replace. Decoding never fails on an
+// unknown value, which keeps older SDKs working when the API adds one; use
+// Valid to check a value before relying on it.
+
+// ProbeType identifies the kind of check a monitoring probe runs
+type ProbeType string
+
+// Probe type constants; ProbeTypeBrowser is defined with the browser probes
+const (
+	ProbeTypeICMP      ProbeType = "icmp"
+	ProbeTypeHTTP      ProbeType = "http"
+	ProbeTypeHTTPS     ProbeType = "https"
+	ProbeTypeTCP       ProbeType = "tcp"
+	ProbeTypeHeartbeat ProbeType = "heartbeat"
+)
+
+// Valid reports whether t is a probe type the API accepts
+func (t ProbeType) Valid() bool {
+	switch t {
+	case ProbeTypeICMP, ProbeTypeHTTP, ProbeTypeHTTPS, ProbeTypeTCP, ProbeTypeHeartbeat, ProbeTypeBrowser:
+		return true
+	}
+	return false
+}
+
+// ParseProbeType converts s to a ProbeType, ignoring case and surrounding
+// whitespace and accepting "ping" for icmp
+func ParseProbeType(s string) (ProbeType, error) {
+	t := ProbeType(strings.ToLower(strings.TrimSpace(s)))
+	if t == "ping" {
+		t = ProbeTypeICMP
+	}
+	if !t.Valid() {
+		return "", fmt.Errorf("invalid probe type %q: must be one of icmp, http, https, tcp, heartbeat, browser", s)
+	}
+	return t, nil
+}
+
+// ServerStatus is the lifecycle or connectivity status of a server
+type ServerStatus string
+
+// Server status constants
+const (
+	ServerStatusActive      ServerStatus = "active"
+	ServerStatusInactive    ServerStatus = "inactive"
+	ServerStatusOnline      ServerStatus = "online"
+	ServerStatusOffline     ServerStatus = "offline"
+	ServerStatusMaintenance ServerStatus = "maintenance"
+)
+
+// Valid reports whether s is a known server status
+func (s ServerStatus) Valid() bool {
+	switch s {
+	case ServerStatusActive, ServerStatusInactive, ServerStatusOnline, ServerStatusOffline, ServerStatusMaintenance:
+		return true
+	}
+	return false
+}
+
+// ParseServerStatus converts s to a ServerStatus, ignoring case and
+// surrounding whitespace
+func ParseServerStatus(s string) (ServerStatus, error) {
+	status := ServerStatus(strings.ToLower(strings.TrimSpace(s)))
+	if !status.Valid() {
+		return "", fmt.Errorf("invalid server status %q: must be one of active, inactive, online, offline, maintenance", s)
+	}
+	return status, nil
+}
+
+// Valid reports whether s is one of the alert severity constants
+func (s AlertSeverity) Valid() bool {
+	switch s {
+	case AlertSeverityInfo, AlertSeverityWarning, AlertSeverityCritical:
+		return true
+	}
+	return false
+}
+
+// ParseAlertSeverity converts s to an AlertSeverity, ignoring case and
+// surrounding whitespace and accepting "warn" and "crit"
+func ParseAlertSeverity(s string) (AlertSeverity, error) {
+	severity := AlertSeverity(strings.ToLower(strings.TrimSpace(s)))
+	switch severity {
+	case "warn":
+		severity = AlertSeverityWarning
+	case "crit":
+		severity = AlertSeverityCritical
+	}
+	if !severity.Valid() {
+		return "", fmt.Errorf("invalid severity %q: must be info, warning or critical", s)
+	}
+	return severity, nil
+}
+
+// Environment is the deployment environment a server belongs to
+type Environment string
+
+// Environment constants
+const (
+	EnvironmentProduction  Environment = "production"
+	EnvironmentStaging     Environment = "staging"
+	EnvironmentDevelopment Environment = "development"
+	EnvironmentTesting     Environment = "testing"
+)
+
+// Valid reports whether e is one of the environment constants. Servers may
+// carry other environments; Valid is for catching typos in values the caller
+// expects to be standard.
+func (e Environment) Valid() bool {
+	switch e {
+	case EnvironmentProduction, EnvironmentStaging, EnvironmentDevelopment, EnvironmentTesting:
+		return true
+	}
+	return false
+}
+
+// ParseEnvironment converts s to an Environment, ignoring case and surrounding
+// whitespace and accepting the short forms prod, stage, stg, dev, and test
+func ParseEnvironment(s string) (Environment, error) {
+	env := Environment(strings.ToLower(strings.TrimSpace(s)))
+	switch env {
+	case "prod":
+		env = EnvironmentProduction
+	case "stage", "stg":
+		env = EnvironmentStaging
+	case "dev":
+		env = EnvironmentDevelopment
+	case "test":
+		env = EnvironmentTesting
+	}
+	if !env.Valid() {
+		return "", fmt.Errorf("invalid environment %q: must be one of production, staging, development, testing", s)
+	}
+	return env, nil
+}
@@ -0,0 +1,86 @@
+package nexmonyx
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnums_Valid(t *testing.T) {
+	assert.True(t, ProbeTypeHTTPS.Valid())
+	assert.True(t, ProbeTypeBrowser.Valid())
+	assert.False(t, ProbeType("htpp").Valid())
+	assert.False(t, ProbeType("").Valid())
+
+	assert.True(t, ServerStatusOffline.Valid())
+	assert.False(t, ServerStatus("Online").Valid())
+
+	assert.True(t, AlertSeverityCritical.Valid())
+	assert.False(t, AlertSeverity("high").Valid())
+
+	assert.True(t, EnvironmentStaging.Valid())
+	assert.False(t, Environment("prod").Valid())
+}
+
+func TestEnums_Parse(t *testing.T) {
+	probeType, err := ParseProbeType(" HTTPS ")
+	require.NoError(t, err)
+	assert.Equal(t, ProbeTypeHTTPS, probeType)
+	probeType, err = ParseProbeType("ping")
+	require.NoError(t, err)
+	assert.Equal(t, ProbeTypeICMP, probeType)
+	_, err = ParseProbeType("dns")
+	assert.EqualError(t, err, `invalid probe type "dns": must be one of icmp, http, https, tcp, heartbeat, browser`)
+
+	status, err := ParseServerStatus("Maintenance")
+	require.NoError(t, err)
+	assert.Equal(t, ServerStatusMaintenance, status)
+	_, err = ParseServerStatus("up")
+	assert.Error(t, err)
+
+	severity, err := ParseAlertSeverity("WARN")
+	require.NoError(t, err)
+	assert.Equal(t, AlertSeverityWarning, severity)
+	severity, err = ParseAlertSeverity("crit")
+	require.NoError(t, err)
+	assert.Equal(t, AlertSeverityCritical, severity)
+	_, err = ParseAlertSeverity("urgent")
+	assert.Error(t, err)
+
+	for input, want := range map[string]Environment{
+		"prod":       EnvironmentProduction,
+		"Production": EnvironmentProduction,
+		"stg":        EnvironmentStaging,
+		"dev":        EnvironmentDevelopment,
+		"test":       EnvironmentTesting,
+		" staging\n": EnvironmentStaging,
+	} {
+		env, err := ParseEnvironment(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, want, env, input)
+	}
+	_, err = ParseEnvironment("qa")
+	assert.Error(t, err)
+}
+
+func TestEnums_WireCompatible(t *testing.T) {
+	data, err := json.Marshal(&ProbeCreateRequest{Name: "web", Type: ProbeTypeHTTPS, Target: "https://example.com"})
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"type":"https"`)
+
+	data, err = json.Marshal(&ServerUpdateRequest{Environment: EnvironmentProduction})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"environment":"production"}`, string(data))
+
+	// Values the SDK does not know still decode, so new API values do not break clients
+	var threshold AlertThreshold
+	require.NoError(t, json.Unmarshal([]byte(`{"value":90,"operator":">","severity":"emergency"}`), &threshold))
+	assert.Equal(t, AlertSeverity("emergency"), threshold.Severity)
+	assert.False(t, threshold.Severity.Valid())
+
+	query := (&ServersListOptions{Environment: EnvironmentStaging, Status: ServerStatusOnline}).ToQuery()
+	assert.Equal(t, "staging", query["environment"])
+	assert.Equal(t, "online", query["status"])
+}
@@ -12,6 +12,7 @@ type APIError struct {
 	Message   string `json:"message"`
 	Details   string `json:"details,omitempty"`
 	RequestID string `json:"request_id,omitempty"`
+	requestInfo
 }
 
 // Error implements the error interface
@@ -32,6 +33,7 @@ type RateLimitError struct {
 	Limit      int
 	Remaining  int
 	Reset      int64
+	requestInfo
 }
 
 // Error implements the error interface
@@ -47,6 +49,7 @@ type ValidationError struct {
 	StatusCode int
 	Message    string
 	Errors     map[string][]string `json:"errors,omitempty"`
+	requestInfo
 }
 
 // Error implements the error interface
@@ -62,6 +65,7 @@ type NotFoundError struct {
 	Resource string
 	ID       string
 	Message  string
+	requestInfo
 }
 
 // Error implements the error interface
@@ -78,6 +82,7 @@ func (e *NotFoundError) Error() string {
 // UnauthorizedError represents a 401 error
 type UnauthorizedError struct {
 	Message string
+	requestInfo
 }
 
 // Error implements the error interface
@@ -93,6 +98,7 @@ type ForbiddenError struct {
 	Resource string
 	Action   string
 	Message  string
+	requestInfo
 }
 
 // Error implements the error interface
@@ -111,6 +117,7 @@ type InternalServerError struct {
 	StatusCode int
 	Message    string
 	RequestID  string
+	requestInfo
 }
 
 // Error implements the error interface
@@ -125,6 +132,7 @@ func (e *InternalServerError) Error() string {
 type ConflictError struct {
 	Resource string
 	Message  string
+	requestInfo
 }
 
 // Error implements the error interface
@@ -139,6 +147,7 @@ func (e *ConflictError) Error() string {
 type ServiceUnavailableError struct {
 	Message   string
 	RetryTime int
+	requestInfo
 }
 
 // Error implements the error interface
@@ -149,6 +158,21 @@ func (e *ServiceUnavailableError) Error() string {
 	return e.Message
 }
 
+// OperationError is returned when an asynchronous operation fails or is cancelled
+type OperationError struct {
+	ID      string
+	State   string
+	Message string
+}
+
+// Error implements the error interface
+func (e *OperationError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("operation %s %s: %s", e.ID, e.State, e.Message)
+	}
+	return fmt.Sprintf("operation %s %s", e.ID, e.State)
+}
+
 // IsNotFound returns true if the error is a NotFoundError
 func IsNotFound(err error) bool {
 	_, ok := err.(*NotFoundError)
@@ -195,8 +219,52 @@ func IsServerError(err error) bool {
 	return ok
 }
 
+// isUnsupportedEndpoint reports whether err indicates the API does not offer
+// the requested endpoint, so callers can fall back to an alternative
+func isUnsupportedEndpoint(err error) bool {
+	if IsNotFound(err) {
+		return true
+	}
+	if apiErr, ok := err.(*APIError); ok {
+		return apiErr.ErrorCode == "HTTP_405" || apiErr.ErrorCode == "HTTP_501"
+	}
+	return false
+}
+
 // Common error variables
 var (
 	// ErrUnexpectedResponse is returned when the API returns an unexpected response format
 	ErrUnexpectedResponse = fmt.Errorf("unexpected response format from API")
+
+	// ErrUnknownEventType is returned when decoding a platform event whose type is not in the catalog
+	ErrUnknownEventType = fmt.Errorf("unknown platform event type")
+
+	// ErrChangeReasonRequired is returned for mutating requests without a change
+	// reason when Config.RequireChangeReason is set
+	ErrChangeReasonRequired = fmt.Errorf("change reason required")
+
+	// ErrCredentialNotFound is returned by a CredentialStore when no credential is stored under a name
+	ErrCredentialNotFound = fmt.Errorf("credential not found")
+
+	// ErrKeyringUnavailable is returned when the OS keyring cannot be used on this system
+	ErrKeyringUnavailable = fmt.Errorf("OS keyring unavailable")
+
+	// ErrDryRun matches the *DryRunError returned for mutating requests in DryRunLocal mode
+	ErrDryRun = fmt.Errorf("dry run")
+
+	// ErrSiteLeaseLost is returned when renewing a site lease that expired or
+	// was taken over by another agent
+	ErrSiteLeaseLost = fmt.Errorf("site lease lost")
+
+	// ErrClientClosed is returned for requests and background loops started
+	// after Client.Close
+	ErrClientClosed = fmt.Errorf("client is closed")
+
+	// ErrSpoolOnly is returned for requests other than heartbeats while the
+	// client is in spool-only mode (see Client.SetSpoolOnly)
+	ErrSpoolOnly = fmt.Errorf("client is in spool-only mode")
+
+	// ErrSchemaMismatch matches the *SchemaMismatchError returned in strict
+	// decoding mode for responses with fields the SDK models do not know
+	ErrSchemaMismatch = fmt.Errorf("schema mismatch")
 )
@@ -0,0 +1,205 @@
+package nexmonyx
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RequestEvent describes an API request observed by the client event bus
+type RequestEvent struct {
+	Method     string
+	Path       string
+	Attempt    int           // 1-based attempt that produced the event; attempts made for OnRequestEnd
+	StatusCode int           // HTTP status code, 0 when no response was received
+	Duration   time.Duration // Total time spent including retries (OnRequestEnd only)
+	RetryAfter string        // Retry-After header value (OnRateLimited only)
+	Err        error         // Final SDK error (OnRequestEnd) or the failed attempt's transport error (OnRetry)
+}
+
+// CircuitEvent describes a circuit breaker tripping inside the SDK
+type CircuitEvent struct {
+	Component string // Component whose circuit opened, e.g. "websocket"
+	Reason    string
+}
+
+// EventBus lets embedding applications observe SDK behavior, for example to feed
+// their own metrics or alerts, without wrapping every call site. Handlers run
+// synchronously on the goroutine making the request, so they must be fast and
+// must not block. The zero value is ready to use and it is safe for concurrent use.
+//
+// Example:
+//
+//	client.Events().OnRequestEnd(func(e nexmonyx.RequestEvent) {
+//	    requestDuration.WithLabelValues(e.Method, strconv.Itoa(e.StatusCode)).Observe(e.Duration.Seconds())
+//	})
+type EventBus struct {
+	mu     sync.RWMutex
+	nextID uint64
+
+	requestStart eventHandlers[RequestEvent]
+	requestEnd   eventHandlers[RequestEvent]
+	retry        eventHandlers[RequestEvent]
+	rateLimited  eventHandlers[RequestEvent]
+	circuitOpen  eventHandlers[CircuitEvent]
+
+	platformNotice eventHandlers[PlatformNotice]
+	decodeWarning  eventHandlers[DecodeWarning]
+	clockSkew      eventHandlers[ClockSkewEvent]
+}
+
+// OnRequestStart registers fn to be called before each API request is sent.
+// It returns a function that removes the handler.
+func (b *EventBus) OnRequestStart(fn func(RequestEvent)) func() {
+	return subscribe(b, &b.requestStart, fn)
+}
+
+// OnRequestEnd registers fn to be called once an API request has finished,
+// after all retries. It returns a function that removes the handler.
+func (b *EventBus) OnRequestEnd(fn func(RequestEvent)) func() {
+	return subscribe(b, &b.requestEnd, fn)
+}
+
+// OnRetry registers fn to be called each time a failed attempt is about to be
+// retried. It returns a function that removes the handler.
+func (b *EventBus) OnRetry(fn func(RequestEvent)) func() {
+	return subscribe(b, &b.retry, fn)
+}
+
+// OnRateLimited registers fn to be called for every 429 response received,
+// including ones that are retried. It returns a function that removes the handler.
+func (b *EventBus) OnRateLimited(fn func(RequestEvent)) func() {
+	return subscribe(b, &b.rateLimited, fn)
+}
+
+// OnCircuitOpen registers fn to be called when an SDK circuit breaker opens,
+// such as the WebSocket pending-command limit. It returns a function that removes the handler.
+func (b *EventBus) OnCircuitOpen(fn func(CircuitEvent)) func() {
+	return subscribe(b, &b.circuitOpen, fn)
+}
+
+// OnPlatformNotice registers fn to be called when the API starts reporting an
+// incident or maintenance notice, and with an operational notice once active
+// notices clear. It returns a function that removes the handler.
+func (b *EventBus) OnPlatformNotice(fn func(PlatformNotice)) func() {
+	return subscribe(b, &b.platformNotice, fn)
+}
+
+// OnDecodeWarning registers fn to be called for each response value converted
+// by tolerant decoding (Config.TolerantDecoding). It returns a function that
+// removes the handler.
+func (b *EventBus) OnDecodeWarning(fn func(DecodeWarning)) func() {
+	return subscribe(b, &b.decodeWarning, fn)
+}
+
+// OnClockSkew registers fn to be called when the offset between the local
+// clock and the API's moves beyond Config.ClockSkewTolerance, and again when it
+// returns within it. It returns a function that removes the handler.
+func (b *EventBus) OnClockSkew(fn func(ClockSkewEvent)) func() {
+	return subscribe(b, &b.clockSkew, fn)
+}
+
+func (b *EventBus) emitRequestStart(e RequestEvent) { emit(b, &b.requestStart, e) }
+func (b *EventBus) emitRequestEnd(e RequestEvent)   { emit(b, &b.requestEnd, e) }
+func (b *EventBus) emitRetry(e RequestEvent)        { emit(b, &b.retry, e) }
+func (b *EventBus) emitRateLimited(e RequestEvent)  { emit(b, &b.rateLimited, e) }
+func (b *EventBus) emitCircuitOpen(e CircuitEvent)  { emit(b, &b.circuitOpen, e) }
+
+func (b *EventBus) emitPlatformNotice(e PlatformNotice) { emit(b, &b.platformNotice, e) }
+func (b *EventBus) emitDecodeWarning(e DecodeWarning)   { emit(b, &b.decodeWarning, e) }
+func (b *EventBus) emitClockSkew(e ClockSkewEvent)      { emit(b, &b.clockSkew, e) }
+
+// eventHandlers is the ordered list of handlers registered for one event type
+type eventHandlers[E any] []eventHandler[E]
+
+type eventHandler[E any] struct {
+	id uint64
+	fn func(E)
+}
+
+func subscribe[E any](b *EventBus, handlers *eventHandlers[E], fn func(E)) func() {
+	if fn == nil {
+		return func() {}
+	}
+
+	b.mu.Lock()
+	b.nextID++
+	id := b.nextID
+	*handlers = append(*handlers, eventHandler[E]{id: id, fn: fn})
+	b.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			for i, h := range *handlers {
+				if h.id == id {
+					*handlers = append((*handlers)[:i:i], (*handlers)[i+1:]...)
+					return
+				}
+			}
+		})
+	}
+}
+
+func emit[E any](b *EventBus, handlers *eventHandlers[E], e E) {
+	b.mu.RLock()
+	snapshot := *handlers
+	b.mu.RUnlock()
+
+	for _, h := range snapshot {
+		h.fn(e)
+	}
+}
+
+// Events returns the client's event bus. Clients derived with WithToken,
+// WithUnifiedAPIKey, and similar methods share the same bus.
+func (c *Client) Events() *EventBus {
+	return c.events
+}
+
+type requestTraceKey struct{}
+
+// requestTrace identifies the SDK request behind a resty request so retry hooks
+// can report the original method and path
+type requestTrace struct {
+	method string
+	path   string
+}
+
+// withRequestTrace attaches the request identity to ctx for the retry hook
+func withRequestTrace(ctx context.Context, method, path string) context.Context {
+	return context.WithValue(ctx, requestTraceKey{}, requestTrace{method: method, path: path})
+}
+
+// onRetryHook publishes rate-limit and retry events for every attempt that
+// matched the retry condition
+func (c *Client) onRetryHook(resp *resty.Response, err error) {
+	if resp == nil || resp.Request == nil {
+		return
+	}
+
+	trace, _ := resp.Request.Context().Value(requestTraceKey{}).(requestTrace)
+	event := RequestEvent{
+		Method:     trace.method,
+		Path:       trace.path,
+		Attempt:    resp.Request.Attempt,
+		StatusCode: resp.StatusCode(),
+		Err:        err,
+	}
+
+	if event.StatusCode == http.StatusTooManyRequests {
+		rateLimited := event
+		rateLimited.RetryAfter = resp.Header().Get("Retry-After")
+		c.events.emitRateLimited(rateLimited)
+	}
+
+	// resty also runs retry hooks after the final attempt; only report real retries
+	if resp.Request.Attempt <= c.maxRetries(trace.method, trace.path) {
+		c.events.emitRetry(event)
+	}
+}
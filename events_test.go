@@ -0,0 +1,153 @@
+package nexmonyx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventBus_RequestStartAndEnd(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil, Config{BaseURL: server.URL, RetryCount: 3})
+
+	var starts, ends []RequestEvent
+	client.Events().OnRequestStart(func(e RequestEvent) { starts = append(starts, e) })
+	client.Events().OnRequestEnd(func(e RequestEvent) { ends = append(ends, e) })
+
+	_, err := client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/servers"})
+	require.NoError(t, err)
+
+	require.Len(t, starts, 1)
+	assert.Equal(t, "GET", starts[0].Method)
+	assert.Equal(t, "/v1/servers", starts[0].Path)
+
+	require.Len(t, ends, 1)
+	assert.Equal(t, "/v1/servers", ends[0].Path)
+	assert.Equal(t, http.StatusOK, ends[0].StatusCode)
+	assert.Equal(t, 1, ends[0].Attempt)
+	assert.NoError(t, ends[0].Err)
+	assert.Greater(t, ends[0].Duration, time.Duration(0))
+}
+
+func TestEventBus_RetryAndRateLimited(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil, Config{BaseURL: server.URL, RetryCount: 3})
+
+	var retries, rateLimited, ends []RequestEvent
+	client.Events().OnRetry(func(e RequestEvent) { retries = append(retries, e) })
+	client.Events().OnRateLimited(func(e RequestEvent) { rateLimited = append(rateLimited, e) })
+	client.Events().OnRequestEnd(func(e RequestEvent) { ends = append(ends, e) })
+
+	_, err := client.Do(context.Background(), &Request{Method: "POST", Path: "/v1/metrics/comprehensive"})
+	require.NoError(t, err)
+
+	require.Len(t, rateLimited, 1)
+	assert.Equal(t, "POST", rateLimited[0].Method)
+	assert.Equal(t, "/v1/metrics/comprehensive", rateLimited[0].Path)
+	assert.Equal(t, "1", rateLimited[0].RetryAfter)
+	assert.Equal(t, 1, rateLimited[0].Attempt)
+
+	require.Len(t, retries, 1)
+	assert.Equal(t, http.StatusTooManyRequests, retries[0].StatusCode)
+	assert.Equal(t, 1, retries[0].Attempt)
+
+	require.Len(t, ends, 1)
+	assert.Equal(t, 2, ends[0].Attempt)
+	assert.Equal(t, http.StatusOK, ends[0].StatusCode)
+}
+
+func TestEventBus_RetriesExhausted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil, Config{BaseURL: server.URL, RetryCount: 1})
+
+	var retries, rateLimited, ends []RequestEvent
+	client.Events().OnRetry(func(e RequestEvent) { retries = append(retries, e) })
+	client.Events().OnRateLimited(func(e RequestEvent) { rateLimited = append(rateLimited, e) })
+	client.Events().OnRequestEnd(func(e RequestEvent) { ends = append(ends, e) })
+
+	_, err := client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/servers"})
+	require.Error(t, err)
+
+	assert.Len(t, rateLimited, 2, "every 429 response is reported")
+	assert.Len(t, retries, 1, "the final attempt is not a retry")
+	require.Len(t, ends, 1)
+	assert.True(t, IsRateLimit(ends[0].Err))
+	assert.Equal(t, http.StatusTooManyRequests, ends[0].StatusCode)
+}
+
+func TestEventBus_Unsubscribe(t *testing.T) {
+	bus := &EventBus{}
+
+	var first, second int
+	unsubscribe := bus.OnRequestStart(func(RequestEvent) { first++ })
+	bus.OnRequestStart(func(RequestEvent) { second++ })
+
+	bus.emitRequestStart(RequestEvent{})
+	unsubscribe()
+	unsubscribe()
+	bus.emitRequestStart(RequestEvent{})
+
+	assert.Equal(t, 1, first)
+	assert.Equal(t, 2, second)
+}
+
+func TestEventBus_SharedWithDerivedClients(t *testing.T) {
+	client, err := NewClient(&Config{Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	derived := client.WithUnifiedAPIKey("key")
+	assert.Same(t, client.Events(), derived.Events())
+
+	bus := &EventBus{}
+	custom, err := NewClient(&Config{Events: bus})
+	require.NoError(t, err)
+	assert.Same(t, bus, custom.Events())
+}
+
+func TestEventBus_ConcurrentSubscribeAndEmit(t *testing.T) {
+	bus := &EventBus{}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			unsubscribe := bus.OnRetry(func(RequestEvent) {})
+			unsubscribe()
+		}()
+		go func() {
+			defer wg.Done()
+			bus.emitRetry(RequestEvent{})
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,29 @@
+module github.com/nexmonyx/go-sdk/v2/examples/service_monitoring
+
+go 1.24
+
+replace (
+	github.com/nexmonyx/go-sdk/v2 => ../../
+	github.com/nexmonyx/go-sdk/v2/collect => ../../collect
+)
+
+require (
+	github.com/nexmonyx/go-sdk/v2 v2.0.0-00010101000000-000000000000
+	github.com/nexmonyx/go-sdk/v2/collect v0.0.0-00010101000000-000000000000
+)
+
+require (
+	github.com/ebitengine/purego v0.8.1 // indirect
+	github.com/go-ole/go-ole v1.2.6 // indirect
+	github.com/go-resty/resty/v2 v2.11.0 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/gorilla/websocket v1.5.3 // indirect
+	github.com/lufia/plan9stats v0.0.0-20211012122336-39d0f177ccd0 // indirect
+	github.com/power-devops/perfstat v0.0.0-20210106213030-5aafc221ea8c // indirect
+	github.com/shirou/gopsutil/v4 v4.24.12 // indirect
+	github.com/tklauser/go-sysconf v0.3.12 // indirect
+	github.com/tklauser/numcpus v0.6.1 // indirect
+	github.com/yusufpapurcu/wmi v1.2.4 // indirect
+	golang.org/x/net v0.41.0 // indirect
+	golang.org/x/sys v0.33.0 // indirect
+)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/nexmonyx/go-sdk/v2"
+	"github.com/nexmonyx/go-sdk/v2/collect"
 )
 
 func main() {
@@ -25,19 +26,54 @@ func main() {
 
 	ctx := context.Background()
 
-	// Example 1: Submit service monitoring data as part of comprehensive metrics
+	// Example 1: Collect real systemd services from this host and submit them
+	collectLocalServices(ctx, client)
+
+	// Example 2: Submit hand-built service monitoring data as part of comprehensive metrics
 	submitServiceMonitoringData(ctx, client)
 
-	// Example 2: Monitor critical services
+	// Example 3: Monitor critical services
 	monitorCriticalServices()
 
-	// Example 3: Analyze service logs
+	// Example 4: Analyze service logs
 	analyzeServiceLogs()
 
-	// Example 4: Track service resource usage
+	// Example 5: Track service resource usage
 	trackServiceResources()
 }
 
+func collectLocalServices(ctx context.Context, client *nexmonyx.Client) {
+	fmt.Println("=== Collecting Local Systemd Services ===")
+
+	// Reuse one collector: CPU percentages and journal positions carry over
+	// between collections
+	config := nexmonyx.NewServiceMonitoringConfig()
+	config.IncludePatterns = append(config.IncludePatterns, "nginx*", "postgresql*")
+	collector := collect.NewServiceCollector(config)
+
+	serviceInfo, err := collector.Collect(ctx)
+	if serviceInfo == nil {
+		log.Printf("Failed to collect services: %v", err)
+		return
+	}
+	if err != nil {
+		log.Printf("Some service logs could not be read: %v", err)
+	}
+
+	for _, service := range serviceInfo.Services {
+		fmt.Printf("  %s: %s/%s (restarts: %d)\n", service.Name, service.State, service.SubState, service.RestartCount)
+	}
+
+	err = client.Metrics.SubmitComprehensive(ctx, &nexmonyx.ComprehensiveMetricsRequest{
+		ServerUUID:  "your-server-uuid",
+		CollectedAt: time.Now().UTC().Format(time.RFC3339),
+		Services:    serviceInfo,
+	})
+	if err != nil {
+		log.Printf("Failed to submit metrics: %v", err)
+	}
+}
+
 func submitServiceMonitoringData(ctx context.Context, client *nexmonyx.Client) {
 	fmt.Println("=== Submitting Service Monitoring Data ===")
 
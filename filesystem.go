@@ -2,6 +2,8 @@ package nexmonyx
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -22,7 +24,7 @@ type FilesystemMetricsSubmission struct {
 // FilesystemMetricsData represents filesystem metrics for a single filesystem
 type FilesystemMetricsData struct {
 	FilesystemName string  `json:"filesystem_name"`
-	FilesystemType string  `json:"filesystem_type"` // 'zfs', 'lvm', 'mdraid', 'btrfs', 'ext4', 'xfs', 'ntfs'
+	FilesystemType string  `json:"filesystem_type"` // 'zfs', 'lvm', 'mdraid', 'btrfs', 'ceph', 'ext4', 'xfs', 'ntfs'
 	MountPoint     *string `json:"mount_point,omitempty"`
 	DevicePath     *string `json:"device_path,omitempty"`
 
@@ -92,6 +94,56 @@ type FilesystemMetricsData struct {
 	BTRFSDataRatio       *float64 `json:"btrfs_data_ratio,omitempty"`
 	BTRFSMetadataRatio   *float64 `json:"btrfs_metadata_ratio,omitempty"`
 
+	// BTRFS allocation and subvolumes
+	BTRFSLabel              *string                 `json:"btrfs_label,omitempty"`
+	BTRFSMissingDevices     *int                    `json:"btrfs_missing_devices,omitempty"`
+	BTRFSUnallocatedBytes   *int64                  `json:"btrfs_unallocated_bytes,omitempty"`
+	BTRFSMetadataUsedBytes  *int64                  `json:"btrfs_metadata_used_bytes,omitempty"`
+	BTRFSMetadataTotalBytes *int64                  `json:"btrfs_metadata_total_bytes,omitempty"`
+	BTRFSSubvolumeCount     *int                    `json:"btrfs_subvolume_count,omitempty"`
+	BTRFSSnapshotCount      *int                    `json:"btrfs_snapshot_count,omitempty"`
+	BTRFSSubvolumes         []BTRFSSubvolumeMetrics `json:"btrfs_subvolumes,omitempty"`
+
+	// BTRFS scrub and device error counters (btrfs device stats)
+	BTRFSScrubState               *string    `json:"btrfs_scrub_state,omitempty"` // 'running', 'finished', 'aborted', 'never'
+	BTRFSScrubPercentComplete     *float64   `json:"btrfs_scrub_percent_complete,omitempty"`
+	BTRFSScrubLastRun             *time.Time `json:"btrfs_scrub_last_run,omitempty"`
+	BTRFSScrubCorrectedErrors     *int64     `json:"btrfs_scrub_corrected_errors,omitempty"`
+	BTRFSScrubUncorrectableErrors *int64     `json:"btrfs_scrub_uncorrectable_errors,omitempty"`
+	BTRFSReadErrors               *int64     `json:"btrfs_read_errors,omitempty"`
+	BTRFSWriteErrors              *int64     `json:"btrfs_write_errors,omitempty"`
+	BTRFSFlushErrors              *int64     `json:"btrfs_flush_errors,omitempty"`
+	BTRFSCorruptionErrors         *int64     `json:"btrfs_corruption_errors,omitempty"`
+	BTRFSGenerationErrors         *int64     `json:"btrfs_generation_errors,omitempty"`
+
+	// Ceph OSD-specific metrics
+	CephClusterFSID     *string  `json:"ceph_cluster_fsid,omitempty"`
+	CephOSDID           *int     `json:"ceph_osd_id,omitempty"`
+	CephDeviceClass     *string  `json:"ceph_device_class,omitempty"` // 'hdd', 'ssd', 'nvme'
+	CephOSDUp           *bool    `json:"ceph_osd_up,omitempty"`
+	CephOSDIn           *bool    `json:"ceph_osd_in,omitempty"`
+	CephCrushWeight     *float64 `json:"ceph_crush_weight,omitempty"`
+	CephReweight        *float64 `json:"ceph_reweight,omitempty"`
+	CephClusterHealth   *string  `json:"ceph_cluster_health,omitempty"` // 'HEALTH_OK', 'HEALTH_WARN', 'HEALTH_ERR'
+	CephCommitLatencyMs *float64 `json:"ceph_commit_latency_ms,omitempty"`
+	CephApplyLatencyMs  *float64 `json:"ceph_apply_latency_ms,omitempty"`
+
+	// Ceph placement group and replication health
+	CephPGCount             *int   `json:"ceph_pg_count,omitempty"`
+	CephPGsActiveClean      *int   `json:"ceph_pgs_active_clean,omitempty"`
+	CephPGsDegraded         *int   `json:"ceph_pgs_degraded,omitempty"`
+	CephPGsUndersized       *int   `json:"ceph_pgs_undersized,omitempty"`
+	CephPGsInconsistent     *int   `json:"ceph_pgs_inconsistent,omitempty"`
+	CephObjectsDegraded     *int64 `json:"ceph_objects_degraded,omitempty"`
+	CephObjectsMisplaced    *int64 `json:"ceph_objects_misplaced,omitempty"`
+	CephObjectsUnfound      *int64 `json:"ceph_objects_unfound,omitempty"`
+	CephRecoveryBytesPerSec *int64 `json:"ceph_recovery_bytes_per_sec,omitempty"`
+
+	// Ceph scrub state
+	CephScrubState    *string    `json:"ceph_scrub_state,omitempty"` // 'idle', 'scrubbing', 'deep_scrubbing'
+	CephLastScrub     *time.Time `json:"ceph_last_scrub,omitempty"`
+	CephLastDeepScrub *time.Time `json:"ceph_last_deep_scrub,omitempty"`
+
 	// Performance metrics
 	ReadOpsPerSec     *float64 `json:"read_ops_per_sec,omitempty"`
 	WriteOpsPerSec    *float64 `json:"write_ops_per_sec,omitempty"`
@@ -248,6 +300,126 @@ func (s *FilesystemService) SubmitLVM(ctx context.Context, serverUUID uuid.UUID,
 	return s.Submit(ctx, submission)
 }
 
+// SubmitBTRFS is a convenience method for submitting BTRFS-specific metrics.
+// Volumes without an OverallHealth are scored from their device errors, scrub
+// results, missing devices, and free space.
+func (s *FilesystemService) SubmitBTRFS(ctx context.Context, serverUUID uuid.UUID, btrfsMetrics []BTRFSVolumeMetrics) error {
+	submission := &FilesystemMetricsSubmission{
+		ServerUUID:  serverUUID,
+		Timestamp:   time.Now(),
+		Filesystems: make([]FilesystemMetricsData, 0, len(btrfsMetrics)),
+	}
+
+	for _, volume := range btrfsMetrics {
+		name := volume.FilesystemUUID
+		if volume.Label != nil && *volume.Label != "" {
+			name = *volume.Label
+		}
+
+		filesystemData := FilesystemMetricsData{
+			FilesystemName:                name,
+			FilesystemType:                "btrfs",
+			MountPoint:                    volume.MountPoint,
+			DevicePath:                    volume.DevicePath,
+			TotalBytes:                    volume.TotalBytes,
+			UsedBytes:                     volume.UsedBytes,
+			AvailableBytes:                volume.AvailableBytes,
+			UsagePercent:                  volume.UsagePercent,
+			BTRFSFilesystemUUID:           &volume.FilesystemUUID,
+			BTRFSLabel:                    volume.Label,
+			BTRFSTotalDevices:             volume.TotalDevices,
+			BTRFSMissingDevices:           volume.MissingDevices,
+			BTRFSRAIDType:                 volume.DataProfile,
+			BTRFSDataRatio:                volume.DataRatio,
+			BTRFSMetadataRatio:            volume.MetadataRatio,
+			BTRFSUnallocatedBytes:         volume.UnallocatedBytes,
+			BTRFSMetadataUsedBytes:        volume.MetadataUsedBytes,
+			BTRFSMetadataTotalBytes:       volume.MetadataTotalBytes,
+			BTRFSSubvolumeCount:           volume.SubvolumeCount,
+			BTRFSSnapshotCount:            volume.SnapshotCount,
+			BTRFSSubvolumes:               volume.Subvolumes,
+			BTRFSScrubState:               volume.ScrubState,
+			BTRFSScrubPercentComplete:     volume.ScrubPercentComplete,
+			BTRFSScrubLastRun:             volume.ScrubLastRun,
+			BTRFSScrubCorrectedErrors:     volume.ScrubCorrectedErrors,
+			BTRFSScrubUncorrectableErrors: volume.ScrubUncorrectableErrors,
+			BTRFSReadErrors:               volume.ReadErrors,
+			BTRFSWriteErrors:              volume.WriteErrors,
+			BTRFSFlushErrors:              volume.FlushErrors,
+			BTRFSCorruptionErrors:         volume.CorruptionErrors,
+			BTRFSGenerationErrors:         volume.GenerationErrors,
+			OverallHealth:                 volume.OverallHealth,
+			HealthScore:                   volume.HealthScore,
+			WarningCount:                  volume.WarningCount,
+			ErrorCount:                    volume.ErrorCount,
+		}
+		if filesystemData.OverallHealth == "" {
+			scoreBTRFSHealth(&filesystemData)
+		}
+		submission.Filesystems = append(submission.Filesystems, filesystemData)
+	}
+
+	return s.Submit(ctx, submission)
+}
+
+// SubmitCeph is a convenience method for submitting Ceph OSD metrics. OSDs
+// without an OverallHealth are scored from their up/in state, placement group
+// and object replication health, fullness, and commit latency.
+func (s *FilesystemService) SubmitCeph(ctx context.Context, serverUUID uuid.UUID, cephMetrics []CephOSDMetrics) error {
+	submission := &FilesystemMetricsSubmission{
+		ServerUUID:  serverUUID,
+		Timestamp:   time.Now(),
+		Filesystems: make([]FilesystemMetricsData, 0, len(cephMetrics)),
+	}
+
+	for _, osd := range cephMetrics {
+		osdID := osd.OSDID
+		filesystemData := FilesystemMetricsData{
+			FilesystemName:          fmt.Sprintf("osd.%d", osd.OSDID),
+			FilesystemType:          "ceph",
+			DevicePath:              osd.DevicePath,
+			TotalBytes:              osd.TotalBytes,
+			UsedBytes:               osd.UsedBytes,
+			AvailableBytes:          osd.AvailableBytes,
+			UsagePercent:            osd.UsagePercent,
+			CephClusterFSID:         osd.ClusterFSID,
+			CephOSDID:               &osdID,
+			CephDeviceClass:         osd.DeviceClass,
+			CephOSDUp:               &osd.Up,
+			CephOSDIn:               &osd.In,
+			CephCrushWeight:         osd.CrushWeight,
+			CephReweight:            osd.Reweight,
+			CephClusterHealth:       osd.ClusterHealth,
+			CephCommitLatencyMs:     osd.CommitLatencyMs,
+			CephApplyLatencyMs:      osd.ApplyLatencyMs,
+			CephPGCount:             osd.PGCount,
+			CephPGsActiveClean:      osd.PGsActiveClean,
+			CephPGsDegraded:         osd.PGsDegraded,
+			CephPGsUndersized:       osd.PGsUndersized,
+			CephPGsInconsistent:     osd.PGsInconsistent,
+			CephObjectsDegraded:     osd.ObjectsDegraded,
+			CephObjectsMisplaced:    osd.ObjectsMisplaced,
+			CephObjectsUnfound:      osd.ObjectsUnfound,
+			CephRecoveryBytesPerSec: osd.RecoveryBytesPerSec,
+			CephScrubState:          osd.ScrubState,
+			CephLastScrub:           osd.LastScrub,
+			CephLastDeepScrub:       osd.LastDeepScrub,
+			ReadOpsPerSec:           osd.ReadOpsPerSec,
+			WriteOpsPerSec:          osd.WriteOpsPerSec,
+			OverallHealth:           osd.OverallHealth,
+			HealthScore:             osd.HealthScore,
+			WarningCount:            osd.WarningCount,
+			ErrorCount:              osd.ErrorCount,
+		}
+		if filesystemData.OverallHealth == "" {
+			scoreCephHealth(&filesystemData)
+		}
+		submission.Filesystems = append(submission.Filesystems, filesystemData)
+	}
+
+	return s.Submit(ctx, submission)
+}
+
 // Convenience types for specific storage technologies
 type ZFSPoolMetrics struct {
 	PoolName                string
@@ -319,4 +491,230 @@ type LVMVolumeMetrics struct {
 	HealthScore              *float64
 	WarningCount             int
 	ErrorCount               int
-}
\ No newline at end of file
+}
+
+// BTRFSVolumeMetrics holds metrics for a BTRFS filesystem, from btrfs
+// filesystem usage, btrfs device stats, and btrfs scrub status
+type BTRFSVolumeMetrics struct {
+	FilesystemUUID     string
+	Label              *string
+	MountPoint         *string
+	DevicePath         *string
+	TotalBytes         *int64
+	UsedBytes          *int64
+	AvailableBytes     *int64
+	UsagePercent       *float64
+	TotalDevices       *int
+	MissingDevices     *int
+	DataProfile        *string // 'single', 'dup', 'raid0', 'raid1', 'raid1c3', 'raid10', 'raid5', 'raid6'
+	DataRatio          *float64
+	MetadataRatio      *float64
+	UnallocatedBytes   *int64
+	MetadataUsedBytes  *int64
+	MetadataTotalBytes *int64
+	SubvolumeCount     *int
+	SnapshotCount      *int
+	Subvolumes         []BTRFSSubvolumeMetrics
+
+	ScrubState               *string // 'running', 'finished', 'aborted', 'never'
+	ScrubPercentComplete     *float64
+	ScrubLastRun             *time.Time
+	ScrubCorrectedErrors     *int64
+	ScrubUncorrectableErrors *int64
+
+	// Device error counters, summed across devices
+	ReadErrors       *int64
+	WriteErrors      *int64
+	FlushErrors      *int64
+	CorruptionErrors *int64
+	GenerationErrors *int64
+
+	OverallHealth string // Computed when empty
+	HealthScore   *float64
+	WarningCount  int
+	ErrorCount    int
+}
+
+// BTRFSSubvolumeMetrics describes a BTRFS subvolume or snapshot
+type BTRFSSubvolumeMetrics struct {
+	ID              int64      `json:"id"`
+	Path            string     `json:"path"`
+	ParentUUID      string     `json:"parent_uuid,omitempty"` // Set for snapshots
+	IsSnapshot      bool       `json:"is_snapshot"`
+	ReadOnly        bool       `json:"read_only"`
+	ReferencedBytes *int64     `json:"referenced_bytes,omitempty"` // Requires quotas
+	ExclusiveBytes  *int64     `json:"exclusive_bytes,omitempty"`  // Requires quotas
+	QuotaLimitBytes *int64     `json:"quota_limit_bytes,omitempty"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+}
+
+// CephOSDMetrics holds metrics for a Ceph OSD hosted on the server, from
+// ceph osd df, ceph pg dump, and the OSD's perf counters
+type CephOSDMetrics struct {
+	OSDID          int
+	ClusterFSID    *string
+	DevicePath     *string
+	DeviceClass    *string // 'hdd', 'ssd', 'nvme'
+	Up             bool
+	In             bool
+	CrushWeight    *float64
+	Reweight       *float64
+	ClusterHealth  *string // 'HEALTH_OK', 'HEALTH_WARN', 'HEALTH_ERR'
+	TotalBytes     *int64
+	UsedBytes      *int64
+	AvailableBytes *int64
+	UsagePercent   *float64
+
+	CommitLatencyMs *float64
+	ApplyLatencyMs  *float64
+	ReadOpsPerSec   *float64
+	WriteOpsPerSec  *float64
+
+	// Placement groups on this OSD and replication health
+	PGCount             *int
+	PGsActiveClean      *int
+	PGsDegraded         *int
+	PGsUndersized       *int
+	PGsInconsistent     *int
+	ObjectsDegraded     *int64
+	ObjectsMisplaced    *int64
+	ObjectsUnfound      *int64
+	RecoveryBytesPerSec *int64
+
+	ScrubState    *string // 'idle', 'scrubbing', 'deep_scrubbing'
+	LastScrub     *time.Time
+	LastDeepScrub *time.Time
+
+	OverallHealth string // Computed when empty
+	HealthScore   *float64
+	WarningCount  int
+	ErrorCount    int
+}
+
+// Health scoring thresholds for BTRFS and Ceph
+const (
+	filesystemUsageWarningPercent  = 90.0
+	cephNearFullPercent            = 85.0 // Ceph's default nearfull ratio
+	cephFullPercent                = 95.0 // Ceph's default full ratio
+	cephCommitLatencyWarningMs     = 100.0
+	btrfsUnallocatedWarningPercent = 1.0
+)
+
+// filesystemHealth accumulates findings into the overall health fields
+type filesystemHealth struct {
+	data  *FilesystemMetricsData
+	score float64
+}
+
+func (h *filesystemHealth) critical(penalty float64, format string, args ...interface{}) {
+	h.score -= penalty
+	h.data.ErrorCount++
+	h.data.CriticalAlerts = append(h.data.CriticalAlerts, fmt.Sprintf(format, args...))
+}
+
+func (h *filesystemHealth) warning(penalty float64, format string, args ...interface{}) {
+	h.score -= penalty
+	h.data.WarningCount++
+	h.data.WarningMessages = append(h.data.WarningMessages, fmt.Sprintf(format, args...))
+}
+
+// finish sets OverallHealth and, unless already set, HealthScore (0-100)
+func (h *filesystemHealth) finish() {
+	switch {
+	case h.data.ErrorCount > 0:
+		h.data.OverallHealth = "CRITICAL"
+	case h.data.WarningCount > 0:
+		h.data.OverallHealth = "WARNING"
+	default:
+		h.data.OverallHealth = "HEALTHY"
+	}
+	if h.data.HealthScore == nil {
+		score := math.Max(0, h.score)
+		h.data.HealthScore = &score
+	}
+}
+
+func derefInt64(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func derefInt(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// scoreBTRFSHealth derives the health of a BTRFS filesystem
+func scoreBTRFSHealth(data *FilesystemMetricsData) {
+	h := &filesystemHealth{data: data, score: 100}
+
+	if missing := derefInt(data.BTRFSMissingDevices); missing > 0 {
+		h.critical(50, "%d device(s) missing", missing)
+	}
+	if errs := derefInt64(data.BTRFSCorruptionErrors) + derefInt64(data.BTRFSGenerationErrors); errs > 0 {
+		h.critical(40, "%d corruption or generation error(s)", errs)
+	}
+	if errs := derefInt64(data.BTRFSScrubUncorrectableErrors); errs > 0 {
+		h.critical(40, "scrub found %d uncorrectable error(s)", errs)
+	}
+	if errs := derefInt64(data.BTRFSReadErrors) + derefInt64(data.BTRFSWriteErrors) + derefInt64(data.BTRFSFlushErrors); errs > 0 {
+		h.warning(20, "%d read, write, or flush error(s)", errs)
+	}
+	if errs := derefInt64(data.BTRFSScrubCorrectedErrors); errs > 0 {
+		h.warning(10, "scrub corrected %d error(s)", errs)
+	}
+	if data.UsagePercent != nil && *data.UsagePercent >= filesystemUsageWarningPercent {
+		h.warning(15, "%.1f%% used", *data.UsagePercent)
+	}
+	if data.BTRFSUnallocatedBytes != nil && data.TotalBytes != nil && *data.TotalBytes > 0 {
+		if percent := float64(*data.BTRFSUnallocatedBytes) / float64(*data.TotalBytes) * 100; percent < btrfsUnallocatedWarningPercent {
+			h.warning(15, "only %.2f%% unallocated; metadata allocation may fail", percent)
+		}
+	}
+
+	h.finish()
+}
+
+// scoreCephHealth derives the health of a Ceph OSD
+func scoreCephHealth(data *FilesystemMetricsData) {
+	h := &filesystemHealth{data: data, score: 100}
+
+	if data.CephOSDUp != nil && !*data.CephOSDUp {
+		h.critical(100, "OSD is down")
+	}
+	if data.CephOSDIn != nil && !*data.CephOSDIn {
+		h.warning(30, "OSD is out of the cluster")
+	}
+	if unfound := derefInt64(data.CephObjectsUnfound); unfound > 0 {
+		h.critical(50, "%d unfound object(s)", unfound)
+	}
+	if inconsistent := derefInt(data.CephPGsInconsistent); inconsistent > 0 {
+		h.critical(30, "%d inconsistent placement group(s)", inconsistent)
+	}
+	if degraded := derefInt(data.CephPGsDegraded) + derefInt(data.CephPGsUndersized); degraded > 0 {
+		h.warning(20, "%d degraded or undersized placement group(s)", degraded)
+	}
+	if degraded := derefInt64(data.CephObjectsDegraded); degraded > 0 {
+		h.warning(10, "%d degraded object(s)", degraded)
+	}
+	if misplaced := derefInt64(data.CephObjectsMisplaced); misplaced > 0 {
+		h.warning(5, "%d misplaced object(s)", misplaced)
+	}
+	if data.UsagePercent != nil {
+		switch usage := *data.UsagePercent; {
+		case usage >= cephFullPercent:
+			h.critical(40, "OSD is full (%.1f%% used)", usage)
+		case usage >= cephNearFullPercent:
+			h.warning(15, "OSD is near full (%.1f%% used)", usage)
+		}
+	}
+	if data.CephCommitLatencyMs != nil && *data.CephCommitLatencyMs >= cephCommitLatencyWarningMs {
+		h.warning(10, "commit latency %.0fms", *data.CephCommitLatencyMs)
+	}
+
+	h.finish()
+}
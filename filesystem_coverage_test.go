@@ -574,3 +574,105 @@ func TestFilesystemService_SubmitLVM_Error(t *testing.T) {
 	err := client.Filesystem.SubmitLVM(context.Background(), serverUUID, lvmMetrics)
 	assert.Error(t, err)
 }
+
+func TestFilesystemService_SubmitBTRFS(t *testing.T) {
+	var submission FilesystemMetricsSubmission
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/metrics/filesystem", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&submission))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(&Config{BaseURL: server.URL})
+
+	label := "data"
+	usage := 42.0
+	totalBytes := int64(1 << 40)
+	unallocated := int64(1 << 38)
+	corruption := int64(3)
+	readErrors := int64(1)
+	manualHealth := 70.0
+
+	err := client.Filesystem.SubmitBTRFS(context.Background(), uuid.New(), []BTRFSVolumeMetrics{
+		{
+			FilesystemUUID:   "a1b2c3",
+			Label:            &label,
+			TotalBytes:       &totalBytes,
+			UsagePercent:     &usage,
+			UnallocatedBytes: &unallocated,
+			Subvolumes:       []BTRFSSubvolumeMetrics{{ID: 256, Path: "@home"}, {ID: 300, Path: "@home-snap", IsSnapshot: true, ReadOnly: true}},
+		},
+		{FilesystemUUID: "d4e5f6", CorruptionErrors: &corruption, ReadErrors: &readErrors},
+		{FilesystemUUID: "g7h8i9", OverallHealth: "WARNING", HealthScore: &manualHealth},
+	})
+	assert.NoError(t, err)
+	if !assert.Len(t, submission.Filesystems, 3) {
+		return
+	}
+
+	healthy := submission.Filesystems[0]
+	assert.Equal(t, "btrfs", healthy.FilesystemType)
+	assert.Equal(t, "data", healthy.FilesystemName)
+	assert.Equal(t, "HEALTHY", healthy.OverallHealth)
+	assert.Equal(t, 100.0, *healthy.HealthScore)
+	assert.Len(t, healthy.BTRFSSubvolumes, 2)
+	assert.True(t, healthy.BTRFSSubvolumes[1].IsSnapshot)
+
+	damaged := submission.Filesystems[1]
+	assert.Equal(t, "d4e5f6", damaged.FilesystemName)
+	assert.Equal(t, "CRITICAL", damaged.OverallHealth)
+	assert.Equal(t, 1, damaged.ErrorCount)
+	assert.Equal(t, 1, damaged.WarningCount)
+	assert.Equal(t, 40.0, *damaged.HealthScore)
+	assert.Equal(t, int64(3), *damaged.BTRFSCorruptionErrors)
+
+	reported := submission.Filesystems[2]
+	assert.Equal(t, "WARNING", reported.OverallHealth, "reported health is kept")
+	assert.Equal(t, 70.0, *reported.HealthScore)
+}
+
+func TestFilesystemService_SubmitCeph(t *testing.T) {
+	var submission FilesystemMetricsSubmission
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&submission))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(&Config{BaseURL: server.URL})
+
+	nearFull := 88.0
+	degradedPGs := 4
+	misplaced := int64(120)
+	lastDeepScrub := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Second)
+
+	err := client.Filesystem.SubmitCeph(context.Background(), uuid.New(), []CephOSDMetrics{
+		{OSDID: 0, Up: true, In: true, LastDeepScrub: &lastDeepScrub},
+		{OSDID: 1, Up: true, In: true, UsagePercent: &nearFull, PGsDegraded: &degradedPGs, ObjectsMisplaced: &misplaced},
+		{OSDID: 2, Up: false, In: false},
+	})
+	assert.NoError(t, err)
+	if !assert.Len(t, submission.Filesystems, 3) {
+		return
+	}
+
+	assert.Equal(t, "ceph", submission.Filesystems[0].FilesystemType)
+	assert.Equal(t, "osd.0", submission.Filesystems[0].FilesystemName)
+	assert.Equal(t, 0, *submission.Filesystems[0].CephOSDID)
+	assert.Equal(t, "HEALTHY", submission.Filesystems[0].OverallHealth)
+	assert.True(t, lastDeepScrub.Equal(*submission.Filesystems[0].CephLastDeepScrub))
+
+	degraded := submission.Filesystems[1]
+	assert.Equal(t, "WARNING", degraded.OverallHealth)
+	assert.Equal(t, 3, degraded.WarningCount)
+	assert.Equal(t, 60.0, *degraded.HealthScore)
+
+	down := submission.Filesystems[2]
+	assert.Equal(t, "CRITICAL", down.OverallHealth)
+	assert.False(t, *down.CephOSDUp)
+	assert.Equal(t, 0.0, *down.HealthScore)
+	assert.Contains(t, down.CriticalAlerts, "OSD is down")
+}
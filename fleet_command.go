@@ -0,0 +1,476 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	defaultFleetCommandConcurrency = 10
+	defaultFleetCommandTimeout     = 60 * time.Second
+)
+
+// FleetCommandStatus is the outcome of a command on one server
+type FleetCommandStatus string
+
+// Fleet command statuses
+const (
+	FleetCommandSucceeded FleetCommandStatus = "succeeded" // Exit code 0
+	FleetCommandFailed    FleetCommandStatus = "failed"    // Non-zero exit code
+	FleetCommandTimedOut  FleetCommandStatus = "timed_out" // No result within the timeout
+	FleetCommandError     FleetCommandStatus = "error"     // The request failed
+	FleetCommandSkipped   FleetCommandStatus = "skipped"   // Not run because ctx was canceled
+)
+
+// ServerSelector selects servers by a tag expression. Expressions combine tags
+// with && (or "and"), || (or "or"), ! (or "not") and parentheses; tags may use
+// * and ? wildcards. Examples:
+//
+//	prod && web
+//	env:prod && (role:web || role:api) && !draining
+//	region:eu-*
+type ServerSelector struct {
+	expr string
+	root selectorNode
+}
+
+type selectorNode interface {
+	match(tags map[string]bool) bool
+}
+
+type selectorTag string
+type selectorNot struct{ node selectorNode }
+type selectorAnd []selectorNode
+type selectorOr []selectorNode
+
+func (n selectorTag) match(tags map[string]bool) bool {
+	if !strings.ContainsAny(string(n), "*?[") {
+		return tags[string(n)]
+	}
+	for tag := range tags {
+		if ok, _ := path.Match(string(n), tag); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (n selectorNot) match(tags map[string]bool) bool { return !n.node.match(tags) }
+
+func (n selectorAnd) match(tags map[string]bool) bool {
+	for _, node := range n {
+		if !node.match(tags) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n selectorOr) match(tags map[string]bool) bool {
+	for _, node := range n {
+		if node.match(tags) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseServerSelector parses a tag expression. An empty expression selects
+// every server.
+func ParseServerSelector(expr string) (*ServerSelector, error) {
+	p := &selectorParser{tokens: tokenizeSelector(expr)}
+	if len(p.tokens) == 0 {
+		return &ServerSelector{expr: expr, root: selectorAnd{}}, nil
+	}
+
+	root, err := p.or()
+	if err != nil {
+		return nil, fmt.Errorf("invalid server selector %q: %w", expr, err)
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("invalid server selector %q: unexpected %q", expr, p.tokens[p.pos])
+	}
+	return &ServerSelector{expr: expr, root: root}, nil
+}
+
+// Match returns true if the tags satisfy the selector
+func (s *ServerSelector) Match(tags []string) bool {
+	set := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		set[tag] = true
+	}
+	return s.root.match(set)
+}
+
+// String returns the selector's expression
+func (s *ServerSelector) String() string {
+	return s.expr
+}
+
+func tokenizeSelector(expr string) []string {
+	var tokens []string
+	for i := 0; i < len(expr); {
+		switch c := expr[i]; {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '(' || c == ')' || c == '!':
+			tokens = append(tokens, string(c))
+			i++
+		case strings.HasPrefix(expr[i:], "&&") || strings.HasPrefix(expr[i:], "||"):
+			tokens = append(tokens, expr[i:i+2])
+			i += 2
+		default:
+			j := i
+			for j < len(expr) && !strings.ContainsRune(" \t\n()!&|", rune(expr[j])) {
+				j++
+			}
+			if j == i {
+				// A lone & or |
+				j++
+			}
+			tokens = append(tokens, expr[i:j])
+			i = j
+		}
+	}
+	return tokens
+}
+
+type selectorParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *selectorParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *selectorParser) or() (selectorNode, error) {
+	var nodes selectorOr
+	for {
+		node, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+		if tok := p.peek(); tok != "||" && tok != "or" {
+			break
+		}
+		p.pos++
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return nodes, nil
+}
+
+func (p *selectorParser) and() (selectorNode, error) {
+	var nodes selectorAnd
+	for {
+		node, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+		if tok := p.peek(); tok != "&&" && tok != "and" {
+			break
+		}
+		p.pos++
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return nodes, nil
+}
+
+func (p *selectorParser) unary() (selectorNode, error) {
+	tok := p.peek()
+	switch tok {
+	case "":
+		return nil, fmt.Errorf("unexpected end of expression")
+	case "!", "not":
+		p.pos++
+		node, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return selectorNot{node}, nil
+	case "(":
+		p.pos++
+		node, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing )")
+		}
+		p.pos++
+		return node, nil
+	case ")", "&&", "||", "and", "or", "&", "|":
+		return nil, fmt.Errorf("unexpected %q", tok)
+	}
+	if _, err := path.Match(tok, ""); err != nil {
+		return nil, fmt.Errorf("invalid tag pattern %q", tok)
+	}
+	p.pos++
+	return selectorTag(tok), nil
+}
+
+// FleetCommandOptions configures ExecuteOnFleet
+type FleetCommandOptions struct {
+	// Selector is a tag expression choosing the servers; see ServerSelector
+	Selector string
+
+	// ServerUUIDs run the command on these servers in addition to the selected ones
+	ServerUUIDs []string
+
+	// Concurrency limits how many servers run the command at once (default: 10)
+	Concurrency int
+
+	// Timeout per server (default: 60s)
+	Timeout time.Duration
+
+	// OnResult is called as each server completes, e.g. to show progress
+	OnResult func(*FleetCommandResult)
+}
+
+// FleetCommandResult is the outcome of a command on one server
+type FleetCommandResult struct {
+	ServerUUID string             `json:"server_uuid"`
+	Hostname   string             `json:"hostname,omitempty"`
+	Status     FleetCommandStatus `json:"status"`
+	ExitCode   int                `json:"exit_code"`
+	Stdout     string             `json:"stdout,omitempty"`
+	Stderr     string             `json:"stderr,omitempty"`
+	Error      string             `json:"error,omitempty"`
+	Duration   time.Duration      `json:"duration"`
+}
+
+// FleetCommandReport consolidates the results of a command run across servers
+type FleetCommandReport struct {
+	Command    string               `json:"command"`
+	Selector   string               `json:"selector,omitempty"`
+	StartedAt  time.Time            `json:"started_at"`
+	FinishedAt time.Time            `json:"finished_at"`
+	Results    []FleetCommandResult `json:"results"` // Sorted by hostname
+}
+
+// FleetOutputGroup is a set of servers that produced the same output
+type FleetOutputGroup struct {
+	Status   FleetCommandStatus
+	ExitCode int
+	Output   string
+	Servers  []string // Hostnames, or UUIDs for servers without one
+}
+
+// Counts returns the number of servers per status
+func (r *FleetCommandReport) Counts() map[FleetCommandStatus]int {
+	counts := make(map[FleetCommandStatus]int)
+	for _, result := range r.Results {
+		counts[result.Status]++
+	}
+	return counts
+}
+
+// Succeeded returns true if the command exited 0 on every server
+func (r *FleetCommandReport) Succeeded() bool {
+	return r.Counts()[FleetCommandSucceeded] == len(r.Results)
+}
+
+// Groups groups servers with identical status, exit code and output, largest
+// group first, so a fleet's output reads as a handful of distinct answers
+func (r *FleetCommandReport) Groups() []FleetOutputGroup {
+	var groups []FleetOutputGroup
+	index := make(map[string]int)
+	for _, result := range r.Results {
+		output := result.Stdout + result.Stderr + result.Error
+		key := fmt.Sprintf("%s\x00%d\x00%s", result.Status, result.ExitCode, output)
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, FleetOutputGroup{Status: result.Status, ExitCode: result.ExitCode, Output: output})
+		}
+		groups[i].Servers = append(groups[i].Servers, result.name())
+	}
+	sort.SliceStable(groups, func(i, j int) bool { return len(groups[i].Servers) > len(groups[j].Servers) })
+	return groups
+}
+
+// Write prints a summary table followed by the output groups
+func (r *FleetCommandReport) Write(w io.Writer) error {
+	var b strings.Builder
+	tw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "SERVER\tSTATUS\tEXIT\tDURATION\n")
+	for _, result := range r.Results {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\n", result.name(), result.Status, result.ExitCode, result.Duration.Round(time.Millisecond))
+	}
+	tw.Flush()
+
+	counts := r.Counts()
+	fmt.Fprintf(&b, "\n%d servers: %d succeeded, %d failed, %d timed out, %d errors, %d skipped in %s\n",
+		len(r.Results), counts[FleetCommandSucceeded], counts[FleetCommandFailed], counts[FleetCommandTimedOut],
+		counts[FleetCommandError], counts[FleetCommandSkipped], r.FinishedAt.Sub(r.StartedAt).Round(time.Millisecond))
+
+	for _, group := range r.Groups() {
+		fmt.Fprintf(&b, "\n== %s (exit %d) on %d servers: %s\n", group.Status, group.ExitCode, len(group.Servers), strings.Join(group.Servers, ", "))
+		if group.Output != "" {
+			fmt.Fprintln(&b, strings.TrimRight(group.Output, "\n"))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (r *FleetCommandResult) name() string {
+	if r.Hostname != "" {
+		return r.Hostname
+	}
+	return r.ServerUUID
+}
+
+// ExecuteOnFleet runs a command on every server matching opts.Selector and in
+// opts.ServerUUIDs, with bounded concurrency and a per-server timeout. A
+// server's failure is recorded in its result rather than failing the call; the
+// error is only set if the servers could not be listed, or ctx's error if it
+// was canceled midway, in which case the remaining servers are reported as
+// skipped.
+func (s *ServersService) ExecuteOnFleet(ctx context.Context, command string, opts *FleetCommandOptions) (*FleetCommandReport, error) {
+	if command == "" {
+		return nil, fmt.Errorf("command is required")
+	}
+	if opts == nil {
+		opts = &FleetCommandOptions{}
+	}
+	if opts.Selector == "" && len(opts.ServerUUIDs) == 0 {
+		return nil, fmt.Errorf("a selector or server UUIDs are required")
+	}
+
+	targets, err := s.fleetTargets(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultFleetCommandConcurrency
+	}
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultFleetCommandTimeout
+	}
+
+	report := &FleetCommandReport{
+		Command:   command,
+		Selector:  opts.Selector,
+		StartedAt: time.Now(),
+		Results:   make([]FleetCommandResult, len(targets)),
+	}
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+	for i, target := range targets {
+		report.Results[i] = FleetCommandResult{ServerUUID: target.ServerUUID, Hostname: target.Hostname, Status: FleetCommandSkipped}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *FleetCommandResult) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			s.runFleetCommand(ctx, command, timeout, result)
+			if opts.OnResult != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				opts.OnResult(result)
+			}
+		}(&report.Results[i])
+	}
+	wg.Wait()
+	report.FinishedAt = time.Now()
+
+	sort.SliceStable(report.Results, func(i, j int) bool { return report.Results[i].name() < report.Results[j].name() })
+	return report, ctx.Err()
+}
+
+// fleetTargets resolves the selector and explicit UUIDs to servers
+func (s *ServersService) fleetTargets(ctx context.Context, opts *FleetCommandOptions) ([]Server, error) {
+	var targets []Server
+	seen := make(map[string]bool)
+
+	if opts.Selector != "" {
+		selector, err := ParseServerSelector(opts.Selector)
+		if err != nil {
+			return nil, err
+		}
+		err = eachPage(ctx, s.client, "/v2/servers", nil, func(server *Server) error {
+			if selector.Match(server.Tags) && !seen[server.ServerUUID] {
+				seen[server.ServerUUID] = true
+				targets = append(targets, *server)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list servers: %w", err)
+		}
+	}
+
+	for _, uuid := range opts.ServerUUIDs {
+		if uuid != "" && !seen[uuid] {
+			seen[uuid] = true
+			targets = append(targets, Server{ServerUUID: uuid})
+		}
+	}
+	return targets, nil
+}
+
+// runFleetCommand runs the command on one server and fills in its result
+func (s *ServersService) runFleetCommand(ctx context.Context, command string, timeout time.Duration, result *FleetCommandResult) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	started := time.Now()
+	output, err := s.ExecuteCommand(ctx, result.ServerUUID, command)
+	result.Duration = time.Since(started)
+
+	switch {
+	case err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded):
+		result.Status = FleetCommandTimedOut
+		result.Error = fmt.Sprintf("no result within %s", timeout)
+	case err != nil && ctx.Err() != nil:
+		result.Status = FleetCommandSkipped
+		result.Error = ctx.Err().Error()
+	case err != nil:
+		result.Status = FleetCommandError
+		result.Error = err.Error()
+	default:
+		result.Stdout, _ = output["stdout"].(string)
+		result.Stderr, _ = output["stderr"].(string)
+		if code, ok := output["exit_code"].(float64); ok {
+			result.ExitCode = int(code)
+		}
+		result.Status = FleetCommandSucceeded
+		if result.ExitCode != 0 {
+			result.Status = FleetCommandFailed
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseServerSelector(t *testing.T) {
+	tests := []struct {
+		expr  string
+		tags  []string
+		match bool
+	}{
+		{"prod", []string{"prod", "web"}, true},
+		{"prod && web", []string{"prod"}, false},
+		{"prod and web", []string{"web", "prod"}, true},
+		{"env:prod && (role:web || role:api) && !draining", []string{"env:prod", "role:api"}, true},
+		{"env:prod && (role:web || role:api) && !draining", []string{"env:prod", "role:api", "draining"}, false},
+		{"not staging or canary", []string{"staging", "canary"}, true},
+		{"region:eu-*", []string{"region:eu-west-1"}, true},
+		{"region:eu-*", []string{"region:us-east-1"}, false},
+		{"", nil, true},
+	}
+	for _, tt := range tests {
+		selector, err := ParseServerSelector(tt.expr)
+		require.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.match, selector.Match(tt.tags), "%q on %v", tt.expr, tt.tags)
+	}
+
+	for _, expr := range []string{"prod &&", "(prod", "prod web", "prod & web", "|| prod", "a[", "!"} {
+		_, err := ParseServerSelector(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestServersService_ExecuteOnFleet(t *testing.T) {
+	var mu sync.Mutex
+	var executed []string
+	hang := make(chan struct{})
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/v2/servers" {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data": []Server{
+					{ServerUUID: "srv-1", Hostname: "web1", Tags: []string{"env:prod", "role:web"}},
+					{ServerUUID: "srv-2", Hostname: "web2", Tags: []string{"env:prod", "role:web"}},
+					{ServerUUID: "srv-3", Hostname: "web3", Tags: []string{"env:prod", "role:web"}},
+					{ServerUUID: "srv-4", Hostname: "web4", Tags: []string{"env:prod", "role:web"}},
+					{ServerUUID: "srv-5", Hostname: "db1", Tags: []string{"env:prod", "role:db"}},
+				},
+				"meta": PaginationMeta{Page: 1, TotalPages: 1},
+			})
+			return
+		}
+
+		uuid := strings.Split(r.URL.Path, "/")[3]
+		mu.Lock()
+		executed = append(executed, uuid)
+		mu.Unlock()
+
+		switch uuid {
+		case "srv-1", "srv-2":
+			w.Write([]byte(`{"status":"success","data":{"stdout":"nginx 1.24\n","stderr":"","exit_code":0}}`))
+		case "srv-3":
+			w.Write([]byte(`{"status":"success","data":{"stdout":"","stderr":"nginx: not found","exit_code":127}}`))
+		case "srv-4":
+			<-hang
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":"error","message":"server not found"}`))
+		}
+	}), Config{})
+	// Registered after the server's cleanup, so it runs first and lets the
+	// server close
+	t.Cleanup(func() { close(hang) })
+
+	var progress int
+	report, err := client.Servers.ExecuteOnFleet(context.Background(), "nginx -v", &FleetCommandOptions{
+		Selector:    "env:prod && role:web",
+		ServerUUIDs: []string{"srv-1", "srv-missing"},
+		Concurrency: 2,
+		Timeout:     time.Second,
+		OnResult:    func(*FleetCommandResult) { progress++ },
+	})
+	require.NoError(t, err)
+	mu.Lock()
+	assert.ElementsMatch(t, []string{"srv-1", "srv-2", "srv-3", "srv-4", "srv-missing"}, executed)
+	mu.Unlock()
+	assert.Equal(t, 5, progress)
+
+	require.Len(t, report.Results, 5)
+	assert.Equal(t, "srv-missing", report.Results[0].name())
+	assert.Equal(t, FleetCommandError, report.Results[0].Status)
+	assert.Equal(t, FleetCommandSucceeded, report.Results[1].Status)
+	assert.Equal(t, "nginx 1.24\n", report.Results[1].Stdout)
+	assert.Equal(t, FleetCommandFailed, report.Results[3].Status)
+	assert.Equal(t, 127, report.Results[3].ExitCode)
+	assert.Equal(t, FleetCommandTimedOut, report.Results[4].Status)
+	assert.False(t, report.Succeeded())
+	assert.Equal(t, map[FleetCommandStatus]int{
+		FleetCommandSucceeded: 2, FleetCommandFailed: 1, FleetCommandTimedOut: 1, FleetCommandError: 1,
+	}, report.Counts())
+
+	groups := report.Groups()
+	require.Len(t, groups, 4)
+	assert.Equal(t, []string{"web1", "web2"}, groups[0].Servers)
+	assert.Equal(t, "nginx 1.24\n", groups[0].Output)
+
+	var out strings.Builder
+	require.NoError(t, report.Write(&out))
+	assert.Contains(t, out.String(), "5 servers: 2 succeeded, 1 failed, 1 timed out, 1 errors, 0 skipped")
+	assert.Contains(t, out.String(), "== succeeded (exit 0) on 2 servers: web1, web2\nnginx 1.24\n")
+
+	_, err = client.Servers.ExecuteOnFleet(context.Background(), "uptime", nil)
+	assert.Error(t, err)
+	_, err = client.Servers.ExecuteOnFleet(context.Background(), "uptime", &FleetCommandOptions{Selector: "prod &&"})
+	assert.Error(t, err)
+}
+
+func TestServersService_ExecuteOnFleet_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client, err := NewClient(&Config{BaseURL: "http://127.0.0.1:0", Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	report, err := client.Servers.ExecuteOnFleet(ctx, "uptime", &FleetCommandOptions{ServerUUIDs: []string{"srv-1", "srv-2"}})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 2, report.Counts()[FleetCommandSkipped])
+}
@@ -0,0 +1,111 @@
+package nexmonyx
+
+import "time"
+
+// GPUMetrics represents the runtime metrics of one GPU
+type GPUMetrics struct {
+	Index  int    `json:"index"`
+	UUID   string `json:"uuid,omitempty"`
+	Name   string `json:"name,omitempty"`   // e.g. "NVIDIA A100-SXM4-80GB"
+	Vendor string `json:"vendor,omitempty"` // nvidia, amd, intel
+	BusID  string `json:"bus_id,omitempty"`
+
+	UtilizationPercent       float64 `json:"utilization_percent"`
+	MemoryUtilizationPercent float64 `json:"memory_utilization_percent,omitempty"` // Memory controller busy time
+	MemoryUsedBytes          int64   `json:"memory_used_bytes"`
+	MemoryTotalBytes         int64   `json:"memory_total_bytes"`
+	TemperatureCelsius       float64 `json:"temperature_celsius,omitempty"`
+	FanSpeedPercent          float64 `json:"fan_speed_percent,omitempty"`
+	PowerDrawWatts           float64 `json:"power_draw_watts,omitempty"`
+	PowerLimitWatts          float64 `json:"power_limit_watts,omitempty"`
+
+	GraphicsClockMHz    int `json:"graphics_clock_mhz,omitempty"`
+	MemoryClockMHz      int `json:"memory_clock_mhz,omitempty"`
+	MaxGraphicsClockMHz int `json:"max_graphics_clock_mhz,omitempty"`
+	MaxMemoryClockMHz   int `json:"max_memory_clock_mhz,omitempty"`
+
+	Processes []GPUProcessMetrics `json:"processes,omitempty"`
+}
+
+// GPUProcessMetrics represents a process using a GPU
+type GPUProcessMetrics struct {
+	PID             int    `json:"pid"`
+	Name            string `json:"name,omitempty"`
+	Type            string `json:"type,omitempty"` // compute, graphics
+	MemoryUsedBytes int64  `json:"memory_used_bytes"`
+}
+
+// NewGPUMetrics creates a new GPUMetrics instance
+func NewGPUMetrics(index int, name string) *GPUMetrics {
+	return &GPUMetrics{
+		Index:     index,
+		Name:      name,
+		Processes: make([]GPUProcessMetrics, 0),
+	}
+}
+
+// AddProcess adds a process using the GPU
+func (g *GPUMetrics) AddProcess(process GPUProcessMetrics) {
+	if g.Processes == nil {
+		g.Processes = make([]GPUProcessMetrics, 0)
+	}
+	g.Processes = append(g.Processes, process)
+}
+
+// SetMemory sets the used and total GPU memory in bytes
+func (g *GPUMetrics) SetMemory(usedBytes, totalBytes int64) {
+	g.MemoryUsedBytes = usedBytes
+	g.MemoryTotalBytes = totalBytes
+}
+
+// MemoryUsedPercent returns the percentage of GPU memory in use
+func (g *GPUMetrics) MemoryUsedPercent() float64 {
+	if g.MemoryTotalBytes <= 0 {
+		return 0
+	}
+	return float64(g.MemoryUsedBytes) / float64(g.MemoryTotalBytes) * 100
+}
+
+// TemperatureStatus returns ok, warning or critical for the GPU temperature
+func (g *GPUMetrics) TemperatureStatus(warning, critical float64) string {
+	return DetermineTemperatureStatus(g.TemperatureCelsius, warning, critical)
+}
+
+// AggregateGPUMetrics summarizes per-GPU metrics for AggregatedMetricsRequest
+func AggregateGPUMetrics(gpus []GPUMetrics) *GPUAggregation {
+	agg := &GPUAggregation{
+		TotalGPUs:    len(gpus),
+		CalculatedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	if len(gpus) == 0 {
+		return agg
+	}
+
+	var usage, temperature float64
+	temperatures := 0
+	for _, gpu := range gpus {
+		usage += gpu.UtilizationPercent
+		if gpu.UtilizationPercent > agg.MaxUsagePercent {
+			agg.MaxUsagePercent = gpu.UtilizationPercent
+		}
+		agg.TotalMemoryBytes += SafeInt64ToUint64OrZero(gpu.MemoryTotalBytes)
+		agg.UsedMemoryBytes += SafeInt64ToUint64OrZero(gpu.MemoryUsedBytes)
+		if gpu.TemperatureCelsius > 0 {
+			temperature += gpu.TemperatureCelsius
+			temperatures++
+			if gpu.TemperatureCelsius > agg.MaxTemperature {
+				agg.MaxTemperature = gpu.TemperatureCelsius
+			}
+		}
+		agg.PowerUsageWatts += gpu.PowerDrawWatts
+	}
+
+	agg.AvgUsagePercent = usage / float64(len(gpus))
+	if temperatures > 0 {
+		agg.AvgTemperature = temperature / float64(temperatures)
+	}
+	if agg.TotalMemoryBytes > 0 {
+		agg.MemoryUsedPercent = float64(agg.UsedMemoryBytes) / float64(agg.TotalMemoryBytes) * 100
+	}
+	return agg
+}
@@ -0,0 +1,75 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGPU(index int, utilization, temperature float64) GPUMetrics {
+	gpu := NewGPUMetrics(index, "NVIDIA A100-SXM4-80GB")
+	gpu.UtilizationPercent = utilization
+	gpu.TemperatureCelsius = temperature
+	gpu.PowerDrawWatts = 250
+	gpu.GraphicsClockMHz = 1410
+	gpu.MemoryClockMHz = 1593
+	gpu.SetMemory(20<<30, 80<<30)
+	gpu.AddProcess(GPUProcessMetrics{PID: 4242, Name: "python3", Type: "compute", MemoryUsedBytes: 18 << 30})
+	return *gpu
+}
+
+func TestGPUMetrics_Helpers(t *testing.T) {
+	gpu := newTestGPU(0, 90, 84)
+	assert.Equal(t, 25.0, gpu.MemoryUsedPercent())
+	assert.Equal(t, "warning", gpu.TemperatureStatus(80, 90))
+	assert.Len(t, gpu.Processes, 1)
+
+	assert.Zero(t, (&GPUMetrics{}).MemoryUsedPercent())
+
+	agg := AggregateGPUMetrics([]GPUMetrics{gpu, newTestGPU(1, 30, 60)})
+	assert.Equal(t, 2, agg.TotalGPUs)
+	assert.Equal(t, 60.0, agg.AvgUsagePercent)
+	assert.Equal(t, 90.0, agg.MaxUsagePercent)
+	assert.Equal(t, uint64(160<<30), agg.TotalMemoryBytes)
+	assert.Equal(t, 25.0, agg.MemoryUsedPercent)
+	assert.Equal(t, 72.0, agg.AvgTemperature)
+	assert.Equal(t, 500.0, agg.PowerUsageWatts)
+}
+
+func TestMetricsService_SubmitComprehensive_GPU(t *testing.T) {
+	metrics := &ComprehensiveMetricsRequest{
+		ServerUUID:  "srv-1",
+		CollectedAt: time.Now().UTC().Format(time.RFC3339),
+		GPU:         []GPUMetrics{newTestGPU(0, 90, 84), newTestGPU(1, 30, 60)},
+	}
+	require.Empty(t, metrics.Validate())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var received ComprehensiveMetricsRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		assert.Equal(t, metrics.GPU, received.GPU)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{ServerUUID: "srv-1", ServerSecret: "secret"}})
+	require.NoError(t, err)
+	require.NoError(t, client.Metrics.SubmitComprehensive(context.Background(), metrics))
+
+	metrics.GPU[1].UtilizationPercent = 120
+	metrics.GPU[1].MemoryUsedBytes = 100 << 30
+	metrics.GPU[1].Processes[0].PID = 0
+	assert.ElementsMatch(t, []string{
+		"gpu[1].utilization_percent",
+		"gpu[1].memory_used_bytes",
+		"gpu[1].processes[0].pid",
+	}, issueFields(metrics.Validate(), ValidationSeverityError))
+}
@@ -0,0 +1,318 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Hardware component types compared by DiffHardwareInventory
+const (
+	HardwareComponentSystem         = "system"
+	HardwareComponentMotherboard    = "motherboard"
+	HardwareComponentBMC            = "bmc"
+	HardwareComponentCPU            = "cpu"
+	HardwareComponentMemoryModule   = "memory_module"
+	HardwareComponentStorage        = "storage"
+	HardwareComponentNetwork        = "network"
+	HardwareComponentGPU            = "gpu"
+	HardwareComponentPowerSupply    = "power_supply"
+	HardwareComponentRAIDController = "raid_controller"
+)
+
+// HardwareInventoryDiff is the structured difference between two hardware
+// inventories of a server
+type HardwareInventoryDiff struct {
+	ServerUUID string                  `json:"server_uuid,omitempty"`
+	From       time.Time               `json:"from"` // Collection time of the older inventory
+	To         time.Time               `json:"to"`   // Collection time of the newer inventory
+	Added      []HardwareComponentDiff `json:"added,omitempty"`
+	Removed    []HardwareComponentDiff `json:"removed,omitempty"`
+	Replaced   []HardwareComponentDiff `json:"replaced,omitempty"` // A different part in the same slot, e.g. a swapped DIMM or disk
+	Changed    []HardwareComponentDiff `json:"changed,omitempty"`  // The same part with different attributes, e.g. new firmware
+}
+
+// HardwareComponentDiff describes one added, removed, replaced, or changed component
+type HardwareComponentDiff struct {
+	ComponentType string                `json:"component_type"`     // HardwareComponent* constant
+	Key           string                `json:"key"`                // Serial number, MAC address, slot, or position
+	Location      string                `json:"location,omitempty"` // Slot, device name, or bus ID when known
+	Before        map[string]string     `json:"before,omitempty"`   // Attributes in the older inventory
+	After         map[string]string     `json:"after,omitempty"`    // Attributes in the newer inventory
+	Changes       []HardwareFieldChange `json:"changes,omitempty"`  // Attributes that differ, for replaced and changed components
+}
+
+// HardwareFieldChange is an attribute that differs between two inventories
+type HardwareFieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// HasChanges reports whether the inventories differ
+func (d *HardwareInventoryDiff) HasChanges() bool {
+	return len(d.Added)+len(d.Removed)+len(d.Replaced)+len(d.Changed) > 0
+}
+
+// FirmwareDrift returns the changed components whose firmware or BIOS version differs
+func (d *HardwareInventoryDiff) FirmwareDrift() []HardwareComponentDiff {
+	var drift []HardwareComponentDiff
+	for _, component := range d.Changed {
+		for _, change := range component.Changes {
+			if change.Field == "firmware_version" || change.Field == "bios_version" {
+				drift = append(drift, component)
+				break
+			}
+		}
+	}
+	return drift
+}
+
+// hardwareComponent is a component flattened for comparison
+type hardwareComponent struct {
+	componentType string
+	key           string
+	location      string
+	fields        map[string]string
+}
+
+// DiffHardwareInventory compares two inventories of the same server.
+// Components are matched by serial number or MAC address when reported and
+// by slot or position otherwise, so a part with a new serial number in an
+// occupied slot is reported as replaced rather than removed and added.
+func DiffHardwareInventory(from, to *HardwareInventoryInfo) *HardwareInventoryDiff {
+	diff := &HardwareInventoryDiff{}
+	before := flattenHardwareInventory(from)
+	after := flattenHardwareInventory(to)
+
+	afterByKey := make(map[string]*hardwareComponent, len(after))
+	for i := range after {
+		afterByKey[after[i].componentType+"/"+after[i].key] = &after[i]
+	}
+
+	matched := make(map[*hardwareComponent]bool)
+	var removed []hardwareComponent
+	for _, old := range before {
+		current, ok := afterByKey[old.componentType+"/"+old.key]
+		if !ok {
+			removed = append(removed, old)
+			continue
+		}
+		matched[current] = true
+		if changes := diffHardwareFields(old.fields, current.fields); len(changes) > 0 {
+			diff.Changed = append(diff.Changed, HardwareComponentDiff{
+				ComponentType: old.componentType,
+				Key:           old.key,
+				Location:      current.location,
+				Before:        old.fields,
+				After:         current.fields,
+				Changes:       changes,
+			})
+		}
+	}
+
+	// Pair removed and added parts of the same type in the same slot
+	addedByLocation := make(map[string]*hardwareComponent)
+	for i := range after {
+		if !matched[&after[i]] && after[i].location != "" {
+			addedByLocation[after[i].componentType+"/"+after[i].location] = &after[i]
+		}
+	}
+	for _, old := range removed {
+		current, ok := addedByLocation[old.componentType+"/"+old.location]
+		if old.location == "" || !ok || matched[current] {
+			diff.Removed = append(diff.Removed, HardwareComponentDiff{ComponentType: old.componentType, Key: old.key, Location: old.location, Before: old.fields})
+			continue
+		}
+		matched[current] = true
+		diff.Replaced = append(diff.Replaced, HardwareComponentDiff{
+			ComponentType: old.componentType,
+			Key:           current.key,
+			Location:      old.location,
+			Before:        old.fields,
+			After:         current.fields,
+			Changes:       diffHardwareFields(old.fields, current.fields),
+		})
+	}
+
+	for i := range after {
+		if !matched[&after[i]] {
+			diff.Added = append(diff.Added, HardwareComponentDiff{ComponentType: after[i].componentType, Key: after[i].key, Location: after[i].location, After: after[i].fields})
+		}
+	}
+	return diff
+}
+
+func diffHardwareFields(before, after map[string]string) []HardwareFieldChange {
+	names := make(map[string]bool, len(before)+len(after))
+	for name := range before {
+		names[name] = true
+	}
+	for name := range after {
+		names[name] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	var changes []HardwareFieldChange
+	for _, name := range sorted {
+		if before[name] != after[name] {
+			changes = append(changes, HardwareFieldChange{Field: name, Before: before[name], After: after[name]})
+		}
+	}
+	return changes
+}
+
+// flattenHardwareInventory lists the inventory's components with their
+// identifying key and compared attributes. Empty attributes are omitted.
+func flattenHardwareInventory(inv *HardwareInventoryInfo) []hardwareComponent {
+	if inv == nil {
+		return nil
+	}
+	var components []hardwareComponent
+	seen := make(map[string]int)
+	add := func(componentType, identity, location string, index int, fields ...string) {
+		key := identity
+		if key == "" {
+			key = location
+		}
+		if key == "" {
+			key = fmt.Sprintf("#%d", index)
+		}
+		// Keep keys unique when parts report the same serial or none at all
+		if n := seen[componentType+"/"+key]; n > 0 {
+			seen[componentType+"/"+key]++
+			key = fmt.Sprintf("%s#%d", key, n+1)
+		} else {
+			seen[componentType+"/"+key] = 1
+		}
+
+		values := make(map[string]string)
+		for i := 0; i+1 < len(fields); i += 2 {
+			if fields[i+1] != "" && fields[i+1] != "0" {
+				values[fields[i]] = fields[i+1]
+			}
+		}
+		components = append(components, hardwareComponent{componentType: componentType, key: key, location: location, fields: values})
+	}
+
+	if s := inv.System; s != nil {
+		add(HardwareComponentSystem, "", "", 0,
+			"manufacturer", s.Manufacturer, "product_name", s.ProductName, "serial_number", s.SerialNumber, "uuid", s.UUID)
+	}
+	if m := inv.Motherboard; m != nil {
+		fields := []string{"manufacturer", m.Manufacturer, "product_name", m.ProductName, "serial_number", m.SerialNumber, "version", m.Version}
+		if m.BIOS != nil {
+			fields = append(fields, "bios_vendor", m.BIOS.Vendor, "bios_version", m.BIOS.Version, "bios_release_date", m.BIOS.ReleaseDate)
+		}
+		add(HardwareComponentMotherboard, "", "", 0, fields...)
+	}
+	if b := inv.BMC; b != nil {
+		add(HardwareComponentBMC, "", "", 0,
+			"type", b.Type, "model", b.Model, "firmware_version", b.FirmwareVersion, "firmware_build_date", b.FirmwareBuildDate)
+	}
+	for i, cpu := range inv.CPUs {
+		add(HardwareComponentCPU, "", cpu.Socket, i,
+			"model", cpu.Model, "cores", fmt.Sprint(cpu.Cores), "threads", fmt.Sprint(cpu.Threads))
+	}
+
+	modules := inv.MemoryModules
+	if len(modules) == 0 && inv.Memory != nil {
+		modules = inv.Memory.Modules
+	}
+	for i, m := range modules {
+		size := m.Size
+		if size == 0 && m.SizeGB > 0 {
+			size = int64(m.SizeGB * 1024 * 1024 * 1024)
+		}
+		speed := m.SpeedMHz
+		if speed == 0 {
+			speed = m.Speed
+		}
+		add(HardwareComponentMemoryModule, m.SerialNumber, m.Slot, i,
+			"size", fmt.Sprint(size), "type", m.Type, "speed_mhz", fmt.Sprint(speed),
+			"manufacturer", m.Manufacturer, "part_number", m.PartNumber, "serial_number", m.SerialNumber)
+	}
+
+	storage := inv.Storage
+	if len(storage) == 0 {
+		storage = inv.StorageDevices
+	}
+	for i, d := range storage {
+		add(HardwareComponentStorage, d.SerialNumber, d.DeviceName, i,
+			"model", d.Model, "capacity", fmt.Sprint(d.Capacity), "type", d.Type,
+			"firmware_version", d.FirmwareVersion, "serial_number", d.SerialNumber)
+	}
+
+	network := inv.Network
+	if len(network) == 0 {
+		network = inv.NetworkCards
+	}
+	for i, n := range network {
+		add(HardwareComponentNetwork, strings.ToLower(n.MACAddress), "", i,
+			"model", n.Model, "vendor", n.Vendor, "speed_mbps", fmt.Sprint(n.SpeedMbps),
+			"driver", n.Driver, "driver_version", n.DriverVersion)
+	}
+	for i, g := range inv.GPUs {
+		add(HardwareComponentGPU, "", g.BusID, i,
+			"model", g.Model, "vendor", g.Vendor, "memory_size", fmt.Sprint(g.MemorySize), "driver_version", g.DriverVersion)
+	}
+	for i, p := range inv.PowerSupplies {
+		add(HardwareComponentPowerSupply, p.SerialNumber, "", i,
+			"model", p.Model, "manufacturer", p.Manufacturer, "max_power_watts", fmt.Sprint(p.MaxPowerWatts), "serial_number", p.SerialNumber)
+	}
+	for i, r := range inv.RAIDControllers {
+		add(HardwareComponentRAIDController, "", "", i,
+			"manufacturer", r.Manufacturer, "model", r.Model, "firmware_version", r.FirmwareVersion)
+	}
+	return components
+}
+
+// DiffInventory compares the first and last hardware inventories collected
+// for a server between from and to, to detect swapped parts and firmware drift
+// Authentication: JWT Token required
+// Endpoint: GET /v2/hardware/inventory/{uuid}
+// Parameters:
+//   - serverUUID: Server UUID
+//   - from, to: Window whose first and last inventories are compared
+//
+// Returns: Added, removed, replaced, and changed components; empty when fewer
+// than two inventories were collected in the window
+func (s *HardwareInventoryService) DiffInventory(ctx context.Context, serverUUID string, from, to time.Time) (*HardwareInventoryDiff, error) {
+	if !to.After(from) {
+		return nil, fmt.Errorf("to must be after from")
+	}
+
+	records, err := s.GetHardwareInventory(ctx, serverUUID, &TimeRange{
+		Start: from.Format(time.RFC3339),
+		End:   to.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no hardware inventory collected for server %s between %s and %s",
+			serverUUID, from.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+
+	first, last := records[0], records[0]
+	for _, record := range records[1:] {
+		if record.CollectedAt.Before(first.CollectedAt) {
+			first = record
+		}
+		if record.CollectedAt.After(last.CollectedAt) {
+			last = record
+		}
+	}
+
+	diff := DiffHardwareInventory(&first.Hardware, &last.Hardware)
+	diff.ServerUUID = serverUUID
+	diff.From = first.CollectedAt
+	diff.To = last.CollectedAt
+	return diff, nil
+}
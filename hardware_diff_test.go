@@ -0,0 +1,102 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testHardwareInventory() *HardwareInventoryInfo {
+	return &HardwareInventoryInfo{
+		Motherboard: &MotherboardInfo{Manufacturer: "Dell", ProductName: "0X3D66", BIOS: &BIOSInfo{Version: "2.17.1"}},
+		BMC:         &BMCInventoryInfo{Type: "idrac", FirmwareVersion: "6.10.30.00"},
+		MemoryModules: []MemoryModuleInfo{
+			{Slot: "A1", SerialNumber: "DIMM-1", Size: 32 << 30, Type: "DDR4"},
+			{Slot: "A2", SerialNumber: "DIMM-2", Size: 32 << 30, Type: "DDR4"},
+		},
+		Storage: []StorageDeviceInfo{
+			{DeviceName: "/dev/sda", SerialNumber: "DISK-1", Model: "PM883", FirmwareVersion: "HXT7404Q"},
+		},
+		Network: []NetworkCardInfo{{Model: "X710", MACAddress: "AA:BB:CC:00:00:01"}},
+	}
+}
+
+func TestDiffHardwareInventory(t *testing.T) {
+	assert.False(t, DiffHardwareInventory(testHardwareInventory(), testHardwareInventory()).HasChanges())
+
+	after := testHardwareInventory()
+	after.Motherboard.BIOS.Version = "2.19.0"
+	after.MemoryModules[1].SerialNumber = "DIMM-9" // Swapped in the same slot
+	after.MemoryModules = append(after.MemoryModules, MemoryModuleInfo{Slot: "B1", SerialNumber: "DIMM-3"})
+	after.Storage[0].FirmwareVersion = "HXT7904Q"
+	after.Network = []NetworkCardInfo{{Model: "X710", MACAddress: "aa:bb:cc:00:00:01"}} // MAC case is ignored
+
+	diff := DiffHardwareInventory(testHardwareInventory(), after)
+	require.True(t, diff.HasChanges())
+
+	require.Len(t, diff.Replaced, 1)
+	assert.Equal(t, HardwareComponentMemoryModule, diff.Replaced[0].ComponentType)
+	assert.Equal(t, "A2", diff.Replaced[0].Location)
+	assert.Equal(t, "DIMM-9", diff.Replaced[0].Key)
+	assert.Equal(t, []HardwareFieldChange{{Field: "serial_number", Before: "DIMM-2", After: "DIMM-9"}}, diff.Replaced[0].Changes)
+
+	require.Len(t, diff.Added, 1)
+	assert.Equal(t, "DIMM-3", diff.Added[0].Key)
+	assert.Empty(t, diff.Removed)
+
+	drift := diff.FirmwareDrift()
+	require.Len(t, drift, 2)
+	assert.Equal(t, HardwareComponentMotherboard, drift[0].ComponentType)
+	assert.Equal(t, []HardwareFieldChange{{Field: "bios_version", Before: "2.17.1", After: "2.19.0"}}, drift[0].Changes)
+	assert.Equal(t, "DISK-1", drift[1].Key)
+
+	after = testHardwareInventory()
+	after.Storage = nil
+	after.StorageDevices = []StorageDeviceInfo{{DeviceName: "/dev/sdb", SerialNumber: "DISK-2"}}
+	diff = DiffHardwareInventory(testHardwareInventory(), after)
+	require.Len(t, diff.Removed, 1, "a disk in another slot is not a replacement")
+	assert.Equal(t, "DISK-1", diff.Removed[0].Key)
+	require.Len(t, diff.Added, 1)
+	assert.Equal(t, "/dev/sdb", diff.Added[0].Location)
+}
+
+func TestHardwareInventoryService_DiffInventory(t *testing.T) {
+	var query map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/hardware/inventory/srv-1", r.URL.Path)
+		query = map[string]string{"start": r.URL.Query().Get("start"), "end": r.URL.Query().Get("end")}
+
+		newer := testHardwareInventory()
+		newer.BMC.FirmwareVersion = "7.00.00.00"
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": []HardwareInventoryRecord{
+			{ID: 2, CollectedAt: time.Date(2026, 10, 9, 0, 0, 0, 0, time.UTC), Hardware: *newer},
+			{ID: 1, CollectedAt: time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC), Hardware: *testHardwareInventory()},
+		}})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	diff, err := client.HardwareInventory.DiffInventory(ctx, "srv-1", from, from.AddDate(0, 0, 14))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"start": "2026-10-01T00:00:00Z", "end": "2026-10-15T00:00:00Z"}, query)
+	assert.Equal(t, "srv-1", diff.ServerUUID)
+	assert.Equal(t, 2, diff.From.Day())
+	assert.Equal(t, 9, diff.To.Day())
+	require.Len(t, diff.Changed, 1)
+	assert.Equal(t, HardwareComponentBMC, diff.Changed[0].ComponentType)
+	assert.Len(t, diff.FirmwareDrift(), 1)
+
+	_, err = client.HardwareInventory.DiffInventory(ctx, "srv-1", from, from)
+	assert.Error(t, err)
+}
@@ -312,6 +312,54 @@ func (s *HealthService) DeleteHealthCheckDefinition(ctx context.Context, id uint
 	return err
 }
 
+// EnableHealthCheckDefinition resumes scheduled execution of a health check definition
+func (s *HealthService) EnableHealthCheckDefinition(ctx context.Context, id uint64) (*HealthCheckDefinitionResponse, error) {
+	return s.setHealthCheckDefinitionState(ctx, id, "enable")
+}
+
+// DisableHealthCheckDefinition stops scheduled execution of a health check definition
+// without deleting it or its results
+func (s *HealthService) DisableHealthCheckDefinition(ctx context.Context, id uint64) (*HealthCheckDefinitionResponse, error) {
+	return s.setHealthCheckDefinitionState(ctx, id, "disable")
+}
+
+func (s *HealthService) setHealthCheckDefinitionState(ctx context.Context, id uint64, action string) (*HealthCheckDefinitionResponse, error) {
+	var resp StandardResponse
+	resp.Data = &HealthCheckDefinitionResponse{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/health/definitions/%d/%s", id, action),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if def, ok := resp.Data.(*HealthCheckDefinitionResponse); ok {
+		return def, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// GetLatestHealthCheckResults retrieves the most recent results recorded for a health check definition, newest first
+func (s *HealthService) GetLatestHealthCheckResults(ctx context.Context, definitionID uint64) ([]HealthCheckResultResponse, error) {
+	var resp StandardResponse
+	var results []HealthCheckResultResponse
+	resp.Data = &results
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/health/definitions/%d/results/latest", definitionID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
 // SubmitHealthCheckResult submits a health check result for a defined health check
 func (s *HealthService) SubmitHealthCheckResult(ctx context.Context, req *SubmitHealthCheckResultRequest) (*HealthCheckResultResponse, error) {
 	var resp StandardResponse
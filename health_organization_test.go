@@ -19,9 +19,11 @@ import (
 // 3. GetHealthCheckDefinition
 // 4. UpdateHealthCheckDefinition
 // 5. DeleteHealthCheckDefinition
-// 6. SubmitHealthCheckResult
-// 7. GetOrganizationHealthStatus
-// 8. ListHealthAlerts
+// 6. EnableHealthCheckDefinition / DisableHealthCheckDefinition
+// 7. GetLatestHealthCheckResults
+// 8. SubmitHealthCheckResult
+// 9. GetOrganizationHealthStatus
+// 10. ListHealthAlerts
 
 // ==================== CreateHealthCheckDefinition Tests ====================
 
@@ -690,6 +692,101 @@ func TestHealthService_DeleteHealthCheckDefinition_Unauthorized(t *testing.T) {
 	assert.Error(t, err)
 }
 
+// ==================== Enable/DisableHealthCheckDefinition Tests ====================
+
+func TestHealthService_EnableDisableHealthCheckDefinition_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		enabled := r.URL.Path == "/v1/health/definitions/7/enable"
+		if !enabled {
+			assert.Equal(t, "/v1/health/definitions/7/disable", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(StandardResponse{
+			Status: "success",
+			Data: &HealthCheckDefinitionResponse{
+				ID:        7,
+				CheckType: "database",
+				Enabled:   enabled,
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	definition, err := client.Health.DisableHealthCheckDefinition(context.Background(), 7)
+	require.NoError(t, err)
+	assert.False(t, definition.Enabled)
+
+	definition, err = client.Health.EnableHealthCheckDefinition(context.Background(), 7)
+	require.NoError(t, err)
+	assert.True(t, definition.Enabled)
+}
+
+func TestHealthService_EnableHealthCheckDefinition_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  "error",
+			Message: "Health check definition not found",
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	_, err = client.Health.EnableHealthCheckDefinition(context.Background(), 999)
+
+	assert.True(t, IsNotFound(err))
+}
+
+// ==================== GetLatestHealthCheckResults Tests ====================
+
+func TestHealthService_GetLatestHealthCheckResults_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/health/definitions/7/results/latest", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(StandardResponse{
+			Status: "success",
+			Data: []HealthCheckResultResponse{
+				{ID: 12, DefinitionID: 7, Status: "critical", Score: 20, ConsecutiveFailures: 2},
+				{ID: 11, DefinitionID: 7, Status: "warning", Score: 60, ConsecutiveFailures: 1},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	results, err := client.Health.GetLatestHealthCheckResults(context.Background(), 7)
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, uint64(12), results[0].ID)
+	assert.Equal(t, "critical", results[0].Status)
+	assert.Equal(t, 2, results[0].ConsecutiveFailures)
+}
+
 // ==================== SubmitHealthCheckResult Tests ====================
 
 func TestHealthService_SubmitHealthCheckResult_Success_Healthy(t *testing.T) {
@@ -0,0 +1,224 @@
+package nexmonyx
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ClientHealthStatus summarizes a ClientHealth. The values match NodeInfo.Status.
+type ClientHealthStatus string
+
+const (
+	ClientHealthy   ClientHealthStatus = "healthy"
+	ClientDegraded  ClientHealthStatus = "degraded"
+	ClientUnhealthy ClientHealthStatus = "unhealthy"
+)
+
+const (
+	healthMinSuccessRate  = 95.0            // Below this the client is degraded
+	healthMaxP95Latency   = 5 * time.Second // Above this the client is degraded
+	healthUnhealthyScore  = 50              // Below this the client is unhealthy
+	healthCircuitPenalty  = 25
+	healthLatencyPenalty  = 15
+	healthSpoolPenalty    = 10
+	nodeInfoHealthKeyName = "sdk_health"
+)
+
+// ClientHealth is a point-in-time summary of how well the client is reaching
+// the API, suitable for an agent's own health endpoint or NodeInfo metadata
+type ClientHealth struct {
+	Status    ClientHealthStatus `json:"status"`
+	Score     int                `json:"score"`             // 0-100
+	Reasons   []string           `json:"reasons,omitempty"` // Why the score is below 100
+	CheckedAt time.Time          `json:"checked_at"`
+
+	// Recent requests, from the client's History
+	Requests    int           `json:"requests"`
+	Failures    int           `json:"failures"`
+	SuccessRate float64       `json:"success_rate"` // Percentage, 100 when there were no requests
+	LatencyP50  time.Duration `json:"latency_p50_ns"`
+	LatencyP95  time.Duration `json:"latency_p95_ns"`
+	LatencyP99  time.Duration `json:"latency_p99_ns"`
+	LastError   string        `json:"last_error,omitempty"`
+
+	Circuits     []CircuitState `json:"circuits,omitempty"`
+	SpoolBacklog int            `json:"spool_backlog"` // From Config.SpoolBacklog
+}
+
+// CircuitState is the state of one of the SDK's circuit breakers
+type CircuitState struct {
+	Component string    `json:"component"`
+	Open      bool      `json:"open"`
+	Trips     int       `json:"trips"` // Times the circuit has opened
+	OpenedAt  time.Time `json:"opened_at"`
+	Reason    string    `json:"reason,omitempty"`
+}
+
+// HealthScore combines the success rate and latency of the client's recent
+// requests, the state of its circuit breakers, and the spool backlog reported
+// by Config.SpoolBacklog into a single score. Transport errors, 5xx, 401, 403,
+// and 429 responses count as failures; other 4xx responses reflect the request
+// rather than the client and count as successes. Request statistics are empty
+// when Config.RequestHistorySize is negative.
+//
+// Example:
+//
+//	http.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+//	    health := client.HealthScore()
+//	    if health.Status == nexmonyx.ClientUnhealthy {
+//	        w.WriteHeader(http.StatusServiceUnavailable)
+//	    }
+//	    json.NewEncoder(w).Encode(health)
+//	})
+func (c *Client) HealthScore() *ClientHealth {
+	health := &ClientHealth{CheckedAt: time.Now().UTC(), SuccessRate: 100}
+
+	var latencies []time.Duration
+	for _, summary := range c.History() {
+		health.Requests++
+		latencies = append(latencies, summary.Duration)
+		if isHealthFailure(summary) {
+			health.Failures++
+			health.LastError = summary.Error
+		}
+	}
+	if health.Requests > 0 {
+		health.SuccessRate = 100 * float64(health.Requests-health.Failures) / float64(health.Requests)
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		health.LatencyP50 = latencyPercentile(latencies, 50)
+		health.LatencyP95 = latencyPercentile(latencies, 95)
+		health.LatencyP99 = latencyPercentile(latencies, 99)
+	}
+	health.Circuits = c.circuits.snapshot()
+	if c.config.SpoolBacklog != nil {
+		health.SpoolBacklog = c.config.SpoolBacklog()
+	}
+
+	score := health.SuccessRate
+	if health.SuccessRate < healthMinSuccessRate {
+		health.Reasons = append(health.Reasons, fmt.Sprintf("%d of %d recent requests failed", health.Failures, health.Requests))
+	}
+	for _, circuit := range health.Circuits {
+		if circuit.Open {
+			score -= healthCircuitPenalty
+			health.Reasons = append(health.Reasons, fmt.Sprintf("%s circuit open: %s", circuit.Component, circuit.Reason))
+		}
+	}
+	if health.LatencyP95 > healthMaxP95Latency {
+		score -= healthLatencyPenalty
+		health.Reasons = append(health.Reasons, fmt.Sprintf("p95 latency %s", health.LatencyP95.Round(time.Millisecond)))
+	}
+	if health.SpoolBacklog > 0 {
+		score -= healthSpoolPenalty
+		health.Reasons = append(health.Reasons, fmt.Sprintf("%d submissions spooled", health.SpoolBacklog))
+	}
+	health.Score = int(math.Round(math.Max(score, 0)))
+
+	switch {
+	case health.Score < healthUnhealthyScore:
+		health.Status = ClientUnhealthy
+	case len(health.Reasons) > 0:
+		health.Status = ClientDegraded
+	default:
+		health.Status = ClientHealthy
+	}
+	return health
+}
+
+// ApplyToNodeInfo stores the health in node.Metadata under "sdk_health" and
+// lowers node.Status when the client is less healthy than the node reports
+func (h *ClientHealth) ApplyToNodeInfo(node *NodeInfo) {
+	if node.Metadata == nil {
+		node.Metadata = make(map[string]interface{})
+	}
+	node.Metadata[nodeInfoHealthKeyName] = h
+
+	if healthRank(string(h.Status)) > healthRank(node.Status) {
+		node.Status = string(h.Status)
+	}
+}
+
+func healthRank(status string) int {
+	switch ClientHealthStatus(status) {
+	case ClientDegraded:
+		return 1
+	case ClientUnhealthy:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// isHealthFailure reports whether a request failed because of connectivity,
+// the API, or the client's credentials
+func isHealthFailure(summary RequestSummary) bool {
+	if summary.Error == "" {
+		return false
+	}
+	switch summary.StatusCode {
+	case 0, http.StatusUnauthorized, http.StatusForbidden, http.StatusTooManyRequests:
+		return true
+	}
+	return summary.StatusCode >= http.StatusInternalServerError
+}
+
+// latencyPercentile returns the nearest-rank percentile of sorted latencies
+func latencyPercentile(sorted []time.Duration, percentile float64) time.Duration {
+	rank := int(math.Ceil(percentile / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
+// circuitStates tracks the SDK's circuit breakers for HealthScore
+type circuitStates struct {
+	mu     sync.Mutex
+	states map[string]*CircuitState
+}
+
+// open records the circuit as open and publishes the event on bus
+func (s *circuitStates) open(bus *EventBus, event CircuitEvent) {
+	s.mu.Lock()
+	if s.states == nil {
+		s.states = make(map[string]*CircuitState)
+	}
+	state, ok := s.states[event.Component]
+	if !ok {
+		state = &CircuitState{Component: event.Component}
+		s.states[event.Component] = state
+	}
+	if !state.Open {
+		state.Open = true
+		state.Trips++
+		state.OpenedAt = time.Now().UTC()
+	}
+	state.Reason = event.Reason
+	s.mu.Unlock()
+
+	bus.emitCircuitOpen(event)
+}
+
+// close records the circuit as closed once the component accepts work again
+func (s *circuitStates) close(component string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if state, ok := s.states[component]; ok {
+		state.Open = false
+	}
+}
+
+// snapshot returns the circuits that have opened at least once, ordered by component
+func (s *circuitStates) snapshot() []CircuitState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]CircuitState, 0, len(s.states))
+	for _, state := range s.states {
+		out = append(out, *state)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Component < out[j].Component })
+	return out
+}
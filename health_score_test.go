@@ -0,0 +1,96 @@
+package nexmonyx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_HealthScore(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":"error","message":"not found"}`))
+		case "/v1/broken":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"status":"error","message":"database unavailable"}`))
+		default:
+			w.Write([]byte(`{"status":"success"}`))
+		}
+	}))
+	defer server.Close()
+
+	backlog := 0
+	client, err := NewClient(&Config{
+		BaseURL:       server.URL,
+		Auth:          AuthConfig{Token: "test-token"},
+		RetryWaitTime: time.Millisecond,
+		RetryMaxWait:  time.Millisecond,
+		SpoolBacklog:  func() int { return backlog },
+	})
+	require.NoError(t, err)
+
+	health := client.HealthScore()
+	assert.Equal(t, ClientHealthy, health.Status)
+	assert.Equal(t, 100, health.Score)
+	assert.Zero(t, health.Requests)
+
+	for _, path := range []string{"/v1/a", "/v1/b", "/v1/missing", "/v1/c"} {
+		client.Do(context.Background(), &Request{Method: "GET", Path: path})
+	}
+	health = client.HealthScore()
+	assert.Equal(t, ClientHealthy, health.Status, "a 404 is not a client failure")
+	assert.Equal(t, 4, health.Requests)
+	assert.Greater(t, health.LatencyP99, time.Duration(0))
+	assert.LessOrEqual(t, health.LatencyP50, health.LatencyP95)
+
+	client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/broken"})
+	backlog = 12
+	health = client.HealthScore()
+	assert.Equal(t, ClientDegraded, health.Status)
+	assert.Equal(t, 1, health.Failures)
+	assert.Equal(t, 80.0, health.SuccessRate)
+	assert.Equal(t, 70, health.Score)
+	assert.Equal(t, 12, health.SpoolBacklog)
+	assert.NotEmpty(t, health.LastError)
+	assert.Equal(t, []string{"1 of 5 recent requests failed", "12 submissions spooled"}, health.Reasons)
+
+	client.circuits.open(client.Events(), CircuitEvent{Component: "websocket", Reason: "too many pending commands"})
+	health = client.HealthScore()
+	assert.Equal(t, ClientUnhealthy, health.Status)
+	assert.Equal(t, 45, health.Score)
+	require.Len(t, health.Circuits, 1)
+	assert.True(t, health.Circuits[0].Open)
+	assert.Equal(t, 1, health.Circuits[0].Trips)
+
+	client.circuits.close("websocket")
+	health = client.HealthScore()
+	assert.False(t, health.Circuits[0].Open)
+	assert.Equal(t, 70, health.Score)
+
+	node := &NodeInfo{Status: "healthy"}
+	health.ApplyToNodeInfo(node)
+	assert.Equal(t, "degraded", node.Status)
+	assert.Same(t, health, node.Metadata["sdk_health"])
+
+	node.Status = "unhealthy"
+	health.ApplyToNodeInfo(node)
+	assert.Equal(t, "unhealthy", node.Status, "the node's own status is never raised")
+}
+
+func TestLatencyPercentile(t *testing.T) {
+	latencies := make([]time.Duration, 100)
+	for i := range latencies {
+		latencies[i] = time.Duration(i+1) * time.Millisecond
+	}
+	assert.Equal(t, 50*time.Millisecond, latencyPercentile(latencies, 50))
+	assert.Equal(t, 99*time.Millisecond, latencyPercentile(latencies, 99))
+	assert.Equal(t, 7*time.Millisecond, latencyPercentile([]time.Duration{7 * time.Millisecond}, 95))
+}
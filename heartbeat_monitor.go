@@ -0,0 +1,285 @@
+package nexmonyx
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	defaultHeartbeatMonitorInterval    = 30 * time.Second
+	defaultHeartbeatMonitorMaxInterval = 5 * time.Minute
+	defaultHeartbeatOfflineAfter       = 3
+	defaultHeartbeatRecoverAfter       = 2
+)
+
+// HeartbeatState is an agent's connectivity as seen by a HeartbeatMonitor
+type HeartbeatState string
+
+// Heartbeat state constants
+const (
+	HeartbeatHealthy  HeartbeatState = "healthy"  // Heartbeats are succeeding
+	HeartbeatDegraded HeartbeatState = "degraded" // Some recent heartbeats failed, or the agent is recovering
+	HeartbeatOffline  HeartbeatState = "offline"  // OfflineAfter consecutive heartbeats failed
+)
+
+// HeartbeatStateChange is passed to HeartbeatMonitorOptions.OnStateChange
+type HeartbeatStateChange struct {
+	From                 HeartbeatState
+	To                   HeartbeatState
+	ConsecutiveFailures  int
+	ConsecutiveSuccesses int
+	Err                  error // The heartbeat error that caused the change, nil on recovery
+}
+
+// HeartbeatMonitorOptions configures a HeartbeatMonitor
+type HeartbeatMonitorOptions struct {
+	// Interval between heartbeats while healthy (default: 30s)
+	Interval time.Duration
+
+	// MaxInterval caps the backoff between heartbeats after failures
+	// (default: 5m). The interval doubles with every consecutive failure.
+	MaxInterval time.Duration
+
+	// DegradedAfter is the number of consecutive failures after which the
+	// agent is degraded (default: 1)
+	DegradedAfter int
+
+	// OfflineAfter is the number of consecutive failures after which the
+	// agent is offline (default: 3)
+	OfflineAfter int
+
+	// RecoverAfter is the number of consecutive successes after which a
+	// degraded or offline agent is healthy again (default: 2). An offline
+	// agent is degraded until then.
+	RecoverAfter int
+
+	// SpoolWhenOffline switches the client into spool-only mode while the
+	// agent is offline (see Client.SetSpoolOnly)
+	SpoolWhenOffline bool
+
+	// Heartbeat sends one heartbeat (default: Servers.Heartbeat). Set it to
+	// send HeartbeatWithVersion or a monitoring agent heartbeat instead.
+	Heartbeat func(ctx context.Context) error
+
+	// OnStateChange is called when the state changes
+	OnStateChange func(HeartbeatStateChange)
+
+	// OnError is called when a heartbeat fails. The monitor keeps running.
+	OnError func(error)
+}
+
+// HeartbeatMonitor sends heartbeats and tracks consecutive failures and
+// successes to decide whether the agent is healthy, degraded, or offline.
+// After a failure it backs off exponentially up to MaxInterval, and returns to
+// Interval after the first success. It is safe for concurrent use.
+//
+// Example:
+//
+//	monitor := client.Servers.NewHeartbeatMonitor(&nexmonyx.HeartbeatMonitorOptions{
+//	    SpoolWhenOffline: true,
+//	    OnStateChange: func(change nexmonyx.HeartbeatStateChange) {
+//	        log.Printf("connectivity %s -> %s", change.From, change.To)
+//	    },
+//	})
+//	go monitor.Run(ctx)
+//
+//	// In the collection loop
+//	if err := client.Metrics.SubmitComprehensive(ctx, metrics); errors.Is(err, nexmonyx.ErrSpoolOnly) {
+//	    spool = append(spool, metrics)
+//	}
+type HeartbeatMonitor struct {
+	client  *Client
+	options HeartbeatMonitorOptions
+
+	mu        sync.Mutex
+	state     HeartbeatState
+	failures  int
+	successes int
+	lastBeat  time.Time
+	lastErr   error
+	spooling  bool // Whether the monitor switched the client into spool-only mode
+}
+
+// NewHeartbeatMonitor creates a heartbeat monitor for the authenticated
+// server. It starts out healthy. Call Run to start it.
+func (s *ServersService) NewHeartbeatMonitor(opts *HeartbeatMonitorOptions) *HeartbeatMonitor {
+	options := HeartbeatMonitorOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.Interval <= 0 {
+		options.Interval = defaultHeartbeatMonitorInterval
+	}
+	if options.MaxInterval < options.Interval {
+		options.MaxInterval = max(defaultHeartbeatMonitorMaxInterval, options.Interval)
+	}
+	if options.OfflineAfter <= 0 {
+		options.OfflineAfter = defaultHeartbeatOfflineAfter
+	}
+	if options.DegradedAfter <= 0 {
+		options.DegradedAfter = 1
+	}
+	options.DegradedAfter = min(options.DegradedAfter, options.OfflineAfter)
+	if options.RecoverAfter <= 0 {
+		options.RecoverAfter = defaultHeartbeatRecoverAfter
+	}
+	if options.Heartbeat == nil {
+		options.Heartbeat = s.Heartbeat
+	}
+
+	return &HeartbeatMonitor{
+		client:  s.client,
+		options: options,
+		state:   HeartbeatHealthy,
+	}
+}
+
+// Run sends a heartbeat immediately and then after every interval until ctx
+// is done, returning ctx's error. When the monitor put the client into
+// spool-only mode, Run takes it out again before returning.
+func (m *HeartbeatMonitor) Run(ctx context.Context) error {
+	ctx, stop, err := m.client.runBackground(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer stop()
+	defer m.stopSpooling()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		m.Beat(ctx)
+		timer.Reset(m.NextInterval())
+	}
+}
+
+// Beat sends a single heartbeat and records its outcome. Heartbeats sent by
+// the monitor are let through in spool-only mode.
+func (m *HeartbeatMonitor) Beat(ctx context.Context) error {
+	err := m.options.Heartbeat(withSpoolBypass(ctx))
+	if ctx.Err() != nil {
+		// Canceled heartbeats say nothing about connectivity
+		return err
+	}
+	m.Observe(err)
+	if err != nil && m.options.OnError != nil {
+		m.options.OnError(err)
+	}
+	return err
+}
+
+// Observe records the outcome of a heartbeat sent outside the monitor, a
+// success when err is nil
+func (m *HeartbeatMonitor) Observe(err error) {
+	m.mu.Lock()
+	from := m.state
+	if err != nil {
+		m.failures++
+		m.successes = 0
+		m.lastErr = err
+		switch {
+		case m.failures >= m.options.OfflineAfter:
+			m.state = HeartbeatOffline
+		case m.failures >= m.options.DegradedAfter && m.state == HeartbeatHealthy:
+			m.state = HeartbeatDegraded
+		}
+	} else {
+		m.successes++
+		m.failures = 0
+		m.lastErr = nil
+		m.lastBeat = time.Now()
+		switch {
+		case m.successes >= m.options.RecoverAfter:
+			m.state = HeartbeatHealthy
+		case m.state == HeartbeatOffline:
+			m.state = HeartbeatDegraded
+		}
+	}
+	change := HeartbeatStateChange{
+		From:                 from,
+		To:                   m.state,
+		ConsecutiveFailures:  m.failures,
+		ConsecutiveSuccesses: m.successes,
+		Err:                  err,
+	}
+	m.mu.Unlock()
+
+	if change.From == change.To {
+		return
+	}
+	if m.options.SpoolWhenOffline {
+		if change.To == HeartbeatOffline {
+			m.startSpooling()
+		} else if change.From == HeartbeatOffline {
+			m.stopSpooling()
+		}
+	}
+	if m.options.OnStateChange != nil {
+		m.options.OnStateChange(change)
+	}
+}
+
+// State returns the current state
+func (m *HeartbeatMonitor) State() HeartbeatState {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.state
+}
+
+// Consecutive returns the number of consecutive failed and successful
+// heartbeats; at most one of them is non-zero
+func (m *HeartbeatMonitor) Consecutive() (failures, successes int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.failures, m.successes
+}
+
+// LastHeartbeat returns the time of the last successful heartbeat and the
+// error from the most recent attempt
+func (m *HeartbeatMonitor) LastHeartbeat() (time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lastBeat, m.lastErr
+}
+
+// NextInterval returns the delay before the next heartbeat: Interval, doubled
+// for every consecutive failure up to MaxInterval
+func (m *HeartbeatMonitor) NextInterval() time.Duration {
+	m.mu.Lock()
+	failures := m.failures
+	m.mu.Unlock()
+
+	interval := m.options.Interval
+	for i := 0; i < failures && interval < m.options.MaxInterval; i++ {
+		interval *= 2
+	}
+	return min(interval, m.options.MaxInterval)
+}
+
+func (m *HeartbeatMonitor) startSpooling() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.spooling && !m.client.SpoolOnly() {
+		m.spooling = true
+		m.client.SetSpoolOnly(true)
+	}
+}
+
+// stopSpooling leaves spool-only mode if the monitor entered it. A mode set
+// beforehand with Client.SetSpoolOnly is left alone.
+func (m *HeartbeatMonitor) stopSpooling() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.spooling {
+		m.spooling = false
+		m.client.SetSpoolOnly(false)
+	}
+}
@@ -0,0 +1,153 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeartbeatMonitor_StateMachine(t *testing.T) {
+	client, err := NewClient(&Config{BaseURL: "http://127.0.0.1:0", Auth: AuthConfig{ServerUUID: "srv-1", ServerSecret: "secret"}})
+	require.NoError(t, err)
+
+	var changes []HeartbeatStateChange
+	monitor := client.Servers.NewHeartbeatMonitor(&HeartbeatMonitorOptions{
+		Interval:         time.Second,
+		MaxInterval:      10 * time.Second,
+		SpoolWhenOffline: true,
+		OnStateChange:    func(change HeartbeatStateChange) { changes = append(changes, change) },
+	})
+	assert.Equal(t, HeartbeatHealthy, monitor.State())
+	assert.Equal(t, time.Second, monitor.NextInterval())
+
+	failure := errors.New("connection refused")
+	monitor.Observe(failure)
+	assert.Equal(t, HeartbeatDegraded, monitor.State())
+	assert.Equal(t, 2*time.Second, monitor.NextInterval())
+	monitor.Observe(failure)
+	assert.Equal(t, HeartbeatDegraded, monitor.State())
+	assert.False(t, client.SpoolOnly())
+
+	monitor.Observe(failure)
+	assert.Equal(t, HeartbeatOffline, monitor.State())
+	assert.True(t, client.SpoolOnly())
+	failures, successes := monitor.Consecutive()
+	assert.Equal(t, 3, failures)
+	assert.Zero(t, successes)
+	monitor.Observe(failure)
+	assert.Equal(t, 10*time.Second, monitor.NextInterval(), "backoff is capped at MaxInterval")
+
+	monitor.Observe(nil)
+	assert.Equal(t, HeartbeatDegraded, monitor.State(), "recovering until RecoverAfter successes")
+	assert.False(t, client.SpoolOnly())
+	assert.Equal(t, time.Second, monitor.NextInterval())
+	monitor.Observe(nil)
+	assert.Equal(t, HeartbeatHealthy, monitor.State())
+
+	require.Len(t, changes, 4)
+	assert.Equal(t, HeartbeatStateChange{From: HeartbeatHealthy, To: HeartbeatDegraded, ConsecutiveFailures: 1, Err: failure}, changes[0])
+	assert.Equal(t, HeartbeatOffline, changes[1].To)
+	assert.Equal(t, 3, changes[1].ConsecutiveFailures)
+	assert.Equal(t, HeartbeatStateChange{From: HeartbeatOffline, To: HeartbeatDegraded, ConsecutiveSuccesses: 1}, changes[2])
+	assert.Equal(t, HeartbeatStateChange{From: HeartbeatDegraded, To: HeartbeatHealthy, ConsecutiveSuccesses: 2}, changes[3])
+}
+
+func TestHeartbeatMonitor_KeepsManualSpoolOnly(t *testing.T) {
+	client, err := NewClient(&Config{BaseURL: "http://127.0.0.1:0", Auth: AuthConfig{ServerUUID: "srv-1", ServerSecret: "secret"}})
+	require.NoError(t, err)
+
+	client.SetSpoolOnly(true)
+	monitor := client.Servers.NewHeartbeatMonitor(&HeartbeatMonitorOptions{OfflineAfter: 1, RecoverAfter: 1, SpoolWhenOffline: true})
+	monitor.Observe(errors.New("timeout"))
+	monitor.Observe(nil)
+	assert.Equal(t, HeartbeatHealthy, monitor.State())
+	assert.True(t, client.SpoolOnly(), "a mode set by the caller is not cleared")
+}
+
+func TestClient_SpoolOnly(t *testing.T) {
+	var paths []string
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{ServerUUID: "srv-1", ServerSecret: "secret"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	client.SetSpoolOnly(true)
+	err = client.Metrics.SubmitComprehensive(ctx, &ComprehensiveMetricsRequest{ServerUUID: "srv-1"})
+	assert.ErrorIs(t, err, ErrSpoolOnly)
+	require.NoError(t, client.Servers.Heartbeat(ctx))
+
+	client.SetSpoolOnly(false)
+	require.NoError(t, client.Metrics.SubmitComprehensive(ctx, &ComprehensiveMetricsRequest{ServerUUID: "srv-1"}))
+	assert.Equal(t, []string{"/v1/heartbeat", "/v2/metrics/comprehensive"}, paths)
+}
+
+func TestHeartbeatMonitor_Run(t *testing.T) {
+	var failing atomic.Bool
+	failing.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/heartbeat", r.URL.Path)
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, RetryCount: 1, RetryWaitTime: time.Millisecond, Auth: AuthConfig{ServerUUID: "srv-1", ServerSecret: "secret"}})
+	require.NoError(t, err)
+
+	states := make(chan HeartbeatState, 10)
+	var errs atomic.Int32
+	monitor := client.Servers.NewHeartbeatMonitor(&HeartbeatMonitorOptions{
+		Interval:         10 * time.Millisecond,
+		OfflineAfter:     2,
+		RecoverAfter:     1,
+		SpoolWhenOffline: true,
+		OnStateChange: func(change HeartbeatStateChange) {
+			// The API comes back once the agent is offline
+			if change.To == HeartbeatOffline {
+				assert.True(t, client.SpoolOnly())
+				failing.Store(false)
+			}
+			states <- change.To
+		},
+		OnError: func(error) { errs.Add(1) },
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- monitor.Run(ctx) }()
+
+	for _, want := range []HeartbeatState{HeartbeatDegraded, HeartbeatOffline, HeartbeatHealthy} {
+		select {
+		case state := <-states:
+			assert.Equal(t, want, state)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %s", want)
+		}
+	}
+	assert.False(t, client.SpoolOnly())
+	assert.Equal(t, int32(2), errs.Load())
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
@@ -0,0 +1,300 @@
+package nexmonyx
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+)
+
+// NewTLSConfig builds a TLS configuration for Config.TLSConfig from PEM files.
+// caFile adds a CA bundle to the system roots, e.g. a corporate root that
+// signs a TLS-inspecting proxy. certFile and keyFile set a client certificate
+// for mutual TLS. Empty paths are skipped.
+//
+// Example:
+//
+//	tlsConfig, err := nexmonyx.NewTLSConfig("/etc/ssl/corp-ca.pem", "client.pem", "client-key.pem")
+//	client, err := nexmonyx.NewClient(&nexmonyx.Config{
+//	    TLSConfig: tlsConfig,
+//	    ProxyURL:  "http://proxy.corp.example:3128",
+//	})
+func NewTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, fmt.Errorf("both a client certificate and key are required")
+		}
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+type requestTimeoutKey struct{}
+
+// WithRequestTimeout returns a context whose requests use timeout per attempt
+// instead of Config.Timeout. Unlike a context deadline, the timeout may be
+// longer than the client's, and applies to each retry separately.
+//
+// Example:
+//
+//	// Large exports can take several minutes
+//	ctx = nexmonyx.WithRequestTimeout(ctx, 5*time.Minute)
+//	data, err := client.Audit.ExportAuditLogs(ctx, "csv", filters)
+func WithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey{}, timeout)
+}
+
+// RequestTimeoutFromContext returns the timeout set with WithRequestTimeout
+func RequestTimeoutFromContext(ctx context.Context) (time.Duration, bool) {
+	timeout, ok := ctx.Value(requestTimeoutKey{}).(time.Duration)
+	return timeout, ok
+}
+
+// newHTTPClient returns the HTTP client for config. The caller's HTTPClient
+// and Transport are never modified; TLSConfig, ProxyURL, and the connection
+// settings are applied to a clone of the transport. release is non-nil when
+// the client uses a shared transport, and must be called once it is closed.
+func newHTTPClient(config *Config) (httpClient *http.Client, release func(), err error) {
+	httpClient = &http.Client{}
+	if config.HTTPClient != nil {
+		clientCopy := *config.HTTPClient
+		httpClient = &clientCopy
+	}
+
+	transport := httpClient.Transport
+	if config.Transport != nil {
+		transport = config.Transport
+	}
+
+	settings := newTransportSettings(config)
+	if settings.customized() {
+		switch t := transport.(type) {
+		case nil:
+		case *http.Transport:
+			settings.base = t
+		default:
+			return nil, nil, fmt.Errorf("TLSConfig, ProxyURL, and connection settings require an *http.Transport, got %T", transport)
+		}
+
+		if config.ShareTransport {
+			transport, release, err = acquireSharedTransport(settings)
+		} else {
+			transport, err = settings.build()
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	// The timeout is applied per attempt by the transport so that it can be
+	// overridden per request with WithRequestTimeout
+	httpClient.Timeout = 0
+	httpClient.Transport = &timeoutTransport{base: transport, timeout: config.Timeout}
+	return httpClient, release, nil
+}
+
+// transportSettings are the Config fields applied to a clone of the
+// transport. It is comparable, and keys the shared transports.
+type transportSettings struct {
+	base                *http.Transport
+	tlsConfig           *tls.Config
+	proxyURL            string
+	maxIdleConns        int
+	maxIdleConnsPerHost int
+	maxConnsPerHost     int
+	idleConnTimeout     time.Duration
+	keepAlive           time.Duration
+	disableKeepAlives   bool
+	forceHTTP2          bool
+}
+
+func newTransportSettings(config *Config) transportSettings {
+	return transportSettings{
+		tlsConfig:           config.TLSConfig,
+		proxyURL:            config.ProxyURL,
+		maxIdleConns:        config.MaxIdleConns,
+		maxIdleConnsPerHost: config.MaxIdleConnsPerHost,
+		maxConnsPerHost:     config.MaxConnsPerHost,
+		idleConnTimeout:     config.IdleConnTimeout,
+		keepAlive:           config.KeepAlive,
+		disableKeepAlives:   config.DisableKeepAlives,
+		forceHTTP2:          config.ForceHTTP2,
+	}
+}
+
+// customized reports whether any setting requires cloning the transport
+func (s transportSettings) customized() bool {
+	return s != transportSettings{base: s.base}
+}
+
+// build clones the base transport, or http.DefaultTransport, and applies the settings
+func (s transportSettings) build() (*http.Transport, error) {
+	var transport *http.Transport
+	if s.base != nil {
+		transport = s.base.Clone()
+	} else {
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	}
+
+	if s.tlsConfig != nil {
+		transport.TLSClientConfig = s.tlsConfig.Clone()
+	}
+	if s.proxyURL != "" {
+		proxy, err := parseProxyURL(s.proxyURL)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+	if s.maxIdleConns != 0 {
+		transport.MaxIdleConns = s.maxIdleConns
+	}
+	if s.maxIdleConnsPerHost != 0 {
+		transport.MaxIdleConnsPerHost = s.maxIdleConnsPerHost
+	}
+	if s.maxConnsPerHost != 0 {
+		transport.MaxConnsPerHost = s.maxConnsPerHost
+	}
+	if s.idleConnTimeout != 0 {
+		transport.IdleConnTimeout = s.idleConnTimeout
+	}
+	if s.keepAlive != 0 {
+		transport.DialContext = (&net.Dialer{Timeout: defaultDialTimeout, KeepAlive: s.keepAlive}).DialContext
+	}
+	if s.disableKeepAlives {
+		transport.DisableKeepAlives = true
+	}
+	if s.forceHTTP2 {
+		transport.ForceAttemptHTTP2 = true
+	}
+	return transport, nil
+}
+
+// defaultDialTimeout matches the dialer of http.DefaultTransport
+const defaultDialTimeout = 30 * time.Second
+
+// sharedTransports holds the transports of open clients created with
+// Config.ShareTransport, one per distinct set of transport settings. The
+// settings hold the TLS config and base transport by pointer, so a transport
+// is dropped once the last client using it is closed; otherwise every client
+// given a fresh *tls.Config would add an entry for the life of the process.
+var sharedTransports = struct {
+	sync.Mutex
+	transports map[transportSettings]*sharedTransportEntry
+}{transports: make(map[transportSettings]*sharedTransportEntry)}
+
+type sharedTransportEntry struct {
+	transport *http.Transport
+	refs      int
+}
+
+// acquireSharedTransport returns the shared transport for settings, building
+// it if needed. release drops the caller's reference, and closes the
+// transport's idle connections once no client uses it; calling it again has
+// no effect.
+func acquireSharedTransport(settings transportSettings) (transport *http.Transport, release func(), err error) {
+	sharedTransports.Lock()
+	defer sharedTransports.Unlock()
+
+	entry, ok := sharedTransports.transports[settings]
+	if !ok {
+		transport, err := settings.build()
+		if err != nil {
+			return nil, nil, err
+		}
+		entry = &sharedTransportEntry{transport: transport}
+		sharedTransports.transports[settings] = entry
+	}
+	entry.refs++
+
+	var once sync.Once
+	release = func() {
+		once.Do(func() {
+			sharedTransports.Lock()
+			defer sharedTransports.Unlock()
+			entry.refs--
+			if entry.refs > 0 {
+				return
+			}
+			delete(sharedTransports.transports, settings)
+			entry.transport.CloseIdleConnections()
+		})
+	}
+	return entry.transport, release, nil
+}
+
+// parseProxyURL validates a Config.ProxyURL
+func parseProxyURL(rawURL string) (*url.URL, error) {
+	proxy, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", err)
+	}
+	switch proxy.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy URL %q: scheme must be http, https, or socks5", rawURL)
+	}
+	if proxy.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: missing host", rawURL)
+	}
+	return proxy, nil
+}
+
+// timeoutTransport limits each attempt to the client's timeout, or to the
+// timeout set with WithRequestTimeout
+type timeoutTransport struct {
+	base    http.RoundTripper
+	timeout time.Duration
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	timeout := t.timeout
+	if override, ok := RequestTimeoutFromContext(req.Context()); ok {
+		timeout = override
+	}
+	if timeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The attempt lasts until the body has been read
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
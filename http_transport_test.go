@@ -0,0 +1,279 @@
+package nexmonyx
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePEM(t *testing.T, name, blockType string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+	return path
+}
+
+func healthHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(`{"status":"success"}`))
+}
+
+func TestNewTLSConfig_CustomCAAndClientCertificate(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		healthHandler(w, r)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	// Client certificate
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "agent"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	caFile := writePEM(t, "ca.pem", "CERTIFICATE", server.Certificate().Raw)
+	certFile := writePEM(t, "client.pem", "CERTIFICATE", certDER)
+	keyFile := writePEM(t, "client-key.pem", "EC PRIVATE KEY", keyDER)
+
+	tlsConfig, err := NewTLSConfig(caFile, certFile, keyFile)
+	require.NoError(t, err)
+
+	client, err := NewClient(&Config{BaseURL: server.URL, TLSConfig: tlsConfig})
+	require.NoError(t, err)
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	require.NoError(t, err)
+
+	// Without the CA the server certificate is not trusted
+	client, err = NewClient(&Config{BaseURL: server.URL, RetryWaitTime: time.Millisecond, RetryMaxWait: time.Millisecond})
+	require.NoError(t, err)
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	assert.Error(t, err)
+
+	_, err = NewTLSConfig("", certFile, "")
+	assert.Error(t, err)
+	_, err = NewTLSConfig(keyFile, "", "")
+	assert.Error(t, err, "a key is not a CA bundle")
+}
+
+func TestConfig_ProxyURL(t *testing.T) {
+	var proxied atomic.Int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied.Add(1)
+		assert.Equal(t, "api.nexmonyx.test", r.URL.Host, "proxies receive the absolute URL")
+		healthHandler(w, r)
+	}))
+	defer proxy.Close()
+
+	client, err := NewClient(&Config{BaseURL: "http://api.nexmonyx.test", ProxyURL: proxy.URL})
+	require.NoError(t, err)
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), proxied.Load())
+
+	for _, invalid := range []string{"ftp://proxy:21", "proxy.corp:3128", "http://"} {
+		_, err = NewClient(&Config{ProxyURL: invalid})
+		assert.Error(t, err, invalid)
+	}
+}
+
+type recordingTransport struct {
+	requests atomic.Int32
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests.Add(1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestConfig_Transport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(healthHandler))
+	defer server.Close()
+
+	transport := &recordingTransport{}
+	httpClient := &http.Client{Timeout: time.Second}
+	client, err := NewClient(&Config{BaseURL: server.URL, HTTPClient: httpClient, Transport: transport})
+	require.NoError(t, err)
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), transport.requests.Load())
+	assert.Nil(t, httpClient.Transport, "the caller's client is not modified")
+	assert.Equal(t, time.Second, httpClient.Timeout)
+
+	_, err = NewClient(&Config{Transport: transport, ProxyURL: "http://proxy:3128"})
+	assert.Error(t, err, "proxy settings need an *http.Transport")
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		healthHandler(w, r)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:       server.URL,
+		Timeout:       20 * time.Millisecond,
+		RetryWaitTime: time.Millisecond,
+		RetryMaxWait:  time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/slow"})
+	assert.Error(t, err)
+
+	ctx := WithRequestTimeout(context.Background(), 5*time.Second)
+	_, err = client.Do(ctx, &Request{Method: "GET", Path: "/v1/slow"})
+	assert.NoError(t, err, "the override may exceed the client timeout")
+
+	timeout, ok := RequestTimeoutFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, timeout)
+}
+
+func clientTransport(t *testing.T, client *Client) *http.Transport {
+	t.Helper()
+	transport, ok := client.client.GetClient().Transport.(*timeoutTransport).base.(*http.Transport)
+	require.True(t, ok)
+	return transport
+}
+
+func TestConfig_ConnectionPool(t *testing.T) {
+	client, err := NewClient(&Config{
+		MaxIdleConns:        500,
+		MaxIdleConnsPerHost: 100,
+		MaxConnsPerHost:     200,
+		IdleConnTimeout:     5 * time.Minute,
+		KeepAlive:           time.Minute,
+		ForceHTTP2:          true,
+	})
+	require.NoError(t, err)
+
+	transport := clientTransport(t, client)
+	assert.NotSame(t, http.DefaultTransport, transport)
+	assert.Equal(t, 500, transport.MaxIdleConns)
+	assert.Equal(t, 100, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 200, transport.MaxConnsPerHost)
+	assert.Equal(t, 5*time.Minute, transport.IdleConnTimeout)
+	assert.True(t, transport.ForceAttemptHTTP2)
+	assert.NotNil(t, transport.DialContext)
+	assert.Equal(t, 100, http.DefaultTransport.(*http.Transport).MaxIdleConns, "the default transport is not modified")
+
+	// The caller's transport is cloned, not modified
+	base := &http.Transport{}
+	client, err = NewClient(&Config{Transport: base, MaxIdleConnsPerHost: 50, DisableKeepAlives: true})
+	require.NoError(t, err)
+	transport = clientTransport(t, client)
+	assert.NotSame(t, base, transport)
+	assert.Equal(t, 50, transport.MaxIdleConnsPerHost)
+	assert.True(t, transport.DisableKeepAlives)
+	assert.Zero(t, base.MaxIdleConnsPerHost)
+	assert.False(t, base.DisableKeepAlives)
+
+	_, err = NewClient(&Config{Transport: &recordingTransport{}, MaxConnsPerHost: 10})
+	assert.Error(t, err, "connection settings need an *http.Transport")
+}
+
+func TestConfig_ShareTransport(t *testing.T) {
+	var connections atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(healthHandler))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	config := &Config{BaseURL: server.URL, MaxIdleConnsPerHost: 20, ShareTransport: true}
+	first, err := NewClient(config)
+	require.NoError(t, err)
+	second, err := NewClient(config)
+	require.NoError(t, err)
+	derived := first.WithToken("token")
+	assert.Same(t, clientTransport(t, first), clientTransport(t, second))
+	assert.Same(t, clientTransport(t, first), clientTransport(t, derived))
+
+	for _, client := range []*Client{first, second, derived} {
+		_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), connections.Load(), "clients reuse the shared connection")
+
+	// Different settings get their own transport
+	other, err := NewClient(&Config{BaseURL: server.URL, MaxIdleConnsPerHost: 30, ShareTransport: true})
+	require.NoError(t, err)
+	assert.NotSame(t, clientTransport(t, first), clientTransport(t, other))
+
+	unshared, err := NewClient(&Config{BaseURL: server.URL, MaxIdleConnsPerHost: 20})
+	require.NoError(t, err)
+	assert.NotSame(t, clientTransport(t, first), clientTransport(t, unshared))
+}
+
+func TestConfig_ShareTransport_Close(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(healthHandler))
+	defer server.Close()
+
+	shared := func(config *Config) bool {
+		sharedTransports.Lock()
+		defer sharedTransports.Unlock()
+		_, ok := sharedTransports.transports[newTransportSettings(config)]
+		return ok
+	}
+
+	config := &Config{BaseURL: server.URL, TLSConfig: &tls.Config{MinVersion: tls.VersionTLS12}, ShareTransport: true}
+	first, err := NewClient(config)
+	require.NoError(t, err)
+	derived := first.WithToken("token")
+	assert.Same(t, clientTransport(t, first), clientTransport(t, derived))
+	_, err = first.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	require.NoError(t, err)
+
+	_, err = first.Close(context.Background())
+	require.NoError(t, err)
+	_, err = first.Close(context.Background())
+	require.NoError(t, err)
+	assert.True(t, shared(config), "the derived client still uses the transport")
+
+	_, err = derived.Close(context.Background())
+	require.NoError(t, err)
+	assert.False(t, shared(config), "the transport is dropped with its last client")
+
+	// A client created after that gets a new transport
+	next, err := NewClient(config)
+	require.NoError(t, err)
+	assert.NotSame(t, clientTransport(t, derived), clientTransport(t, next))
+	_, err = next.Close(context.Background())
+	require.NoError(t, err)
+	assert.False(t, shared(config))
+}
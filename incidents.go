@@ -77,31 +77,12 @@ func (s *IncidentsService) ListIncidents(ctx context.Context, opts *IncidentList
 		Data    *IncidentListResponse `json:"data"`
 	}
 
-	query := make(map[string]string)
+	var query map[string]string
 	if opts != nil {
-		if opts.Status != "" {
-			query["status"] = opts.Status
-		}
-		if opts.Severity != "" {
-			query["severity"] = opts.Severity
-		}
-		if opts.ServerID > 0 {
-			query["server_id"] = fmt.Sprintf("%d", opts.ServerID)
-		}
-		if opts.ProbeID > 0 {
-			query["probe_id"] = fmt.Sprintf("%d", opts.ProbeID)
-		}
-		if opts.Sort != "" {
-			query["sort"] = opts.Sort
-		}
-		
-		// Add pagination parameters from ListOptions
-		if opts.Page > 0 {
-			query["page"] = fmt.Sprintf("%d", opts.Page)
-		}
-		if opts.Limit > 0 {
-			query["limit"] = fmt.Sprintf("%d", opts.Limit)
+		if err := opts.Validate(); err != nil {
+			return nil, err
 		}
+		query = opts.ToQuery()
 	}
 
 	_, err := s.client.Do(ctx, &Request{
@@ -0,0 +1,149 @@
+// Package promcollector implements the Prometheus collector returned by
+// Client.MetricsCollector. It is only imported in builds with the
+// nexmonyx_prometheus tag, so other builds do not depend on the Prometheus
+// client library.
+package promcollector
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "nexmonyx_sdk"
+
+// Collector exports these metrics:
+//
+//   - nexmonyx_sdk_requests_total{method, endpoint, status}
+//   - nexmonyx_sdk_request_duration_seconds{method, endpoint}
+//   - nexmonyx_sdk_retries_total{method, endpoint}
+//   - nexmonyx_sdk_rate_limited_total{method, endpoint}
+//   - nexmonyx_sdk_heartbeats_total{result}
+//   - nexmonyx_sdk_spool_backlog
+//
+// endpoint is the request path with IDs replaced by {id}, and status is
+// "error" when no response was received.
+type Collector struct {
+	requests     *prometheus.CounterVec
+	duration     *prometheus.HistogramVec
+	retries      *prometheus.CounterVec
+	rateLimited  *prometheus.CounterVec
+	heartbeats   *prometheus.CounterVec
+	spoolBacklog *prometheus.Desc
+	spool        func() int
+}
+
+// New creates a collector that reports spool() as the spool backlog, or 0
+// when spool is nil
+func New(spool func() int) *Collector {
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "API requests completed by the SDK, after retries.",
+		}, []string{"method", "endpoint", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Time spent on API requests, including retries.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "endpoint"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "retries_total",
+			Help:      "Failed API request attempts that were retried.",
+		}, []string{"method", "endpoint"}),
+		rateLimited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "rate_limited_total",
+			Help:      "API responses with status 429, including retried ones.",
+		}, []string{"method", "endpoint"}),
+		heartbeats: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "heartbeats_total",
+			Help:      "Server and monitoring agent heartbeats sent, by result.",
+		}, []string{"result"}),
+		spoolBacklog: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "spool_backlog"),
+			"Metric submissions spooled for a later backfill.",
+			nil, nil,
+		),
+		spool: spool,
+	}
+}
+
+// ObserveRequest counts a completed request. statusCode is 0 when no
+// response was received.
+func (m *Collector) ObserveRequest(method, path string, statusCode int, duration time.Duration, err error) {
+	label := endpoint(path)
+	status := "error"
+	if statusCode != 0 {
+		status = strconv.Itoa(statusCode)
+	}
+	m.requests.WithLabelValues(method, label, status).Inc()
+	m.duration.WithLabelValues(method, label).Observe(duration.Seconds())
+
+	if strings.HasSuffix(path, "/heartbeat") {
+		result := "success"
+		if err != nil {
+			result = "failure"
+		}
+		m.heartbeats.WithLabelValues(result).Inc()
+	}
+}
+
+// ObserveRetry counts a failed attempt that is retried
+func (m *Collector) ObserveRetry(method, path string) {
+	m.retries.WithLabelValues(method, endpoint(path)).Inc()
+}
+
+// ObserveRateLimited counts a response with status 429
+func (m *Collector) ObserveRateLimited(method, path string) {
+	m.rateLimited.WithLabelValues(method, endpoint(path)).Inc()
+}
+
+// Describe implements prometheus.Collector
+func (m *Collector) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+	m.duration.Describe(ch)
+	m.retries.Describe(ch)
+	m.rateLimited.Describe(ch)
+	m.heartbeats.Describe(ch)
+	ch <- m.spoolBacklog
+}
+
+// Collect implements prometheus.Collector
+func (m *Collector) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+	m.duration.Collect(ch)
+	m.retries.Collect(ch)
+	m.rateLimited.Collect(ch)
+	m.heartbeats.Collect(ch)
+
+	backlog := 0
+	if m.spool != nil {
+		backlog = m.spool()
+	}
+	ch <- prometheus.MustNewConstMetric(m.spoolBacklog, prometheus.GaugeValue, float64(backlog))
+}
+
+var apiVersionSegment = regexp.MustCompile(`^v[0-9]+$`)
+
+// endpoint replaces the IDs in an API path with {id} to keep label
+// cardinality bounded. API path literals never contain digits apart from
+// the version, so any other segment with a digit is treated as an ID.
+func endpoint(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.ContainsAny(segment, "0123456789") && !apiVersionSegment.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
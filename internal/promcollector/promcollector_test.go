@@ -0,0 +1,55 @@
+package promcollector
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollector(t *testing.T) {
+	collector := New(func() int { return 4 })
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(collector))
+
+	collector.ObserveRateLimited("GET", "/v1/server/srv-123/details")
+	collector.ObserveRetry("GET", "/v1/server/srv-123/details")
+	collector.ObserveRetry("GET", "/v1/server/srv-123/details")
+	collector.ObserveRequest("GET", "/v1/server/srv-123/details", 200, 30*time.Millisecond, nil)
+	collector.ObserveRequest("POST", "/v1/heartbeat", 200, time.Millisecond, nil)
+	collector.ObserveRequest("POST", "/v1/monitoring/heartbeat", 0, time.Millisecond, errors.New("connection refused"))
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(collector.requests.WithLabelValues("GET", "/v1/server/{id}/details", "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(collector.requests.WithLabelValues("POST", "/v1/monitoring/heartbeat", "error")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(collector.retries.WithLabelValues("GET", "/v1/server/{id}/details")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(collector.rateLimited.WithLabelValues("GET", "/v1/server/{id}/details")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(collector.heartbeats.WithLabelValues("success")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(collector.heartbeats.WithLabelValues("failure")))
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	names := make(map[string]bool)
+	for _, family := range families {
+		names[family.GetName()] = true
+		if family.GetName() == "nexmonyx_sdk_spool_backlog" {
+			assert.Equal(t, 4.0, family.GetMetric()[0].GetGauge().GetValue())
+		}
+	}
+	assert.True(t, names["nexmonyx_sdk_request_duration_seconds"])
+	assert.True(t, names["nexmonyx_sdk_spool_backlog"])
+
+	problems, err := testutil.CollectAndLint(collector)
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+}
+
+func TestEndpoint(t *testing.T) {
+	assert.Equal(t, "/v2/probes/{id}/results", endpoint("/v2/probes/3f2c9a1e-7b4d-4c55-9a0e-2d1f6b8e4a10/results"))
+	assert.Equal(t, "/v1/tags/{id}/servers", endpoint("/v1/tags/42/servers"))
+	assert.Equal(t, "/v1/servers", endpoint("/v1/servers?page=2"))
+	assert.Equal(t, "/v1/heartbeat", endpoint("/v1/heartbeat"))
+}
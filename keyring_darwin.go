@@ -0,0 +1,55 @@
+package nexmonyx
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// The macOS implementation uses the login keychain through security(1)
+
+// securityItemNotFound is the exit status of security(1) when no item matches
+const securityItemNotFound = 44
+
+func keyringAvailable() bool {
+	_, err := exec.LookPath("security")
+	return err == nil
+}
+
+func keyringGet(service, name string) (string, error) {
+	out, err := exec.Command("security", "find-generic-password", "-s", service, "-a", name, "-w").Output()
+	if err != nil {
+		return "", keychainError("find-generic-password", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func keyringSet(service, name, secret string) error {
+	// -U updates an existing item instead of failing. -w without a value, as
+	// the last argument, makes security prompt for the secret and its
+	// confirmation on stdin, keeping it off the command line. KeyringStore
+	// base64 encodes secrets, so they fit on one line.
+	cmd := exec.Command("security", "add-generic-password", "-U", "-s", service, "-a", name, "-w")
+	cmd.Stdin = strings.NewReader(secret + "\n" + secret + "\n")
+	if err := cmd.Run(); err != nil {
+		return keychainError("add-generic-password", err)
+	}
+	return nil
+}
+
+func keyringDelete(service, name string) error {
+	err := exec.Command("security", "delete-generic-password", "-s", service, "-a", name).Run()
+	if err != nil {
+		return keychainError("delete-generic-password", err)
+	}
+	return nil
+}
+
+func keychainError(command string, err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == securityItemNotFound {
+		return ErrCredentialNotFound
+	}
+	return fmt.Errorf("security %s failed: %w", command, err)
+}
@@ -0,0 +1,71 @@
+package nexmonyx
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// The Linux implementation uses the kernel user keyring through keyctl(1)
+
+func keyringAvailable() bool {
+	if _, err := exec.LookPath("keyctl"); err != nil {
+		return false
+	}
+	return exec.Command("keyctl", "show", "@u").Run() == nil
+}
+
+func keyringDescription(service, name string) string {
+	return service + ":" + name
+}
+
+// keyringSearch returns the key ID for service and name
+func keyringSearch(service, name string) (string, error) {
+	out, err := exec.Command("keyctl", "search", "@u", "user", keyringDescription(service, name)).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", ErrCredentialNotFound
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func keyringGet(service, name string) (string, error) {
+	id, err := keyringSearch(service, name)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := exec.Command("keyctl", "pipe", id).Output()
+	if err != nil {
+		return "", fmt.Errorf("keyctl pipe failed: %w", err)
+	}
+	return string(out), nil
+}
+
+func keyringSet(service, name, secret string) error {
+	// padd reads the secret from stdin, keeping it off the command line
+	cmd := exec.Command("keyctl", "padd", "user", keyringDescription(service, name), "@u")
+	cmd.Stdin = strings.NewReader(secret)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("keyctl padd failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+func keyringDelete(service, name string) error {
+	id, err := keyringSearch(service, name)
+	if err != nil {
+		return err
+	}
+	if err := exec.Command("keyctl", "unlink", id, "@u").Run(); err != nil {
+		return fmt.Errorf("keyctl unlink failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+//go:build !linux && !darwin && !windows
+
+package nexmonyx
+
+// No OS keyring is supported on this platform; NewCredentialStore falls back
+// to the encrypted file store
+
+func keyringAvailable() bool {
+	return false
+}
+
+func keyringGet(service, name string) (string, error) {
+	return "", ErrKeyringUnavailable
+}
+
+func keyringSet(service, name, secret string) error {
+	return ErrKeyringUnavailable
+}
+
+func keyringDelete(service, name string) error {
+	return ErrKeyringUnavailable
+}
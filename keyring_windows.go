@@ -0,0 +1,111 @@
+package nexmonyx
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+// The Windows implementation uses the Credential Manager (wincred)
+
+const (
+	credTypeGeneric         = 1
+	credPersistLocalMachine = 2
+	errorNotFound           = syscall.Errno(1168)
+)
+
+var (
+	advapi32       = syscall.NewLazyDLL("advapi32.dll")
+	procCredReadW  = advapi32.NewProc("CredReadW")
+	procCredWriteW = advapi32.NewProc("CredWriteW")
+	procCredDelete = advapi32.NewProc("CredDeleteW")
+	procCredFree   = advapi32.NewProc("CredFree")
+)
+
+// winCredential mirrors the CREDENTIALW structure
+type winCredential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        syscall.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+func keyringAvailable() bool {
+	return procCredReadW.Find() == nil
+}
+
+func keyringTarget(service, name string) (*uint16, error) {
+	return syscall.UTF16PtrFromString(service + ":" + name)
+}
+
+func keyringGet(service, name string) (string, error) {
+	target, err := keyringTarget(service, name)
+	if err != nil {
+		return "", err
+	}
+
+	var cred *winCredential
+	ret, _, callErr := procCredReadW.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0, uintptr(unsafe.Pointer(&cred)))
+	if ret == 0 {
+		if callErr == errorNotFound {
+			return "", ErrCredentialNotFound
+		}
+		return "", fmt.Errorf("CredRead failed: %w", callErr)
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(cred)))
+
+	return string(unsafe.Slice(cred.CredentialBlob, cred.CredentialBlobSize)), nil
+}
+
+func keyringSet(service, name, secret string) error {
+	target, err := keyringTarget(service, name)
+	if err != nil {
+		return err
+	}
+	user, err := syscall.UTF16PtrFromString(name)
+	if err != nil {
+		return err
+	}
+
+	blob := []byte(secret)
+	cred := winCredential{
+		Type:               credTypeGeneric,
+		TargetName:         target,
+		CredentialBlobSize: uint32(len(blob)),
+		Persist:            credPersistLocalMachine,
+		UserName:           user,
+	}
+	if len(blob) > 0 {
+		cred.CredentialBlob = &blob[0]
+	}
+
+	ret, _, callErr := procCredWriteW.Call(uintptr(unsafe.Pointer(&cred)), 0)
+	if ret == 0 {
+		return fmt.Errorf("CredWrite failed: %w", callErr)
+	}
+	return nil
+}
+
+func keyringDelete(service, name string) error {
+	target, err := keyringTarget(service, name)
+	if err != nil {
+		return err
+	}
+
+	ret, _, callErr := procCredDelete.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0)
+	if ret == 0 {
+		if callErr == errorNotFound {
+			return ErrCredentialNotFound
+		}
+		return fmt.Errorf("CredDelete failed: %w", callErr)
+	}
+	return nil
+}
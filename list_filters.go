@@ -0,0 +1,252 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ServersListOptions filters ServersService.ListFiltered on the server side.
+// Search (from ListOptions) matches hostnames, FQDNs and IP addresses.
+type ServersListOptions struct {
+	ListOptions
+	Environment         Environment  `url:"environment,omitempty"`
+	Status              ServerStatus `url:"status,omitempty"`
+	Provider            string       `url:"provider,omitempty"`
+	Tags                []string     `url:"tags,omitempty,comma"` // Servers must carry every tag
+	LastHeartbeatBefore *time.Time   `url:"last_heartbeat_before,omitempty"`
+	LastHeartbeatAfter  *time.Time   `url:"last_heartbeat_after,omitempty"`
+}
+
+// Validate checks the filters locally
+func (o *ServersListOptions) Validate() error {
+	if err := validateListPaging(&o.ListOptions); err != nil {
+		return err
+	}
+	if err := validateFilterTags(o.Tags); err != nil {
+		return err
+	}
+	return validateFilterRange("last_heartbeat", o.LastHeartbeatAfter, o.LastHeartbeatBefore)
+}
+
+// ToQuery converts options to query parameters
+func (o *ServersListOptions) ToQuery() map[string]string {
+	params := o.ListOptions.ToQuery()
+	if o.Environment != "" {
+		params["environment"] = string(o.Environment)
+	}
+	if o.Status != "" {
+		params["status"] = string(o.Status)
+	}
+	if o.Provider != "" {
+		params["provider"] = o.Provider
+	}
+	if len(o.Tags) > 0 {
+		params["tags"] = strings.Join(o.Tags, ",")
+	}
+	if o.LastHeartbeatBefore != nil {
+		params["last_heartbeat_before"] = o.LastHeartbeatBefore.UTC().Format(time.RFC3339)
+	}
+	if o.LastHeartbeatAfter != nil {
+		params["last_heartbeat_after"] = o.LastHeartbeatAfter.UTC().Format(time.RFC3339)
+	}
+	return params
+}
+
+// AlertsListOptions filters AlertsService.ListFiltered on the server side
+type AlertsListOptions struct {
+	ListOptions
+	Status          string        `url:"status,omitempty"`
+	Severity        AlertSeverity `url:"severity,omitempty"`
+	Type            string        `url:"type,omitempty"`
+	MetricName      string        `url:"metric_name,omitempty"`
+	ServerID        uint          `url:"server_id,omitempty"`
+	Enabled         *bool         `url:"enabled,omitempty"`
+	Tags            []string      `url:"tags,omitempty,comma"`
+	TriggeredBefore *time.Time    `url:"triggered_before,omitempty"`
+	TriggeredAfter  *time.Time    `url:"triggered_after,omitempty"`
+}
+
+// Validate checks the filters locally
+func (o *AlertsListOptions) Validate() error {
+	if err := validateListPaging(&o.ListOptions); err != nil {
+		return err
+	}
+	if o.Severity != "" && !o.Severity.Valid() {
+		return fmt.Errorf("invalid severity %q: must be info, warning or critical", o.Severity)
+	}
+	if err := validateFilterTags(o.Tags); err != nil {
+		return err
+	}
+	return validateFilterRange("triggered", o.TriggeredAfter, o.TriggeredBefore)
+}
+
+// ToQuery converts options to query parameters
+func (o *AlertsListOptions) ToQuery() map[string]string {
+	params := o.ListOptions.ToQuery()
+	if o.Status != "" {
+		params["status"] = o.Status
+	}
+	if o.Severity != "" {
+		params["severity"] = string(o.Severity)
+	}
+	if o.Type != "" {
+		params["type"] = o.Type
+	}
+	if o.MetricName != "" {
+		params["metric_name"] = o.MetricName
+	}
+	if o.ServerID > 0 {
+		params["server_id"] = strconv.FormatUint(uint64(o.ServerID), 10)
+	}
+	if o.Enabled != nil {
+		params["enabled"] = strconv.FormatBool(*o.Enabled)
+	}
+	if len(o.Tags) > 0 {
+		params["tags"] = strings.Join(o.Tags, ",")
+	}
+	if o.TriggeredBefore != nil {
+		params["triggered_before"] = o.TriggeredBefore.UTC().Format(time.RFC3339)
+	}
+	if o.TriggeredAfter != nil {
+		params["triggered_after"] = o.TriggeredAfter.UTC().Format(time.RFC3339)
+	}
+	return params
+}
+
+// Validate checks the filters locally
+func (o *IncidentListOptions) Validate() error {
+	if err := validateListPaging(&o.ListOptions); err != nil {
+		return err
+	}
+	switch IncidentStatus(o.Status) {
+	case "", IncidentStatusActive, IncidentStatusAcknowledged, IncidentStatusResolved:
+	default:
+		return fmt.Errorf("invalid status %q: must be active, acknowledged or resolved", o.Status)
+	}
+	switch IncidentSeverity(o.Severity) {
+	case "", IncidentSeverityInfo, IncidentSeverityWarning, IncidentSeverityCritical:
+	default:
+		return fmt.Errorf("invalid severity %q: must be info, warning or critical", o.Severity)
+	}
+	if err := validateFilterTags(o.Tags); err != nil {
+		return err
+	}
+	return validateFilterRange("started", o.StartedAfter, o.StartedBefore)
+}
+
+// ToQuery converts options to query parameters
+func (o *IncidentListOptions) ToQuery() map[string]string {
+	params := o.ListOptions.ToQuery()
+	if o.Status != "" {
+		params["status"] = o.Status
+	}
+	if o.Severity != "" {
+		params["severity"] = o.Severity
+	}
+	if o.ServerID > 0 {
+		params["server_id"] = strconv.FormatUint(uint64(o.ServerID), 10)
+	}
+	if o.ProbeID > 0 {
+		params["probe_id"] = strconv.FormatUint(uint64(o.ProbeID), 10)
+	}
+	if o.Sort != "" {
+		params["sort"] = o.Sort
+	}
+	if len(o.Tags) > 0 {
+		params["tags"] = strings.Join(o.Tags, ",")
+	}
+	if o.StartedBefore != nil {
+		params["started_before"] = o.StartedBefore.UTC().Format(time.RFC3339)
+	}
+	if o.StartedAfter != nil {
+		params["started_after"] = o.StartedAfter.UTC().Format(time.RFC3339)
+	}
+	return params
+}
+
+// ListFiltered retrieves servers matching typed filters. Invalid filters are
+// reported before any request is made.
+func (s *ServersService) ListFiltered(ctx context.Context, opts *ServersListOptions) ([]*Server, *PaginationMeta, error) {
+	if opts == nil {
+		opts = &ServersListOptions{}
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var resp PaginatedResponse
+	var servers []*Server
+	resp.Data = &servers
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   "/v2/servers",
+		Query:  opts.ToQuery(),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return servers, resp.Meta, nil
+}
+
+// ListFiltered retrieves alert rules matching typed filters. Invalid filters
+// are reported before any request is made.
+func (s *AlertsService) ListFiltered(ctx context.Context, opts *AlertsListOptions) ([]*Alert, *PaginationMeta, error) {
+	if opts == nil {
+		opts = &AlertsListOptions{}
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var resp PaginatedResponse
+	var alerts []*Alert
+	resp.Data = &alerts
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   "/v1/alerts/rules",
+		Query:  opts.ToQuery(),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return alerts, resp.Meta, nil
+}
+
+func validateListPaging(o *ListOptions) error {
+	if o.Page < 0 || o.Limit < 0 || o.PerPage < 0 {
+		return fmt.Errorf("page, limit and per_page must not be negative")
+	}
+	if o.IncludeDeleted && o.OnlyDeleted {
+		return fmt.Errorf("include_deleted and only_deleted are mutually exclusive")
+	}
+	return nil
+}
+
+func validateFilterTags(tags []string) error {
+	for _, tag := range tags {
+		if strings.TrimSpace(tag) == "" {
+			return fmt.Errorf("tags must not be empty")
+		}
+		if strings.Contains(tag, ",") {
+			return fmt.Errorf("tag %q must not contain a comma", tag)
+		}
+	}
+	return nil
+}
+
+// validateFilterRange checks that after is before before when both are set
+func validateFilterRange(name string, after, before *time.Time) error {
+	if after != nil && before != nil && !after.Before(*before) {
+		return fmt.Errorf("%s_after must be before %s_before", name, name)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package nexmonyx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServersListOptions(t *testing.T) {
+	before := time.Date(2026, 10, 15, 12, 0, 0, 0, time.FixedZone("CEST", 2*3600))
+	after := before.Add(-24 * time.Hour)
+	opts := &ServersListOptions{
+		ListOptions:         ListOptions{Page: 2, Limit: 50, Search: "web"},
+		Environment:         "production",
+		Status:              "offline",
+		Provider:            "aws",
+		Tags:                []string{"team:core", "env:prod"},
+		LastHeartbeatBefore: &before,
+		LastHeartbeatAfter:  &after,
+	}
+	require.NoError(t, opts.Validate())
+	assert.Equal(t, map[string]string{
+		"page":                  "2",
+		"limit":                 "50",
+		"search":                "web",
+		"environment":           "production",
+		"status":                "offline",
+		"provider":              "aws",
+		"tags":                  "team:core,env:prod",
+		"last_heartbeat_before": "2026-10-15T10:00:00Z",
+		"last_heartbeat_after":  "2026-10-14T10:00:00Z",
+	}, opts.ToQuery())
+
+	opts.LastHeartbeatAfter, opts.LastHeartbeatBefore = opts.LastHeartbeatBefore, opts.LastHeartbeatAfter
+	assert.ErrorContains(t, opts.Validate(), "last_heartbeat_after must be before last_heartbeat_before")
+
+	assert.Error(t, (&ServersListOptions{Tags: []string{"a,b"}}).Validate())
+	assert.Error(t, (&ServersListOptions{Tags: []string{" "}}).Validate())
+	assert.Error(t, (&ServersListOptions{ListOptions: ListOptions{Limit: -1}}).Validate())
+}
+
+func TestAlertsListOptions(t *testing.T) {
+	enabled := false
+	opts := &AlertsListOptions{Severity: AlertSeverityCritical, ServerID: 7, Enabled: &enabled, MetricName: "cpu_usage"}
+	require.NoError(t, opts.Validate())
+	assert.Equal(t, map[string]string{
+		"severity":    "critical",
+		"server_id":   "7",
+		"enabled":     "false",
+		"metric_name": "cpu_usage",
+	}, opts.ToQuery())
+
+	assert.ErrorContains(t, (&AlertsListOptions{Severity: "urgent"}).Validate(), "invalid severity")
+}
+
+func TestIncidentListOptions(t *testing.T) {
+	after := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	opts := &IncidentListOptions{
+		ListOptions:  ListOptions{Page: 1, Limit: 20},
+		Status:       string(IncidentStatusActive),
+		ProbeID:      3,
+		Tags:         []string{"db"},
+		StartedAfter: &after,
+	}
+	require.NoError(t, opts.Validate())
+	assert.Equal(t, map[string]string{
+		"page":          "1",
+		"limit":         "20",
+		"status":        "active",
+		"probe_id":      "3",
+		"tags":          "db",
+		"started_after": "2026-10-01T00:00:00Z",
+	}, opts.ToQuery())
+
+	assert.ErrorContains(t, (&IncidentListOptions{Status: "open"}).Validate(), "invalid status")
+	assert.ErrorContains(t, (&IncidentListOptions{Severity: "high"}).Validate(), "invalid severity")
+}
+
+func TestListFiltered(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/servers":
+			assert.Equal(t, "staging", r.URL.Query().Get("environment"))
+			assert.Equal(t, "web,linux", r.URL.Query().Get("tags"))
+			w.Write([]byte(`{"status":"success","data":[{"server_uuid":"srv-1","environment":"staging"}],"meta":{"page":1,"limit":25,"total_items":1,"total_pages":1}}`))
+		case "/v1/alerts/rules":
+			assert.Equal(t, "warning", r.URL.Query().Get("severity"))
+			w.Write([]byte(`{"status":"success","data":[{"name":"High CPU","severity":"warning"}],"meta":{"page":1,"limit":25,"total_items":1,"total_pages":1}}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	servers, meta, err := client.Servers.ListFiltered(ctx, &ServersListOptions{Environment: "staging", Tags: []string{"web", "linux"}})
+	require.NoError(t, err)
+	require.Len(t, servers, 1)
+	assert.Equal(t, "srv-1", servers[0].ServerUUID)
+	assert.Equal(t, 1, meta.TotalItems)
+
+	alerts, _, err := client.Alerts.ListFiltered(ctx, &AlertsListOptions{Severity: AlertSeverityWarning})
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+
+	// Invalid filters never reach the API
+	_, _, err = client.Servers.ListFiltered(ctx, &ServersListOptions{Tags: []string{""}})
+	assert.Error(t, err)
+	_, err = client.Incidents.ListIncidents(ctx, &IncidentListOptions{Status: "open"})
+	assert.Error(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+func TestListOptions_SortByAndFields(t *testing.T) {
+	opts := &ListOptions{
+		Page:   2,
+		Sort:   "hostname",
+		Order:  "asc",
+		SortBy: []string{SortDesc(ServerSortLastHeartbeat), ServerSortHostname},
+		Fields: []string{"server_uuid", "hostname", "status"},
+	}
+	query := opts.ToQuery()
+	assert.Equal(t, "-last_heartbeat,hostname", query["sort"])
+	assert.NotContains(t, query, "order")
+	assert.Equal(t, "server_uuid,hostname,status", query["fields"])
+	assert.Equal(t, "2", query["page"])
+
+	// SortBy also applies to the typed list options
+	filtered := &ServersListOptions{ListOptions: ListOptions{SortBy: []string{ServerSortCreatedAt}}, Status: "online"}
+	assert.Equal(t, "created_at", filtered.ToQuery()["sort"])
+}
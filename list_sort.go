@@ -0,0 +1,38 @@
+package nexmonyx
+
+// Sort keys accepted in ListOptions.SortBy when listing servers
+const (
+	ServerSortHostname      = "hostname"
+	ServerSortCreatedAt     = "created_at"
+	ServerSortLastHeartbeat = "last_heartbeat"
+	ServerSortStatus        = "status"
+	ServerSortEnvironment   = "environment"
+)
+
+// Sort keys accepted in ListOptions.SortBy when listing probes
+const (
+	ProbeSortName      = "name"
+	ProbeSortType      = "type"
+	ProbeSortCreatedAt = "created_at"
+	ProbeSortInterval  = "frequency"
+)
+
+// Sort keys accepted in ListOptions.SortBy when listing alert rules
+const (
+	AlertSortName          = "name"
+	AlertSortSeverity      = "severity"
+	AlertSortCreatedAt     = "created_at"
+	AlertSortLastTriggered = "last_triggered"
+)
+
+// Sort keys accepted in ListOptions.SortBy when listing incidents
+const (
+	IncidentSortStartedAt  = "started_at"
+	IncidentSortSeverity   = "severity"
+	IncidentSortResolvedAt = "resolved_at"
+)
+
+// SortDesc returns key marked for descending order in ListOptions.SortBy
+func SortDesc(key string) string {
+	return "-" + key
+}
@@ -0,0 +1,142 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// streamPage decodes one page of a paginated list response item by item,
+// calling fn for each element of "data" without holding the whole page in
+// memory. It returns the page's pagination metadata, or nil when the response
+// has none.
+func streamPage[T any](ctx context.Context, c *Client, req *Request, fn func(*T) error) (*PaginationMeta, error) {
+	var meta *PaginationMeta
+	err := c.stream(ctx, req, func(body io.Reader) error {
+		dec := json.NewDecoder(body)
+		if err := expectDelim(dec, '{'); err != nil {
+			return err
+		}
+
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return fmt.Errorf("failed to decode list response: %w", err)
+			}
+
+			switch tok {
+			case "data":
+				if err := streamArray(dec, fn); err != nil {
+					return err
+				}
+			case "meta":
+				if err := dec.Decode(&meta); err != nil {
+					return fmt.Errorf("failed to decode pagination metadata: %w", err)
+				}
+			default:
+				var skip json.RawMessage
+				if err := dec.Decode(&skip); err != nil {
+					return fmt.Errorf("failed to decode list response: %w", err)
+				}
+			}
+		}
+		return nil
+	})
+	return meta, err
+}
+
+// streamArray decodes a JSON array element by element. A null array is
+// treated as empty.
+func streamArray[T any](dec *json.Decoder, fn func(*T) error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("failed to decode list response: %w", err)
+	}
+	if tok == nil {
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("failed to decode list response: expected array, got %v", tok)
+	}
+
+	for dec.More() {
+		item := new(T)
+		if err := dec.Decode(item); err != nil {
+			return fmt.Errorf("failed to decode list item: %w", err)
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, ']')
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("failed to decode list response: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("failed to decode list response: expected %v, got %v", want, tok)
+	}
+	return nil
+}
+
+// eachPage streams every page of a paginated list, starting at the page in
+// query (or the first page), until the metadata reports no more pages
+func eachPage[T any](ctx context.Context, c *Client, path string, query map[string]string, fn func(*T) error) error {
+	if query == nil {
+		query = map[string]string{}
+	}
+	page := 1
+	if p, err := strconv.Atoi(query["page"]); err == nil && p > 0 {
+		page = p
+	}
+
+	for {
+		query["page"] = strconv.Itoa(page)
+
+		count := 0
+		meta, err := streamPage(ctx, c, &Request{Method: "GET", Path: path, Query: query}, func(item *T) error {
+			count++
+			return fn(item)
+		})
+		if err != nil {
+			return err
+		}
+
+		if meta == nil || count == 0 || !(meta.HasMore || page < meta.TotalPages) {
+			return nil
+		}
+		if meta.NextPage != nil && *meta.NextPage > page {
+			page = *meta.NextPage
+		} else {
+			page++
+		}
+	}
+}
+
+// ListEach calls fn for every server matching opts, across all pages,
+// decoding each server as it arrives instead of buffering whole pages. Use it
+// for organizations with tens of thousands of servers. Iteration stops at the
+// first error returned by fn, which ListEach returns.
+func (s *ServersService) ListEach(ctx context.Context, opts *ListOptions, fn func(*Server) error) error {
+	var query map[string]string
+	if opts != nil {
+		query = opts.ToQuery()
+	}
+	return eachPage(ctx, s.client, "/v2/servers", query, fn)
+}
+
+// ListProbeResultsEach calls fn for every probe result matching opts, across
+// all pages, without buffering whole pages. Iteration stops at the first
+// error returned by fn, which ListProbeResultsEach returns.
+func (s *MonitoringService) ListProbeResultsEach(ctx context.Context, opts *ProbeResultListOptions, fn func(*ProbeResult) error) error {
+	var query map[string]string
+	if opts != nil {
+		query = opts.ToQuery()
+	}
+	return eachPage(ctx, s.client, "/v1/monitoring/probe-results", query, fn)
+}
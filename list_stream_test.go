@@ -0,0 +1,126 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serverPage writes a page of servers in the same envelope as GET /v2/servers
+func serverPage(w http.ResponseWriter, page, totalPages, perPage int) {
+	items := make([]string, 0, perPage)
+	for i := 0; i < perPage; i++ {
+		items = append(items, fmt.Sprintf(`{"server_uuid":"srv-%d-%d","hostname":"web-%d"}`, page, i, i))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"status":"success","data":[%s],"meta":{"page":%d,"total_pages":%d,"has_more":%t}}`,
+		strings.Join(items, ","), page, totalPages, page < totalPages)
+}
+
+func TestServersService_ListEach(t *testing.T) {
+	var pages []string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/servers", r.URL.Path)
+		assert.Equal(t, "production", r.URL.Query().Get("environment"))
+		pages = append(pages, r.URL.Query().Get("page"))
+
+		var page int
+		fmt.Sscan(r.URL.Query().Get("page"), &page)
+		serverPage(w, page, 3, 2)
+	}), Config{})
+
+	var uuids []string
+	err := client.Servers.ListEach(context.Background(), &ListOptions{
+		Filters: map[string]string{"environment": "production"},
+	}, func(s *Server) error {
+		uuids = append(uuids, s.ServerUUID)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, pages)
+	assert.Equal(t, []string{"srv-1-0", "srv-1-1", "srv-2-0", "srv-2-1", "srv-3-0", "srv-3-1"}, uuids)
+}
+
+func TestServersService_ListEach_StopsOnCallbackError(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		serverPage(w, 1, 5, 10)
+	}), Config{})
+
+	stop := errors.New("stop")
+	seen := 0
+	err := client.Servers.ListEach(context.Background(), nil, func(*Server) error {
+		seen++
+		if seen == 3 {
+			return stop
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, 3, seen)
+	assert.Equal(t, 1, requests)
+}
+
+func TestMonitoringService_ListProbeResultsEach(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/monitoring/probe-results", r.URL.Path)
+		assert.Equal(t, "failed", r.URL.Query().Get("status"))
+		assert.Equal(t, "4", r.URL.Query().Get("page"))
+		w.Header().Set("Content-Type", "application/json")
+		// No metadata: a single page
+		w.Write([]byte(`{"status":"success","message":"ok","data":[{"probe_uuid":"p-1","status":"failed"},{"probe_uuid":"p-2","status":"failed"}]}`))
+	}), Config{})
+
+	count := 0
+	err := client.Monitoring.ListProbeResultsEach(context.Background(), &ProbeResultListOptions{
+		ListOptions: ListOptions{Page: 4},
+		Status:      "failed",
+	}, func(r *ProbeResult) error {
+		count++
+		assert.Equal(t, "failed", r.Status)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestStreamPage_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		code int
+	}{
+		{"malformed", `{"data":[{"server_uuid":`, http.StatusOK},
+		{"data not an array", `{"data":{"server_uuid":"srv-1"}}`, http.StatusOK},
+		{"api error", `{"status":"error","message":"forbidden"}`, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.code)
+				w.Write([]byte(tt.body))
+			}), Config{})
+
+			err := client.Servers.ListEach(context.Background(), nil, func(*Server) error { return nil })
+			assert.Error(t, err)
+		})
+	}
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":null,"meta":{"page":1,"total_pages":1}}`))
+	}), Config{})
+	err := client.Servers.ListEach(context.Background(), nil, func(*Server) error {
+		t.Error("callback called for null data")
+		return nil
+	})
+	assert.NoError(t, err)
+}
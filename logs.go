@@ -0,0 +1,378 @@
+package nexmonyx
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Log severities, from most to least severe, as used by syslog and the
+// systemd journal
+const (
+	LogSeverityEmergency = "emergency"
+	LogSeverityAlert     = "alert"
+	LogSeverityCritical  = "critical"
+	LogSeverityError     = "error"
+	LogSeverityWarning   = "warning"
+	LogSeverityNotice    = "notice"
+	LogSeverityInfo      = "info"
+	LogSeverityDebug     = "debug"
+)
+
+// logSeverities is indexed by syslog priority (0 emergency - 7 debug)
+var logSeverities = []string{
+	LogSeverityEmergency,
+	LogSeverityAlert,
+	LogSeverityCritical,
+	LogSeverityError,
+	LogSeverityWarning,
+	LogSeverityNotice,
+	LogSeverityInfo,
+	LogSeverityDebug,
+}
+
+const (
+	maxLogBatchSize      = 1000
+	maxLogMessageBytes   = 64 << 10
+	defaultLogBatchSize  = 500
+	defaultLogBufferSize = 10000
+	defaultLogFlushEvery = 5 * time.Second
+)
+
+// LogsService handles log shipping from agents
+type LogsService struct {
+	client *Client
+}
+
+// LogEntry is a log line with its structured fields
+type LogEntry struct {
+	Timestamp time.Time              `json:"timestamp"`
+	Severity  string                 `json:"severity"`         // See the LogSeverity constants
+	Source    string                 `json:"source,omitempty"` // e.g. a systemd unit "nginx.service" or a file path
+	Message   string                 `json:"message"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+}
+
+// ParseLogSeverity normalizes a severity name or syslog priority number,
+// accepting common aliases such as "warn", "err", "crit", "fatal", and
+// "trace". It returns "" for unknown values.
+func ParseLogSeverity(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if priority, err := strconv.Atoi(s); err == nil {
+		if priority >= 0 && priority < len(logSeverities) {
+			return logSeverities[priority]
+		}
+		return ""
+	}
+
+	switch s {
+	case "emerg", "panic":
+		return LogSeverityEmergency
+	case "crit", "fatal":
+		return LogSeverityCritical
+	case "err":
+		return LogSeverityError
+	case "warn":
+		return LogSeverityWarning
+	case "information", "informational":
+		return LogSeverityInfo
+	case "trace":
+		return LogSeverityDebug
+	}
+	if logSeverityRank(s) >= 0 {
+		return s
+	}
+	return ""
+}
+
+// logSeverityRank returns the syslog priority of a severity, or -1 when unknown
+func logSeverityRank(severity string) int {
+	for i, s := range logSeverities {
+		if s == severity {
+			return i
+		}
+	}
+	return -1
+}
+
+// LogSeverityAtLeast reports whether severity is as severe as minSeverity or
+// more. Unknown severities are treated as info.
+func LogSeverityAtLeast(severity, minSeverity string) bool {
+	rank, minRank := logSeverityRank(ParseLogSeverity(severity)), logSeverityRank(ParseLogSeverity(minSeverity))
+	if rank < 0 {
+		rank = logSeverityRank(LogSeverityInfo)
+	}
+	if minRank < 0 {
+		return true
+	}
+	return rank <= minRank
+}
+
+// FilterLogEntries returns the entries at minSeverity or above
+func FilterLogEntries(entries []LogEntry, minSeverity string) []LogEntry {
+	var filtered []LogEntry
+	for _, entry := range entries {
+		if LogSeverityAtLeast(entry.Severity, minSeverity) {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
+
+// LogEntryFromJournal converts a record from `journalctl --output=json` into a
+// LogEntry. MESSAGE, PRIORITY, __REALTIME_TIMESTAMP, and _SYSTEMD_UNIT (or
+// SYSLOG_IDENTIFIER) fill the entry; the remaining user and trusted fields
+// become Fields, while journal cursors and binary values are left out.
+func LogEntryFromJournal(record map[string]interface{}) LogEntry {
+	entry := LogEntry{Severity: LogSeverityInfo, Fields: make(map[string]interface{})}
+	for key, value := range record {
+		text, ok := value.(string)
+		if !ok {
+			continue // Binary fields are encoded as byte arrays
+		}
+		switch key {
+		case "MESSAGE":
+			entry.Message = text
+		case "PRIORITY":
+			if severity := ParseLogSeverity(text); severity != "" {
+				entry.Severity = severity
+			}
+		case "__REALTIME_TIMESTAMP":
+			if usec, err := strconv.ParseInt(text, 10, 64); err == nil {
+				entry.Timestamp = time.UnixMicro(usec).UTC()
+			}
+		case "_SYSTEMD_UNIT":
+			entry.Source = text
+		default:
+			if !strings.HasPrefix(key, "__") {
+				entry.Fields[key] = text
+			}
+		}
+	}
+	if entry.Source == "" {
+		if identifier, ok := entry.Fields["SYSLOG_IDENTIFIER"].(string); ok {
+			entry.Source = identifier
+		}
+	}
+	return entry
+}
+
+// Submit ships log entries for a server in gzip-compressed batches of up to
+// 1000 entries. The server UUID defaults to the one used for authentication.
+// When a batch fails, the entries from that batch on are not sent and the
+// error reports how many entries were accepted.
+func (s *LogsService) Submit(ctx context.Context, serverUUID string, entries []LogEntry) error {
+	if serverUUID == "" {
+		serverUUID = s.client.config.Auth.ServerUUID
+	}
+	if serverUUID == "" {
+		return fmt.Errorf("server UUID is required")
+	}
+
+	for start := 0; start < len(entries); start += maxLogBatchSize {
+		end := min(start+maxLogBatchSize, len(entries))
+		if err := s.submitBatch(ctx, serverUUID, entries[start:end]); err != nil {
+			return fmt.Errorf("%d of %d log entries submitted: %w", start, len(entries), err)
+		}
+	}
+	return nil
+}
+
+func (s *LogsService) submitBatch(ctx context.Context, serverUUID string, entries []LogEntry) error {
+	var body bytes.Buffer
+	gz := gzip.NewWriter(&body)
+	if err := json.NewEncoder(gz).Encode(map[string]interface{}{"entries": entries}); err != nil {
+		return fmt.Errorf("failed to encode log entries: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to compress log entries: %w", err)
+	}
+
+	var resp StandardResponse
+	_, err := s.client.Do(ctx, &Request{
+		Method:  "POST",
+		Path:    fmt.Sprintf("/v2/servers/%s/logs", url.PathEscape(serverUUID)),
+		Headers: map[string]string{"Content-Encoding": "gzip"},
+		Body:    body.Bytes(),
+		Result:  &resp,
+	})
+	return err
+}
+
+// LogShipperOptions configures a LogShipper
+type LogShipperOptions struct {
+	// MinSeverity drops entries less severe than this (default: all entries)
+	MinSeverity string
+
+	// BufferSize is the most entries held in memory. When the buffer is full
+	// the oldest entries are dropped (default: 10000).
+	BufferSize int
+
+	// BatchSize is the number of entries sent per request (default: 500, max: 1000)
+	BatchSize int
+
+	// FlushInterval between flushes in Run (default: 5s). Run also flushes as
+	// soon as a full batch is buffered.
+	FlushInterval time.Duration
+
+	// OnError is called when a flush in Run fails. The entries stay buffered
+	// and are retried on the next flush.
+	OnError func(error)
+}
+
+// LogShipper buffers log entries in bounded memory and ships them in batches,
+// so agents can add entries as they are read without blocking on the API
+type LogShipper struct {
+	service    *LogsService
+	serverUUID string
+	options    LogShipperOptions
+	ready      chan struct{}
+
+	mu      sync.Mutex
+	buffer  []LogEntry
+	removed uint64 // Entries removed from the front of the buffer, sent or dropped
+	dropped uint64
+}
+
+// NewShipper creates a LogShipper for a server. The server UUID defaults to
+// the one used for authentication. Call Run to start shipping.
+func (s *LogsService) NewShipper(serverUUID string, opts *LogShipperOptions) *LogShipper {
+	options := LogShipperOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.BufferSize <= 0 {
+		options.BufferSize = defaultLogBufferSize
+	}
+	if options.BatchSize <= 0 {
+		options.BatchSize = defaultLogBatchSize
+	}
+	options.BatchSize = min(options.BatchSize, maxLogBatchSize, options.BufferSize)
+	if options.FlushInterval <= 0 {
+		options.FlushInterval = defaultLogFlushEvery
+	}
+
+	return &LogShipper{
+		service:    s,
+		serverUUID: serverUUID,
+		options:    options,
+		ready:      make(chan struct{}, 1),
+	}
+}
+
+// Add buffers an entry, returning false when it is below MinSeverity.
+// Messages over 64 KiB are truncated, and the oldest entry is dropped when the
+// buffer is full.
+func (l *LogShipper) Add(entry LogEntry) bool {
+	if l.options.MinSeverity != "" && !LogSeverityAtLeast(entry.Severity, l.options.MinSeverity) {
+		return false
+	}
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now().UTC()
+	}
+	if len(entry.Message) > maxLogMessageBytes {
+		cut := maxLogMessageBytes
+		for cut > 0 && !utf8.RuneStart(entry.Message[cut]) {
+			cut--
+		}
+		entry.Message = entry.Message[:cut]
+	}
+
+	l.mu.Lock()
+	if len(l.buffer) >= l.options.BufferSize {
+		l.buffer = l.buffer[1:]
+		l.removed++
+		l.dropped++
+	}
+	l.buffer = append(l.buffer, entry)
+	full := len(l.buffer) >= l.options.BatchSize
+	l.mu.Unlock()
+
+	if full {
+		select {
+		case l.ready <- struct{}{}:
+		default:
+		}
+	}
+	return true
+}
+
+// Pending returns the number of buffered entries
+func (l *LogShipper) Pending() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.buffer)
+}
+
+// Dropped returns the number of entries dropped because the buffer was full
+func (l *LogShipper) Dropped() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.dropped
+}
+
+// Flush sends the buffered entries in batches. Entries that could not be
+// sent stay buffered, ahead of entries added since.
+func (l *LogShipper) Flush(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		batch := l.buffer[:min(l.options.BatchSize, len(l.buffer))]
+		start := l.removed
+		l.mu.Unlock()
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if err := l.service.Submit(ctx, l.serverUUID, batch); err != nil {
+			return err
+		}
+
+		l.mu.Lock()
+		// Entries dropped while the batch was in flight are already gone
+		if sent := start + uint64(len(batch)); sent > l.removed {
+			l.buffer = l.buffer[sent-l.removed:]
+			l.removed = sent
+		}
+		l.mu.Unlock()
+	}
+}
+
+// Run flushes every FlushInterval, and whenever a full batch is buffered,
+// until ctx is done. It then makes a final flush, bounded by FlushInterval,
+// and returns ctx's error.
+func (l *LogShipper) Run(ctx context.Context) error {
+	ctx, stop, err := l.service.client.runBackground(ctx, l.Pending)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	ticker := time.NewTicker(l.options.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), l.options.FlushInterval)
+			defer cancel()
+			if err := l.Flush(flushCtx); err != nil && l.options.OnError != nil {
+				l.options.OnError(err)
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		case <-l.ready:
+		}
+
+		if err := l.Flush(ctx); err != nil && ctx.Err() == nil && l.options.OnError != nil {
+			l.options.OnError(err)
+		}
+	}
+}
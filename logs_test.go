@@ -0,0 +1,166 @@
+package nexmonyx
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogSeverity(t *testing.T) {
+	for input, want := range map[string]string{
+		"3":       LogSeverityError,
+		"WARN":    LogSeverityWarning,
+		"crit":    LogSeverityCritical,
+		"fatal":   LogSeverityCritical,
+		"notice":  LogSeverityNotice,
+		"trace":   LogSeverityDebug,
+		"9":       "",
+		"verbose": "",
+	} {
+		assert.Equal(t, want, ParseLogSeverity(input), input)
+	}
+
+	assert.True(t, LogSeverityAtLeast("error", "warning"))
+	assert.False(t, LogSeverityAtLeast("info", "warn"))
+	assert.True(t, LogSeverityAtLeast("verbose", "info"), "unknown severities count as info")
+	assert.True(t, LogSeverityAtLeast("debug", ""))
+
+	entries := []LogEntry{{Severity: "debug"}, {Severity: "err"}, {Severity: "notice"}}
+	assert.Equal(t, []LogEntry{{Severity: "err"}, {Severity: "notice"}}, FilterLogEntries(entries, LogSeverityNotice))
+}
+
+func TestLogEntryFromJournal(t *testing.T) {
+	var record map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"__CURSOR": "s=abc;i=1",
+		"__REALTIME_TIMESTAMP": "1767225600123456",
+		"PRIORITY": "4",
+		"_SYSTEMD_UNIT": "nginx.service",
+		"SYSLOG_IDENTIFIER": "nginx",
+		"_PID": "812",
+		"MESSAGE": "upstream timed out",
+		"BINARY": [104, 105]
+	}`), &record))
+
+	entry := LogEntryFromJournal(record)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 123456000, time.UTC), entry.Timestamp)
+	assert.Equal(t, LogSeverityWarning, entry.Severity)
+	assert.Equal(t, "nginx.service", entry.Source)
+	assert.Equal(t, "upstream timed out", entry.Message)
+	assert.Equal(t, map[string]interface{}{"SYSLOG_IDENTIFIER": "nginx", "_PID": "812"}, entry.Fields)
+
+	delete(record, "_SYSTEMD_UNIT")
+	assert.Equal(t, "nginx", LogEntryFromJournal(record).Source)
+}
+
+// newLogServer records the entries of each log batch and fails while failing is set
+func newLogServer(t *testing.T) (*httptest.Server, func() [][]LogEntry, func(bool)) {
+	var mu sync.Mutex
+	var batches [][]LogEntry
+	failing := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/servers/srv-1/logs", r.URL.Path)
+		assert.Equal(t, "gzip", r.Header.Get("Content-Encoding"))
+		w.Header().Set("Content-Type", "application/json")
+
+		mu.Lock()
+		defer mu.Unlock()
+		if failing {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":"error","message":"rejected"}`))
+			return
+		}
+
+		gz, err := gzip.NewReader(r.Body)
+		require.NoError(t, err)
+		var body struct {
+			Entries []LogEntry `json:"entries"`
+		}
+		require.NoError(t, json.NewDecoder(gz).Decode(&body))
+		batches = append(batches, body.Entries)
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+
+	get := func() [][]LogEntry {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([][]LogEntry(nil), batches...)
+	}
+	setFailing := func(f bool) {
+		mu.Lock()
+		failing = f
+		mu.Unlock()
+	}
+	return server, get, setFailing
+}
+
+func TestLogsService_Submit(t *testing.T) {
+	server, batches, _ := newLogServer(t)
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{ServerUUID: "srv-1", ServerSecret: "secret"}})
+	require.NoError(t, err)
+
+	entries := make([]LogEntry, 2500)
+	for i := range entries {
+		entries[i] = LogEntry{Timestamp: time.Now().UTC(), Severity: LogSeverityInfo, Message: "line", Fields: map[string]interface{}{"n": float64(i)}}
+	}
+	require.NoError(t, client.Logs.Submit(context.Background(), "", entries))
+
+	sent := batches()
+	require.Len(t, sent, 3)
+	assert.Len(t, sent[0], 1000)
+	assert.Len(t, sent[2], 500)
+	assert.Equal(t, float64(2499), sent[2][499].Fields["n"])
+}
+
+func TestLogShipper(t *testing.T) {
+	server, batches, setFailing := newLogServer(t)
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	shipper := client.Logs.NewShipper("srv-1", &LogShipperOptions{MinSeverity: LogSeverityInfo, BufferSize: 5, BatchSize: 2})
+	assert.False(t, shipper.Add(LogEntry{Severity: LogSeverityDebug, Message: "skipped"}))
+	for i := 0; i < 7; i++ {
+		assert.True(t, shipper.Add(LogEntry{Severity: LogSeverityInfo, Message: string(rune('a' + i))}))
+	}
+	assert.Equal(t, 5, shipper.Pending())
+	assert.Equal(t, uint64(2), shipper.Dropped(), "the oldest entries are dropped when the buffer is full")
+
+	setFailing(true)
+	assert.Error(t, shipper.Flush(context.Background()))
+	assert.Equal(t, 5, shipper.Pending(), "failed entries stay buffered")
+
+	setFailing(false)
+	require.NoError(t, shipper.Flush(context.Background()))
+	assert.Zero(t, shipper.Pending())
+
+	var messages []string
+	for _, batch := range batches() {
+		assert.LessOrEqual(t, len(batch), 2)
+		for _, entry := range batch {
+			messages = append(messages, entry.Message)
+		}
+	}
+	assert.Equal(t, []string{"c", "d", "e", "f", "g"}, messages)
+
+	shipper.Add(LogEntry{Severity: LogSeverityError, Message: strings.Repeat("x", maxLogMessageBytes+10)})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, shipper.Run(ctx), context.Canceled)
+	assert.Zero(t, shipper.Pending(), "Run flushes before returning")
+	sent := batches()
+	assert.Len(t, sent[len(sent)-1][0].Message, maxLogMessageBytes)
+}
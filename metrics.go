@@ -25,22 +25,10 @@ func (s *MetricsService) Submit(ctx context.Context, serverUUID string, metrics
 	return err
 }
 
-// SubmitComprehensiveMetrics submits comprehensive metrics for a server
+// SubmitComprehensiveMetrics submits comprehensive metrics for a server.
+// Use SubmitComprehensiveFull to get the submission receipt.
 func (s *MetricsService) SubmitComprehensive(ctx context.Context, metrics *ComprehensiveMetricsRequest) error {
-	// If using server authentication and ServerUUID is not set in the request,
-	// automatically populate it from the client configuration
-	if s.client.config.Auth.ServerUUID != "" && metrics.ServerUUID == "" {
-		metrics.ServerUUID = s.client.config.Auth.ServerUUID
-	}
-
-	var resp StandardResponse
-
-	_, err := s.client.Do(ctx, &Request{
-		Method: "POST",
-		Path:   "/v2/metrics/comprehensive",
-		Body:   metrics,
-		Result: &resp,
-	})
+	_, err := s.SubmitComprehensiveFull(ctx, metrics)
 	return err
 }
 
@@ -0,0 +1,162 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// MaxBackfillAge is the oldest collection time the API accepts for backfilled metrics
+const MaxBackfillAge = 7 * 24 * time.Hour
+
+// MetricsGap is an interval during which no metrics were stored for a server
+type MetricsGap struct {
+	Start           time.Time `json:"start"`
+	End             time.Time `json:"end"`
+	DurationSeconds int64     `json:"duration_seconds"`
+	MissedSamples   int       `json:"missed_samples,omitempty"` // Expected samples based on the collection interval
+	Reason          string    `json:"reason,omitempty"`         // e.g. "agent_offline", "no_data"
+}
+
+// Duration returns the length of the gap
+func (g MetricsGap) Duration() time.Duration {
+	return g.End.Sub(g.Start)
+}
+
+// MetricsBackfillResult reports how a backfill batch was handled
+type MetricsBackfillResult struct {
+	Accepted int                    `json:"accepted"`
+	Rejected []MetricsBackfillError `json:"rejected,omitempty"`
+}
+
+// MetricsBackfillError describes a payload that was not backfilled
+type MetricsBackfillError struct {
+	Index       int    `json:"index"` // Position in the slice passed to Backfill
+	CollectedAt string `json:"collected_at"`
+	Reason      string `json:"reason"`
+}
+
+// GetGaps lists intervals within timeRange for which no metrics were stored,
+// typically because the agent was offline
+// Authentication: JWT Token or Server credentials required
+// Endpoint: GET /v2/metrics/server/{uuid}/gaps
+// Parameters:
+//   - serverUUID: Server UUID
+//   - timeRange: Window to inspect (defaults to the last 24 hours when nil)
+// Returns: Gaps ordered by start time
+func (s *MetricsService) GetGaps(ctx context.Context, serverUUID string, timeRange *QueryTimeRange) ([]MetricsGap, error) {
+	if timeRange == nil {
+		timeRange = Last24Hours()
+	}
+	start, end := timeRange.ToStrings()
+
+	var resp struct {
+		Data    []MetricsGap `json:"data"`
+		Status  string       `json:"status"`
+		Message string       `json:"message"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v2/metrics/server/%s/gaps", serverUUID),
+		Query: map[string]string{
+			"start": start,
+			"end":   end,
+		},
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// Backfill submits spooled comprehensive metrics with their original collection
+// times so they are stored in the matching time slots instead of being treated as
+// live data. Payloads without a valid RFC3339 CollectedAt, in the future, or older
+// than MaxBackfillAge are rejected locally and reported in the result; the rest are
+// sent in a single request.
+// Authentication: Server credentials required
+// Endpoint: POST /v2/metrics/comprehensive/backfill
+// Parameters:
+//   - requests: Historical payloads as they would have been passed to SubmitComprehensive
+// Returns: MetricsBackfillResult with the accepted count and every rejected payload
+func (s *MetricsService) Backfill(ctx context.Context, requests []*ComprehensiveMetricsRequest) (*MetricsBackfillResult, error) {
+	result := &MetricsBackfillResult{}
+
+	now := time.Now()
+	payloads := make([]ComprehensiveMetricsRequest, 0, len(requests))
+	indexes := make([]int, 0, len(requests))
+	for i, req := range requests {
+		if req == nil {
+			continue
+		}
+
+		reason := ""
+		collectedAt, err := time.Parse(time.RFC3339, req.CollectedAt)
+		switch {
+		case err != nil:
+			reason = "collected_at must be an RFC3339 timestamp"
+		case collectedAt.Sub(now) > 5*time.Minute:
+			reason = "collected_at is in the future"
+		case now.Sub(collectedAt) > MaxBackfillAge:
+			reason = fmt.Sprintf("collected_at is older than the maximum backfill age of %s", MaxBackfillAge)
+		}
+		if reason != "" {
+			result.Rejected = append(result.Rejected, MetricsBackfillError{
+				Index:       i,
+				CollectedAt: req.CollectedAt,
+				Reason:      reason,
+			})
+			continue
+		}
+
+		// Copy so filling in the server UUID doesn't modify the caller's spool
+		payload := *req
+		if s.client.config.Auth.ServerUUID != "" && payload.ServerUUID == "" {
+			payload.ServerUUID = s.client.config.Auth.ServerUUID
+		}
+		payloads = append(payloads, payload)
+		indexes = append(indexes, i)
+	}
+
+	if len(payloads) == 0 {
+		return result, nil
+	}
+
+	var resp struct {
+		Data    *MetricsBackfillResult `json:"data"`
+		Status  string                 `json:"status"`
+		Message string                 `json:"message"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v2/metrics/comprehensive/backfill",
+		Body: map[string]interface{}{
+			"backfill": true,
+			"metrics":  payloads,
+		},
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Data == nil {
+		result.Accepted = len(payloads)
+		return result, nil
+	}
+
+	// The API indexes into the batch it received; map back to the caller's slice
+	result.Accepted = resp.Data.Accepted
+	for _, rejected := range resp.Data.Rejected {
+		if rejected.Index >= 0 && rejected.Index < len(indexes) {
+			rejected.Index = indexes[rejected.Index]
+		}
+		result.Rejected = append(result.Rejected, rejected)
+	}
+
+	return result, nil
+}
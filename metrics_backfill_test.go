@@ -0,0 +1,141 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsService_GetGaps(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	end := start.Add(6 * time.Hour)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v2/metrics/server/server-123/gaps", r.URL.Path)
+		assert.Equal(t, start.Format(time.RFC3339), r.URL.Query().Get("start"))
+		assert.Equal(t, end.Format(time.RFC3339), r.URL.Query().Get("end"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data": []map[string]interface{}{
+				{
+					"start":            "2026-01-02T04:00:00Z",
+					"end":              "2026-01-02T04:30:00Z",
+					"duration_seconds": 1800,
+					"missed_samples":   30,
+					"reason":           "agent_offline",
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	gaps, err := client.Metrics.GetGaps(context.Background(), "server-123", &QueryTimeRange{Start: start, End: end})
+	require.NoError(t, err)
+	require.Len(t, gaps, 1)
+	assert.Equal(t, 30*time.Minute, gaps[0].Duration())
+	assert.Equal(t, 30, gaps[0].MissedSamples)
+	assert.Equal(t, "agent_offline", gaps[0].Reason)
+}
+
+func TestMetricsService_GetGaps_DefaultRange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEmpty(t, r.URL.Query().Get("start"))
+		assert.NotEmpty(t, r.URL.Query().Get("end"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status":"success","data":[]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	gaps, err := client.Metrics.GetGaps(context.Background(), "server-123", nil)
+	require.NoError(t, err)
+	assert.Empty(t, gaps)
+}
+
+func TestMetricsService_Backfill(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v2/metrics/comprehensive/backfill", r.URL.Path)
+
+		var body struct {
+			Backfill bool                          `json:"backfill"`
+			Metrics  []ComprehensiveMetricsRequest `json:"metrics"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.True(t, body.Backfill)
+		require.Len(t, body.Metrics, 2)
+		assert.Equal(t, "agent-uuid", body.Metrics[0].ServerUUID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data": map[string]interface{}{
+				"accepted": 1,
+				"rejected": []map[string]interface{}{
+					{"index": 1, "collected_at": body.Metrics[1].CollectedAt, "reason": "duplicate sample"},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{ServerUUID: "agent-uuid", ServerSecret: "secret"},
+	})
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	spool := []*ComprehensiveMetricsRequest{
+		{CollectedAt: now.Add(-2 * time.Hour).Format(time.RFC3339)},
+		{CollectedAt: "not-a-time"},
+		{CollectedAt: now.Add(-MaxBackfillAge - time.Hour).Format(time.RFC3339)},
+		{CollectedAt: now.Add(-time.Hour).Format(time.RFC3339)},
+	}
+
+	result, err := client.Metrics.Backfill(context.Background(), spool)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Accepted)
+	require.Len(t, result.Rejected, 3)
+
+	rejected := make(map[int]string)
+	for _, r := range result.Rejected {
+		rejected[r.Index] = r.Reason
+	}
+	assert.Contains(t, rejected[1], "RFC3339")
+	assert.Contains(t, rejected[2], "maximum backfill age")
+	assert.Equal(t, "duplicate sample", rejected[3], "server-side index is mapped back to the caller's slice")
+	assert.Empty(t, spool[0].ServerUUID, "caller's payloads must not be modified")
+}
+
+func TestMetricsService_Backfill_AllRejectedLocally(t *testing.T) {
+	client, err := NewClient(&Config{BaseURL: "http://127.0.0.1:1", Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	result, err := client.Metrics.Backfill(context.Background(), []*ComprehensiveMetricsRequest{
+		{CollectedAt: time.Now().Add(time.Hour).Format(time.RFC3339)},
+		nil,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Accepted)
+	require.Len(t, result.Rejected, 1)
+	assert.Contains(t, result.Rejected[0].Reason, "future")
+}
@@ -0,0 +1,52 @@
+//go:build nexmonyx_prometheus
+
+package nexmonyx
+
+import (
+	"github.com/nexmonyx/go-sdk/v2/internal/promcollector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsCollector returns a Prometheus collector for the client's own
+// behavior, so an agent can scrape how its SDK is doing alongside its other
+// metrics:
+//
+//   - nexmonyx_sdk_requests_total{method, endpoint, status}
+//   - nexmonyx_sdk_request_duration_seconds{method, endpoint}
+//   - nexmonyx_sdk_retries_total{method, endpoint}
+//   - nexmonyx_sdk_rate_limited_total{method, endpoint}
+//   - nexmonyx_sdk_heartbeats_total{result}
+//   - nexmonyx_sdk_spool_backlog
+//
+// endpoint is the request path with IDs replaced by {id}, and status is
+// "error" when no response was received. The spool backlog comes from
+// Config.SpoolBacklog and is 0 when that is not set.
+//
+// The collector observes the client's event bus, so it also counts requests
+// made by clients derived with WithToken and similar methods. Every call
+// returns the same collector; register it with a registry of your choice.
+//
+// MetricsCollector is only available in builds with the nexmonyx_prometheus
+// tag, so other builds do not depend on the Prometheus client library.
+//
+// Example:
+//
+//	registry := prometheus.NewRegistry()
+//	registry.MustRegister(client.MetricsCollector())
+//	http.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+func (c *Client) MetricsCollector() prometheus.Collector {
+	c.collectorOnce.Do(func() {
+		m := promcollector.New(c.config.SpoolBacklog)
+		c.events.OnRequestEnd(func(e RequestEvent) {
+			m.ObserveRequest(e.Method, e.Path, e.StatusCode, e.Duration, e.Err)
+		})
+		c.events.OnRetry(func(e RequestEvent) {
+			m.ObserveRetry(e.Method, e.Path)
+		})
+		c.events.OnRateLimited(func(e RequestEvent) {
+			m.ObserveRateLimited(e.Method, e.Path)
+		})
+		c.collector = m
+	})
+	return c.collector.(prometheus.Collector)
+}
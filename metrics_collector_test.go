@@ -0,0 +1,71 @@
+//go:build nexmonyx_prometheus
+
+package nexmonyx
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_MetricsCollector(t *testing.T) {
+	var serverCalls int32
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/server/srv-123/details":
+			// Rate limited, then a server error, then success
+			switch atomic.AddInt32(&serverCalls, 1) {
+			case 1:
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(`{"status":"error","message":"slow down"}`))
+			case 2:
+				w.WriteHeader(http.StatusServiceUnavailable)
+				w.Write([]byte(`{"status":"error","message":"unavailable"}`))
+			default:
+				w.Write([]byte(`{"status":"success","data":{"server_uuid":"srv-123"}}`))
+			}
+		case "/v1/monitoring/heartbeat":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":"error","message":"invalid node"}`))
+		default:
+			w.Write([]byte(`{"status":"success"}`))
+		}
+	}), Config{SpoolBacklog: func() int { return 4 }})
+
+	collector := client.MetricsCollector()
+	assert.Same(t, collector, client.MetricsCollector())
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(collector))
+
+	ctx := context.Background()
+	_, err := client.Servers.GetDetails(ctx, "srv-123")
+	require.NoError(t, err)
+	require.NoError(t, client.Servers.Heartbeat(ctx))
+	assert.Error(t, client.Monitoring.Heartbeat(ctx, NodeInfo{AgentID: "agent-1"}))
+
+	err = testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP nexmonyx_sdk_heartbeats_total Server and monitoring agent heartbeats sent, by result.
+# TYPE nexmonyx_sdk_heartbeats_total counter
+nexmonyx_sdk_heartbeats_total{result="failure"} 1
+nexmonyx_sdk_heartbeats_total{result="success"} 1
+# HELP nexmonyx_sdk_rate_limited_total API responses with status 429, including retried ones.
+# TYPE nexmonyx_sdk_rate_limited_total counter
+nexmonyx_sdk_rate_limited_total{endpoint="/v1/server/{id}/details",method="GET"} 1
+# HELP nexmonyx_sdk_retries_total Failed API request attempts that were retried.
+# TYPE nexmonyx_sdk_retries_total counter
+nexmonyx_sdk_retries_total{endpoint="/v1/server/{id}/details",method="GET"} 2
+# HELP nexmonyx_sdk_spool_backlog Metric submissions spooled for a later backfill.
+# TYPE nexmonyx_sdk_spool_backlog gauge
+nexmonyx_sdk_spool_backlog 4
+`), "nexmonyx_sdk_heartbeats_total", "nexmonyx_sdk_rate_limited_total", "nexmonyx_sdk_retries_total", "nexmonyx_sdk_spool_backlog")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, testutil.CollectAndCount(collector, "nexmonyx_sdk_requests_total"))
+}
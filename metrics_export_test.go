@@ -0,0 +1,62 @@
+package nexmonyx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeSeriesResult_WriteCSV(t *testing.T) {
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	result := &TimeSeriesResult{Series: []TimeSeries{
+		{MetricName: "cpu.usage_percent", Labels: map[string]string{"server_uuid": "srv-1", "hostname": "web-1"}, Timestamps: []time.Time{start, start.Add(time.Minute)}, Values: []float64{12.5, 40}},
+		{MetricName: "cpu.usage_percent", Labels: map[string]string{"server_uuid": "srv-2", "tag:env": "prod, eu"}, Timestamps: []time.Time{start}, Values: []float64{3}},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, result.WriteCSV(&buf))
+	assert.Equal(t, "timestamp,metric_name,hostname,server_uuid,tag:env,value\n"+
+		"2026-10-01T00:00:00Z,cpu.usage_percent,web-1,srv-1,,12.5\n"+
+		"2026-10-01T00:01:00Z,cpu.usage_percent,web-1,srv-1,,40\n"+
+		"2026-10-01T00:00:00Z,cpu.usage_percent,,srv-2,\"prod, eu\",3\n", buf.String())
+}
+
+func TestMetricsService_ExportTimeSeries(t *testing.T) {
+	var accept string
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST /v2/metrics/query/export", r.Method+" "+r.URL.Path)
+		accept = r.Header.Get("Accept")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", accept)
+		w.Write([]byte("PAR1\x00\x01PAR1"))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	query := &QueryRequest{MetricNames: []string{"cpu.usage_percent"}, Granularity: MetricsGranularityRaw}
+	var buf bytes.Buffer
+	written, err := client.Metrics.ExportTimeSeries(ctx, query, MetricsExportParquet, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), written)
+	assert.Equal(t, "PAR1\x00\x01PAR1", buf.String())
+	assert.Equal(t, "application/vnd.apache.parquet", accept)
+	assert.Equal(t, "parquet", body["format"])
+	assert.Equal(t, "raw", body["granularity"])
+	assert.NotEmpty(t, body["start"], "a nil time range defaults to the last 24 hours")
+
+	_, err = client.Metrics.ExportTimeSeries(ctx, query, "xlsx", &buf)
+	assert.Error(t, err)
+	_, err = client.Metrics.ExportTimeSeries(ctx, &QueryRequest{}, MetricsExportCSV, &buf)
+	assert.Error(t, err)
+}
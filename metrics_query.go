@@ -0,0 +1,185 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Time-series query granularities. MetricsGranularityAuto lets the API pick
+// the finest bucket that keeps each series within its point limit.
+const (
+	MetricsGranularityAuto   = ""
+	MetricsGranularityRaw    = "raw"
+	MetricsGranularityMinute = "1m"
+	MetricsGranularity5Min   = "5m"
+	MetricsGranularityHour   = "1h"
+	MetricsGranularityDay    = "1d"
+)
+
+// Time-series group-by dimensions. Tag values are grouped with
+// MetricsGroupByTag.
+const (
+	MetricsGroupByServer = "server"
+	MetricsGroupByGroup  = "group"
+)
+
+// MetricsGroupByTag returns the group-by dimension for a server tag key
+func MetricsGroupByTag(key string) string {
+	return "tag:" + key
+}
+
+// QueryRequest selects time-series data to read back with QueryTimeSeries
+type QueryRequest struct {
+	ServerUUIDs []string        `json:"server_uuids,omitempty"` // Empty queries every server in the organization
+	MetricNames []string        `json:"metric_names"`           // e.g. "cpu.usage_percent", "memory.used_bytes"
+	TimeRange   *QueryTimeRange `json:"-"`                      // Defaults to the last 24 hours when nil
+	Granularity string          `json:"granularity,omitempty"`  // MetricsGranularity* constant
+	Aggregation string          `json:"aggregation,omitempty"`  // avg (default), sum, min, max, count
+	GroupBy     []string        `json:"group_by,omitempty"`     // MetricsGroupBy* dimensions; empty aggregates all servers into one series per metric
+	MaxPoints   int             `json:"max_points,omitempty"`   // Downsample each series to at most this many points; 0 uses the API default
+}
+
+// Validate checks the query locally
+func (q *QueryRequest) Validate() error {
+	if len(q.MetricNames) == 0 {
+		return fmt.Errorf("at least one metric name is required")
+	}
+	if q.TimeRange != nil && !q.TimeRange.End.After(q.TimeRange.Start) {
+		return fmt.Errorf("time range end must be after start")
+	}
+	switch q.Granularity {
+	case MetricsGranularityAuto, MetricsGranularityRaw, MetricsGranularityMinute,
+		MetricsGranularity5Min, MetricsGranularityHour, MetricsGranularityDay:
+	default:
+		return fmt.Errorf("invalid granularity %q", q.Granularity)
+	}
+	switch q.Aggregation {
+	case "", "avg", "sum", "min", "max", "count":
+	default:
+		return fmt.Errorf("invalid aggregation %q", q.Aggregation)
+	}
+	for _, dimension := range q.GroupBy {
+		if dimension != MetricsGroupByServer && dimension != MetricsGroupByGroup &&
+			(!strings.HasPrefix(dimension, "tag:") || dimension == "tag:") {
+			return fmt.Errorf("invalid group_by dimension %q", dimension)
+		}
+	}
+	if q.MaxPoints < 0 {
+		return fmt.Errorf("max_points must not be negative")
+	}
+	return nil
+}
+
+// body returns the request body with the time range resolved
+func (q *QueryRequest) body(format string) interface{} {
+	timeRange := q.TimeRange
+	if timeRange == nil {
+		timeRange = Last24Hours()
+	}
+	start, end := timeRange.ToStrings()
+
+	return struct {
+		*QueryRequest
+		Start  string `json:"start"`
+		End    string `json:"end"`
+		Format string `json:"format,omitempty"`
+	}{q, start, end, format}
+}
+
+// TimeSeriesResult is the response to a time-series query
+type TimeSeriesResult struct {
+	Start       time.Time    `json:"start"`
+	End         time.Time    `json:"end"`
+	Granularity string       `json:"granularity"` // Bucket size applied, coarser than requested when downsampled
+	Downsampled bool         `json:"downsampled"`
+	Series      []TimeSeries `json:"series"`
+}
+
+// TimeSeries is one metric's values for one combination of group-by values.
+// Timestamps and Values are parallel; buckets without data are omitted.
+type TimeSeries struct {
+	MetricName string            `json:"metric_name"`
+	Labels     map[string]string `json:"labels,omitempty"` // Group-by values, e.g. "server_uuid", "hostname", "tag:env"
+	Timestamps []time.Time       `json:"timestamps"`
+	Values     []float64         `json:"values"`
+}
+
+// TimeSeriesPoint is a single timestamped value
+type TimeSeriesPoint struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// Len returns the number of points in the series
+func (s *TimeSeries) Len() int {
+	if len(s.Values) < len(s.Timestamps) {
+		return len(s.Values)
+	}
+	return len(s.Timestamps)
+}
+
+// Points returns the series as timestamped values
+func (s *TimeSeries) Points() []TimeSeriesPoint {
+	points := make([]TimeSeriesPoint, s.Len())
+	for i := range points {
+		points[i] = TimeSeriesPoint{Timestamp: s.Timestamps[i], Value: s.Values[i]}
+	}
+	return points
+}
+
+// Latest returns the most recent point, or false for an empty series
+func (s *TimeSeries) Latest() (TimeSeriesPoint, bool) {
+	n := s.Len()
+	if n == 0 {
+		return TimeSeriesPoint{}, false
+	}
+	return TimeSeriesPoint{Timestamp: s.Timestamps[n-1], Value: s.Values[n-1]}, true
+}
+
+// ForMetric returns the series for a metric
+func (r *TimeSeriesResult) ForMetric(metricName string) []TimeSeries {
+	var series []TimeSeries
+	for _, s := range r.Series {
+		if s.MetricName == metricName {
+			series = append(series, s)
+		}
+	}
+	return series
+}
+
+// QueryTimeSeries reads metrics back as typed time-series, downsampled to the
+// requested granularity and grouped per server, group, or tag
+// Authentication: JWT Token or Server credentials required
+// Endpoint: POST /v2/metrics/query
+// Parameters:
+//   - query: Metrics, servers, time range, and bucketing to read
+//
+// Returns: One series per metric and combination of group-by values
+func (s *MetricsService) QueryTimeSeries(ctx context.Context, query *QueryRequest) (*TimeSeriesResult, error) {
+	if query == nil {
+		return nil, fmt.Errorf("query is required")
+	}
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp StandardResponse
+	resp.Data = &TimeSeriesResult{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v2/metrics/query",
+		Body:   query.body(""),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result, ok := resp.Data.(*TimeSeriesResult); ok {
+		return result, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
@@ -0,0 +1,94 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryRequestValidate(t *testing.T) {
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	valid := QueryRequest{
+		MetricNames: []string{"cpu.usage_percent"},
+		TimeRange:   &QueryTimeRange{Start: start, End: start.Add(time.Hour)},
+		Granularity: MetricsGranularity5Min,
+		GroupBy:     []string{MetricsGroupByServer, MetricsGroupByTag("env")},
+	}
+	require.NoError(t, valid.Validate())
+
+	for name, mutate := range map[string]func(*QueryRequest){
+		"no metrics":      func(q *QueryRequest) { q.MetricNames = nil },
+		"empty range":     func(q *QueryRequest) { q.TimeRange = &QueryTimeRange{Start: start, End: start} },
+		"bad granularity": func(q *QueryRequest) { q.Granularity = "15s" },
+		"bad aggregation": func(q *QueryRequest) { q.Aggregation = "p95" },
+		"bad group_by":    func(q *QueryRequest) { q.GroupBy = []string{"tag:"} },
+		"negative points": func(q *QueryRequest) { q.MaxPoints = -1 },
+	} {
+		query := valid
+		mutate(&query)
+		assert.Error(t, query.Validate(), name)
+	}
+}
+
+func TestMetricsService_QueryTimeSeries(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST /v2/metrics/query", r.Method+" "+r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{
+			"start":"2026-10-01T00:00:00Z","end":"2026-10-01T01:00:00Z","granularity":"5m","downsampled":true,
+			"series":[
+				{"metric_name":"cpu.usage_percent","labels":{"server_uuid":"srv-1"},"timestamps":["2026-10-01T00:00:00Z","2026-10-01T00:05:00Z"],"values":[12.5,40]},
+				{"metric_name":"cpu.usage_percent","labels":{"server_uuid":"srv-2"},"timestamps":[],"values":[]},
+				{"metric_name":"memory.used_percent","labels":{"server_uuid":"srv-1"},"timestamps":["2026-10-01T00:00:00Z"],"values":[63]}
+			]}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	result, err := client.Metrics.QueryTimeSeries(ctx, &QueryRequest{
+		ServerUUIDs: []string{"srv-1", "srv-2"},
+		MetricNames: []string{"cpu.usage_percent", "memory.used_percent"},
+		TimeRange:   &QueryTimeRange{Start: start, End: start.Add(time.Hour)},
+		Granularity: MetricsGranularityMinute,
+		Aggregation: "max",
+		GroupBy:     []string{MetricsGroupByServer},
+		MaxPoints:   10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-01T00:00:00Z", body["start"])
+	assert.Equal(t, "2026-10-01T01:00:00Z", body["end"])
+	assert.Equal(t, "1m", body["granularity"])
+	assert.Equal(t, []interface{}{"server"}, body["group_by"])
+	assert.Equal(t, float64(10), body["max_points"])
+	assert.NotContains(t, body, "TimeRange")
+
+	assert.True(t, result.Downsampled)
+	assert.Equal(t, MetricsGranularity5Min, result.Granularity)
+	cpu := result.ForMetric("cpu.usage_percent")
+	require.Len(t, cpu, 2)
+	assert.Equal(t, "srv-1", cpu[0].Labels["server_uuid"])
+	assert.Equal(t, []TimeSeriesPoint{
+		{Timestamp: start, Value: 12.5},
+		{Timestamp: start.Add(5 * time.Minute), Value: 40},
+	}, cpu[0].Points())
+	latest, ok := cpu[0].Latest()
+	assert.True(t, ok)
+	assert.Equal(t, 40.0, latest.Value)
+	_, ok = cpu[1].Latest()
+	assert.False(t, ok)
+
+	_, err = client.Metrics.QueryTimeSeries(ctx, &QueryRequest{MetricNames: []string{"cpu.usage_percent"}, Granularity: "10s"})
+	assert.Error(t, err)
+}
@@ -1,3 +1,5 @@
+//go:build !nexmonyx_agent
+
 package nexmonyx
 
 import (
@@ -395,3 +397,83 @@ func (s *MLService) GetAggregatedModelPerformance(ctx context.Context) (*ModelPe
 
 	return resp.Data, nil
 }
+
+// GetTrainingJob retrieves a single training job by ID
+// Authentication: JWT Token required
+// Endpoint: GET /v1/ml/training-jobs/{job_id}
+// Parameters:
+//   - jobID: Training job ID
+// Returns: Training job with current status and progress
+func (s *MLService) GetTrainingJob(ctx context.Context, jobID uint) (*TrainingJob, error) {
+	var resp struct {
+		Data    *TrainingJob `json:"data"`
+		Status  string       `json:"status"`
+		Message string       `json:"message"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/ml/training-jobs/%d", jobID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// GetTrainingJobStatus retrieves the lightweight status of a training job without logs or metrics
+// Authentication: JWT Token required
+// Endpoint: GET /v1/ml/training-jobs/{job_id}/status
+// Parameters:
+//   - jobID: Training job ID
+// Returns: Current status, progress, and status message
+func (s *MLService) GetTrainingJobStatus(ctx context.Context, jobID uint) (*TrainingJobStatus, error) {
+	var resp struct {
+		Data    *TrainingJobStatus `json:"data"`
+		Status  string             `json:"status"`
+		Message string             `json:"message"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/ml/training-jobs/%d/status", jobID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// TrainingJobOperation returns an Operation that tracks a training job started by TrainModel
+//
+// Example:
+//
+//	job, _ := client.ML.TrainModel(ctx, "tag_prediction", nil)
+//	trained, err := client.ML.TrainingJobOperation(job.ID, nil).Wait(ctx)
+func (s *MLService) TrainingJobOperation(jobID uint, opts *OperationOptions) *Operation[TrainingJob] {
+	return NewOperation(fmt.Sprintf("training-job-%d", jobID), func(ctx context.Context) (*TrainingJob, OperationStatus, error) {
+		job, err := s.GetTrainingJob(ctx, jobID)
+		if err != nil {
+			return nil, OperationStatus{}, err
+		}
+		if job == nil {
+			return nil, OperationStatus{}, ErrUnexpectedResponse
+		}
+
+		return job, OperationStatus{
+			State:    normalizeOperationState(job.Status),
+			Progress: job.Progress,
+			Message:  job.ErrorMessage,
+		}, nil
+	}, opts)
+}
+
+// WaitForTrainingJob waits for a training job started with ML.TrainModel and
+// returns the finished job
+func (c *Client) WaitForTrainingJob(ctx context.Context, jobID uint, opts *PollOptions) (*TrainingJob, error) {
+	return waitForResult(ctx, c, c.ML.TrainingJobOperation(jobID, nil), opts)
+}
@@ -1,3 +1,5 @@
+//go:build !nexmonyx_agent
+
 package nexmonyx
 
 import (
@@ -540,6 +542,41 @@ func TestMLService_GetTrainingJobs(t *testing.T) {
 	assert.Equal(t, 10, meta.TotalItems)
 }
 
+func TestMLService_GetTrainingJobStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/ml/training-jobs/42/status", r.URL.Path)
+
+		response := map[string]interface{}{
+			"status": "success",
+			"data": TrainingJobStatus{
+				JobID:     42,
+				Status:    "running",
+				Progress:  60,
+				Message:   "Evaluating validation set",
+				UpdatedAt: CustomTime{Time: time.Now()},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	status, err := client.ML.GetTrainingJobStatus(context.Background(), 42)
+	require.NoError(t, err)
+	assert.Equal(t, uint(42), status.JobID)
+	assert.Equal(t, "running", status.Status)
+	assert.Equal(t, 60, status.Progress)
+	assert.Equal(t, "Evaluating validation set", status.Message)
+}
+
 func TestMLService_GetAggregatedModelPerformance(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "GET", r.Method)
@@ -653,3 +690,27 @@ func TestMLService_ErrorHandling(t *testing.T) {
 		})
 	}
 }
+
+func TestMLService_TrainingJobOperation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/ml/training-jobs/5", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": TrainingJob{
+				ID:        5,
+				Status:    "completed",
+				Progress:  100,
+				CreatedAt: CustomTime{Time: time.Now()},
+				UpdatedAt: CustomTime{Time: time.Now()},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	job, err := client.ML.TrainingJobOperation(5, fastOperationOptions()).Wait(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 100, job.Progress)
+}
@@ -6,7 +6,9 @@ import (
 	"time"
 )
 
-// CustomTime handles custom time parsing for API responses
+// CustomTime handles custom time parsing for API responses. It accepts RFC
+// 3339 and a few other layouts, layouts added with RegisterTimeLayouts, and
+// Unix timestamps in seconds or milliseconds, quoted or not.
 type CustomTime struct {
 	time.Time
 }
@@ -14,37 +16,29 @@ type CustomTime struct {
 // UnmarshalJSON implements json.Unmarshaler
 func (ct *CustomTime) UnmarshalJSON(b []byte) error {
 	s := string(b)
-	s = s[1 : len(s)-1] // Remove quotes
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1] // Remove quotes
+	}
 
 	if s == "null" || s == "" {
 		return nil
 	}
 
-	// Try multiple formats
-	formats := []string{
-		time.RFC3339,
-		"2006-01-02T15:04:05.000Z",
-		"2006-01-02T15:04:05Z",
-		"2006-01-02 15:04:05",
-	}
-
-	var err error
-	for _, format := range formats {
-		ct.Time, err = time.Parse(format, s)
-		if err == nil {
-			return nil
-		}
+	t, err := parseCustomTime(s)
+	if err != nil {
+		return err
 	}
-
-	return err
+	ct.Time = t
+	return nil
 }
 
-// MarshalJSON implements json.Marshaler
+// MarshalJSON implements json.Marshaler, using the layout set with
+// SetTimeFormat (default: time.RFC3339)
 func (ct CustomTime) MarshalJSON() ([]byte, error) {
 	if ct.IsZero() {
 		return []byte("null"), nil
 	}
-	return json.Marshal(ct.Time.Format(time.RFC3339))
+	return json.Marshal(ct.Time.Format(customTimeFormat()))
 }
 
 // GormModel is the base model for all entities
@@ -55,6 +49,12 @@ type GormModel struct {
 	DeletedAt *CustomTime `json:"deleted_at,omitempty"`
 }
 
+// IsDeleted returns true if the resource was soft-deleted. Deleted resources
+// are only listed with ListOptions.IncludeDeleted or OnlyDeleted.
+func (m *GormModel) IsDeleted() bool {
+	return m.DeletedAt != nil && !m.DeletedAt.IsZero()
+}
+
 // BaseModel is the base model for entities with UUID
 type BaseModel struct {
 	UUID      string      `json:"uuid"`
@@ -194,22 +194,40 @@ type Server struct {
 	Labels       map[string]string      `json:"labels,omitempty"`
 	Metadata     map[string]interface{} `json:"metadata,omitempty"`
 	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+
+	// End-of-life information, set while the server is archived
+	ArchivedAt      *CustomTime            `json:"archived_at,omitempty"`
+	ArchivedBy      string                 `json:"archived_by,omitempty"`
+	RetentionPolicy *ServerRetentionPolicy `json:"retention_policy,omitempty"`
+	DataExpiresAt   *CustomTime            `json:"data_expires_at,omitempty"` // When retained history will be deleted
+}
+
+// IsArchived reports whether the server has been archived
+func (s *Server) IsArchived() bool {
+	return s.ArchivedAt != nil
+}
+
+// ServerRetentionPolicy controls what happens to an archived server's historical data
+type ServerRetentionPolicy struct {
+	RetentionDays    int    `json:"retention_days,omitempty"`    // Days to keep metrics and alert history (0 uses the organization default)
+	KeepIndefinitely bool   `json:"keep_indefinitely,omitempty"` // Keep history until the server is deleted
+	Reason           string `json:"reason,omitempty"`            // Why the server was decommissioned
 }
 
 // ServerCreateRequest represents a request to create/register a new server
 type ServerCreateRequest struct {
-	Hostname       string `json:"hostname"`
-	MainIP         string `json:"main_ip"`
-	OS             string `json:"os"`
-	OSVersion      string `json:"os_version"`
-	OSArch         string `json:"os_arch"`
-	SerialNumber   string `json:"serial_number"`
-	MacAddress     string `json:"mac_address"`
-	Environment    string `json:"environment,omitempty"`
-	Location       string `json:"location,omitempty"`
-	Classification string `json:"classification,omitempty"`
-	HardwareIP     string `json:"hardware_ip,omitempty"`
-	HardwareType   string `json:"hardware_type,omitempty"`
+	Hostname       string      `json:"hostname"`
+	MainIP         string      `json:"main_ip"`
+	OS             string      `json:"os"`
+	OSVersion      string      `json:"os_version"`
+	OSArch         string      `json:"os_arch"`
+	SerialNumber   string      `json:"serial_number"`
+	MacAddress     string      `json:"mac_address"`
+	Environment    Environment `json:"environment,omitempty"`
+	Location       string      `json:"location,omitempty"`
+	Classification string      `json:"classification,omitempty"`
+	HardwareIP     string      `json:"hardware_ip,omitempty"`
+	HardwareType   string      `json:"hardware_type,omitempty"`
 }
 
 // ServerRegistrationResponse represents the response from server registration
@@ -221,11 +239,11 @@ type ServerRegistrationResponse struct {
 
 // ServerUpdateRequest represents a request to update server information
 type ServerUpdateRequest struct {
-	Hostname       string `json:"hostname,omitempty"`
-	MainIP         string `json:"main_ip,omitempty"`
-	Environment    string `json:"environment,omitempty"`
-	Location       string `json:"location,omitempty"`
-	Classification string `json:"classification,omitempty"`
+	Hostname       string      `json:"hostname,omitempty"`
+	MainIP         string      `json:"main_ip,omitempty"`
+	Environment    Environment `json:"environment,omitempty"`
+	Location       string      `json:"location,omitempty"`
+	Classification string      `json:"classification,omitempty"`
 }
 
 // ScopeFilters represents filters for matching servers in alert rule scope
@@ -313,11 +331,11 @@ type ServerDiskInfo struct {
 // ServerDetailsUpdateRequest represents a request to update detailed server information
 type ServerDetailsUpdateRequest struct {
 	// Basic server information
-	Hostname       string `json:"hostname,omitempty"`
-	MainIP         string `json:"main_ip,omitempty"`
-	Environment    string `json:"environment,omitempty"`
-	Location       string `json:"location,omitempty"`
-	Classification string `json:"classification,omitempty"`
+	Hostname       string      `json:"hostname,omitempty"`
+	MainIP         string      `json:"main_ip,omitempty"`
+	Environment    Environment `json:"environment,omitempty"`
+	Location       string      `json:"location,omitempty"`
+	Classification string      `json:"classification,omitempty"`
 	// System information
 	OS           string `json:"os,omitempty"`
 	OSVersion    string `json:"os_version,omitempty"`
@@ -428,10 +446,10 @@ type AlertConditions struct {
 
 // AlertThreshold represents a threshold configuration
 type AlertThreshold struct {
-	Value    float64 `json:"value"`
-	Operator string  `json:"operator"` // >, >=, <, <=, ==, !=
-	Duration int     `json:"duration"` // in minutes (how long condition must be true)
-	Severity string  `json:"severity"` // critical, warning, info
+	Value    float64       `json:"value"`
+	Operator string        `json:"operator"` // >, >=, <, <=, ==, !=
+	Duration int           `json:"duration"` // in minutes (how long condition must be true)
+	Severity AlertSeverity `json:"severity"` // critical, warning, info
 }
 
 // AlertState represents the current state of an alert
@@ -520,8 +538,8 @@ type CreateAlertInstanceRequest struct {
 	OrganizationID uint                   `json:"organization_id"`
 	RuleID         uint                   `json:"rule_id"`
 	ServerID       uint                   `json:"server_id"`
-	State          string                 `json:"state"`     // firing, acknowledged, resolved
-	Severity       string                 `json:"severity"`  // info, warning, critical
+	State          string                 `json:"state"`    // firing, acknowledged, resolved
+	Severity       AlertSeverity          `json:"severity"` // info, warning, critical
 	Value          float64                `json:"value"`
 	Message        string                 `json:"message"`
 	Metadata       map[string]interface{} `json:"metadata,omitempty"`
@@ -677,6 +695,9 @@ type HardwareInventoryInfo struct {
 	RAIDControllers     []RAIDControllerInfo   `json:"raid_controllers,omitempty"`
 	TemperatureSensors  []TemperatureSensorInfo `json:"temperature_sensors,omitempty"`
 	Services            *ServiceInfo           `json:"services,omitempty"`
+
+	// Baseboard management controller (iDRAC, iLO, ...)
+	BMC *BMCInventoryInfo `json:"bmc,omitempty"`
 }
 
 // SystemHardwareInfo represents system-level hardware information
@@ -900,6 +921,16 @@ type NetworkMetrics struct {
 	ErrorsOut   int64  `json:"errors_out"`
 	DropsIn     int64  `json:"drops_in"`
 	DropsOut    int64  `json:"drops_out"`
+
+	// Virtual interfaces. Kind is empty or "physical" for flat interfaces;
+	// bond slaves and bridge ports are reported as their own entries too.
+	Kind      string         `json:"kind,omitempty"`   // physical, bond, bridge, vlan
+	Master    string         `json:"master,omitempty"` // Bond or bridge this interface belongs to
+	SpeedMbps int64          `json:"speed_mbps,omitempty"`
+	LinkUp    *bool          `json:"link_up,omitempty"`
+	Bond      *BondMetrics   `json:"bond,omitempty"`
+	Bridge    *BridgeMetrics `json:"bridge,omitempty"`
+	VLAN      *VLANMetrics   `json:"vlan,omitempty"`
 }
 
 // ProcessMetrics represents process metrics
@@ -915,6 +946,11 @@ type ProcessMetrics struct {
 	CreateTime    int64   `json:"create_time"`
 	OpenFiles     int     `json:"open_files"`
 	NumThreads    int     `json:"num_threads"`
+
+	// Process tree and cgroup placement
+	ParentPID   int    `json:"parent_pid,omitempty"`
+	Cgroup      string `json:"cgroup,omitempty"`       // e.g. "/system.slice/nginx.service"
+	ContainerID string `json:"container_id,omitempty"` // Set for processes running in a container
 }
 
 // ComprehensiveMetricsRequest represents a comprehensive metrics submission
@@ -928,8 +964,11 @@ type ComprehensiveMetricsRequest struct {
 	DiskUsageAggregate *DiskUsageAggregate    `json:"disk_usage_aggregate,omitempty"`
 	Network            []NetworkMetrics       `json:"network,omitempty"`
 	Processes          []ProcessMetrics       `json:"processes,omitempty"`
+	ProcessGroups      []ProcessGroupMetrics  `json:"process_groups,omitempty"`
+	Containers         []ContainerMetrics     `json:"containers,omitempty"`
 	Temperature        *TemperatureMetrics    `json:"temperature,omitempty"`
 	Power              *PowerMetrics          `json:"power,omitempty"`
+	GPU                []GPUMetrics           `json:"gpu,omitempty"`
 	Services           *ServiceInfo           `json:"services,omitempty"`
 	CustomMetrics      map[string]interface{} `json:"custom_metrics,omitempty"`
 }
@@ -1191,7 +1230,7 @@ type NamespaceDeployment struct {
 // ProbeCreateRequest represents a request to create a probe
 type ProbeCreateRequest struct {
 	Name           string                 `json:"name"`
-	Type           string                 `json:"type"`
+	Type           ProbeType              `json:"type"`
 	Target         string                 `json:"target"`
 	Configuration  map[string]interface{} `json:"configuration,omitempty"`
 	Interval       int                    `json:"interval"`
@@ -1199,12 +1238,14 @@ type ProbeCreateRequest struct {
 	OrganizationID uint                   `json:"organization_id"`
 	RegionCode     string                 `json:"region_code,omitempty"`
 	Enabled        bool                   `json:"enabled"`
+	Tags           []string               `json:"tags,omitempty"`
+	TargetGroupID  uint                   `json:"target_group_id,omitempty"` // Check every target in a ProbeTargetGroup instead of Target
 }
 
 // ProbeUpdateRequest represents a request to update a probe
 type ProbeUpdateRequest struct {
 	Name          *string                `json:"name,omitempty"`
-	Type          *string                `json:"type,omitempty"`
+	Type          *ProbeType             `json:"type,omitempty"`
 	Target        *string                `json:"target,omitempty"`
 	Configuration map[string]interface{} `json:"configuration,omitempty"`
 	Interval      *int                   `json:"interval,omitempty"`
@@ -1352,6 +1393,18 @@ type ServiceInfo struct {
 	Services []*ServiceMonitoringInfo      `json:"services,omitempty"`
 	Metrics  []*ServiceMetrics             `json:"metrics,omitempty"`
 	Logs     map[string][]ServiceLogEntry  `json:"logs,omitempty"`
+
+	// ListeningPorts are the sockets accepting connections on the server
+	ListeningPorts []ListeningPort `json:"listening_ports,omitempty"`
+}
+
+// ListeningPort is a socket accepting connections on a server
+type ListeningPort struct {
+	Port     int    `json:"port"`
+	Protocol string `json:"protocol"`          // tcp, udp
+	Address  string `json:"address,omitempty"` // Bound address, e.g. "0.0.0.0" or "::1"
+	Process  string `json:"process,omitempty"`
+	Service  string `json:"service,omitempty"` // Owning systemd unit, if known
 }
 
 // ServiceMonitoringInfo represents service monitoring data
@@ -1525,6 +1578,10 @@ type IncidentListOptions struct {
 	ServerID uint   `url:"server_id,omitempty"`
 	ProbeID  uint   `url:"probe_id,omitempty"`
 	Sort     string `url:"sort,omitempty"`
+
+	Tags          []string   `url:"tags,omitempty,comma"` // Incidents must carry every tag
+	StartedBefore *time.Time `url:"started_before,omitempty"`
+	StartedAfter  *time.Time `url:"started_after,omitempty"`
 }
 
 // IncidentStats represents incident statistics
@@ -1840,7 +1897,7 @@ func NewServerDetailsUpdateRequest() *ServerDetailsUpdateRequest {
 func (r *ServerDetailsUpdateRequest) WithBasicInfo(hostname, mainIP, environment, location, classification string) *ServerDetailsUpdateRequest {
 	r.Hostname = hostname
 	r.MainIP = mainIP
-	r.Environment = environment
+	r.Environment = Environment(environment)
 	r.Location = location
 	r.Classification = classification
 	return r
@@ -2366,6 +2423,33 @@ type TagInheritanceRuleCreateRequest struct {
 	Priority     int               `json:"priority"`
 }
 
+// TagInheritanceRuleUpdateRequest represents a request to update an inheritance rule
+type TagInheritanceRuleUpdateRequest struct {
+	Name         *string            `json:"name,omitempty"`
+	Description  *string            `json:"description,omitempty"`
+	SourceType   *InheritanceSource `json:"source_type,omitempty"`
+	TargetType   *InheritanceTarget `json:"target_type,omitempty"`
+	Namespace    *string            `json:"namespace,omitempty"`
+	KeyPattern   *string            `json:"key_pattern,omitempty"`
+	ValuePattern *string            `json:"value_pattern,omitempty"`
+	Conditions   *string            `json:"conditions,omitempty"`
+	Enabled      *bool              `json:"enabled,omitempty"`
+	Priority     *int               `json:"priority,omitempty"`
+}
+
+// TagInheritanceRuleRun represents a single execution of an inheritance rule
+type TagInheritanceRuleRun struct {
+	ID             uint        `json:"id"`
+	RuleID         uint        `json:"rule_id"`
+	Status         string      `json:"status"`       // pending, running, completed, failed
+	TriggeredBy    string      `json:"triggered_by"` // schedule, manual
+	ProcessedCount int         `json:"processed_count"`
+	AppliedCount   int         `json:"applied_count"`
+	ErrorMessage   string      `json:"error_message,omitempty"`
+	StartedAt      CustomTime  `json:"started_at"`
+	CompletedAt    *CustomTime `json:"completed_at,omitempty"`
+}
+
 // OrganizationTag represents a tag set at the organization level
 type OrganizationTag struct {
 	ID             uint       `json:"id"`
@@ -2441,6 +2525,32 @@ func (o *OrganizationTagListOptions) ToQuery() map[string]string {
 	return query
 }
 
+// TagInheritanceRuleListOptions provides filtering options for inheritance rules
+type TagInheritanceRuleListOptions struct {
+	Enabled    *bool
+	SourceType InheritanceSource
+	TargetType InheritanceTarget
+}
+
+// ToQuery converts options to query parameters
+func (o *TagInheritanceRuleListOptions) ToQuery() map[string]string {
+	query := make(map[string]string)
+	if o.Enabled != nil {
+		if *o.Enabled {
+			query["enabled"] = "true"
+		} else {
+			query["enabled"] = "false"
+		}
+	}
+	if o.SourceType != "" {
+		query["source_type"] = string(o.SourceType)
+	}
+	if o.TargetType != "" {
+		query["target_type"] = string(o.TargetType)
+	}
+	return query
+}
+
 // ServerRelationshipListOptions provides filtering options for server relationships
 type ServerRelationshipListOptions struct {
 	ServerID     string
@@ -2689,6 +2799,7 @@ type TagDetectionRuleListOptions struct {
 	Limit     int
 }
 
+// ToQuery converts options to query parameters
 func (o *TagDetectionRuleListOptions) ToQuery() map[string]string {
 	query := make(map[string]string)
 	if o.Enabled != nil {
@@ -3860,6 +3971,7 @@ type AuditLog struct {
 	ErrorMessage     string                 `json:"error_message,omitempty"`
 	DurationMs       int                    `json:"duration_ms,omitempty"`      // Operation duration
 	ComplianceFlags  []string               `json:"compliance_flags,omitempty"` // GDPR, HIPAA, SOC2, etc.
+	ChangeReason     string                 `json:"change_reason,omitempty"`    // Sent with WithChangeReason
 	CreatedAt        CustomTime             `json:"created_at"`
 	Metadata         map[string]interface{} `json:"metadata,omitempty"`
 }
@@ -4566,6 +4678,18 @@ type ClusterStatistics struct {
 	LastCheckTime      CustomTime           `json:"last_check_time"`      // Most recent health check
 }
 
+// ClusterConnectionTestResult represents the outcome of a cluster connectivity test
+type ClusterConnectionTestResult struct {
+	Success           bool        `json:"success"`                      // Whether the API server was reachable and authenticated
+	Status            string      `json:"status"`                       // Resulting cluster status: online, offline, error
+	Message           string      `json:"message,omitempty"`            // Human-readable result summary
+	ErrorMessage      string      `json:"error_message,omitempty"`      // Error details if the test failed
+	LatencyMs         int64       `json:"latency_ms"`                   // Round-trip latency to the API server
+	KubernetesVersion string      `json:"kubernetes_version,omitempty"` // Server version reported by the cluster
+	NodeCount         int         `json:"node_count"`                   // Number of nodes discovered
+	TestedAt          *CustomTime `json:"tested_at,omitempty"`          // When the test was performed
+}
+
 // ============================================================================
 // Package/Tier Models
 // ============================================================================
@@ -154,6 +154,7 @@ func (s *MonitoringService) GetAgents(ctx context.Context, opts *ListOptions) ([
 
 // GetMonitoringStatus retrieves monitoring status for an organization
 func (s *MonitoringService) GetStatus(ctx context.Context, organizationID string) (*MonitoringStatus, error) {
+	organizationID = s.client.organizationID(ctx, organizationID)
 	var resp StandardResponse
 	resp.Data = &MonitoringStatus{}
 
@@ -209,6 +210,8 @@ type MonitoringProbe struct {
 	Config         map[string]interface{} `json:"config,omitempty"`
 	AlertConfig    *ProbeAlertConfig      `json:"alert_config,omitempty"`
 	Tags           []string               `json:"tags,omitempty"`
+	Affinity       *ProbeAffinity         `json:"affinity,omitempty"` // Restricts which agents may run the probe
+	TargetGroupID  *uint                  `json:"target_group_id,omitempty"` // Set when the probe checks a ProbeTargetGroup
 }
 
 // ProbeAlertConfig represents alert configuration for a probe
@@ -488,6 +491,7 @@ type ProbeResult struct {
 	StatusCode   int                 `json:"status_code,omitempty"`
 	Error        string              `json:"error,omitempty"`
 	Details      *ProbeResultDetails `json:"details,omitempty"`
+	Target       string              `json:"target,omitempty"` // Group member checked, for probes bound to a target group
 }
 
 // ProbeResultDetails represents detailed probe result information
@@ -530,6 +534,7 @@ type ProbeResultListOptions struct {
 	ProbeUUID string `url:"probe_uuid,omitempty"`
 	Status    string `url:"status,omitempty"`
 	Region    string `url:"region,omitempty"`
+	Target    string `url:"target,omitempty"` // Results for one member of the probe's target group
 }
 
 // ToQuery converts options to query parameters
@@ -544,6 +549,9 @@ func (o *ProbeResultListOptions) ToQuery() map[string]string {
 	if o.Region != "" {
 		params["region"] = o.Region
 	}
+	if o.Target != "" {
+		params["target"] = o.Target
+	}
 	return params
 }
 
@@ -621,6 +629,7 @@ type ProbeConfig struct {
 	UserAgent          *string           `json:"user_agent,omitempty"`
 	Keyword            *string           `json:"keyword,omitempty"`
 	Port               *int              `json:"port,omitempty"`
+	Steps              []ProbeStep       `json:"steps,omitempty"` // Scripted multi-step HTTP check; see package probeexec
 }
 
 // ProbeAlertChannel represents an alert channel for a probe
@@ -708,6 +717,31 @@ func (s *MonitoringService) Heartbeat(ctx context.Context, nodeInfo NodeInfo) er
 	return err
 }
 
+// GetRegionLoad retrieves scaling signals for the monitoring agents in a region,
+// for controllers that size agent deployments from probe load
+func (s *MonitoringService) GetRegionLoad(ctx context.Context, region string) (*RegionLoad, error) {
+	if region == "" {
+		return nil, fmt.Errorf("region is required")
+	}
+
+	var resp StandardResponse
+	resp.Data = &RegionLoad{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/monitoring/regions/%s/load", region),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if load, ok := resp.Data.(*RegionLoad); ok {
+		return load, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
 // ==========================================
 // Monitoring Agent Data Structures
 // ==========================================
@@ -727,6 +761,8 @@ type ProbeAssignment struct {
 	OrganizationID uint                   `json:"organization_id"`
 	AssignedAt     *CustomTime            `json:"assigned_at,omitempty"`
 	LastExecuted   *CustomTime            `json:"last_executed,omitempty"`
+	Affinity       *ProbeAffinity         `json:"affinity,omitempty"`
+	Targets        []ProbeTarget          `json:"targets,omitempty"` // Group members to check, one result each; Target is empty
 }
 
 // ProbeExecutionResult represents the result of executing a probe
@@ -752,6 +788,12 @@ type ProbeExecutionResult struct {
 	ContentMatch   *bool   `json:"content_match,omitempty"`
 	ResponseSize   int     `json:"response_size,omitempty"`   // bytes
 	ResponseBody   string  `json:"response_body,omitempty"`   // truncated for large responses
+
+	// Group member checked, for probes assigned with ProbeAssignment.Targets
+	Target string `json:"target,omitempty"`
+
+	// Certificate served by an HTTPS target, for certificate expiry tracking
+	Certificate *ProbeCertificate `json:"certificate,omitempty"`
 }
 
 // ProbeResultsSubmission represents a submission of multiple probe results
@@ -803,3 +845,26 @@ type MonitoringAgentHeartbeat struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// RegionLoad describes the probe workload of a region and how it is spread across agents
+type RegionLoad struct {
+	Region              string      `json:"region"`
+	AssignedProbes      int         `json:"assigned_probes"`       // Enabled probes assigned to the region
+	ExecutionsPerMinute float64     `json:"executions_per_minute"` // Expected executions at the assigned intervals
+	Backlog             int         `json:"backlog"`               // Executions due but not yet started
+	OldestBacklogAge    int         `json:"oldest_backlog_age"`    // seconds
+	ActiveAgents        int         `json:"active_agents"`
+	Agents              []AgentLoad `json:"agents,omitempty"`
+	RecommendedReplicas int         `json:"recommended_replicas"` // Agent replicas needed to keep up with the workload
+	UpdatedAt           *CustomTime `json:"updated_at,omitempty"`
+}
+
+// AgentLoad represents the utilization of a single monitoring agent
+type AgentLoad struct {
+	AgentID        string      `json:"agent_id"`
+	Status         string      `json:"status"` // healthy, degraded, unhealthy
+	AssignedProbes int         `json:"assigned_probes"`
+	MaxConcurrency int         `json:"max_concurrency,omitempty"`
+	Utilization    float64     `json:"utilization"`         // percentage of capacity in use
+	CPUUsage       float64     `json:"cpu_usage,omitempty"` // percentage
+	LastSeen       *CustomTime `json:"last_seen,omitempty"`
+}
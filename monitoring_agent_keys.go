@@ -103,6 +103,7 @@ func (s *MonitoringAgentKeysService) CreateAdmin(ctx context.Context, req *Creat
 
 // Create creates a new monitoring agent key for the organization
 func (s *MonitoringAgentKeysService) Create(ctx context.Context, organizationID string, req *CreateMonitoringAgentKeyRequest) (*CreateMonitoringAgentKeyResponse, error) {
+	organizationID = s.client.organizationID(ctx, organizationID)
 	// Clear organization ID as it's provided in the path for org endpoints
 	req.OrganizationID = 0
 
@@ -125,6 +126,7 @@ func (s *MonitoringAgentKeysService) Create(ctx context.Context, organizationID
 
 // List retrieves monitoring agent keys for an organization
 func (s *MonitoringAgentKeysService) List(ctx context.Context, organizationID string, opts *ListMonitoringAgentKeysOptions) ([]*MonitoringAgentKey, *PaginationMeta, error) {
+	organizationID = s.client.organizationID(ctx, organizationID)
 	var resp struct {
 		StandardResponse
 		Keys       []*MonitoringAgentKey `json:"keys"`
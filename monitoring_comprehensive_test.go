@@ -675,6 +675,55 @@ func TestMonitoringService_Heartbeat_Comprehensive(t *testing.T) {
 	})
 }
 
+func TestMonitoringService_GetRegionLoad_Comprehensive(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "GET", r.Method)
+			assert.Equal(t, "/v1/monitoring/regions/us-east-1/load", r.URL.Path)
+
+			response := StandardResponse{
+				Status: "success",
+				Data: &RegionLoad{
+					Region:              "us-east-1",
+					AssignedProbes:      120,
+					Backlog:             14,
+					ActiveAgents:        2,
+					RecommendedReplicas: 3,
+					Agents: []AgentLoad{
+						{AgentID: "agent-1", Status: "healthy", AssignedProbes: 60, Utilization: 92.5},
+						{AgentID: "agent-2", Status: "healthy", AssignedProbes: 60, Utilization: 88},
+					},
+				},
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(response)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient(&Config{
+			BaseURL: server.URL,
+			Auth:    AuthConfig{MonitoringKey: "test-key"},
+		})
+
+		load, err := client.Monitoring.GetRegionLoad(context.Background(), "us-east-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, 120, load.AssignedProbes)
+		assert.Equal(t, 14, load.Backlog)
+		assert.Equal(t, 3, load.RecommendedReplicas)
+		require.Len(t, load.Agents, 2)
+		assert.Equal(t, 92.5, load.Agents[0].Utilization)
+	})
+
+	t.Run("Missing Region", func(t *testing.T) {
+		client, _ := NewClient(&Config{Auth: AuthConfig{MonitoringKey: "test-key"}})
+
+		_, err := client.Monitoring.GetRegionLoad(context.Background(), "")
+		assert.Error(t, err)
+	})
+}
+
 func TestMonitoringService_ListProbeResults_Comprehensive(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
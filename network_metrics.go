@@ -0,0 +1,203 @@
+package nexmonyx
+
+import "time"
+
+// Network interface kinds, reported in NetworkMetrics.Kind
+const (
+	NetworkKindPhysical = "physical"
+	NetworkKindBond     = "bond"
+	NetworkKindBridge   = "bridge"
+	NetworkKindVLAN     = "vlan"
+)
+
+// Linux bonding modes
+const (
+	BondModeBalanceRR    = "balance-rr"
+	BondModeActiveBackup = "active-backup"
+	BondModeBalanceXOR   = "balance-xor"
+	BondModeBroadcast    = "broadcast"
+	BondMode8023AD       = "802.3ad"
+	BondModeBalanceTLB   = "balance-tlb"
+	BondModeBalanceALB   = "balance-alb"
+)
+
+// BondMetrics is the runtime state of a bond interface
+type BondMetrics struct {
+	Mode         string             `json:"mode"`                    // balance-rr, active-backup, 802.3ad, ...
+	ActiveSlave  string             `json:"active_slave,omitempty"`  // active-backup, balance-tlb and balance-alb only
+	PrimarySlave string             `json:"primary_slave,omitempty"` // Preferred active slave, if configured
+	MIIStatus    string             `json:"mii_status,omitempty"`    // up, down
+	Slaves       []BondSlaveMetrics `json:"slaves"`
+
+	// 802.3ad only
+	AggregatorID int    `json:"aggregator_id,omitempty"`
+	LACPRate     string `json:"lacp_rate,omitempty"` // slow, fast
+}
+
+// BondSlaveMetrics is the state of one slave of a bond
+type BondSlaveMetrics struct {
+	Interface        string `json:"interface"`
+	LinkUp           bool   `json:"link_up"`
+	LinkFailureCount int64  `json:"link_failure_count"` // Since the bond was created
+	SpeedMbps        int64  `json:"speed_mbps,omitempty"`
+	Duplex           string `json:"duplex,omitempty"`
+	PermanentMAC     string `json:"permanent_mac,omitempty"`
+	AggregatorID     int    `json:"aggregator_id,omitempty"` // 802.3ad; slaves outside the bond's aggregator carry no traffic
+}
+
+// BridgeMetrics is the runtime state of a bridge interface
+type BridgeMetrics struct {
+	STPEnabled      bool                `json:"stp_enabled"`
+	RootID          string              `json:"root_id,omitempty"`
+	BridgeID        string              `json:"bridge_id,omitempty"`
+	TopologyChanges int64               `json:"topology_changes,omitempty"`
+	VLANFiltering   bool                `json:"vlan_filtering,omitempty"`
+	Ports           []BridgePortMetrics `json:"ports"`
+}
+
+// IsRoot returns true if this bridge is the spanning tree root
+func (b *BridgeMetrics) IsRoot() bool {
+	return b.RootID != "" && b.RootID == b.BridgeID
+}
+
+// BridgePortMetrics is the state of one port of a bridge
+type BridgePortMetrics struct {
+	Interface string `json:"interface"`
+	State     string `json:"state"` // disabled, listening, learning, forwarding, blocking
+	PathCost  int    `json:"path_cost,omitempty"`
+	VLANs     []int  `json:"vlans,omitempty"` // With VLAN filtering
+}
+
+// VLANMetrics describes a VLAN interface
+type VLANMetrics struct {
+	ID       int    `json:"id"`
+	Parent   string `json:"parent"`
+	Protocol string `json:"protocol,omitempty"` // 802.1Q (default) or 802.1ad
+}
+
+// BondThroughput aggregates the traffic of a bond's slaves
+type BondThroughput struct {
+	Bond         string
+	Mode         string
+	Slaves       int
+	SlavesUp     int
+	CapacityMbps int64 // Speed of the links that can carry traffic
+
+	BytesRecv   int64
+	BytesSent   int64
+	PacketsRecv int64
+	PacketsSent int64
+	ErrorsIn    int64
+	ErrorsOut   int64
+	DropsIn     int64
+	DropsOut    int64
+}
+
+// BondThroughputs aggregates the counters of each bond's slaves in network.
+// Slaves missing from network are skipped; when none are present the bond's
+// own counters are used.
+func BondThroughputs(network []NetworkMetrics) []BondThroughput {
+	byName := make(map[string]*NetworkMetrics, len(network))
+	for i := range network {
+		byName[network[i].Interface] = &network[i]
+	}
+
+	var throughputs []BondThroughput
+	for i := range network {
+		bond := &network[i]
+		if bond.Bond == nil {
+			continue
+		}
+
+		t := BondThroughput{
+			Bond:         bond.Interface,
+			Mode:         bond.Bond.Mode,
+			Slaves:       len(bond.Bond.Slaves),
+			CapacityMbps: bond.Bond.capacityMbps(),
+		}
+		counted := 0
+		for _, slave := range bond.Bond.Slaves {
+			if slave.LinkUp {
+				t.SlavesUp++
+			}
+			if nic, ok := byName[slave.Interface]; ok {
+				t.add(nic)
+				counted++
+			}
+		}
+		if counted == 0 {
+			t.add(bond)
+		}
+		throughputs = append(throughputs, t)
+	}
+	return throughputs
+}
+
+// capacityMbps returns the speed of the up slaves that can carry traffic in
+// the bond's mode
+func (b *BondMetrics) capacityMbps() int64 {
+	var capacity int64
+	for _, slave := range b.Slaves {
+		if !slave.LinkUp {
+			continue
+		}
+		switch b.Mode {
+		case BondModeActiveBackup:
+			if slave.Interface == b.ActiveSlave {
+				return slave.SpeedMbps
+			}
+		case BondModeBroadcast:
+			// Every slave sends the same frames
+			if slave.SpeedMbps > capacity {
+				capacity = slave.SpeedMbps
+			}
+		case BondMode8023AD:
+			if b.AggregatorID == 0 || slave.AggregatorID == b.AggregatorID {
+				capacity += slave.SpeedMbps
+			}
+		default:
+			capacity += slave.SpeedMbps
+		}
+	}
+	return capacity
+}
+
+func (t *BondThroughput) add(nic *NetworkMetrics) {
+	t.BytesRecv += nic.BytesRecv
+	t.BytesSent += nic.BytesSent
+	t.PacketsRecv += nic.PacketsRecv
+	t.PacketsSent += nic.PacketsSent
+	t.ErrorsIn += nic.ErrorsIn
+	t.ErrorsOut += nic.ErrorsOut
+	t.DropsIn += nic.DropsIn
+	t.DropsOut += nic.DropsOut
+}
+
+// RatesSince returns the receive and transmit rates in bytes per second
+// between prev and t, taken elapsed apart. Counter resets yield 0.
+func (t BondThroughput) RatesSince(prev BondThroughput, elapsed time.Duration) (rxBytesPerSec, txBytesPerSec float64) {
+	if elapsed <= 0 {
+		return 0, 0
+	}
+	seconds := elapsed.Seconds()
+	if t.BytesRecv >= prev.BytesRecv {
+		rxBytesPerSec = float64(t.BytesRecv-prev.BytesRecv) / seconds
+	}
+	if t.BytesSent >= prev.BytesSent {
+		txBytesPerSec = float64(t.BytesSent-prev.BytesSent) / seconds
+	}
+	return rxBytesPerSec, txBytesPerSec
+}
+
+// Utilization returns the busier direction's rate as a percentage of
+// CapacityMbps, or 0 when the capacity is unknown
+func (t BondThroughput) Utilization(rxBytesPerSec, txBytesPerSec float64) float64 {
+	if t.CapacityMbps <= 0 {
+		return 0
+	}
+	busiest := rxBytesPerSec
+	if txBytesPerSec > busiest {
+		busiest = txBytesPerSec
+	}
+	return busiest * 8 / float64(t.CapacityMbps*1_000_000) * 100
+}
@@ -0,0 +1,99 @@
+package nexmonyx
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bondedNetwork() []NetworkMetrics {
+	up := true
+	return []NetworkMetrics{
+		{Interface: "eth0", Master: "bond0", SpeedMbps: 10000, LinkUp: &up, BytesRecv: 1000, BytesSent: 400},
+		{Interface: "eth1", Master: "bond0", SpeedMbps: 10000, LinkUp: &up, BytesRecv: 500, BytesSent: 100},
+		{Interface: "bond0", Kind: NetworkKindBond, BytesRecv: 1500, BytesSent: 500, Bond: &BondMetrics{
+			Mode:         BondMode8023AD,
+			AggregatorID: 1,
+			Slaves: []BondSlaveMetrics{
+				{Interface: "eth0", LinkUp: true, SpeedMbps: 10000, AggregatorID: 1},
+				{Interface: "eth1", LinkUp: true, SpeedMbps: 10000, AggregatorID: 1, LinkFailureCount: 2},
+				{Interface: "eth2", LinkUp: false, SpeedMbps: 10000, AggregatorID: 2, LinkFailureCount: 9},
+			},
+		}},
+		{Interface: "bond1", Kind: NetworkKindBond, BytesRecv: 70, BytesSent: 30, Bond: &BondMetrics{
+			Mode:        BondModeActiveBackup,
+			ActiveSlave: "eth4",
+			Slaves: []BondSlaveMetrics{
+				{Interface: "eth3", LinkUp: true, SpeedMbps: 1000},
+				{Interface: "eth4", LinkUp: true, SpeedMbps: 1000},
+			},
+		}},
+		{Interface: "br0", Kind: NetworkKindBridge, Bridge: &BridgeMetrics{
+			STPEnabled: true,
+			RootID:     "8000.aabbccddeeff",
+			BridgeID:   "8000.aabbccddeeff",
+			Ports:      []BridgePortMetrics{{Interface: "bond0.100", State: "forwarding"}},
+		}},
+		{Interface: "bond0.100", Kind: NetworkKindVLAN, Master: "br0", VLAN: &VLANMetrics{ID: 100, Parent: "bond0"}},
+	}
+}
+
+func TestBondThroughputs(t *testing.T) {
+	network := bondedNetwork()
+	throughputs := BondThroughputs(network)
+	require.Len(t, throughputs, 2)
+
+	lacp := throughputs[0]
+	assert.Equal(t, "bond0", lacp.Bond)
+	assert.Equal(t, 3, lacp.Slaves)
+	assert.Equal(t, 2, lacp.SlavesUp)
+	assert.Equal(t, int64(20000), lacp.CapacityMbps, "only up slaves in the active aggregator count")
+	assert.Equal(t, int64(1500), lacp.BytesRecv)
+	assert.Equal(t, int64(500), lacp.BytesSent)
+
+	backup := throughputs[1]
+	assert.Equal(t, int64(1000), backup.CapacityMbps, "active-backup carries one link")
+	assert.Equal(t, int64(70), backup.BytesRecv, "falls back to the bond's counters without slave entries")
+
+	later := lacp
+	later.BytesRecv += 1_250_000_000
+	later.BytesSent += 125_000_000
+	rx, tx := later.RatesSince(lacp, 10*time.Second)
+	assert.Equal(t, 125_000_000.0, rx)
+	assert.Equal(t, 12_500_000.0, tx)
+	assert.InDelta(t, 5.0, later.Utilization(rx, tx), 0.001)
+
+	rx, _ = lacp.RatesSince(later, time.Second)
+	assert.Zero(t, rx, "counter resets yield 0")
+
+	assert.True(t, network[4].Bridge.IsRoot())
+}
+
+func TestNetworkMetrics_VirtualInterfaces(t *testing.T) {
+	req := &ComprehensiveMetricsRequest{
+		ServerUUID:  "srv-1",
+		CollectedAt: time.Now().UTC().Format(time.RFC3339),
+		Network:     bondedNetwork(),
+	}
+	assert.Empty(t, req.Validate())
+
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	var decoded ComprehensiveMetricsRequest
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "802.3ad", decoded.Network[2].Bond.Mode)
+	assert.Equal(t, int64(2), decoded.Network[2].Bond.Slaves[1].LinkFailureCount)
+	assert.Equal(t, 100, decoded.Network[5].VLAN.ID)
+
+	req.Network[3].Bond.ActiveSlave = "eth9"
+	req.Network[5].VLAN.ID = 4095
+	req.Network[2].Bond.Slaves[0].LinkFailureCount = -1
+	assert.ElementsMatch(t, []string{
+		"network[2].bond.slaves[0].link_failure_count",
+		"network[3].bond.active_slave",
+		"network[5].vlan.id",
+	}, issueFields(req.Validate(), ValidationSeverityError))
+}
@@ -73,6 +73,26 @@ func (s *NotificationsService) GetNotificationStatus(ctx context.Context, req *N
 	return nil, ErrUnexpectedResponse
 }
 
+// CancelNotification cancels a scheduled notification that has not been sent yet
+func (s *NotificationsService) CancelNotification(ctx context.Context, notificationID uint) (*NotificationStatusInfo, error) {
+	var resp StandardResponse
+	resp.Data = &NotificationStatusInfo{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/notifications/%d/cancel", notificationID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if statusInfo, ok := resp.Data.(*NotificationStatusInfo); ok {
+		return statusInfo, nil
+	}
+	return nil, ErrUnexpectedResponse
+}
+
 // SendQuotaAlert is a convenience method for sending quota-related notifications
 func (s *NotificationsService) SendQuotaAlert(ctx context.Context, orgID uint, subject, content string, priority NotificationPriority, metadata map[string]interface{}) (*NotificationResponse, error) {
 	req := &NotificationRequest{
@@ -130,6 +150,28 @@ func (s *NotificationsService) TestChannel(ctx context.Context, orgID uint, chan
 	return nil, ErrUnexpectedResponse
 }
 
+// TestChannelByID tests a notification channel using only its ID. The organization
+// is resolved from the client's credentials, which suits controllers that store channel IDs.
+func (s *NotificationsService) TestChannelByID(ctx context.Context, channelID uint, testReq *ChannelTestRequest) (*NotificationChannelTestResult, error) {
+	var resp StandardResponse
+	resp.Data = &NotificationChannelTestResult{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/notifications/channels/%d/test", channelID),
+		Body:   testReq,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result, ok := resp.Data.(*NotificationChannelTestResult); ok {
+		return result, nil
+	}
+	return nil, ErrUnexpectedResponse
+}
+
 // ListChannels retrieves all notification channels for an organization
 func (s *NotificationsService) ListChannels(ctx context.Context, orgID uint, opts *ListOptions) ([]*NotificationChannel, *PaginationMeta, error) {
 	var resp PaginatedResponse
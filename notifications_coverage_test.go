@@ -144,6 +144,79 @@ func TestNotificationsService_GetNotificationStatus_Error(t *testing.T) {
 	assert.Nil(t, response)
 }
 
+func TestNotificationsService_CancelNotification(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/notifications/42/cancel", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data": map[string]interface{}{
+				"id":         42,
+				"status":     "cancelled",
+				"created_at": "2024-01-01T00:00:00Z",
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(&Config{BaseURL: server.URL})
+	info, err := client.Notifications.CancelNotification(context.Background(), 42)
+	assert.NoError(t, err)
+	if assert.NotNil(t, info) {
+		assert.Equal(t, uint(42), info.ID)
+		assert.Equal(t, "cancelled", info.Status)
+	}
+}
+
+func TestNotificationsService_CancelNotification_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status":  "error",
+			"message": "Notification has already been sent",
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(&Config{BaseURL: server.URL})
+	info, err := client.Notifications.CancelNotification(context.Background(), 42)
+	assert.Error(t, err)
+	assert.Nil(t, info)
+}
+
+func TestNotificationsService_TestChannelByID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/notifications/channels/7/test", r.URL.Path)
+
+		var body ChannelTestRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "hello", body.TestMessage)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data": map[string]interface{}{
+				"success":          true,
+				"response_time_ms": 120,
+				"tested_at":        "2024-01-01T00:00:00Z",
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(&Config{BaseURL: server.URL})
+	result, err := client.Notifications.TestChannelByID(context.Background(), 7, &ChannelTestRequest{TestMessage: "hello"})
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.True(t, result.Success)
+		assert.Equal(t, int64(120), result.ResponseTime)
+	}
+}
+
 func TestNotificationsService_SendQuotaAlert(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "/v1/notifications/send", r.URL.Path)
@@ -0,0 +1,111 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// AsyncOperation is an asynchronous server-side operation that can be polled.
+// Every *Operation[T] implements it, so WaitFor accepts operations of any
+// resource type.
+type AsyncOperation interface {
+	// ID returns the identifier of the tracked resource
+	ID() string
+
+	// Poll fetches the latest state once
+	Poll(ctx context.Context) (OperationStatus, error)
+
+	// Status returns the most recently observed status
+	Status() OperationStatus
+}
+
+var _ AsyncOperation = (*Operation[Report])(nil)
+
+// PollOptions configures how WaitFor polls an operation
+type PollOptions struct {
+	// Interval is the delay before the first re-poll (default: 2s)
+	Interval time.Duration
+
+	// MaxInterval caps the delay between polls (default: 30s)
+	MaxInterval time.Duration
+
+	// Backoff grows the delay after each poll (default: 1.5, use 1 for fixed intervals)
+	Backoff float64
+
+	// Timeout limits the total time spent waiting; zero waits until ctx is done
+	Timeout time.Duration
+
+	// OnProgress is called after every poll with the latest status
+	OnProgress func(OperationStatus)
+}
+
+// WaitFor polls op until it finishes, ctx is done, or opts.Timeout passes.
+// It returns the final status, and an *OperationError if the operation failed
+// or was cancelled. When the timeout passes first, the error wraps
+// context.DeadlineExceeded.
+//
+// Example:
+//
+//	op := client.Tasks.TaskOperation(task.ID, nil)
+//	status, err := client.WaitFor(ctx, op, &nexmonyx.PollOptions{
+//	    Interval:   time.Second,
+//	    Timeout:    10 * time.Minute,
+//	    OnProgress: func(s nexmonyx.OperationStatus) { log.Printf("%s %d%%", s.State, s.Progress) },
+//	})
+func (c *Client) WaitFor(ctx context.Context, op AsyncOperation, opts *PollOptions) (OperationStatus, error) {
+	options := PollOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.Interval <= 0 {
+		options.Interval = defaultOperationPollInterval
+	}
+	if options.MaxInterval <= 0 {
+		options.MaxInterval = defaultOperationMaxPollInterval
+	}
+	if options.MaxInterval < options.Interval {
+		options.MaxInterval = options.Interval
+	}
+	if options.Backoff < 1 {
+		options.Backoff = defaultOperationBackoff
+	}
+
+	waitCtx := ctx
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	status, err := pollUntilTerminal(waitCtx, op, options.Interval, options.MaxInterval, options.Backoff, options.OnProgress)
+	if err != nil {
+		if ctx.Err() == nil && waitCtx.Err() != nil {
+			return status, fmt.Errorf("operation %s did not finish within %s: %w", op.ID(), options.Timeout, context.DeadlineExceeded)
+		}
+		return status, err
+	}
+	if status.State == OperationStateFailed || status.State == OperationStateCancelled {
+		return status, &OperationError{ID: op.ID(), State: status.State, Message: status.Message}
+	}
+	return status, nil
+}
+
+// WaitForVMOperation waits for a VM lifecycle operation started by Start,
+// Stop, Restart, Pause, or Resume and returns the finished operation
+func (c *Client) WaitForVMOperation(ctx context.Context, orgID, vmID, operationID uint, opts *PollOptions) (*VMOperation, error) {
+	return waitForResult(ctx, c, c.VMs.LifecycleOperation(orgID, &VMOperation{ID: operationID, VMID: vmID}, nil), opts)
+}
+
+// WaitForTask waits for a task to finish and returns it
+func (c *Client) WaitForTask(ctx context.Context, taskID uint, opts *PollOptions) (*Task, error) {
+	return waitForResult(ctx, c, c.Tasks.TaskOperation(taskID, nil), opts)
+}
+
+// waitForResult waits for op with WaitFor and returns its final resource
+func waitForResult[T any](ctx context.Context, c *Client, op *Operation[T], opts *PollOptions) (*T, error) {
+	if _, err := c.WaitFor(ctx, op, opts); err != nil {
+		return nil, err
+	}
+	return op.Result()
+}
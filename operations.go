@@ -0,0 +1,213 @@
+package nexmonyx
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Operation states shared by every asynchronous API in the SDK
+const (
+	OperationStatePending   = "pending"
+	OperationStateRunning   = "running"
+	OperationStateSucceeded = "succeeded"
+	OperationStateFailed    = "failed"
+	OperationStateCancelled = "cancelled"
+)
+
+const (
+	defaultOperationPollInterval    = 2 * time.Second
+	defaultOperationMaxPollInterval = 30 * time.Second
+	defaultOperationBackoff         = 1.5
+)
+
+// OperationStatus is the normalized state of an asynchronous operation
+type OperationStatus struct {
+	State    string // pending, running, succeeded, failed, cancelled
+	Progress int    // 0-100, when reported by the API
+	Message  string // Progress text or error details
+}
+
+// IsTerminal returns true if the operation has finished (succeeded, failed, or cancelled)
+func (s OperationStatus) IsTerminal() bool {
+	return s.State == OperationStateSucceeded || s.State == OperationStateFailed || s.State == OperationStateCancelled
+}
+
+// OperationPollFunc fetches the latest state of an asynchronous operation.
+// It returns the current resource (which may be partially populated while the
+// operation is running) and its normalized status.
+type OperationPollFunc[T any] func(ctx context.Context) (*T, OperationStatus, error)
+
+// OperationOptions configures how an Operation is polled
+type OperationOptions struct {
+	// PollInterval is the delay before the first re-poll (default: 2s)
+	PollInterval time.Duration
+
+	// MaxPollInterval caps the delay between polls (default: 30s)
+	MaxPollInterval time.Duration
+
+	// BackoffMultiplier grows the delay after each poll (default: 1.5, use 1 for fixed intervals)
+	BackoffMultiplier float64
+
+	// OnProgress is called after every poll with the latest status
+	OnProgress func(OperationStatus)
+}
+
+// Operation tracks an asynchronous server-side operation such as report generation,
+// VM lifecycle changes, ML training, or background jobs. It is safe for concurrent use.
+//
+// Example:
+//
+//	op := client.Reporting.ReportOperation(report.ID, &nexmonyx.OperationOptions{
+//	    OnProgress: func(s nexmonyx.OperationStatus) { log.Printf("%s %d%%", s.State, s.Progress) },
+//	})
+//	report, err := op.Wait(ctx)
+type Operation[T any] struct {
+	id      string
+	poll    OperationPollFunc[T]
+	options OperationOptions
+
+	mu     sync.RWMutex
+	result *T
+	status OperationStatus
+	err    error
+}
+
+// NewOperation creates an Operation that uses poll to track progress
+func NewOperation[T any](id string, poll OperationPollFunc[T], opts *OperationOptions) *Operation[T] {
+	options := OperationOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = defaultOperationPollInterval
+	}
+	if options.MaxPollInterval <= 0 {
+		options.MaxPollInterval = defaultOperationMaxPollInterval
+	}
+	if options.MaxPollInterval < options.PollInterval {
+		options.MaxPollInterval = options.PollInterval
+	}
+	if options.BackoffMultiplier < 1 {
+		options.BackoffMultiplier = defaultOperationBackoff
+	}
+
+	return &Operation[T]{
+		id:      id,
+		poll:    poll,
+		options: options,
+		status:  OperationStatus{State: OperationStatePending},
+	}
+}
+
+// ID returns the identifier of the tracked resource
+func (o *Operation[T]) ID() string {
+	return o.id
+}
+
+// Poll fetches the latest state once and returns it. Polling a finished
+// operation returns the final status without contacting the API.
+func (o *Operation[T]) Poll(ctx context.Context) (OperationStatus, error) {
+	if o.Done() {
+		return o.Status(), nil
+	}
+
+	result, status, err := o.poll(ctx)
+	if err != nil {
+		return o.Status(), err
+	}
+
+	o.mu.Lock()
+	if result != nil {
+		o.result = result
+	}
+	o.status = status
+	if status.State == OperationStateFailed || status.State == OperationStateCancelled {
+		o.err = &OperationError{ID: o.id, State: status.State, Message: status.Message}
+	}
+	onProgress := o.options.OnProgress
+	o.mu.Unlock()
+
+	if onProgress != nil {
+		onProgress(status)
+	}
+
+	return status, nil
+}
+
+// Wait polls until the operation finishes or ctx is done. It returns the final
+// resource on success, or an *OperationError if the operation failed or was cancelled.
+func (o *Operation[T]) Wait(ctx context.Context) (*T, error) {
+	_, err := pollUntilTerminal(ctx, o, o.options.PollInterval, o.options.MaxPollInterval, o.options.BackoffMultiplier, nil)
+	if err != nil {
+		return nil, err
+	}
+	return o.Result()
+}
+
+// pollUntilTerminal polls op until it reaches a terminal state or ctx is
+// done, growing the delay between polls by backoff up to maxInterval
+func pollUntilTerminal(ctx context.Context, op AsyncOperation, interval, maxInterval time.Duration, backoff float64, onProgress func(OperationStatus)) (OperationStatus, error) {
+	for {
+		status, err := op.Poll(ctx)
+		if err != nil {
+			return status, err
+		}
+		if onProgress != nil {
+			onProgress(status)
+		}
+		if status.IsTerminal() {
+			return status, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return status, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * backoff)
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// Done returns true once the operation has reached a terminal state
+func (o *Operation[T]) Done() bool {
+	return o.Status().IsTerminal()
+}
+
+// Status returns the most recently observed status
+func (o *Operation[T]) Status() OperationStatus {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.status
+}
+
+// Result returns the most recently observed resource and the operation error, if any
+func (o *Operation[T]) Result() (*T, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.result, o.err
+}
+
+// normalizeOperationState maps the status strings used by individual services
+// onto the shared operation states
+func normalizeOperationState(status string) string {
+	switch strings.ToLower(strings.TrimSpace(status)) {
+	case "running", "in_progress", "processing", "generating", "training":
+		return OperationStateRunning
+	case "completed", "complete", "succeeded", "success", "done":
+		return OperationStateSucceeded
+	case "failed", "error", "timeout":
+		return OperationStateFailed
+	case "cancelled", "canceled":
+		return OperationStateCancelled
+	default:
+		return OperationStatePending
+	}
+}
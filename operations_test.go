@@ -0,0 +1,184 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeOperationResource struct {
+	Value string
+}
+
+func sequencedPoll(states ...OperationStatus) (OperationPollFunc[fakeOperationResource], *int32) {
+	var calls int32
+	return func(ctx context.Context) (*fakeOperationResource, OperationStatus, error) {
+		n := atomic.AddInt32(&calls, 1)
+		idx := int(n) - 1
+		if idx >= len(states) {
+			idx = len(states) - 1
+		}
+		return &fakeOperationResource{Value: states[idx].State}, states[idx], nil
+	}, &calls
+}
+
+func fastOperationOptions() *OperationOptions {
+	return &OperationOptions{PollInterval: time.Millisecond, MaxPollInterval: 2 * time.Millisecond}
+}
+
+func TestOperation_WaitSucceeds(t *testing.T) {
+	poll, calls := sequencedPoll(
+		OperationStatus{State: OperationStatePending},
+		OperationStatus{State: OperationStateRunning, Progress: 50},
+		OperationStatus{State: OperationStateSucceeded, Progress: 100},
+	)
+
+	var progress []int
+	opts := fastOperationOptions()
+	opts.OnProgress = func(s OperationStatus) { progress = append(progress, s.Progress) }
+
+	op := NewOperation("op-1", poll, opts)
+	assert.False(t, op.Done())
+
+	result, err := op.Wait(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, OperationStateSucceeded, result.Value)
+	assert.True(t, op.Done())
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+	assert.Equal(t, []int{0, 50, 100}, progress)
+
+	// Polling a finished operation does not contact the API again
+	status, err := op.Poll(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, OperationStateSucceeded, status.State)
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func TestOperation_WaitFailed(t *testing.T) {
+	poll, _ := sequencedPoll(
+		OperationStatus{State: OperationStateRunning},
+		OperationStatus{State: OperationStateFailed, Message: "disk full"},
+	)
+
+	op := NewOperation("op-2", poll, fastOperationOptions())
+	_, err := op.Wait(context.Background())
+	require.Error(t, err)
+
+	var opErr *OperationError
+	require.True(t, errors.As(err, &opErr))
+	assert.Equal(t, "op-2", opErr.ID)
+	assert.Equal(t, OperationStateFailed, opErr.State)
+	assert.Contains(t, err.Error(), "disk full")
+}
+
+func TestOperation_WaitContextCancelled(t *testing.T) {
+	poll, _ := sequencedPoll(OperationStatus{State: OperationStateRunning})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	op := NewOperation("op-3", poll, fastOperationOptions())
+	_, err := op.Wait(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, op.Done())
+}
+
+func TestOperation_PollError(t *testing.T) {
+	pollErr := errors.New("connection refused")
+	op := NewOperation("op-4", func(ctx context.Context) (*fakeOperationResource, OperationStatus, error) {
+		return nil, OperationStatus{}, pollErr
+	}, nil)
+
+	_, err := op.Wait(context.Background())
+	assert.ErrorIs(t, err, pollErr)
+	assert.Equal(t, OperationStatePending, op.Status().State)
+}
+
+func TestNormalizeOperationState(t *testing.T) {
+	tests := map[string]string{
+		"pending":     OperationStatePending,
+		"queued":      OperationStatePending,
+		"in_progress": OperationStateRunning,
+		"generating":  OperationStateRunning,
+		"completed":   OperationStateSucceeded,
+		"failed":      OperationStateFailed,
+		"canceled":    OperationStateCancelled,
+	}
+	for input, expected := range tests {
+		assert.Equal(t, expected, normalizeOperationState(input), input)
+	}
+}
+
+func TestVMsService_LifecycleOperation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/organizations/1/virtual-machines/7/operations/99", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": VMOperation{ID: 99, VMID: 7, Status: "failed", ErrorDetails: "hypervisor unreachable", CreatedAt: CustomTime{Time: time.Now()}},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	op := client.VMs.LifecycleOperation(1, &VMOperation{ID: 99, VMID: 7}, fastOperationOptions())
+	_, err = op.Wait(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "hypervisor unreachable")
+
+	current, _ := op.Result()
+	assert.Equal(t, uint(99), current.ID)
+}
+
+func TestClient_WaitFor(t *testing.T) {
+	client, err := NewClient(&Config{Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	fast := &PollOptions{Interval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+
+	t.Run("succeeds", func(t *testing.T) {
+		poll, calls := sequencedPoll(
+			OperationStatus{State: OperationStateRunning, Progress: 40},
+			OperationStatus{State: OperationStateSucceeded, Progress: 100},
+		)
+		var progress []int
+		opts := *fast
+		opts.OnProgress = func(s OperationStatus) { progress = append(progress, s.Progress) }
+
+		status, err := client.WaitFor(context.Background(), NewOperation("op-1", poll, nil), &opts)
+		require.NoError(t, err)
+		assert.Equal(t, OperationStateSucceeded, status.State)
+		assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+		assert.Equal(t, []int{40, 100}, progress)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		poll, _ := sequencedPoll(OperationStatus{State: OperationStateCancelled, Message: "stopped by user"})
+		_, err := client.WaitFor(context.Background(), NewOperation("op-2", poll, nil), fast)
+
+		var opErr *OperationError
+		require.True(t, errors.As(err, &opErr))
+		assert.Equal(t, "op-2", opErr.ID)
+		assert.Equal(t, OperationStateCancelled, opErr.State)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		poll, _ := sequencedPoll(OperationStatus{State: OperationStateRunning})
+		opts := *fast
+		opts.Timeout = 20 * time.Millisecond
+
+		status, err := client.WaitFor(context.Background(), NewOperation("op-3", poll, nil), &opts)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, err.Error(), "op-3 did not finish within 20ms")
+		assert.Equal(t, OperationStateRunning, status.State)
+	})
+}
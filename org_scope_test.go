@@ -0,0 +1,75 @@
+package nexmonyx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithOrganization(t *testing.T) {
+	var gotPath, gotHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotHeader = r.Header.Get(OrganizationHeader)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	// Unscoped requests carry no header
+	_, err = client.Settings.Get(context.Background(), "org-1")
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/organizations/org-1/settings", gotPath)
+	assert.Empty(t, gotHeader)
+
+	// The context fills in an empty organization ID and sets the header
+	ctx := WithOrganization(context.Background(), "org-2")
+	_, err = client.Settings.Get(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/organizations/org-2/settings", gotPath)
+	assert.Equal(t, "org-2", gotHeader)
+
+	// An explicit organization ID wins for the path
+	_, err = client.Billing.GetSubscription(ctx, "org-3")
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/organizations/org-3/subscription", gotPath)
+
+	// Endpoints without an organization in the path are scoped by the header
+	_, err = client.Do(ctx, &Request{Method: "GET", Path: "/v1/healthz"})
+	require.NoError(t, err)
+	assert.Equal(t, "org-2", gotHeader)
+}
+
+func TestClient_ForOrganization(t *testing.T) {
+	var gotPath, gotHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotHeader = r.Header.Get(OrganizationHeader)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":[],"meta":{"page":1,"total_pages":1}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	scoped := client.ForOrganization("org-1")
+	assert.Empty(t, client.Config().Organization, "the parent client is not scoped")
+
+	_, _, err = scoped.Certificates.ListCertificates(context.Background(), "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "/v2/organizations/org-1/certificates", gotPath)
+	assert.Equal(t, "org-1", gotHeader)
+
+	// The context overrides the client's scope
+	_, _, err = scoped.Certificates.ListCertificates(WithOrganization(context.Background(), "org-2"), "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "/v2/organizations/org-2/certificates", gotPath)
+	assert.Equal(t, "org-2", gotHeader)
+}
@@ -2,6 +2,8 @@ package nexmonyx
 
 import (
 	"context"
+	"fmt"
+	"strings"
 )
 
 // PackagesService handles organization package/tier management and limits
@@ -106,3 +108,61 @@ func (s *PackagesService) ValidateProbeConfig(ctx context.Context, req *ProbeCon
 
 	return resp.Data, nil
 }
+
+// ValidateProbeConfig checks a probe configuration against the package limits
+// without contacting the API, so tooling can reject an obviously invalid probe
+// before creating it. The probe count can only be checked against the number of
+// additional probes requested, since the package does not carry current usage;
+// PackagesService.ValidateProbeConfig remains the authoritative check.
+// A limit of zero or less is treated as unlimited.
+func (p *OrganizationPackage) ValidateProbeConfig(req *ProbeConfigValidationRequest) *ProbeConfigValidationResult {
+	result := &ProbeConfigValidationResult{
+		ProbeTypeAllowed:  true,
+		FrequencyAllowed:  true,
+		RegionsAllowed:    true,
+		ProbeCountAllowed: true,
+		MaxProbes:         p.MaxProbes,
+		MinFrequency:      p.MinFrequency,
+		MaxRegions:        p.MaxRegions,
+		AllowedProbeTypes: p.AllowedProbeTypes,
+	}
+	if req == nil {
+		result.Valid = true
+		return result
+	}
+
+	if len(p.AllowedProbeTypes) > 0 {
+		result.ProbeTypeAllowed = false
+		for _, t := range p.AllowedProbeTypes {
+			if strings.EqualFold(t, req.ProbeType) {
+				result.ProbeTypeAllowed = true
+				break
+			}
+		}
+		if !result.ProbeTypeAllowed {
+			result.Violations = append(result.Violations,
+				fmt.Sprintf("probe type %q is not available on the %s package", req.ProbeType, p.PackageTier))
+		}
+	}
+
+	if p.MinFrequency > 0 && req.Frequency < p.MinFrequency {
+		result.FrequencyAllowed = false
+		result.Violations = append(result.Violations,
+			fmt.Sprintf("frequency of %d seconds is below the minimum of %d seconds", req.Frequency, p.MinFrequency))
+	}
+
+	if p.MaxRegions > 0 && len(req.Regions) > p.MaxRegions {
+		result.RegionsAllowed = false
+		result.Violations = append(result.Violations,
+			fmt.Sprintf("%d regions requested but the package allows %d", len(req.Regions), p.MaxRegions))
+	}
+
+	if p.MaxProbes > 0 && req.AdditionalProbes != nil && *req.AdditionalProbes > p.MaxProbes {
+		result.ProbeCountAllowed = false
+		result.Violations = append(result.Violations,
+			fmt.Sprintf("%d probes requested but the package allows %d", *req.AdditionalProbes, p.MaxProbes))
+	}
+
+	result.Valid = len(result.Violations) == 0
+	return result
+}
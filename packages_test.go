@@ -342,3 +342,40 @@ func TestPackagesService_ErrorHandling(t *testing.T) {
 		})
 	}
 }
+
+func TestOrganizationPackage_ValidateProbeConfig(t *testing.T) {
+	pkg := &OrganizationPackage{
+		PackageTier:       "standard",
+		MaxProbes:         5,
+		MaxRegions:        1,
+		MinFrequency:      300,
+		AllowedProbeTypes: []string{"HTTP", "ICMP"},
+	}
+
+	result := pkg.ValidateProbeConfig(&ProbeConfigValidationRequest{
+		ProbeType: "http",
+		Frequency: 300,
+		Regions:   []string{"us-east-1"},
+	})
+	assert.True(t, result.Valid)
+	assert.Empty(t, result.Violations)
+	assert.Equal(t, 5, result.MaxProbes)
+
+	additional := 6
+	result = pkg.ValidateProbeConfig(&ProbeConfigValidationRequest{
+		ProbeType:        "DNS",
+		Frequency:        60,
+		Regions:          []string{"us-east-1", "eu-west-1"},
+		AdditionalProbes: &additional,
+	})
+	assert.False(t, result.Valid)
+	assert.False(t, result.ProbeTypeAllowed)
+	assert.False(t, result.FrequencyAllowed)
+	assert.False(t, result.RegionsAllowed)
+	assert.False(t, result.ProbeCountAllowed)
+	assert.Len(t, result.Violations, 4)
+
+	unlimited := &OrganizationPackage{PackageTier: "gold"}
+	result = unlimited.ValidateProbeConfig(&ProbeConfigValidationRequest{ProbeType: "TCP", Frequency: 10})
+	assert.True(t, result.Valid)
+}
@@ -0,0 +1,193 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// PayloadValidationResult describes whether a payload would be accepted by the API
+type PayloadValidationResult struct {
+	Valid    bool                `json:"valid"`
+	Errors   map[string][]string `json:"errors,omitempty"`   // Field path (e.g. "disks[0].device") -> problems
+	Warnings map[string][]string `json:"warnings,omitempty"` // Accepted but suspicious values
+
+	// Local is true when the API has no validation endpoint and the SDK's
+	// built-in checks were used instead
+	Local bool `json:"-"`
+}
+
+// Err returns a *ValidationError describing the field errors, or nil when the payload is valid
+func (r *PayloadValidationResult) Err() error {
+	if r.Valid {
+		return nil
+	}
+
+	fields := make([]string, 0, len(r.Errors))
+	for field := range r.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	return &ValidationError{
+		StatusCode: 400,
+		Message:    fmt.Sprintf("invalid fields: %s", strings.Join(fields, ", ")),
+		Errors:     r.Errors,
+	}
+}
+
+func (r *PayloadValidationResult) addError(field, format string, args ...interface{}) {
+	if r.Errors == nil {
+		r.Errors = make(map[string][]string)
+	}
+	r.Errors[field] = append(r.Errors[field], fmt.Sprintf(format, args...))
+	r.Valid = false
+}
+
+func (r *PayloadValidationResult) addWarning(field, format string, args ...interface{}) {
+	if r.Warnings == nil {
+		r.Warnings = make(map[string][]string)
+	}
+	r.Warnings[field] = append(r.Warnings[field], fmt.Sprintf(format, args...))
+}
+
+// Validate checks a comprehensive metrics payload without storing it.
+// The API's validation-only endpoint is used when available; otherwise the payload
+// is checked locally and the result is marked Local.
+// Authentication: Server credentials or API key required
+// Endpoint: POST /v2/metrics/comprehensive/validate
+// Parameters:
+//   - metrics: Payload as it would be passed to SubmitComprehensive
+// Returns: PayloadValidationResult with per-field errors
+func (s *MetricsService) Validate(ctx context.Context, metrics *ComprehensiveMetricsRequest) (*PayloadValidationResult, error) {
+	if metrics == nil {
+		return nil, fmt.Errorf("metrics request is required")
+	}
+
+	// Mirror SubmitComprehensive, which fills in the server UUID from the credentials
+	payload := *metrics
+	if s.client.config.Auth.ServerUUID != "" && payload.ServerUUID == "" {
+		payload.ServerUUID = s.client.config.Auth.ServerUUID
+	}
+
+	result, err := s.client.validatePayload(ctx, "/v2/metrics/comprehensive/validate", &payload)
+	if err != nil {
+		if !isUnsupportedEndpoint(err) {
+			return nil, err
+		}
+		result = validateComprehensiveMetrics(&payload)
+	}
+
+	return result, nil
+}
+
+// ValidateResults checks probe execution results without storing them.
+// The API's validation-only endpoint is used when available; otherwise the results
+// are checked locally and the result is marked Local.
+// Authentication: Monitoring key required
+// Endpoint: POST /v1/monitoring/results/validate
+// Parameters:
+//   - results: Results as they would be passed to SubmitResults
+// Returns: PayloadValidationResult with per-field errors
+func (s *MonitoringService) ValidateResults(ctx context.Context, results []ProbeExecutionResult) (*PayloadValidationResult, error) {
+	result, err := s.client.validatePayload(ctx, "/v1/monitoring/results/validate", &ProbeResultsSubmission{
+		Results: results,
+	})
+	if err != nil {
+		if !isUnsupportedEndpoint(err) {
+			return nil, err
+		}
+		result = validateProbeResults(results)
+	}
+
+	return result, nil
+}
+
+// validatePayload posts body to a validation-only endpoint. A 400 response is
+// reported as an invalid result rather than an error.
+func (c *Client) validatePayload(ctx context.Context, path string, body interface{}) (*PayloadValidationResult, error) {
+	var resp struct {
+		Data    *PayloadValidationResult `json:"data"`
+		Status  string                   `json:"status"`
+		Message string                   `json:"message"`
+	}
+
+	_, err := c.Do(ctx, &Request{
+		Method: "POST",
+		Path:   path,
+		Body:   body,
+		Result: &resp,
+	})
+	if err != nil {
+		if validationErr, ok := err.(*ValidationError); ok {
+			// The 400 handler keeps the raw body as the message; extract field errors from it
+			var errBody struct {
+				Message string              `json:"message"`
+				Errors  map[string][]string `json:"errors"`
+			}
+			result := &PayloadValidationResult{Errors: validationErr.Errors}
+			if len(result.Errors) == 0 && json.Unmarshal([]byte(validationErr.Message), &errBody) == nil {
+				result.Errors = errBody.Errors
+				if len(result.Errors) == 0 && errBody.Message != "" {
+					result.Errors = map[string][]string{"payload": {errBody.Message}}
+				}
+			}
+			if len(result.Errors) == 0 {
+				result.Errors = map[string][]string{"payload": {validationErr.Message}}
+			}
+			return result, nil
+		}
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, ErrUnexpectedResponse
+	}
+
+	return resp.Data, nil
+}
+
+// validateComprehensiveMetrics applies the SDK's built-in checks to a metrics payload
+func validateComprehensiveMetrics(m *ComprehensiveMetricsRequest) *PayloadValidationResult {
+	return validationResult(m.Validate())
+}
+
+// validateProbeResults applies the SDK's built-in checks to probe execution results
+func validateProbeResults(results []ProbeExecutionResult) *PayloadValidationResult {
+	r := &PayloadValidationResult{Valid: true, Local: true}
+
+	if len(results) == 0 {
+		r.addError("results", "must contain at least one result")
+		return r
+	}
+
+	for i, res := range results {
+		field := fmt.Sprintf("results[%d]", i)
+		if res.ProbeID == 0 && res.ProbeUUID == "" {
+			r.addError(field+".probe_uuid", "probe_uuid or probe_id is required")
+		}
+		if res.Region == "" {
+			r.addError(field+".region", "is required")
+		}
+		switch res.Status {
+		case "success", "failed", "timeout", "error":
+		default:
+			r.addError(field+".status", "must be one of: success, failed, timeout, error")
+		}
+		if res.ExecutedAt.IsZero() {
+			r.addError(field+".executed_at", "is required")
+		} else if time.Until(res.ExecutedAt) > 5*time.Minute {
+			r.addWarning(field+".executed_at", "is more than 5 minutes in the future")
+		}
+		if res.ResponseTime < 0 {
+			r.addError(field+".response_time", "must not be negative")
+		}
+		if res.StatusCode != 0 && (res.StatusCode < 100 || res.StatusCode > 599) {
+			r.addError(field+".status_code", "must be a valid HTTP status code")
+		}
+	}
+
+	return r
+}
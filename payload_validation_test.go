@@ -0,0 +1,201 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validComprehensiveMetrics() *ComprehensiveMetricsRequest {
+	return &ComprehensiveMetricsRequest{
+		ServerUUID:  "server-123",
+		CollectedAt: time.Now().UTC().Format(time.RFC3339),
+		CPU:         &CPUMetrics{UsagePercent: 42.5, CoreCount: 4, PerCoreUsage: []float64{40, 45}},
+		Memory:      &MemoryMetrics{TotalBytes: 8 << 30, UsedBytes: 4 << 30, UsagePercent: 50},
+		Disks: []DiskMetrics{
+			{Device: "/dev/sda1", Mountpoint: "/", TotalBytes: 100, UsedBytes: 40, UsagePercent: 40},
+		},
+		Network: []NetworkMetrics{{Interface: "eth0"}},
+	}
+}
+
+func TestMetricsService_Validate_API(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v2/metrics/comprehensive/validate", r.URL.Path)
+
+		var body ComprehensiveMetricsRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "agent-uuid", body.ServerUUID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data": map[string]interface{}{
+				"valid":    false,
+				"errors":   map[string][]string{"cpu.usage_percent": {"must be between 0 and 100"}},
+				"warnings": map[string][]string{"disks": {"no disks reported"}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{ServerUUID: "agent-uuid", ServerSecret: "secret"},
+	})
+	require.NoError(t, err)
+
+	req := validComprehensiveMetrics()
+	req.ServerUUID = ""
+	result, err := client.Metrics.Validate(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.False(t, result.Valid)
+	assert.False(t, result.Local)
+	assert.Equal(t, []string{"must be between 0 and 100"}, result.Errors["cpu.usage_percent"])
+	assert.Contains(t, result.Warnings, "disks")
+	assert.Empty(t, req.ServerUUID, "caller's request must not be modified")
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, result.Err(), &validationErr)
+	assert.Contains(t, validationErr.Message, "cpu.usage_percent")
+}
+
+func TestMetricsService_Validate_BadRequestFieldErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status":  "error",
+			"message": "Validation failed",
+			"errors":  map[string][]string{"collected_at": {"is required"}},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	result, err := client.Metrics.Validate(context.Background(), validComprehensiveMetrics())
+	require.NoError(t, err)
+	assert.False(t, result.Valid)
+	assert.Equal(t, []string{"is required"}, result.Errors["collected_at"])
+}
+
+func TestMetricsService_Validate_LocalFallback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	result, err := client.Metrics.Validate(context.Background(), validComprehensiveMetrics())
+	require.NoError(t, err)
+	assert.True(t, result.Local)
+	assert.True(t, result.Valid)
+	assert.NoError(t, result.Err())
+
+	invalid := validComprehensiveMetrics()
+	invalid.CollectedAt = "yesterday"
+	invalid.CPU.UsagePercent = 120
+	invalid.Disks[0].Device = ""
+	invalid.Memory.UsedBytes = invalid.Memory.TotalBytes + 1
+
+	result, err = client.Metrics.Validate(context.Background(), invalid)
+	require.NoError(t, err)
+	assert.True(t, result.Local)
+	assert.False(t, result.Valid)
+	assert.Contains(t, result.Errors, "collected_at")
+	assert.Contains(t, result.Errors, "cpu.usage_percent")
+	assert.Contains(t, result.Errors, "disks[0].device")
+	assert.Contains(t, result.Errors, "memory.used_bytes")
+}
+
+func TestMetricsService_Validate_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	_, err = client.Metrics.Validate(context.Background(), nil)
+	assert.Error(t, err)
+
+	_, err = client.Metrics.Validate(context.Background(), validComprehensiveMetrics())
+	assert.True(t, IsUnauthorized(err))
+}
+
+func TestMonitoringService_ValidateResults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/monitoring/results/validate", r.URL.Path)
+
+		var body ProbeResultsSubmission
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Len(t, body.Results, 1)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data":   map[string]interface{}{"valid": true},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{MonitoringKey: "MON_key"}})
+	require.NoError(t, err)
+
+	result, err := client.Monitoring.ValidateResults(context.Background(), []ProbeExecutionResult{
+		{ProbeUUID: "probe-1", Region: "us-east-1", Status: "success", ExecutedAt: time.Now()},
+	})
+	require.NoError(t, err)
+	assert.True(t, result.Valid)
+	assert.False(t, result.Local)
+}
+
+func TestMonitoringService_ValidateResults_LocalFallback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{MonitoringKey: "MON_key"}})
+	require.NoError(t, err)
+
+	result, err := client.Monitoring.ValidateResults(context.Background(), []ProbeExecutionResult{
+		{ProbeUUID: "probe-1", Region: "us-east-1", Status: "success", ExecutedAt: time.Now(), StatusCode: 200},
+		{Status: "unknown", ResponseTime: -1, StatusCode: 1000},
+	})
+	require.NoError(t, err)
+	assert.True(t, result.Local)
+	assert.False(t, result.Valid)
+
+	assert.NotContains(t, result.Errors, "results[0].status")
+	for _, field := range []string{
+		"results[1].probe_uuid",
+		"results[1].region",
+		"results[1].status",
+		"results[1].executed_at",
+		"results[1].response_time",
+		"results[1].status_code",
+	} {
+		assert.Contains(t, result.Errors, field)
+	}
+
+	result, err = client.Monitoring.ValidateResults(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Contains(t, result.Errors, "results")
+}
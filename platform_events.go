@@ -0,0 +1,119 @@
+package nexmonyx
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// PlatformEventType identifies a platform event such as "probe.failed". The same
+// types are used for webhook deliveries and any other transport that carries
+// platform events.
+type PlatformEventType string
+
+const (
+	PlatformEventServerRegistered PlatformEventType = "server.registered"
+	PlatformEventProbeFailed      PlatformEventType = "probe.failed"
+	PlatformEventIncidentCreated  PlatformEventType = "incident.created"
+	PlatformEventAPIKeyExpiring   PlatformEventType = "apikey.expiring"
+)
+
+// PlatformEvent is the envelope shared by every platform event. Data holds the
+// type-specific payload; use Decode to obtain it as a typed struct.
+type PlatformEvent struct {
+	ID             string            `json:"id"`
+	Type           PlatformEventType `json:"type"`
+	OrganizationID uint              `json:"organization_id"`
+	OccurredAt     time.Time         `json:"occurred_at"`
+	Data           json.RawMessage   `json:"data"`
+}
+
+// Decode returns the event's payload as the struct registered for its type,
+// for example *ProbeFailedEvent for "probe.failed"
+func (e *PlatformEvent) Decode() (interface{}, error) {
+	return DecodeEvent(string(e.Type), e.Data)
+}
+
+// ServerRegisteredEvent is the payload of a "server.registered" event
+type ServerRegisteredEvent struct {
+	ServerUUID     string    `json:"server_uuid"`
+	Hostname       string    `json:"hostname"`
+	OrganizationID uint      `json:"organization_id"`
+	Environment    string    `json:"environment,omitempty"`
+	Location       string    `json:"location,omitempty"`
+	MainIP         string    `json:"main_ip,omitempty"`
+	AgentVersion   string    `json:"agent_version,omitempty"`
+	RegisteredAt   time.Time `json:"registered_at"`
+}
+
+// ProbeFailedEvent is the payload of a "probe.failed" event
+type ProbeFailedEvent struct {
+	ProbeUUID           string    `json:"probe_uuid"`
+	ProbeName           string    `json:"probe_name"`
+	ProbeType           string    `json:"probe_type"`
+	Target              string    `json:"target"`
+	Region              string    `json:"region"`
+	Status              string    `json:"status"` // failed, timeout, error
+	StatusCode          int       `json:"status_code,omitempty"`
+	Error               string    `json:"error,omitempty"`
+	ResponseTime        int       `json:"response_time,omitempty"` // milliseconds
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	FailedAt            time.Time `json:"failed_at"`
+}
+
+// IncidentCreatedEvent is the payload of an "incident.created" event
+type IncidentCreatedEvent struct {
+	IncidentID  uint             `json:"incident_id"`
+	Title       string           `json:"title"`
+	Description string           `json:"description,omitempty"`
+	Severity    IncidentSeverity `json:"severity"`
+	Source      string           `json:"source,omitempty"` // alert, probe, manual
+	ServerUUID  string           `json:"server_uuid,omitempty"`
+	ProbeUUID   string           `json:"probe_uuid,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+}
+
+// APIKeyExpiringEvent is the payload of an "apikey.expiring" event
+type APIKeyExpiringEvent struct {
+	KeyID         string    `json:"key_id"`
+	KeyName       string    `json:"key_name"`
+	Type          string    `json:"type,omitempty"`
+	ExpiresAt     time.Time `json:"expires_at"`
+	DaysRemaining int       `json:"days_remaining"`
+}
+
+// platformEventCatalog maps each event type to a constructor for its payload
+var platformEventCatalog = map[PlatformEventType]func() interface{}{
+	PlatformEventServerRegistered: func() interface{} { return &ServerRegisteredEvent{} },
+	PlatformEventProbeFailed:      func() interface{} { return &ProbeFailedEvent{} },
+	PlatformEventIncidentCreated:  func() interface{} { return &IncidentCreatedEvent{} },
+	PlatformEventAPIKeyExpiring:   func() interface{} { return &APIKeyExpiringEvent{} },
+}
+
+// PlatformEventTypes returns every event type in the catalog, sorted
+func PlatformEventTypes() []PlatformEventType {
+	types := make([]PlatformEventType, 0, len(platformEventCatalog))
+	for t := range platformEventCatalog {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// DecodeEvent decodes an event payload into the struct registered for
+// eventType. The result is a pointer, e.g. *IncidentCreatedEvent, suitable for
+// a type switch. Types not in the catalog return an error wrapping
+// ErrUnknownEventType so receivers can skip events added after this SDK version.
+func DecodeEvent(eventType string, payload []byte) (interface{}, error) {
+	newPayload, ok := platformEventCatalog[PlatformEventType(eventType)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownEventType, eventType)
+	}
+
+	event := newPayload()
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	return event, nil
+}
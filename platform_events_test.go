@@ -0,0 +1,103 @@
+package nexmonyx
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeEvent(t *testing.T) {
+	tests := []struct {
+		eventType string
+		payload   string
+		check     func(t *testing.T, event interface{})
+	}{
+		{
+			eventType: "server.registered",
+			payload:   `{"server_uuid":"srv-1","hostname":"web-01","registered_at":"2025-01-01T00:00:00Z"}`,
+			check: func(t *testing.T, event interface{}) {
+				e, ok := event.(*ServerRegisteredEvent)
+				require.True(t, ok)
+				assert.Equal(t, "web-01", e.Hostname)
+				assert.Equal(t, 2025, e.RegisteredAt.Year())
+			},
+		},
+		{
+			eventType: "probe.failed",
+			payload:   `{"probe_uuid":"probe-1","region":"us-east-1","status":"timeout","consecutive_failures":3}`,
+			check: func(t *testing.T, event interface{}) {
+				e, ok := event.(*ProbeFailedEvent)
+				require.True(t, ok)
+				assert.Equal(t, "timeout", e.Status)
+				assert.Equal(t, 3, e.ConsecutiveFailures)
+			},
+		},
+		{
+			eventType: "incident.created",
+			payload:   `{"incident_id":12,"title":"API down","severity":"critical"}`,
+			check: func(t *testing.T, event interface{}) {
+				e, ok := event.(*IncidentCreatedEvent)
+				require.True(t, ok)
+				assert.Equal(t, IncidentSeverityCritical, e.Severity)
+			},
+		},
+		{
+			eventType: "apikey.expiring",
+			payload:   `{"key_id":"key-1","key_name":"ci","days_remaining":7}`,
+			check: func(t *testing.T, event interface{}) {
+				e, ok := event.(*APIKeyExpiringEvent)
+				require.True(t, ok)
+				assert.Equal(t, 7, e.DaysRemaining)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.eventType, func(t *testing.T) {
+			event, err := DecodeEvent(tt.eventType, []byte(tt.payload))
+			require.NoError(t, err)
+			tt.check(t, event)
+		})
+	}
+}
+
+func TestDecodeEvent_Errors(t *testing.T) {
+	_, err := DecodeEvent("server.exploded", []byte(`{}`))
+	assert.True(t, errors.Is(err, ErrUnknownEventType))
+
+	_, err = DecodeEvent("probe.failed", []byte(`{"consecutive_failures":"many"}`))
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrUnknownEventType))
+}
+
+func TestPlatformEvent_Decode(t *testing.T) {
+	body := []byte(`{
+		"id": "evt_1",
+		"type": "probe.failed",
+		"organization_id": 100,
+		"occurred_at": "2025-01-01T00:00:00Z",
+		"data": {"probe_uuid": "probe-1", "status": "failed"}
+	}`)
+
+	var envelope PlatformEvent
+	require.NoError(t, json.Unmarshal(body, &envelope))
+	assert.Equal(t, PlatformEventProbeFailed, envelope.Type)
+
+	event, err := envelope.Decode()
+	require.NoError(t, err)
+	failed, ok := event.(*ProbeFailedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "probe-1", failed.ProbeUUID)
+}
+
+func TestPlatformEventTypes(t *testing.T) {
+	assert.Equal(t, []PlatformEventType{
+		PlatformEventAPIKeyExpiring,
+		PlatformEventIncidentCreated,
+		PlatformEventProbeFailed,
+		PlatformEventServerRegistered,
+	}, PlatformEventTypes())
+}
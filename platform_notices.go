@@ -0,0 +1,190 @@
+package nexmonyx
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Response headers the API uses to announce incidents and maintenance
+const (
+	PlatformStatusHeader             = "X-Nexmonyx-Platform-Status"
+	PlatformNoticeHeader             = "X-Nexmonyx-Notice" // Repeated once per banner
+	PlatformDegradedComponentsHeader = "X-Nexmonyx-Degraded-Components"
+)
+
+// PlatformStatus is the platform state reported alongside a notice
+type PlatformStatus string
+
+const (
+	PlatformStatusOperational PlatformStatus = "operational"
+	PlatformStatusDegraded    PlatformStatus = "degraded"
+	PlatformStatusMaintenance PlatformStatus = "maintenance"
+	PlatformStatusOutage      PlatformStatus = "outage"
+)
+
+// PlatformNotice is a status banner returned by the API during an incident or
+// maintenance window
+type PlatformNotice struct {
+	Status     PlatformStatus
+	Message    string
+	Components []string // Affected components, empty when the whole platform is affected
+	ReceivedAt time.Time
+}
+
+// Impaired reports whether the notice indicates that requests may fail or be slow
+func (n PlatformNotice) Impaired() bool {
+	switch n.Status {
+	case PlatformStatusDegraded, PlatformStatusMaintenance, PlatformStatusOutage:
+		return true
+	}
+	return false
+}
+
+// platformNotices holds the notices from the most recent API response
+type platformNotices struct {
+	mu      sync.RWMutex
+	current []PlatformNotice
+}
+
+// update replaces the current notices and returns the ones that were not
+// already active. When active notices are cleared, a single operational
+// notice is returned so listeners can log the recovery.
+func (p *platformNotices) update(notices []PlatformNotice, now time.Time) []PlatformNotice {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(notices) == 0 {
+		if len(p.current) == 0 {
+			return nil
+		}
+		p.current = nil
+		return []PlatformNotice{{Status: PlatformStatusOperational, ReceivedAt: now}}
+	}
+
+	var added []PlatformNotice
+	for _, n := range notices {
+		if !slices.ContainsFunc(p.current, n.sameAs) {
+			added = append(added, n)
+		}
+	}
+	p.current = notices
+	return added
+}
+
+// status returns the most severe status among the current notices
+func (p *platformNotices) status() PlatformStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	status := PlatformStatusOperational
+	for _, n := range p.current {
+		if platformStatusSeverity(n.Status) > platformStatusSeverity(status) {
+			status = n.Status
+		}
+	}
+	return status
+}
+
+func (n PlatformNotice) sameAs(other PlatformNotice) bool {
+	return n.Status == other.Status && n.Message == other.Message && slices.Equal(n.Components, other.Components)
+}
+
+func platformStatusSeverity(s PlatformStatus) int {
+	switch s {
+	case PlatformStatusDegraded:
+		return 1
+	case PlatformStatusMaintenance:
+		return 2
+	case PlatformStatusOutage:
+		return 3
+	}
+	return 0
+}
+
+// parsePlatformNotices reads the notice headers of a response. A status other
+// than operational without a banner still produces a notice, while banners
+// without a status header are treated as informational.
+func parsePlatformNotices(header http.Header, now time.Time) []PlatformNotice {
+	status := PlatformStatus(strings.ToLower(strings.TrimSpace(header.Get(PlatformStatusHeader))))
+	if status == "" {
+		status = PlatformStatusOperational
+	}
+
+	var components []string
+	for _, c := range strings.Split(header.Get(PlatformDegradedComponentsHeader), ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			components = append(components, c)
+		}
+	}
+
+	var messages []string
+	for _, m := range header.Values(PlatformNoticeHeader) {
+		if m = strings.TrimSpace(m); m != "" {
+			messages = append(messages, m)
+		}
+	}
+	if len(messages) == 0 && (status != PlatformStatusOperational || len(components) > 0) {
+		messages = []string{""}
+	}
+
+	notices := make([]PlatformNotice, 0, len(messages))
+	for _, m := range messages {
+		notices = append(notices, PlatformNotice{
+			Status:     status,
+			Message:    m,
+			Components: components,
+			ReceivedAt: now,
+		})
+	}
+	return notices
+}
+
+// Notices returns the platform notices reported by the most recent API
+// response, or nil when the platform reported no incident or maintenance.
+// Each client tracks its own notices; listeners registered with
+// EventBus.OnPlatformNotice are shared by derived clients.
+func (c *Client) Notices() []PlatformNotice {
+	c.notices.mu.RLock()
+	defer c.notices.mu.RUnlock()
+
+	if len(c.notices.current) == 0 {
+		return nil
+	}
+	return slices.Clone(c.notices.current)
+}
+
+// observeNotices records the notices of every response, including failed
+// attempts that will be retried
+func (c *Client) observeNotices(_ *resty.Client, resp *resty.Response) error {
+	now := time.Now()
+	for _, n := range c.notices.update(parsePlatformNotices(resp.Header(), now), now) {
+		c.events.emitPlatformNotice(n)
+	}
+	return nil
+}
+
+// noticeRetryAfter slows retries down while the platform reports a problem:
+// waits are doubled while degraded and stretched to RetryMaxWait during
+// maintenance or an outage. Returning 0 keeps resty's default backoff.
+func (c *Client) noticeRetryAfter(_ *resty.Client, resp *resty.Response) (time.Duration, error) {
+	switch c.notices.status() {
+	case PlatformStatusMaintenance, PlatformStatusOutage:
+		return c.config.RetryMaxWait, nil
+	case PlatformStatusDegraded:
+		attempt := 1
+		if resp != nil && resp.Request != nil && resp.Request.Attempt > 0 {
+			attempt = resp.Request.Attempt
+		}
+		wait := 2 * c.config.RetryWaitTime << (attempt - 1)
+		if wait <= 0 || wait > c.config.RetryMaxWait {
+			wait = c.config.RetryMaxWait
+		}
+		return wait, nil
+	}
+	return 0, nil
+}
@@ -0,0 +1,114 @@
+package nexmonyx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlatformNotices(t *testing.T) {
+	now := time.Now()
+
+	header := http.Header{}
+	assert.Empty(t, parsePlatformNotices(header, now))
+
+	header.Set(PlatformStatusHeader, "Degraded")
+	header.Set(PlatformDegradedComponentsHeader, "metrics, alerts,")
+	header.Add(PlatformNoticeHeader, "Metric ingestion is delayed")
+	header.Add(PlatformNoticeHeader, "Alert evaluation is paused")
+
+	notices := parsePlatformNotices(header, now)
+	require.Len(t, notices, 2)
+	assert.Equal(t, PlatformStatusDegraded, notices[0].Status)
+	assert.Equal(t, "Alert evaluation is paused", notices[1].Message)
+	assert.Equal(t, []string{"metrics", "alerts"}, notices[0].Components)
+	assert.True(t, notices[0].Impaired())
+
+	statusOnly := http.Header{}
+	statusOnly.Set(PlatformStatusHeader, "maintenance")
+	notices = parsePlatformNotices(statusOnly, now)
+	require.Len(t, notices, 1)
+	assert.Equal(t, PlatformStatusMaintenance, notices[0].Status)
+
+	banner := http.Header{}
+	banner.Set(PlatformNoticeHeader, "Scheduled maintenance on Saturday")
+	notices = parsePlatformNotices(banner, now)
+	require.Len(t, notices, 1)
+	assert.Equal(t, PlatformStatusOperational, notices[0].Status)
+	assert.False(t, notices[0].Impaired())
+}
+
+func TestClient_Notices(t *testing.T) {
+	var degraded atomic.Bool
+	degraded.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if degraded.Load() {
+			w.Header().Set(PlatformStatusHeader, "degraded")
+			w.Header().Set(PlatformNoticeHeader, "Metric ingestion is delayed")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	var received []PlatformNotice
+	client.Events().OnPlatformNotice(func(n PlatformNotice) {
+		received = append(received, n)
+	})
+
+	assert.Nil(t, client.Notices())
+
+	for i := 0; i < 2; i++ {
+		_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+		require.NoError(t, err)
+	}
+	notices := client.Notices()
+	require.Len(t, notices, 1)
+	assert.Equal(t, "Metric ingestion is delayed", notices[0].Message)
+	require.Len(t, received, 1, "unchanged notices are reported once")
+
+	degraded.Store(false)
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	require.NoError(t, err)
+	assert.Nil(t, client.Notices())
+	require.Len(t, received, 2)
+	assert.Equal(t, PlatformStatusOperational, received[1].Status)
+}
+
+func TestClient_NoticesSlowRetries(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set(PlatformStatusHeader, "maintenance")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:       server.URL,
+		Auth:          AuthConfig{Token: "test-token"},
+		RetryCount:    1,
+		RetryWaitTime: time.Millisecond,
+		RetryMaxWait:  200 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), attempts.Load())
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond, "retry waits RetryMaxWait during maintenance")
+}
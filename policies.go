@@ -0,0 +1,213 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PoliciesService handles organization-wide security policies for API key
+// issuance and webhooks.
+//
+// The most recently fetched or updated policies are kept on the client.
+// While they are available, APIKeys.CreateUnified, Webhooks.Create and
+// Webhooks.Update check requests against them before submission, so policy
+// violations are reported without a round trip. The API enforces the same
+// policies regardless.
+type PoliciesService struct {
+	client *Client
+
+	mu     sync.RWMutex
+	cached *OrganizationPolicies
+}
+
+// OrganizationPolicies holds the security policies of an organization
+type OrganizationPolicies struct {
+	OrganizationID uint          `json:"organization_id,omitempty"`
+	APIKeys        APIKeyPolicy  `json:"api_keys"`
+	Webhooks       WebhookPolicy `json:"webhooks"`
+	UpdatedAt      *CustomTime   `json:"updated_at,omitempty"`
+	UpdatedBy      string        `json:"updated_by,omitempty"`
+}
+
+// APIKeyPolicy constrains the API keys that can be issued in an organization
+type APIKeyPolicy struct {
+	// MaxTTLDays is the longest lifetime a new key may have. Keys must set an
+	// expiry when it is non-zero. Zero means no limit.
+	MaxTTLDays int `json:"max_ttl_days,omitempty"`
+
+	// RequireIPAllowlist requires new keys to restrict AllowedIPs
+	RequireIPAllowlist bool `json:"require_ip_allowlist"`
+
+	// BannedCapabilities cannot be granted to new keys, including through
+	// wildcards such as "servers:*" or "*"
+	BannedCapabilities []string `json:"banned_capabilities,omitempty"`
+}
+
+// WebhookPolicy constrains the URLs webhooks can deliver to
+type WebhookPolicy struct {
+	RequireHTTPS bool `json:"require_https"`
+
+	// AllowedDomains restricts webhook hosts to these domains and their
+	// subdomains. Empty allows any host.
+	AllowedDomains []string `json:"allowed_domains,omitempty"`
+}
+
+// Get retrieves the organization's policies and caches them for client-side checks
+func (s *PoliciesService) Get(ctx context.Context) (*OrganizationPolicies, error) {
+	var resp StandardResponse
+	resp.Data = &OrganizationPolicies{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   "/v1/policies",
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if policies, ok := resp.Data.(*OrganizationPolicies); ok {
+		s.setCached(policies)
+		return policies, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Update replaces the organization's policies. Existing keys and webhooks
+// are not affected; the policies apply to new and updated ones.
+func (s *PoliciesService) Update(ctx context.Context, policies *OrganizationPolicies) (*OrganizationPolicies, error) {
+	var resp StandardResponse
+	resp.Data = &OrganizationPolicies{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   "/v1/policies",
+		Body:   policies,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if updated, ok := resp.Data.(*OrganizationPolicies); ok {
+		s.setCached(updated)
+		return updated, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Cached returns the most recently fetched policies, or nil if they have not
+// been fetched
+func (s *PoliciesService) Cached() *OrganizationPolicies {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cached
+}
+
+// ClearCache discards the cached policies, disabling client-side checks
+// until the policies are fetched again
+func (s *PoliciesService) ClearCache() {
+	s.setCached(nil)
+}
+
+func (s *PoliciesService) setCached(policies *OrganizationPolicies) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cached = policies
+}
+
+// checkCached checks a request against the cached policies. It returns nil
+// when no policies are cached.
+func (s *PoliciesService) checkCached(method, path string, check func(*OrganizationPolicies) []ValidationIssue) error {
+	policies := s.Cached()
+	if policies == nil {
+		return nil
+	}
+
+	result := validationResult(check(policies))
+	if result.Valid {
+		return nil
+	}
+	validationErr := result.Err().(*ValidationError)
+	validationErr.Message = fmt.Sprintf("organization policy: %s %s: %s", method, path, validationErr.Message)
+	return validationErr
+}
+
+// Check returns the ways req violates the policy. Expiry is measured from now.
+func (p *APIKeyPolicy) Check(req *CreateUnifiedAPIKeyRequest) []ValidationIssue {
+	var v validationIssues
+
+	if p.MaxTTLDays > 0 {
+		maxTTL := time.Duration(p.MaxTTLDays) * 24 * time.Hour
+		if req.ExpiresAt == nil || req.ExpiresAt.IsZero() {
+			v.addError("expires_at", "is required by policy (max %d days)", p.MaxTTLDays)
+		} else if time.Until(req.ExpiresAt.Time) > maxTTL {
+			v.addError("expires_at", "exceeds the policy maximum of %d days", p.MaxTTLDays)
+		}
+	}
+
+	if p.RequireIPAllowlist && len(req.AllowedIPs) == 0 {
+		v.addError("allowed_ips", "is required by policy")
+	}
+
+	for i, capability := range req.Capabilities {
+		for _, banned := range p.BannedCapabilities {
+			if capabilitiesOverlap(capability, banned) {
+				v.addError(fmt.Sprintf("capabilities[%d]", i), "grants %s, which is banned by policy", banned)
+				break
+			}
+		}
+	}
+
+	return v
+}
+
+// Check returns the ways a webhook URL violates the policy
+func (p *WebhookPolicy) Check(webhookURL string) []ValidationIssue {
+	var v validationIssues
+
+	u, err := url.Parse(webhookURL)
+	if err != nil || u.Host == "" {
+		v.addError("url", "must be an absolute URL")
+		return v
+	}
+	if p.RequireHTTPS && u.Scheme != "https" {
+		v.addError("url", "must use https by policy")
+	}
+	if len(p.AllowedDomains) > 0 && !hostInDomains(u.Hostname(), p.AllowedDomains) {
+		v.addError("url", "host %s is not in the policy's allowed domains", u.Hostname())
+	}
+
+	return v
+}
+
+// capabilitiesOverlap returns true if granting a would grant any of b, or
+// the reverse, taking "*" and "resource:*" wildcards into account
+func capabilitiesOverlap(a, b string) bool {
+	if a == b || a == CapabilityAll || b == CapabilityAll {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(a, ":*"); ok && strings.HasPrefix(b, prefix+":") {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(b, ":*"); ok && strings.HasPrefix(a, prefix+":") {
+		return true
+	}
+	return false
+}
+
+// hostInDomains returns true if host is one of domains or a subdomain of one
+func hostInDomains(host string, domains []string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.Trim(domain, "."))
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoliciesService_EnforcedOnCreate(t *testing.T) {
+	var keyCreates, webhookCreates atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v1/policies":
+			w.Write([]byte(`{"status":"success","data":{
+				"organization_id":3,
+				"api_keys":{"max_ttl_days":90,"require_ip_allowlist":true,"banned_capabilities":["servers:delete"]},
+				"webhooks":{"require_https":true,"allowed_domains":["hooks.example.com"]}
+			}}`))
+		case "PUT /v1/policies":
+			var policies OrganizationPolicies
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&policies))
+			json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "data": policies})
+		case "POST /v2/api-keys":
+			keyCreates.Add(1)
+			w.Write([]byte(`{"status":"success","data":{"key_id":"k1","key_value":"nmx_k1"}}`))
+		case "POST /v1/webhooks":
+			webhookCreates.Add(1)
+			w.Write([]byte(`{"status":"success","data":{"id":1,"url":"https://hooks.example.com/nmx"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	violating := &CreateUnifiedAPIKeyRequest{
+		Name:         "CI key",
+		Type:         APIKeyTypeUser,
+		Capabilities: []string{CapabilityServersRead, CapabilityServersAll},
+	}
+
+	// Not checked until the policies have been fetched
+	_, err = client.APIKeys.CreateUnified(ctx, violating)
+	require.NoError(t, err)
+	assert.Nil(t, client.Policies.Cached())
+
+	policies, err := client.Policies.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 90, policies.APIKeys.MaxTTLDays)
+	assert.Same(t, policies, client.Policies.Cached())
+
+	_, err = client.APIKeys.CreateUnified(ctx, violating)
+	var validation *ValidationError
+	require.True(t, errors.As(err, &validation))
+	assert.Contains(t, validation.Message, "organization policy")
+	assert.Contains(t, validation.Errors, "expires_at")
+	assert.Contains(t, validation.Errors, "allowed_ips")
+	assert.Contains(t, validation.Errors, "capabilities[1]")
+	assert.NotContains(t, validation.Errors, "capabilities[0]")
+	assert.Equal(t, int32(1), keyCreates.Load(), "violating requests are not sent")
+
+	expiresAt := &CustomTime{Time: time.Now().Add(30 * 24 * time.Hour)}
+	_, err = client.APIKeys.CreateUnified(ctx, &CreateUnifiedAPIKeyRequest{
+		Name:         "CI key",
+		Type:         APIKeyTypeUser,
+		Capabilities: []string{CapabilityServersRead},
+		ExpiresAt:    expiresAt,
+		AllowedIPs:   []string{"10.0.0.0/8"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), keyCreates.Load())
+
+	_, err = client.Webhooks.Create(ctx, &WebhookCreateRequest{Name: "ops", URL: "http://hooks.example.com/nmx"})
+	require.True(t, errors.As(err, &validation))
+	_, err = client.Webhooks.Create(ctx, &WebhookCreateRequest{Name: "ops", URL: "https://evil.example.net/nmx"})
+	require.True(t, errors.As(err, &validation))
+	_, err = client.Webhooks.Create(ctx, &WebhookCreateRequest{Name: "ops", URL: "https://eu.hooks.example.com/nmx"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), webhookCreates.Load())
+
+	// Relaxing the policy updates the cache
+	policies.APIKeys = APIKeyPolicy{}
+	_, err = client.Policies.Update(ctx, policies)
+	require.NoError(t, err)
+	_, err = client.APIKeys.CreateUnified(ctx, violating)
+	require.NoError(t, err)
+
+	client.Policies.ClearCache()
+	assert.Nil(t, client.Policies.Cached())
+}
+
+func TestAPIKeyPolicy_Check(t *testing.T) {
+	policy := &APIKeyPolicy{MaxTTLDays: 30}
+
+	issues := policy.Check(&CreateUnifiedAPIKeyRequest{ExpiresAt: &CustomTime{Time: time.Now().Add(31 * 24 * time.Hour)}})
+	require.Len(t, issues, 1)
+	assert.Equal(t, "expires_at", issues[0].Field)
+
+	assert.Empty(t, policy.Check(&CreateUnifiedAPIKeyRequest{ExpiresAt: &CustomTime{Time: time.Now().Add(time.Hour)}}))
+	assert.Empty(t, (&APIKeyPolicy{}).Check(&CreateUnifiedAPIKeyRequest{Capabilities: []string{CapabilityAll}}))
+}
+
+func TestCapabilitiesOverlap(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"servers:read", "servers:read", true},
+		{"servers:read", "servers:write", false},
+		{"*", "servers:delete", true},
+		{"servers:*", "servers:delete", true},
+		{"servers:delete", "servers:*", true},
+		{"servers:*", "monitoring:read", false},
+		{"serversx:read", "servers:*", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, capabilitiesOverlap(tt.a, tt.b), "%s / %s", tt.a, tt.b)
+	}
+}
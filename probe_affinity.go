@@ -0,0 +1,133 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ProbeAffinity restricts which monitoring agents may run a probe, for example
+// probes that need access to a private network. All populated fields must be
+// satisfied; an empty affinity matches every agent.
+type ProbeAffinity struct {
+	AgentLabels          map[string]string `json:"agent_labels,omitempty"`          // Agent must carry every label with the given value
+	AvoidAgentLabels     map[string]string `json:"avoid_agent_labels,omitempty"`    // Agent must not carry any of these labels with the given value
+	ClusterIDs           []string          `json:"cluster_ids,omitempty"`           // Agent must belong to one of these clusters
+	RequiredCapabilities []string          `json:"required_capabilities,omitempty"` // Agent must support every capability
+}
+
+// AgentIdentity describes a monitoring agent for affinity matching
+type AgentIdentity struct {
+	AgentID      string            `json:"agent_id,omitempty"`
+	ClusterID    string            `json:"cluster_id,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	Capabilities []string          `json:"capabilities,omitempty"`
+}
+
+// ToQuery converts the identity to query parameters
+func (a *AgentIdentity) ToQuery() map[string]string {
+	params := make(map[string]string)
+	if a.AgentID != "" {
+		params["agent_id"] = a.AgentID
+	}
+	if a.ClusterID != "" {
+		params["cluster_id"] = a.ClusterID
+	}
+	if len(a.Labels) > 0 {
+		labels := make([]string, 0, len(a.Labels))
+		for k, v := range a.Labels {
+			labels = append(labels, k+"="+v)
+		}
+		sort.Strings(labels)
+		params["labels"] = strings.Join(labels, ",")
+	}
+	if len(a.Capabilities) > 0 {
+		params["capabilities"] = strings.Join(a.Capabilities, ",")
+	}
+	return params
+}
+
+// Match reports whether agent satisfies the affinity, returning an error that
+// describes the first unmet requirement
+func (p *ProbeAffinity) Match(agent *AgentIdentity) error {
+	if p == nil {
+		return nil
+	}
+	if agent == nil {
+		agent = &AgentIdentity{}
+	}
+
+	for k, v := range p.AgentLabels {
+		if got, ok := agent.Labels[k]; !ok || got != v {
+			return fmt.Errorf("agent label %s=%s is required", k, v)
+		}
+	}
+	for k, v := range p.AvoidAgentLabels {
+		if got, ok := agent.Labels[k]; ok && got == v {
+			return fmt.Errorf("agent label %s=%s is excluded", k, v)
+		}
+	}
+	if len(p.ClusterIDs) > 0 && !containsString(p.ClusterIDs, agent.ClusterID) {
+		return fmt.Errorf("agent cluster %q is not one of %v", agent.ClusterID, p.ClusterIDs)
+	}
+	for _, c := range p.RequiredCapabilities {
+		if !containsString(agent.Capabilities, c) {
+			return fmt.Errorf("agent capability %q is required", c)
+		}
+	}
+	return nil
+}
+
+// AcceptableBy checks that agent may run the assignment. Agents should call this
+// before executing an assignment, since the server may not yet apply affinity.
+func (p *ProbeAssignment) AcceptableBy(agent *AgentIdentity) error {
+	if err := p.Affinity.Match(agent); err != nil {
+		return fmt.Errorf("probe %s cannot run on this agent: %w", p.ProbeUUID, err)
+	}
+	return nil
+}
+
+// GetAssignedProbesForAgent retrieves the probes assigned to an agent in a region.
+// The agent's identity is sent so the server can apply affinity, and any
+// assignment the agent does not satisfy is dropped from the result.
+func (s *MonitoringService) GetAssignedProbesForAgent(ctx context.Context, region string, agent *AgentIdentity) ([]*ProbeAssignment, error) {
+	var resp StandardResponse
+	var assignments []*ProbeAssignment
+	resp.Data = &assignments
+
+	req := &Request{
+		Method: "GET",
+		Path:   "/v1/monitoring/probes",
+		Query:  map[string]string{},
+		Result: &resp,
+	}
+	if agent != nil {
+		req.Query = agent.ToQuery()
+	}
+	if region != "" {
+		req.Query["region"] = region
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	accepted := make([]*ProbeAssignment, 0, len(assignments))
+	for _, a := range assignments {
+		if a.AcceptableBy(agent) == nil {
+			accepted = append(accepted, a)
+		}
+	}
+	return accepted, nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbeAffinity_Match(t *testing.T) {
+	agent := &AgentIdentity{
+		AgentID:      "agent-1",
+		ClusterID:    "dc-east",
+		Labels:       map[string]string{"network": "private", "tier": "edge"},
+		Capabilities: []string{"http", "icmp"},
+	}
+
+	tests := []struct {
+		name     string
+		affinity *ProbeAffinity
+		wantErr  bool
+	}{
+		{"nil affinity", nil, false},
+		{"empty affinity", &ProbeAffinity{}, false},
+		{"matching labels", &ProbeAffinity{AgentLabels: map[string]string{"network": "private"}}, false},
+		{"label value differs", &ProbeAffinity{AgentLabels: map[string]string{"network": "public"}}, true},
+		{"missing label", &ProbeAffinity{AgentLabels: map[string]string{"zone": "a"}}, true},
+		{"avoided label", &ProbeAffinity{AvoidAgentLabels: map[string]string{"tier": "edge"}}, true},
+		{"avoided label other value", &ProbeAffinity{AvoidAgentLabels: map[string]string{"tier": "core"}}, false},
+		{"matching cluster", &ProbeAffinity{ClusterIDs: []string{"dc-west", "dc-east"}}, false},
+		{"other cluster", &ProbeAffinity{ClusterIDs: []string{"dc-west"}}, true},
+		{"capabilities present", &ProbeAffinity{RequiredCapabilities: []string{"icmp"}}, false},
+		{"capability missing", &ProbeAffinity{RequiredCapabilities: []string{"dns"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.affinity.Match(agent)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProbeAssignment_AcceptableBy(t *testing.T) {
+	assignment := &ProbeAssignment{
+		ProbeUUID: "probe-1",
+		Affinity:  &ProbeAffinity{ClusterIDs: []string{"dc-east"}},
+	}
+
+	assert.NoError(t, assignment.AcceptableBy(&AgentIdentity{ClusterID: "dc-east"}))
+
+	err := assignment.AcceptableBy(&AgentIdentity{ClusterID: "dc-west"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "probe-1")
+
+	assert.Error(t, assignment.AcceptableBy(nil))
+}
+
+func TestMonitoringService_GetAssignedProbesForAgent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/monitoring/probes", r.URL.Path)
+		assert.Equal(t, "us-east-1", r.URL.Query().Get("region"))
+		assert.Equal(t, "dc-east", r.URL.Query().Get("cluster_id"))
+		assert.Equal(t, "network=private,tier=edge", r.URL.Query().Get("labels"))
+		assert.Equal(t, "http,icmp", r.URL.Query().Get("capabilities"))
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(StandardResponse{
+			Status: "success",
+			Data: []*ProbeAssignment{
+				{ProbeUUID: "public-probe"},
+				{ProbeUUID: "private-probe", Affinity: &ProbeAffinity{AgentLabels: map[string]string{"network": "private"}}},
+				{ProbeUUID: "dns-probe", Affinity: &ProbeAffinity{RequiredCapabilities: []string{"dns"}}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{MonitoringKey: "test-key"},
+	})
+	require.NoError(t, err)
+
+	assignments, err := client.Monitoring.GetAssignedProbesForAgent(context.Background(), "us-east-1", &AgentIdentity{
+		ClusterID:    "dc-east",
+		Labels:       map[string]string{"tier": "edge", "network": "private"},
+		Capabilities: []string{"http", "icmp"},
+	})
+	require.NoError(t, err)
+	require.Len(t, assignments, 2)
+	assert.Equal(t, "public-probe", assignments[0].ProbeUUID)
+	assert.Equal(t, "private-probe", assignments[1].ProbeUUID)
+}
@@ -0,0 +1,285 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ProbeBatchOptions configures CreateBatch and UpdateBatch
+type ProbeBatchOptions struct {
+	// Concurrency limits parallel single-probe requests when the batch
+	// endpoint is unavailable (default: 10)
+	Concurrency int
+
+	// RollbackOnFailure undoes every successful change in the batch if any
+	// item fails, so that the batch applies entirely or not at all. Created
+	// probes are deleted; updated probes are restored to their previous name,
+	// interval, enabled state and configuration.
+	RollbackOnFailure bool
+
+	// DisableBatchEndpoint skips the batch endpoint and always fans out
+	// single-probe requests
+	DisableBatchEndpoint bool
+}
+
+// ProbeBatchResult holds the outcome of CreateBatch
+type ProbeBatchResult struct {
+	Probes         []*MonitoringProbe // Aligned with the requests; nil where creation failed or was rolled back
+	Errors         map[int]error      // Failures by request index
+	RolledBack     bool               // Set when RollbackOnFailure undid the batch
+	RollbackErrors map[string]error   // Probes that could not be rolled back, by UUID
+}
+
+// Failed reports whether any probe in the batch failed
+func (r *ProbeBatchResult) Failed() bool {
+	return len(r.Errors) > 0
+}
+
+// ProbeBatchUpdateResult holds the outcome of UpdateBatch keyed by probe UUID
+type ProbeBatchUpdateResult struct {
+	Probes         map[string]*MonitoringProbe
+	Errors         map[string]error
+	RolledBack     bool
+	RollbackErrors map[string]error
+}
+
+// Failed reports whether any probe in the batch failed
+func (r *ProbeBatchUpdateResult) Failed() bool {
+	return len(r.Errors) > 0
+}
+
+// probeBatchCreateResponse is the response envelope of the batch create endpoint
+type probeBatchCreateResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		Results []struct {
+			Index int              `json:"index"`
+			Probe *MonitoringProbe `json:"probe,omitempty"`
+			Error string           `json:"error,omitempty"`
+		} `json:"results"`
+		RolledBack bool `json:"rolled_back"`
+	} `json:"data"`
+}
+
+// CreateBatch creates many probes, such as those rendered by
+// ProbeTemplate.RenderAll, in as few requests as possible. It uses the batch
+// endpoint when available and falls back to bounded concurrent Create calls
+// otherwise. Per-probe failures are reported in Errors rather than failing the
+// whole call.
+// Authentication: JWT Token or API key required
+// Endpoint: POST /v2/probes/batch
+func (s *ProbesService) CreateBatch(ctx context.Context, reqs []*ProbeCreateRequest, opts *ProbeBatchOptions) (*ProbeBatchResult, error) {
+	if opts == nil {
+		opts = &ProbeBatchOptions{}
+	}
+	for i, req := range reqs {
+		if req == nil {
+			return nil, fmt.Errorf("probe request %d is nil", i)
+		}
+	}
+
+	result := &ProbeBatchResult{
+		Probes:         make([]*MonitoringProbe, len(reqs)),
+		Errors:         make(map[int]error),
+		RollbackErrors: make(map[string]error),
+	}
+	if len(reqs) == 0 {
+		return result, nil
+	}
+
+	if !opts.DisableBatchEndpoint {
+		bodies := make([]map[string]interface{}, len(reqs))
+		for i, req := range reqs {
+			bodies[i] = probeCreateBody(req)
+		}
+
+		var resp probeBatchCreateResponse
+		_, err := s.client.Do(ctx, &Request{
+			Method: "POST",
+			Path:   "/v2/probes/batch",
+			Body: map[string]interface{}{
+				"probes": bodies,
+				"atomic": opts.RollbackOnFailure,
+			},
+			Result: &resp,
+		})
+		if err == nil {
+			for _, item := range resp.Data.Results {
+				if item.Index < 0 || item.Index >= len(reqs) {
+					continue
+				}
+				switch {
+				case item.Error != "":
+					result.Errors[item.Index] = &APIError{Status: "error", Message: item.Error}
+				case item.Probe != nil && !resp.Data.RolledBack:
+					result.Probes[item.Index] = item.Probe
+				}
+			}
+			for i := range reqs {
+				if _, failed := result.Errors[i]; !failed && result.Probes[i] == nil && !resp.Data.RolledBack {
+					result.Errors[i] = fmt.Errorf("probe %d missing from batch response", i)
+				}
+			}
+			result.RolledBack = resp.Data.RolledBack
+			return result, nil
+		}
+		if !isUnsupportedEndpoint(err) {
+			return nil, fmt.Errorf("failed to batch create probes: %w", err)
+		}
+	}
+
+	var mu sync.Mutex
+	forEachConcurrently(len(reqs), opts.Concurrency, func(i int) {
+		probe, err := s.Create(ctx, reqs[i])
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			result.Errors[i] = err
+			return
+		}
+		result.Probes[i] = probe
+	})
+
+	if opts.RollbackOnFailure && result.Failed() {
+		// Roll back even if ctx was cancelled part way through the batch
+		rollbackCtx := context.WithoutCancel(ctx)
+		forEachConcurrently(len(reqs), opts.Concurrency, func(i int) {
+			probe := result.Probes[i]
+			if probe == nil {
+				return
+			}
+			err := s.Delete(rollbackCtx, probe.ProbeUUID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				result.RollbackErrors[probe.ProbeUUID] = err
+				return
+			}
+			result.Probes[i] = nil
+		})
+		result.RolledBack = true
+	}
+
+	return result, ctx.Err()
+}
+
+// UpdateBatch applies updates keyed by probe UUID with bounded concurrency.
+// Per-probe failures are reported in Errors rather than failing the whole
+// call. With RollbackOnFailure the current probes are fetched first so that
+// successful updates can be reverted if any update fails.
+// Authentication: JWT Token or API key required
+// Endpoint: PATCH /v2/probes/{uuid}
+func (s *ProbesService) UpdateBatch(ctx context.Context, updates map[string]*ProbeUpdateRequest, opts *ProbeBatchOptions) (*ProbeBatchUpdateResult, error) {
+	if opts == nil {
+		opts = &ProbeBatchOptions{}
+	}
+
+	uuids := make([]string, 0, len(updates))
+	for uuid, req := range updates {
+		if req == nil {
+			return nil, fmt.Errorf("update for probe %s is nil", uuid)
+		}
+		uuids = append(uuids, uuid)
+	}
+	sort.Strings(uuids)
+
+	result := &ProbeBatchUpdateResult{
+		Probes:         make(map[string]*MonitoringProbe, len(uuids)),
+		Errors:         make(map[string]error),
+		RollbackErrors: make(map[string]error),
+	}
+	if len(uuids) == 0 {
+		return result, nil
+	}
+
+	var previous map[string]*MonitoringProbe
+	if opts.RollbackOnFailure {
+		current, err := s.GetMany(ctx, uuids, &BatchGetOptions{
+			Concurrency:          opts.Concurrency,
+			DisableBatchEndpoint: opts.DisableBatchEndpoint,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch probes for rollback: %w", err)
+		}
+		if len(current.Errors) > 0 {
+			for uuid, err := range current.Errors {
+				result.Errors[uuid] = err
+			}
+			return result, nil
+		}
+		previous = current.Items
+	}
+
+	var mu sync.Mutex
+	forEachConcurrently(len(uuids), opts.Concurrency, func(i int) {
+		uuid := uuids[i]
+		probe, err := s.Update(ctx, uuid, updates[uuid])
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			result.Errors[uuid] = err
+			return
+		}
+		result.Probes[uuid] = probe
+	})
+
+	if opts.RollbackOnFailure && result.Failed() {
+		rollbackCtx := context.WithoutCancel(ctx)
+		forEachConcurrently(len(uuids), opts.Concurrency, func(i int) {
+			uuid := uuids[i]
+			mu.Lock()
+			_, updated := result.Probes[uuid]
+			mu.Unlock()
+			if !updated {
+				return
+			}
+
+			prev := previous[uuid]
+			_, err := s.Update(rollbackCtx, uuid, &ProbeUpdateRequest{
+				Name:          &prev.Name,
+				Interval:      &prev.Interval,
+				Enabled:       &prev.Enabled,
+				Configuration: prev.Config,
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				result.RollbackErrors[uuid] = err
+				return
+			}
+			result.Probes[uuid] = prev
+		})
+		result.RolledBack = true
+	}
+
+	return result, ctx.Err()
+}
+
+// forEachConcurrently calls fn for every index in [0, n) with at most
+// concurrency calls in flight (default: 10) and waits for them to finish
+func forEachConcurrently(n, concurrency int, fn func(i int)) {
+	if concurrency <= 0 {
+		concurrency = defaultBatchGetConcurrency
+	}
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+	for i := 0; i < n; i++ {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,228 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbeTemplate_Render(t *testing.T) {
+	tmpl := &ProbeTemplate{
+		Name:       "health",
+		Type:       "https",
+		Target:     "https://{host}/healthz",
+		RegionCode: "{region}",
+		Configuration: map[string]interface{}{
+			"headers": map[string]interface{}{"Host": "{host}"},
+			"expect":  []interface{}{"{env}", 200},
+			"pattern": `^\d{3}$`,
+		},
+	}
+
+	req, err := tmpl.Render(map[string]string{"host": "api.example.com", "region": "us-east", "env": "prod"})
+	require.NoError(t, err)
+	assert.Equal(t, "health https://api.example.com/healthz", req.Name)
+	assert.Equal(t, "https://api.example.com/healthz", req.Target)
+	assert.Equal(t, "us-east", req.RegionCode)
+	assert.Equal(t, map[string]interface{}{"Host": "api.example.com"}, req.Configuration["headers"])
+	assert.Equal(t, []interface{}{"prod", 200}, req.Configuration["expect"])
+	assert.Equal(t, `^\d{3}$`, req.Configuration["pattern"])
+	assert.Equal(t, 60, req.Interval)
+	assert.Equal(t, 10, req.Timeout)
+	assert.True(t, req.Enabled)
+
+	// The template itself is left untouched
+	assert.Equal(t, map[string]interface{}{"Host": "{host}"}, tmpl.Configuration["headers"])
+
+	_, err = tmpl.Render(map[string]string{"host": "api.example.com"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "{env}, {region}")
+
+	// Without a Target the default for the type is used
+	tcp := &ProbeTemplate{Name: "db", Type: "tcp", ProbeName: "db {fqdn}"}
+	req, err = tcp.Render(map[string]string{"fqdn": "db-1.internal", "port": "5432"})
+	require.NoError(t, err)
+	assert.Equal(t, "db-1.internal", req.Target)
+	assert.Equal(t, "db db-1.internal", req.Name)
+	assert.Equal(t, 5432, req.Configuration["port"])
+
+	reqs, err := tmpl.RenderAll([]map[string]string{
+		{"host": "a.example.com", "region": "us-east", "env": "prod"},
+		{"host": "b.example.com", "region": "eu-west", "env": "prod"},
+	})
+	require.NoError(t, err)
+	require.Len(t, reqs, 2)
+	assert.Equal(t, "https://b.example.com/healthz", reqs[1].Target)
+
+	_, err = tmpl.RenderAll([]map[string]string{{"host": "a.example.com", "region": "us-east", "env": "prod"}, {}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "targets[1]")
+}
+
+func TestProbesService_CreateBatch_BatchEndpoint(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v2/probes/batch", r.URL.Path)
+
+		var body struct {
+			Probes []map[string]interface{} `json:"probes"`
+			Atomic bool                     `json:"atomic"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Len(t, body.Probes, 3)
+		assert.Equal(t, float64(60), body.Probes[0]["frequency"])
+		assert.Equal(t, "https://a.example.com", body.Probes[0]["config"].(map[string]interface{})["url"])
+		assert.False(t, body.Atomic)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{"results":[
+			{"index":0,"probe":{"uuid":"probe-a","name":"a"}},
+			{"index":2,"error":"target is not reachable"},
+			{"index":1,"probe":{"uuid":"probe-b","name":"b"}}
+		]}}`))
+	}), Config{})
+
+	reqs := []*ProbeCreateRequest{
+		{Name: "a", Type: "https", Target: "https://a.example.com", Interval: 60},
+		{Name: "b", Type: "https", Target: "https://b.example.com", Interval: 60},
+		{Name: "c", Type: "https", Target: "https://c.example.com", Interval: 60},
+	}
+	result, err := client.Probes.CreateBatch(context.Background(), reqs, nil)
+	require.NoError(t, err)
+	assert.True(t, result.Failed())
+	assert.False(t, result.RolledBack)
+	assert.Equal(t, "probe-a", result.Probes[0].ProbeUUID)
+	assert.Equal(t, "probe-b", result.Probes[1].ProbeUUID)
+	assert.Nil(t, result.Probes[2])
+	require.Len(t, result.Errors, 1)
+	assert.Contains(t, result.Errors[2].Error(), "target is not reachable")
+}
+
+func TestProbesService_CreateBatch_FallbackRollback(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		created = map[string]bool{}
+		deleted []string
+		next    int32
+	)
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/v2/probes/batch":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == "POST" && r.URL.Path == "/v1/probes":
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			if body["name"] == "bad" {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"status":"error","message":"invalid target"}`))
+				return
+			}
+			uuid := "probe-" + string(rune('a'+atomic.AddInt32(&next, 1)-1))
+			mu.Lock()
+			created[uuid] = true
+			mu.Unlock()
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data":   map[string]interface{}{"probe": map[string]interface{}{"uuid": uuid, "name": body["name"]}},
+			})
+		case r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/v2/probes/"):
+			mu.Lock()
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/v2/probes/"))
+			mu.Unlock()
+			w.Write([]byte(`{"status":"success"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}), Config{})
+
+	reqs := []*ProbeCreateRequest{
+		{Name: "one", Type: "http", Target: "http://one.example.com"},
+		{Name: "bad", Type: "http", Target: "nope"},
+		{Name: "two", Type: "http", Target: "http://two.example.com"},
+	}
+	result, err := client.Probes.CreateBatch(context.Background(), reqs, &ProbeBatchOptions{Concurrency: 2, RollbackOnFailure: true})
+	require.NoError(t, err)
+	assert.True(t, result.RolledBack)
+	require.Len(t, result.Errors, 1)
+	assert.Contains(t, result.Errors[1].Error(), "invalid target")
+	assert.Empty(t, result.RollbackErrors)
+	assert.Equal(t, []*MonitoringProbe{nil, nil, nil}, result.Probes)
+	assert.ElementsMatch(t, []string{"probe-a", "probe-b"}, deleted)
+	assert.Len(t, created, 2)
+
+	// Without rollback the successful probes are kept
+	deleted = nil
+	result, err = client.Probes.CreateBatch(context.Background(), reqs, &ProbeBatchOptions{DisableBatchEndpoint: true})
+	require.NoError(t, err)
+	assert.False(t, result.RolledBack)
+	assert.NotNil(t, result.Probes[0])
+	assert.Nil(t, result.Probes[1])
+	assert.NotNil(t, result.Probes[2])
+	assert.Empty(t, deleted)
+
+	_, err = client.Probes.CreateBatch(context.Background(), []*ProbeCreateRequest{nil}, nil)
+	assert.Error(t, err)
+}
+
+func TestProbesService_UpdateBatch_Rollback(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		patches = map[string][]map[string]interface{}{}
+	)
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		uuid := strings.TrimPrefix(r.URL.Path, "/v2/probes/")
+		switch {
+		case r.URL.Path == "/v2/probes/batch-get":
+			w.Write([]byte(`{"status":"success","data":[
+				{"uuid":"probe-a","name":"a","interval":60,"enabled":true},
+				{"uuid":"probe-b","name":"b","interval":30,"enabled":false}
+			]}`))
+		case r.Method == "PATCH":
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			mu.Lock()
+			patches[uuid] = append(patches[uuid], body)
+			mu.Unlock()
+			if uuid == "probe-b" {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"status":"error","message":"probe is locked"}`))
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data":   map[string]interface{}{"uuid": uuid, "name": body["name"], "interval": body["frequency"]},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}), Config{})
+
+	name := "renamed"
+	interval := 120
+	updates := map[string]*ProbeUpdateRequest{
+		"probe-a": {Name: &name, Interval: &interval},
+		"probe-b": {Name: &name, Interval: &interval},
+	}
+	result, err := client.Probes.UpdateBatch(context.Background(), updates, &ProbeBatchOptions{RollbackOnFailure: true})
+	require.NoError(t, err)
+	assert.True(t, result.RolledBack)
+	require.Len(t, result.Errors, 1)
+	assert.Contains(t, result.Errors["probe-b"].Error(), "probe is locked")
+	assert.Empty(t, result.RollbackErrors)
+
+	// probe-a was updated and then restored
+	require.Len(t, patches["probe-a"], 2)
+	assert.Equal(t, "renamed", patches["probe-a"][0]["name"])
+	assert.Equal(t, "a", patches["probe-a"][1]["name"])
+	assert.Equal(t, float64(60), patches["probe-a"][1]["frequency"])
+	assert.Equal(t, "a", result.Probes["probe-a"].Name)
+}
@@ -0,0 +1,202 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Consensus types supported by CalculateProbeConsensus
+const (
+	ConsensusTypeMajority = "majority" // UP when more than half of the regions report UP
+	ConsensusTypeAll      = "all"      // UP only when every region reports UP
+	ConsensusTypeAny      = "any"      // UP when at least one region reports UP
+	ConsensusTypeQuorum   = "quorum"   // UP when at least ConsensusPolicy.Quorum regions report UP
+)
+
+// ConsensusPolicy configures how regional probe results are combined into a global status
+type ConsensusPolicy struct {
+	// Type is the consensus algorithm: "majority" (default), "all", "any", or "quorum"
+	Type string
+
+	// Quorum is the number of regions that must agree when Type is "quorum"
+	Quorum int
+
+	// MaxResultAge discards results older than this before grouping (0 keeps all results)
+	MaxResultAge time.Duration
+}
+
+// ProbeConsensus is the outcome of combining regional probe results
+type ProbeConsensus struct {
+	GlobalStatus      string            `json:"global_status"` // up, down, degraded, unknown
+	ConsensusType     string            `json:"consensus_type"`
+	RegionStatuses    map[string]string `json:"region_statuses"` // Normalized status per region
+	UpRegions         int               `json:"up_regions"`
+	DownRegions       int               `json:"down_regions"`
+	DegradedRegions   int               `json:"degraded_regions"`
+	UnknownRegions    int               `json:"unknown_regions"`
+	TotalRegions      int               `json:"total_regions"`
+	ConsensusRatio    float64           `json:"consensus_ratio"`    // Fraction of regions agreeing with GlobalStatus
+	DissentingRegions []string          `json:"dissenting_regions"` // Regions whose status differs from GlobalStatus
+	ShouldAlert       bool              `json:"should_alert"`
+}
+
+// GroupResultsByRegion returns the most recent result for each region.
+// Results with an unparseable CheckedAt timestamp are only kept when no
+// timestamped result exists for the same region.
+func GroupResultsByRegion(results []RegionalResult) map[string]RegionalResult {
+	latest := make(map[string]RegionalResult)
+	latestAt := make(map[string]time.Time)
+
+	for _, r := range results {
+		if r.Region == "" {
+			continue
+		}
+		checkedAt, _ := time.Parse(time.RFC3339, r.CheckedAt)
+		if existing, ok := latestAt[r.Region]; ok && !checkedAt.After(existing) {
+			continue
+		}
+		latest[r.Region] = r
+		latestAt[r.Region] = checkedAt
+	}
+
+	return latest
+}
+
+// CalculateProbeConsensus groups results by region, keeping the latest result per
+// region, and applies the consensus policy to determine a global status.
+// A nil policy uses majority consensus with no age limit.
+func CalculateProbeConsensus(results []RegionalResult, policy *ConsensusPolicy) (*ProbeConsensus, error) {
+	if policy == nil {
+		policy = &ConsensusPolicy{}
+	}
+
+	consensusType := policy.Type
+	if consensusType == "" {
+		consensusType = ConsensusTypeMajority
+	}
+
+	if policy.MaxResultAge > 0 {
+		cutoff := time.Now().Add(-policy.MaxResultAge)
+		recent := make([]RegionalResult, 0, len(results))
+		for _, r := range results {
+			checkedAt, err := time.Parse(time.RFC3339, r.CheckedAt)
+			if err != nil || checkedAt.Before(cutoff) {
+				continue
+			}
+			recent = append(recent, r)
+		}
+		results = recent
+	}
+
+	grouped := GroupResultsByRegion(results)
+	consensus := &ProbeConsensus{
+		ConsensusType:     consensusType,
+		RegionStatuses:    make(map[string]string, len(grouped)),
+		TotalRegions:      len(grouped),
+		DissentingRegions: []string{},
+	}
+
+	for region, r := range grouped {
+		status := normalizeProbeStatus(r.Status)
+		consensus.RegionStatuses[region] = status
+		switch status {
+		case "up":
+			consensus.UpRegions++
+		case "down":
+			consensus.DownRegions++
+		case "degraded":
+			consensus.DegradedRegions++
+		default:
+			consensus.UnknownRegions++
+		}
+	}
+
+	var required int
+	switch consensusType {
+	case ConsensusTypeMajority:
+		required = consensus.TotalRegions/2 + 1
+	case ConsensusTypeAll:
+		required = consensus.TotalRegions
+	case ConsensusTypeAny:
+		required = 1
+	case ConsensusTypeQuorum:
+		if policy.Quorum <= 0 {
+			return nil, fmt.Errorf("quorum must be greater than zero for quorum consensus")
+		}
+		required = policy.Quorum
+	default:
+		return nil, fmt.Errorf("invalid consensus type '%s': must be one of: majority, all, any, quorum", consensusType)
+	}
+
+	switch {
+	case consensus.TotalRegions == 0 || consensus.UnknownRegions == consensus.TotalRegions:
+		consensus.GlobalStatus = "unknown"
+	case consensus.UpRegions >= required:
+		consensus.GlobalStatus = "up"
+	case consensus.DownRegions >= required:
+		consensus.GlobalStatus = "down"
+	default:
+		consensus.GlobalStatus = "degraded"
+	}
+
+	agreeing := 0
+	for region, status := range consensus.RegionStatuses {
+		if status == consensus.GlobalStatus {
+			agreeing++
+			continue
+		}
+		consensus.DissentingRegions = append(consensus.DissentingRegions, region)
+	}
+	sort.Strings(consensus.DissentingRegions)
+
+	if consensus.TotalRegions > 0 {
+		consensus.ConsensusRatio = float64(agreeing) / float64(consensus.TotalRegions)
+	}
+	consensus.ShouldAlert = consensus.GlobalStatus == "down"
+
+	return consensus, nil
+}
+
+// normalizeProbeStatus maps the status strings reported by regional agents onto
+// the up/down/degraded/unknown values used by the consensus engine
+func normalizeProbeStatus(status string) string {
+	switch strings.ToLower(strings.TrimSpace(status)) {
+	case "up", "success", "ok", "healthy":
+		return "up"
+	case "down", "failure", "failed", "error", "timeout", "unhealthy":
+		return "down"
+	case "degraded", "warning":
+		return "degraded"
+	default:
+		return "unknown"
+	}
+}
+
+// GetConsensusStatus retrieves the server-calculated consensus status for a probe
+// Authentication: JWT Token or API key required
+// Endpoint: GET /v1/probes/{uuid}/consensus
+// Parameters:
+//   - probeUUID: Probe UUID
+// Returns: Most recent ProbeControllerConsensusResult for the probe
+//
+// Use CalculateProbeConsensus with GetRegionalResults to apply a custom policy locally.
+func (s *ProbesService) GetConsensusStatus(ctx context.Context, probeUUID string) (*ProbeControllerConsensusResult, error) {
+	var result struct {
+		Status string                          `json:"status"`
+		Data   *ProbeControllerConsensusResult `json:"data"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/probes/%s/consensus", probeUUID),
+		Result: &result,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get consensus status for probe %s: %w", probeUUID, err)
+	}
+
+	return result.Data, nil
+}
@@ -0,0 +1,191 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func regionalResultAt(region, status string, age time.Duration) RegionalResult {
+	return RegionalResult{
+		Region:    region,
+		Status:    status,
+		CheckedAt: time.Now().Add(-age).UTC().Format(time.RFC3339),
+	}
+}
+
+func TestGroupResultsByRegion(t *testing.T) {
+	results := []RegionalResult{
+		regionalResultAt("nyc", "down", 3*time.Minute),
+		regionalResultAt("nyc", "up", 1*time.Minute),
+		regionalResultAt("sfo", "down", 2*time.Minute),
+		{Region: "", Status: "up"},
+	}
+
+	grouped := GroupResultsByRegion(results)
+	require.Len(t, grouped, 2)
+	assert.Equal(t, "up", grouped["nyc"].Status)
+	assert.Equal(t, "down", grouped["sfo"].Status)
+}
+
+func TestCalculateProbeConsensus(t *testing.T) {
+	tests := []struct {
+		name           string
+		results        []RegionalResult
+		policy         *ConsensusPolicy
+		expectedStatus string
+		dissenting     []string
+		shouldAlert    bool
+	}{
+		{
+			name: "majority up with one dissenting region",
+			results: []RegionalResult{
+				regionalResultAt("nyc", "up", time.Minute),
+				regionalResultAt("lon", "success", time.Minute),
+				regionalResultAt("sfo", "down", time.Minute),
+			},
+			expectedStatus: "up",
+			dissenting:     []string{"sfo"},
+		},
+		{
+			name: "majority down triggers alert",
+			results: []RegionalResult{
+				regionalResultAt("nyc", "timeout", time.Minute),
+				regionalResultAt("lon", "down", time.Minute),
+				regionalResultAt("sfo", "up", time.Minute),
+			},
+			expectedStatus: "down",
+			dissenting:     []string{"sfo"},
+			shouldAlert:    true,
+		},
+		{
+			name: "all policy degrades on single failure",
+			results: []RegionalResult{
+				regionalResultAt("nyc", "up", time.Minute),
+				regionalResultAt("sfo", "down", time.Minute),
+			},
+			policy:         &ConsensusPolicy{Type: ConsensusTypeAll},
+			expectedStatus: "degraded",
+			dissenting:     []string{"nyc", "sfo"},
+		},
+		{
+			name: "any policy up with single healthy region",
+			results: []RegionalResult{
+				regionalResultAt("nyc", "down", time.Minute),
+				regionalResultAt("sfo", "up", time.Minute),
+			},
+			policy:         &ConsensusPolicy{Type: ConsensusTypeAny},
+			expectedStatus: "up",
+			dissenting:     []string{"nyc"},
+		},
+		{
+			name: "quorum of two down regions",
+			results: []RegionalResult{
+				regionalResultAt("nyc", "down", time.Minute),
+				regionalResultAt("sfo", "down", time.Minute),
+				regionalResultAt("lon", "up", time.Minute),
+				regionalResultAt("fra", "degraded", time.Minute),
+			},
+			policy:         &ConsensusPolicy{Type: ConsensusTypeQuorum, Quorum: 2},
+			expectedStatus: "down",
+			dissenting:     []string{"fra", "lon"},
+			shouldAlert:    true,
+		},
+		{
+			name: "stale results are ignored",
+			results: []RegionalResult{
+				regionalResultAt("nyc", "up", time.Minute),
+				regionalResultAt("sfo", "down", time.Hour),
+			},
+			policy:         &ConsensusPolicy{MaxResultAge: 10 * time.Minute},
+			expectedStatus: "up",
+			dissenting:     []string{},
+		},
+		{
+			name:           "no results is unknown",
+			results:        nil,
+			expectedStatus: "unknown",
+			dissenting:     []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			consensus, err := CalculateProbeConsensus(tt.results, tt.policy)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, consensus.GlobalStatus)
+			assert.Equal(t, tt.dissenting, consensus.DissentingRegions)
+			assert.Equal(t, tt.shouldAlert, consensus.ShouldAlert)
+		})
+	}
+}
+
+func TestCalculateProbeConsensus_Counts(t *testing.T) {
+	consensus, err := CalculateProbeConsensus([]RegionalResult{
+		regionalResultAt("nyc", "up", time.Minute),
+		regionalResultAt("lon", "up", time.Minute),
+		regionalResultAt("sfo", "down", time.Minute),
+		regionalResultAt("fra", "pending", time.Minute),
+	}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, ConsensusTypeMajority, consensus.ConsensusType)
+	assert.Equal(t, 4, consensus.TotalRegions)
+	assert.Equal(t, 2, consensus.UpRegions)
+	assert.Equal(t, 1, consensus.DownRegions)
+	assert.Equal(t, 1, consensus.UnknownRegions)
+	assert.Equal(t, "degraded", consensus.GlobalStatus)
+	assert.Equal(t, "unknown", consensus.RegionStatuses["fra"])
+}
+
+func TestCalculateProbeConsensus_InvalidPolicy(t *testing.T) {
+	results := []RegionalResult{regionalResultAt("nyc", "up", time.Minute)}
+
+	_, err := CalculateProbeConsensus(results, &ConsensusPolicy{Type: "weighted"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid consensus type")
+
+	_, err = CalculateProbeConsensus(results, &ConsensusPolicy{Type: ConsensusTypeQuorum})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "quorum must be greater than zero")
+}
+
+func TestProbesService_GetConsensusStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/probes/probe-uuid-123/consensus", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data": ProbeControllerConsensusResult{
+				ProbeUUID:      "probe-uuid-123",
+				GlobalStatus:   "up",
+				ConsensusType:  "majority",
+				UpRegions:      2,
+				DownRegions:    1,
+				TotalRegions:   3,
+				ConsensusRatio: 0.67,
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	result, err := client.Probes.GetConsensusStatus(context.Background(), "probe-uuid-123")
+	require.NoError(t, err)
+	assert.Equal(t, "up", result.GlobalStatus)
+	assert.Equal(t, 3, result.TotalRegions)
+	assert.Equal(t, 1, result.DownRegions)
+}
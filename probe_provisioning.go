@@ -0,0 +1,551 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ProbeProvisioningOptInTag must be on a server for probes to be
+	// provisioned for it, unless ProbeProvisionerOptions.OptInTag says otherwise
+	ProbeProvisioningOptInTag = "auto-probes"
+
+	// provisionedProbeTagPrefix marks probes created by a ProbeProvisioner.
+	// The rest of the tag identifies the template, server, and port.
+	provisionedProbeTagPrefix = "auto-probe:"
+
+	defaultProvisioningInterval = 10 * time.Minute
+)
+
+// ProbeTemplate describes a probe to provision for every opted-in server
+// whose inventory matches. A template matches a server running any of
+// Services or listening on any of Ports; with Ports, one probe is provisioned
+// per matching port.
+//
+// Target and Name may contain the placeholders {hostname}, {fqdn}, {ip} and
+// {port}. An empty Target defaults to a URL or host for the probe type.
+//
+// Outside a ProbeProvisioner, Render fills in arbitrary placeholders to stamp
+// out probes for targets that have no inventory, such as external endpoints.
+type ProbeTemplate struct {
+	Name     string   // Identifies the template; changing it re-provisions its probes
+	Services []string // systemd units, e.g. "nginx.service"
+	Ports    []int    // Listening TCP ports
+
+	// RequiredTags a server must have, in addition to the opt-in tag
+	RequiredTags []string
+
+	Type          ProbeType // http, https, tcp, icmp
+	Target        string
+	ProbeName     string // Default: "{hostname} <template name>"
+	Interval      int    // Seconds (default: 60)
+	Timeout       int    // Seconds (default: 10)
+	RegionCode    string
+	Configuration map[string]interface{}
+}
+
+// ServerInventory is what a ProbeProvisioner knows about a server
+type ServerInventory struct {
+	Server         *Server
+	Services       []string // Active systemd units
+	ListeningPorts []ListeningPort
+}
+
+// ProbeProvisionerOptions configures a ProbeProvisioner
+type ProbeProvisionerOptions struct {
+	Templates []ProbeTemplate
+
+	// OptInTag servers must carry (default: ProbeProvisioningOptInTag)
+	OptInTag string
+
+	// RemoveStale deletes provisioned probes whose server no longer matches
+	// their template, has opted out, or whose template was removed
+	RemoveStale bool
+
+	// Inventory returns a server's inventory. The default reads active
+	// services and listening ports from ServiceMonitoring.GetServerServices.
+	Inventory func(ctx context.Context, server *Server) (*ServerInventory, error)
+
+	// Interval between reconciliations in Run (default: 10m)
+	Interval time.Duration
+
+	// OnReconcile is called with the applied plan after each reconciliation in Run
+	OnReconcile func(*ProvisioningPlan)
+
+	// OnError is called when a reconciliation in Run fails. Run keeps going.
+	OnError func(error)
+}
+
+// ProvisioningAction is a probe to create or delete
+type ProvisioningAction struct {
+	Template   string
+	ServerUUID string
+	Port       int                 // Matched port, 0 for service matches
+	Probe      *ProbeCreateRequest // For creates
+	ProbeUUID  string              // For deletes
+	Err        error               // Set by Reconcile when the action failed
+}
+
+// ProvisioningPlan lists the changes needed to bring provisioned probes in
+// line with inventory
+type ProvisioningPlan struct {
+	Create    []ProvisioningAction
+	Delete    []ProvisioningAction
+	Unchanged int
+}
+
+// ProbeProvisioner provisions probes from templates for servers whose
+// inventory shows what the template monitors, closing the loop between
+// discovery and monitoring. Servers opt in with a tag. Provisioned probes are
+// tagged so they can be told apart from probes created by hand, which are
+// never touched.
+//
+// Example:
+//
+//	provisioner := client.Probes.NewProvisioner(&nexmonyx.ProbeProvisionerOptions{
+//	    Templates: []nexmonyx.ProbeTemplate{
+//	        {Name: "nginx", Services: []string{"nginx.service"}, Type: "https"},
+//	        {Name: "postgres", Ports: []int{5432}, Type: "tcp"},
+//	    },
+//	    RemoveStale: true,
+//	})
+//	plan, err := provisioner.Plan(ctx) // review first
+//	plan, err = provisioner.Reconcile(ctx)
+type ProbeProvisioner struct {
+	service *ProbesService
+	options ProbeProvisionerOptions
+}
+
+// NewProvisioner creates a probe provisioner
+func (s *ProbesService) NewProvisioner(opts *ProbeProvisionerOptions) *ProbeProvisioner {
+	options := ProbeProvisionerOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.OptInTag == "" {
+		options.OptInTag = ProbeProvisioningOptInTag
+	}
+	if options.Interval <= 0 {
+		options.Interval = defaultProvisioningInterval
+	}
+
+	p := &ProbeProvisioner{service: s, options: options}
+	if p.options.Inventory == nil {
+		p.options.Inventory = p.serviceInventory
+	}
+	return p
+}
+
+// Plan compares inventory with the provisioned probes without changing anything
+func (p *ProbeProvisioner) Plan(ctx context.Context) (*ProvisioningPlan, error) {
+	client := p.service.client
+
+	existing := map[string]*MonitoringProbe{}
+	err := eachPage(ctx, client, "/v2/probes", nil, func(probe *MonitoringProbe) error {
+		if key := provisionedProbeKey(probe.Tags); key != "" {
+			existing[key] = probe
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list probes: %w", err)
+	}
+
+	wanted := map[string]ProvisioningAction{}
+	err = eachPage(ctx, client, "/v2/servers", nil, func(server *Server) error {
+		if !hasAllTags(server.Tags, p.options.OptInTag) {
+			return nil
+		}
+		inventory, err := p.options.Inventory(ctx, server)
+		if err != nil {
+			return fmt.Errorf("failed to get inventory of server %s: %w", server.ServerUUID, err)
+		}
+		for _, template := range p.options.Templates {
+			for _, action := range template.actions(inventory) {
+				wanted[action.Probe.Tags[0]] = action
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &ProvisioningPlan{}
+	for key, action := range wanted {
+		if _, ok := existing[key]; ok {
+			plan.Unchanged++
+			continue
+		}
+		plan.Create = append(plan.Create, action)
+	}
+	if p.options.RemoveStale {
+		for key, probe := range existing {
+			if _, ok := wanted[key]; ok {
+				continue
+			}
+			template, serverUUID, port := parseProvisionedProbeKey(key)
+			plan.Delete = append(plan.Delete, ProvisioningAction{
+				Template:   template,
+				ServerUUID: serverUUID,
+				Port:       port,
+				ProbeUUID:  probe.ProbeUUID,
+			})
+		}
+	}
+
+	sortActions(plan.Create)
+	sortActions(plan.Delete)
+	return plan, nil
+}
+
+// Reconcile plans and applies the changes. Failed actions have Err set and
+// are returned joined as the error; the other actions are still applied.
+func (p *ProbeProvisioner) Reconcile(ctx context.Context) (*ProvisioningPlan, error) {
+	plan, err := p.Plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for i := range plan.Create {
+		action := &plan.Create[i]
+		if _, action.Err = p.service.Create(ctx, action.Probe); action.Err != nil {
+			errs = append(errs, fmt.Errorf("create %s probe for server %s: %w", action.Template, action.ServerUUID, action.Err))
+		}
+	}
+	for i := range plan.Delete {
+		action := &plan.Delete[i]
+		if action.Err = p.service.Delete(ctx, action.ProbeUUID); action.Err != nil {
+			errs = append(errs, fmt.Errorf("delete probe %s: %w", action.ProbeUUID, action.Err))
+		}
+	}
+	return plan, errors.Join(errs...)
+}
+
+// Run reconciles immediately and then every interval until ctx is done,
+// returning ctx's error
+func (p *ProbeProvisioner) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		plan, err := p.Reconcile(ctx)
+		if err != nil && ctx.Err() == nil && p.options.OnError != nil {
+			p.options.OnError(err)
+		}
+		if plan != nil && p.options.OnReconcile != nil {
+			p.options.OnReconcile(plan)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// serviceInventory reads a server's active services and listening ports
+func (p *ProbeProvisioner) serviceInventory(ctx context.Context, server *Server) (*ServerInventory, error) {
+	status, err := p.service.client.ServiceMonitoring.GetServerServices(ctx, server.ServerUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := &ServerInventory{Server: server, ListeningPorts: status.ListeningPorts}
+	for _, service := range status.Services {
+		if service != nil && service.State == "active" {
+			inventory.Services = append(inventory.Services, service.Name)
+		}
+	}
+	return inventory, nil
+}
+
+// actions returns the probes the template wants for a server
+func (t *ProbeTemplate) actions(inventory *ServerInventory) []ProvisioningAction {
+	server := inventory.Server
+	if !hasAllTags(server.Tags, t.RequiredTags...) {
+		return nil
+	}
+
+	var ports []int
+	for _, port := range t.Ports {
+		for _, listening := range inventory.ListeningPorts {
+			if listening.Port == port && (listening.Protocol == "" || listening.Protocol == "tcp") {
+				ports = append(ports, port)
+				break
+			}
+		}
+	}
+	if len(ports) == 0 {
+		if !containsAny(inventory.Services, t.Services) {
+			return nil
+		}
+		ports = []int{0}
+	}
+
+	actions := make([]ProvisioningAction, 0, len(ports))
+	for _, port := range ports {
+		actions = append(actions, ProvisioningAction{
+			Template:   t.Name,
+			ServerUUID: server.ServerUUID,
+			Port:       port,
+			Probe:      t.probe(server, port),
+		})
+	}
+	return actions
+}
+
+// probe builds the create request for a server and port (0 for none)
+func (t *ProbeTemplate) probe(server *Server, port int) *ProbeCreateRequest {
+	fqdn := server.FQDN
+	if fqdn == "" {
+		fqdn = server.Hostname
+	}
+	ip := server.MainIP
+	if ip == "" {
+		ip = fqdn
+	}
+	portText := ""
+	if port > 0 {
+		portText = strconv.Itoa(port)
+	}
+	expand := strings.NewReplacer("{hostname}", server.Hostname, "{fqdn}", fqdn, "{ip}", ip, "{port}", portText).Replace
+
+	target := t.Target
+	if target == "" {
+		target = defaultTemplateTarget(t.Type, port)
+	}
+	name := t.ProbeName
+	if name == "" {
+		name = "{hostname} " + t.Name
+		if port > 0 {
+			name += ":{port}"
+		}
+	}
+
+	config := make(map[string]interface{}, len(t.Configuration)+1)
+	for k, v := range t.Configuration {
+		config[k] = v
+	}
+	if t.Type == ProbeTypeTCP && port > 0 {
+		config["port"] = port
+	}
+
+	interval, timeout := t.Interval, t.Timeout
+	if interval <= 0 {
+		interval = 60
+	}
+	if timeout <= 0 {
+		timeout = 10
+	}
+
+	return &ProbeCreateRequest{
+		Name:          expand(name),
+		Type:          t.Type,
+		Target:        expand(target),
+		Configuration: config,
+		Interval:      interval,
+		Timeout:       timeout,
+		RegionCode:    t.RegionCode,
+		Enabled:       true,
+		Tags:          []string{provisionedProbeTagPrefix + t.Name + "/" + server.ServerUUID + "/" + portText},
+	}
+}
+
+// Render builds a create request from the template, replacing every
+// {name} placeholder in Target, ProbeName, RegionCode and string values in
+// Configuration with vars[name]. Without a Target, the default for the probe
+// type is used, which needs the fqdn or ip variable and optionally port. It
+// fails if a placeholder has no variable. The provisioner's matching fields
+// (Services, Ports and RequiredTags) are ignored.
+func (t *ProbeTemplate) Render(vars map[string]string) (*ProbeCreateRequest, error) {
+	port, _ := strconv.Atoi(vars["port"])
+	target := t.Target
+	if target == "" {
+		target = defaultTemplateTarget(t.Type, port)
+	}
+	name := t.ProbeName
+	if name == "" {
+		name = t.Name + " " + target
+	}
+
+	r := &templateRenderer{vars: vars}
+	config := make(map[string]interface{}, len(t.Configuration)+1)
+	for k, v := range t.Configuration {
+		config[k] = r.value(v)
+	}
+	if t.Type == ProbeTypeTCP && port > 0 {
+		config["port"] = port
+	}
+
+	interval, timeout := t.Interval, t.Timeout
+	if interval <= 0 {
+		interval = 60
+	}
+	if timeout <= 0 {
+		timeout = 10
+	}
+
+	req := &ProbeCreateRequest{
+		Name:          r.expand(name),
+		Type:          t.Type,
+		Target:        r.expand(target),
+		Configuration: config,
+		Interval:      interval,
+		Timeout:       timeout,
+		RegionCode:    r.expand(t.RegionCode),
+		Enabled:       true,
+	}
+	if len(r.missing) > 0 {
+		sort.Strings(r.missing)
+		return nil, fmt.Errorf("template %q: undefined placeholders {%s}", t.Name, strings.Join(r.missing, "}, {"))
+	}
+	return req, nil
+}
+
+// RenderAll renders the template once for every set of variables, in order
+func (t *ProbeTemplate) RenderAll(targets []map[string]string) ([]*ProbeCreateRequest, error) {
+	reqs := make([]*ProbeCreateRequest, len(targets))
+	for i, vars := range targets {
+		req, err := t.Render(vars)
+		if err != nil {
+			return nil, fmt.Errorf("targets[%d]: %w", i, err)
+		}
+		reqs[i] = req
+	}
+	return reqs, nil
+}
+
+var templatePlaceholder = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// templateRenderer expands {name} placeholders and records undefined ones
+type templateRenderer struct {
+	vars    map[string]string
+	missing []string
+}
+
+func (r *templateRenderer) expand(s string) string {
+	return templatePlaceholder.ReplaceAllStringFunc(s, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+		if value, ok := r.vars[name]; ok {
+			return value
+		}
+		for _, missing := range r.missing {
+			if missing == name {
+				return placeholder
+			}
+		}
+		r.missing = append(r.missing, name)
+		return placeholder
+	})
+}
+
+// value expands placeholders in strings, including inside nested maps and lists
+func (r *templateRenderer) value(v interface{}) interface{} {
+	switch v := v.(type) {
+	case string:
+		return r.expand(v)
+	case []string:
+		out := make([]string, len(v))
+		for i, s := range v {
+			out[i] = r.expand(s)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = r.value(item)
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			out[k] = r.value(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func defaultTemplateTarget(probeType ProbeType, port int) string {
+	switch probeType {
+	case ProbeTypeHTTP, ProbeTypeHTTPS:
+		if port > 0 && !(probeType == ProbeTypeHTTP && port == 80) && !(probeType == ProbeTypeHTTPS && port == 443) {
+			return string(probeType) + "://{fqdn}:{port}"
+		}
+		return string(probeType) + "://{fqdn}"
+	case ProbeTypeICMP:
+		return "{ip}"
+	default:
+		return "{fqdn}"
+	}
+}
+
+// provisionedProbeKey returns the provisioning tag of a probe, or ""
+func provisionedProbeKey(tags []string) string {
+	for _, tag := range tags {
+		if strings.HasPrefix(tag, provisionedProbeTagPrefix) {
+			return tag
+		}
+	}
+	return ""
+}
+
+// parseProvisionedProbeKey splits a provisioning tag into template, server and port
+func parseProvisionedProbeKey(key string) (template, serverUUID string, port int) {
+	parts := strings.Split(strings.TrimPrefix(key, provisionedProbeTagPrefix), "/")
+	if len(parts) != 3 {
+		return strings.Join(parts, "/"), "", 0
+	}
+	port, _ = strconv.Atoi(parts[2])
+	return parts[0], parts[1], port
+}
+
+func hasAllTags(tags []string, required ...string) bool {
+	for _, want := range required {
+		found := false
+		for _, tag := range tags {
+			if tag == want {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAny(values, wanted []string) bool {
+	for _, want := range wanted {
+		for _, value := range values {
+			if value == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sortActions(actions []ProvisioningAction) {
+	sort.Slice(actions, func(i, j int) bool {
+		a, b := actions[i], actions[j]
+		if a.ServerUUID != b.ServerUUID {
+			return a.ServerUUID < b.ServerUUID
+		}
+		if a.Template != b.Template {
+			return a.Template < b.Template
+		}
+		return a.Port < b.Port
+	})
+}
@@ -0,0 +1,156 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// provisioningServer serves servers, their inventory and probes, recording
+// probe creates and deletes
+type provisioningServer struct {
+	mu       sync.Mutex
+	servers  []Server
+	services map[string]ServiceStatusResponse
+	probes   []MonitoringProbe
+	created  []map[string]interface{}
+	deleted  []string
+}
+
+func (s *provisioningServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case r.Method == "GET" && r.URL.Path == "/v2/servers":
+		json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "data": s.servers, "meta": PaginationMeta{Page: 1, TotalPages: 1}})
+	case r.Method == "GET" && r.URL.Path == "/v2/probes":
+		json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "data": s.probes, "meta": PaginationMeta{Page: 1, TotalPages: 1}})
+	case r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/services"):
+		status, ok := s.services[strings.Split(r.URL.Path, "/")[3]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(status)
+	case r.Method == "POST" && r.URL.Path == "/v1/probes":
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		s.created = append(s.created, body)
+		w.Write([]byte(`{"status":"success","data":{"probe":{"uuid":"new"}}}`))
+	case r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/v2/probes/"):
+		s.deleted = append(s.deleted, strings.TrimPrefix(r.URL.Path, "/v2/probes/"))
+		w.Write([]byte(`{"status":"success"}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newProvisioningTestServer() *provisioningServer {
+	return &provisioningServer{
+		servers: []Server{
+			{ServerUUID: "srv-web", Hostname: "web1", FQDN: "web1.example.com", MainIP: "10.0.0.1", Tags: []string{"auto-probes", "prod"}},
+			{ServerUUID: "srv-db", Hostname: "db1", MainIP: "10.0.0.2", Tags: []string{"auto-probes"}},
+			{ServerUUID: "srv-manual", Hostname: "web2", Tags: []string{"prod"}},
+		},
+		services: map[string]ServiceStatusResponse{
+			"srv-web": {
+				Services: []*ServiceMonitoringInfo{
+					{Name: "nginx.service", State: "active"},
+					{Name: "redis.service", State: "failed"},
+				},
+				ListeningPorts: []ListeningPort{{Port: 443, Protocol: "tcp"}, {Port: 8443, Protocol: "tcp"}},
+			},
+			"srv-db": {
+				Services:       []*ServiceMonitoringInfo{{Name: "postgresql.service", State: "active"}},
+				ListeningPorts: []ListeningPort{{Port: 5432, Protocol: "tcp"}, {Port: 53, Protocol: "udp"}},
+			},
+		},
+		probes: []MonitoringProbe{
+			{ProbeUUID: "probe-manual", Name: "manual"},
+			{ProbeUUID: "probe-pg", Tags: []string{"auto-probe:postgres/srv-db/5432"}},
+			{ProbeUUID: "probe-stale", Tags: []string{"auto-probe:redis/srv-web/"}},
+		},
+	}
+}
+
+var testProbeTemplates = []ProbeTemplate{
+	{Name: "nginx", Services: []string{"nginx.service"}, Ports: []int{443, 8443}, Type: "https", RequiredTags: []string{"prod"}},
+	{Name: "postgres", Ports: []int{5432}, Type: "tcp"},
+	{Name: "redis", Services: []string{"redis.service"}, Type: "tcp"},
+	{Name: "dns", Ports: []int{53}, Type: "tcp"},
+	{Name: "ping", Services: []string{"postgresql.service"}, Type: "icmp", ProbeName: "{hostname} reachability"},
+}
+
+func TestProbeProvisioner_Plan(t *testing.T) {
+	backend := newProvisioningTestServer()
+	server := httptest.NewServer(backend)
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	provisioner := client.Probes.NewProvisioner(&ProbeProvisionerOptions{Templates: testProbeTemplates, RemoveStale: true})
+
+	plan, err := provisioner.Plan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, plan.Unchanged)
+	assert.Empty(t, backend.created, "planning changes nothing")
+
+	require.Len(t, plan.Create, 3)
+	assert.Equal(t, "ping", plan.Create[0].Template)
+	assert.Equal(t, "db1 reachability", plan.Create[0].Probe.Name)
+	assert.Equal(t, "10.0.0.2", plan.Create[0].Probe.Target)
+
+	assert.Equal(t, "https://web1.example.com", plan.Create[1].Probe.Target)
+	assert.Equal(t, "web1 nginx:443", plan.Create[1].Probe.Name)
+	assert.Equal(t, "https://web1.example.com:8443", plan.Create[2].Probe.Target)
+	assert.Equal(t, []string{"auto-probe:nginx/srv-web/8443"}, plan.Create[2].Probe.Tags)
+	assert.Equal(t, 60, plan.Create[2].Probe.Interval)
+
+	require.Len(t, plan.Delete, 1)
+	assert.Equal(t, ProvisioningAction{Template: "redis", ServerUUID: "srv-web", ProbeUUID: "probe-stale"}, plan.Delete[0])
+}
+
+func TestProbeProvisioner_Reconcile(t *testing.T) {
+	backend := newProvisioningTestServer()
+	server := httptest.NewServer(backend)
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	// A custom inventory source replaces the service monitoring lookup
+	provisioner := client.Probes.NewProvisioner(&ProbeProvisionerOptions{
+		Templates: []ProbeTemplate{{Name: "postgres", Ports: []int{5432}, Type: "tcp", Target: "{ip}"}},
+		OptInTag:  "prod",
+		Inventory: func(ctx context.Context, server *Server) (*ServerInventory, error) {
+			return &ServerInventory{Server: server, ListeningPorts: []ListeningPort{{Port: 5432}}}, nil
+		},
+	})
+
+	plan, err := provisioner.Reconcile(context.Background())
+	require.NoError(t, err)
+	require.Len(t, plan.Create, 2)
+	assert.Empty(t, plan.Delete, "stale probes are kept unless RemoveStale is set")
+	assert.Empty(t, backend.deleted)
+
+	require.Len(t, backend.created, 2)
+	assert.Equal(t, "web2 postgres:5432", backend.created[0]["name"])
+	assert.Equal(t, map[string]interface{}{"host": "web2", "port": float64(5432)}, backend.created[0]["config"])
+	assert.Equal(t, map[string]interface{}{"host": "10.0.0.1", "port": float64(5432)}, backend.created[1]["config"])
+	assert.Equal(t, []interface{}{"auto-probe:postgres/srv-web/5432"}, backend.created[1]["tags"])
+
+	// Failing to read a server's inventory fails the whole plan
+	delete(backend.services, "srv-db")
+	provisioner = client.Probes.NewProvisioner(&ProbeProvisionerOptions{Templates: testProbeTemplates})
+	_, err = provisioner.Reconcile(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,169 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StatsOptions selects what ProbesService.GetResultStats aggregates
+type StatsOptions struct {
+	// TimeRange limits the results aggregated (default: the last 24 hours)
+	TimeRange *QueryTimeRange
+
+	// Buckets is the number of response time histogram buckets (default:
+	// chosen by the API)
+	Buckets int
+
+	// Percentiles are the response time percentiles to compute, between 0 and
+	// 100 (default: 50, 90, and 99)
+	Percentiles []float64
+
+	// GroupByRegion additionally returns the statistics of each region in
+	// ProbeResultStats.Regions
+	GroupByRegion bool
+}
+
+// ToQuery converts StatsOptions to query parameters
+func (o *StatsOptions) ToQuery() map[string]string {
+	params := map[string]string{}
+	if o.TimeRange != nil {
+		if !o.TimeRange.Start.IsZero() {
+			params["start_time"] = o.TimeRange.Start.UTC().Format(time.RFC3339)
+		}
+		if !o.TimeRange.End.IsZero() {
+			params["end_time"] = o.TimeRange.End.UTC().Format(time.RFC3339)
+		}
+	}
+	if o.Buckets > 0 {
+		params["buckets"] = strconv.Itoa(o.Buckets)
+	}
+	if len(o.Percentiles) > 0 {
+		percentiles := make([]string, len(o.Percentiles))
+		for i, p := range o.Percentiles {
+			percentiles[i] = strconv.FormatFloat(p, 'f', -1, 64)
+		}
+		params["percentiles"] = strings.Join(percentiles, ",")
+	}
+	if o.GroupByRegion {
+		params["group_by"] = "region"
+	}
+	return params
+}
+
+// Validate checks the options before they are sent
+func (o *StatsOptions) Validate() error {
+	if r := o.TimeRange; r != nil && !r.Start.IsZero() && !r.End.IsZero() && r.End.Before(r.Start) {
+		return fmt.Errorf("time range end %s is before start %s", r.End.Format(time.RFC3339), r.Start.Format(time.RFC3339))
+	}
+	if o.Buckets < 0 {
+		return fmt.Errorf("buckets must not be negative")
+	}
+	for _, p := range o.Percentiles {
+		if p <= 0 || p > 100 {
+			return fmt.Errorf("percentile %v is not between 0 and 100", p)
+		}
+	}
+	return nil
+}
+
+// ResponseTimePercentile is a response time percentile in milliseconds
+type ResponseTimePercentile struct {
+	Percentile   float64 `json:"percentile"`    // e.g. 99 or 99.9
+	ResponseTime float64 `json:"response_time"` // Milliseconds
+}
+
+// ErrorRatePoint is the share of failed results in one interval of a
+// ProbeResultStats error rate series
+type ErrorRatePoint struct {
+	Timestamp   CustomTime `json:"timestamp"` // Start of the interval
+	SampleCount int        `json:"sample_count"`
+	ErrorCount  int        `json:"error_count"`
+	ErrorRate   float64    `json:"error_rate"` // Percentage of failed results
+}
+
+// ResponseTimeBucket is a response time histogram bucket. Results with
+// LowerBound <= response time < UpperBound, in milliseconds, are counted.
+type ResponseTimeBucket struct {
+	LowerBound float64 `json:"lower_bound"`
+	UpperBound float64 `json:"upper_bound"`
+	Count      int     `json:"count"`
+}
+
+// ProbeResultStats aggregates a probe's results over a time range
+type ProbeResultStats struct {
+	ProbeUUID   string     `json:"probe_uuid,omitempty"`
+	Region      string     `json:"region,omitempty"` // Set in the entries of Regions
+	Start       CustomTime `json:"start"`
+	End         CustomTime `json:"end"`
+	Resolution  string     `json:"resolution,omitempty"` // Interval of ErrorRateSeries, e.g. "5m"
+	SampleCount int        `json:"sample_count"`
+	ErrorCount  int        `json:"error_count"`
+	ErrorRate   float64    `json:"error_rate"` // Percentage of failed results
+
+	Percentiles     []ResponseTimePercentile `json:"percentiles"`
+	ErrorRateSeries []ErrorRatePoint         `json:"error_rate_series"`
+	Histogram       []ResponseTimeBucket     `json:"histogram"`
+
+	// Regions holds the statistics of each region when
+	// StatsOptions.GroupByRegion is set
+	Regions map[string]*ProbeResultStats `json:"regions,omitempty"`
+}
+
+// Percentile returns the response time percentile p in milliseconds, and
+// false if it was not computed
+func (s *ProbeResultStats) Percentile(p float64) (float64, bool) {
+	for _, rt := range s.Percentiles {
+		if rt.Percentile == p {
+			return rt.ResponseTime, true
+		}
+	}
+	return 0, false
+}
+
+// GetResultStats aggregates a probe's results on the server into response
+// time percentiles, an error rate series, and a response time histogram, so
+// dashboards do not have to page through raw results with ListResults.
+//
+// Example:
+//
+//	stats, err := client.Probes.GetResultStats(ctx, probeUUID, nexmonyx.StatsOptions{
+//	    TimeRange:     nexmonyx.Last7Days(),
+//	    GroupByRegion: true,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	p99, _ := stats.Percentile(99)
+//	fmt.Printf("p99 %.0fms, %.2f%% errors\n", p99, stats.ErrorRate)
+//
+// Authentication: JWT Token or API Key/Secret
+// Endpoint: GET /v2/probes/{uuid}/results/stats
+func (s *ProbesService) GetResultStats(ctx context.Context, probeUUID string, opts StatsOptions) (*ProbeResultStats, error) {
+	if probeUUID == "" {
+		return nil, fmt.Errorf("probe UUID is required")
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp StandardResponse
+	resp.Data = &ProbeResultStats{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v2/probes/%s/results/stats", probeUUID),
+		Query:  opts.ToQuery(),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if stats, ok := resp.Data.(*ProbeResultStats); ok {
+		return stats, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
@@ -0,0 +1,92 @@
+package nexmonyx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbesService_GetResultStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v2/probes/probe-1/results/stats", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "2026-10-14T12:00:00Z", query.Get("start_time"))
+		assert.Equal(t, "2026-10-15T12:00:00Z", query.Get("end_time"))
+		assert.Equal(t, "20", query.Get("buckets"))
+		assert.Equal(t, "50,99,99.9", query.Get("percentiles"))
+		assert.Equal(t, "region", query.Get("group_by"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{
+			"probe_uuid": "probe-1",
+			"start": "2026-10-14T12:00:00Z",
+			"end": "2026-10-15T12:00:00Z",
+			"resolution": "1h",
+			"sample_count": 2880,
+			"error_count": 36,
+			"error_rate": 1.25,
+			"percentiles": [{"percentile": 50, "response_time": 84}, {"percentile": 99, "response_time": 412.5}, {"percentile": 99.9, "response_time": 980}],
+			"error_rate_series": [{"timestamp": "2026-10-14T12:00:00Z", "sample_count": 120, "error_count": 3, "error_rate": 2.5}],
+			"histogram": [{"lower_bound": 0, "upper_bound": 50, "count": 610}, {"lower_bound": 50, "upper_bound": 100, "count": 1720}],
+			"regions": {"eu-west": {"region": "eu-west", "sample_count": 1440, "error_count": 0, "error_rate": 0, "percentiles": [{"percentile": 50, "response_time": 61}]}}
+		}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	end := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	stats, err := client.Probes.GetResultStats(context.Background(), "probe-1", StatsOptions{
+		TimeRange:     &QueryTimeRange{Start: end.Add(-24 * time.Hour), End: end},
+		Buckets:       20,
+		Percentiles:   []float64{50, 99, 99.9},
+		GroupByRegion: true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2880, stats.SampleCount)
+	assert.Equal(t, 1.25, stats.ErrorRate)
+	p99, ok := stats.Percentile(99)
+	assert.True(t, ok)
+	assert.Equal(t, 412.5, p99)
+	_, ok = stats.Percentile(90)
+	assert.False(t, ok, "not requested")
+
+	require.Len(t, stats.ErrorRateSeries, 1)
+	assert.Equal(t, 3, stats.ErrorRateSeries[0].ErrorCount)
+	require.Len(t, stats.Histogram, 2)
+	assert.Equal(t, 1720, stats.Histogram[1].Count)
+
+	require.Contains(t, stats.Regions, "eu-west")
+	p50, ok := stats.Regions["eu-west"].Percentile(50)
+	assert.True(t, ok)
+	assert.Equal(t, 61.0, p50)
+}
+
+func TestProbesService_GetResultStats_Validation(t *testing.T) {
+	client, err := NewClient(&Config{BaseURL: "http://127.0.0.1:0", Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.Probes.GetResultStats(ctx, "", StatsOptions{})
+	assert.EqualError(t, err, "probe UUID is required")
+
+	now := time.Now()
+	_, err = client.Probes.GetResultStats(ctx, "probe-1", StatsOptions{TimeRange: &QueryTimeRange{Start: now, End: now.Add(-time.Hour)}})
+	assert.ErrorContains(t, err, "is before start")
+
+	_, err = client.Probes.GetResultStats(ctx, "probe-1", StatsOptions{Percentiles: []float64{50, 101}})
+	assert.EqualError(t, err, "percentile 101 is not between 0 and 100")
+
+	_, err = client.Probes.GetResultStats(ctx, "probe-1", StatsOptions{Buckets: -1})
+	assert.Error(t, err)
+
+	assert.Empty(t, (&StatsOptions{}).ToQuery(), "defaults are left to the API")
+}
@@ -0,0 +1,218 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Probe result retention sources, reported in ProbeRetention.Source
+const (
+	ProbeRetentionSourceProbe         = "probe"          // Set on the probe with SetRetention
+	ProbeRetentionSourceHighFrequency = "high_frequency" // Organization default for high-frequency probes
+	ProbeRetentionSourceOrganization  = "organization"   // Organization default
+	ProbeRetentionSourcePlan          = "plan"           // Capped by the billing plan
+)
+
+// ProbeRetention describes how long a probe's results are kept. Raw results
+// are rolled up at RollupResolution once they are older than RawDays.
+type ProbeRetention struct {
+	ProbeUUID        string `json:"probe_uuid,omitempty"`
+	RawDays          int    `json:"raw_days"`
+	RollupDays       int    `json:"rollup_days"`
+	RollupResolution string `json:"rollup_resolution,omitempty"` // e.g. "5m", "1h"
+	Source           string `json:"source,omitempty"`            // Where RawDays comes from: probe, high_frequency, organization, plan
+	PlanMaxDays      int    `json:"plan_max_days,omitempty"`     // Longest retention the plan allows
+}
+
+// Overridden returns true if the probe has its own raw retention rather than
+// an organization default
+func (r *ProbeRetention) Overridden() bool {
+	return r.Source == ProbeRetentionSourceProbe
+}
+
+// ProbeRetentionDefaults holds the organization's default probe result
+// retention. Probes running at least every HighFrequencyIntervalSeconds use
+// HighFrequencyRawDays, so they can keep less raw data than slower probes.
+type ProbeRetentionDefaults struct {
+	RawDays                      int    `json:"raw_days"`
+	RollupDays                   int    `json:"rollup_days"`
+	RollupResolution             string `json:"rollup_resolution,omitempty"`
+	HighFrequencyIntervalSeconds int    `json:"high_frequency_interval_seconds,omitempty"` // 0 disables the high-frequency tier
+	HighFrequencyRawDays         int    `json:"high_frequency_raw_days,omitempty"`
+	PlanMaxDays                  int    `json:"plan_max_days,omitempty"` // Read only
+}
+
+// ProbeHistoryOptions selects the time range of ProbesService.ListHistory
+type ProbeHistoryOptions struct {
+	ListOptions
+	StartTime *time.Time `url:"start_time,omitempty"`
+	EndTime   *time.Time `url:"end_time,omitempty"`
+	Region    string     `url:"region,omitempty"`
+}
+
+// ToQuery converts ProbeHistoryOptions to query parameters
+func (o *ProbeHistoryOptions) ToQuery() map[string]string {
+	params := o.ListOptions.ToQuery()
+	if o.StartTime != nil {
+		params["start_time"] = o.StartTime.Format(time.RFC3339)
+	}
+	if o.EndTime != nil {
+		params["end_time"] = o.EndTime.Format(time.RFC3339)
+	}
+	if o.Region != "" {
+		params["region"] = o.Region
+	}
+	return params
+}
+
+// ProbeHistoryResult is a raw result or a rollup of results in a probe's history
+type ProbeHistoryResult struct {
+	ProbeResult
+	Resolution      string `json:"resolution"`                  // "raw" or the rollup resolution, e.g. "1h"
+	SampleCount     int    `json:"sample_count,omitempty"`      // Results in the rollup
+	SuccessCount    int    `json:"success_count,omitempty"`     // Successful results in the rollup
+	MinResponseTime int    `json:"min_response_time,omitempty"` // Rollups only; ResponseTime is the mean
+	MaxResponseTime int    `json:"max_response_time,omitempty"`
+}
+
+// IsRollup returns true if the result aggregates several results
+func (r *ProbeHistoryResult) IsRollup() bool {
+	return r.Resolution != "" && r.Resolution != "raw"
+}
+
+// ProbeHistory is a page of a probe's historical results with the retention
+// that shaped them
+type ProbeHistory struct {
+	Results   []*ProbeHistoryResult `json:"results"`
+	Retention *ProbeRetention       `json:"retention"`
+
+	// RawSince is the oldest time raw results are available for. Older
+	// results are rollups.
+	RawSince *CustomTime `json:"raw_since,omitempty"`
+
+	// AvailableSince is the oldest time any results are available for. Set
+	// when the requested range starts before it.
+	AvailableSince *CustomTime `json:"available_since,omitempty"`
+}
+
+// Truncated returns true if the requested range reached past retention, so
+// the oldest results have already been deleted
+func (h *ProbeHistory) Truncated() bool {
+	return h.AvailableSince != nil
+}
+
+// GetRetention retrieves the retention that applies to a probe's results
+func (s *ProbesService) GetRetention(ctx context.Context, uuid string) (*ProbeRetention, error) {
+	var resp StandardResponse
+	resp.Data = &ProbeRetention{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v2/probes/%s/retention", uuid),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if retention, ok := resp.Data.(*ProbeRetention); ok {
+		return retention, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// SetRetention sets how many days of raw results a probe keeps. Older results
+// are kept as rollups for the organization's rollup retention. Zero removes
+// the override so the organization default applies again. The API caps the
+// value at the plan's maximum; the returned retention shows what applies.
+func (s *ProbesService) SetRetention(ctx context.Context, uuid string, days int) (*ProbeRetention, error) {
+	if days < 0 {
+		return nil, fmt.Errorf("retention days must not be negative")
+	}
+
+	var resp StandardResponse
+	resp.Data = &ProbeRetention{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v2/probes/%s/retention", uuid),
+		Body:   map[string]int{"raw_days": days},
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if retention, ok := resp.Data.(*ProbeRetention); ok {
+		return retention, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// GetRetentionDefaults retrieves the organization's default probe result retention
+func (s *ProbesService) GetRetentionDefaults(ctx context.Context) (*ProbeRetentionDefaults, error) {
+	var resp StandardResponse
+	resp.Data = &ProbeRetentionDefaults{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   "/v2/probes/retention-defaults",
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if defaults, ok := resp.Data.(*ProbeRetentionDefaults); ok {
+		return defaults, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// SetRetentionDefaults updates the organization's default probe result
+// retention. Probes with their own retention are not affected.
+func (s *ProbesService) SetRetentionDefaults(ctx context.Context, defaults *ProbeRetentionDefaults) (*ProbeRetentionDefaults, error) {
+	var resp StandardResponse
+	resp.Data = &ProbeRetentionDefaults{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   "/v2/probes/retention-defaults",
+		Body:   defaults,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if updated, ok := resp.Data.(*ProbeRetentionDefaults); ok {
+		return updated, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// ListHistory retrieves a probe's historical results, newest first. Results
+// older than the raw retention are returned as rollups, and the retention
+// that applies is included with the results.
+func (s *ProbesService) ListHistory(ctx context.Context, uuid string, opts *ProbeHistoryOptions) (*ProbeHistory, *PaginationMeta, error) {
+	var resp PaginatedResponse
+	history := &ProbeHistory{}
+	resp.Data = history
+
+	req := &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v2/probes/%s/history", uuid),
+		Result: &resp,
+	}
+	if opts != nil {
+		req.Query = opts.ToQuery()
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return history, resp.Meta, nil
+}
@@ -0,0 +1,98 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbesService_Retention(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/probes/probe-1/retention":
+			w.Write([]byte(`{"status":"success","data":{"probe_uuid":"probe-1","raw_days":7,"rollup_days":365,"rollup_resolution":"1h","source":"high_frequency"}}`))
+		case "PUT /v2/probes/probe-1/retention":
+			var body map[string]int
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, 3, body["raw_days"])
+			w.Write([]byte(`{"status":"success","data":{"probe_uuid":"probe-1","raw_days":3,"rollup_days":365,"rollup_resolution":"1h","source":"probe"}}`))
+		case "GET /v2/probes/retention-defaults":
+			w.Write([]byte(`{"status":"success","data":{"raw_days":30,"rollup_days":365,"rollup_resolution":"1h","high_frequency_interval_seconds":60,"high_frequency_raw_days":7,"plan_max_days":395}}`))
+		case "PUT /v2/probes/retention-defaults":
+			var defaults ProbeRetentionDefaults
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&defaults))
+			assert.Equal(t, 3, defaults.HighFrequencyRawDays)
+			json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "data": defaults})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	retention, err := client.Probes.GetRetention(ctx, "probe-1")
+	require.NoError(t, err)
+	assert.Equal(t, 7, retention.RawDays)
+	assert.False(t, retention.Overridden())
+
+	retention, err = client.Probes.SetRetention(ctx, "probe-1", 3)
+	require.NoError(t, err)
+	assert.True(t, retention.Overridden())
+
+	_, err = client.Probes.SetRetention(ctx, "probe-1", -1)
+	assert.Error(t, err)
+
+	defaults, err := client.Probes.GetRetentionDefaults(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 60, defaults.HighFrequencyIntervalSeconds)
+
+	defaults.HighFrequencyRawDays = 3
+	defaults, err = client.Probes.SetRetentionDefaults(ctx, defaults)
+	require.NoError(t, err)
+	assert.Equal(t, 3, defaults.HighFrequencyRawDays)
+}
+
+func TestProbesService_ListHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/probes/probe-1/history", r.URL.Path)
+		assert.Equal(t, "2026-01-01T00:00:00Z", r.URL.Query().Get("start_time"))
+		assert.Equal(t, "us-east-1", r.URL.Query().Get("region"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{
+			"results":[
+				{"probe_uuid":"probe-1","region":"us-east-1","status":"success","response_time":120,"resolution":"raw"},
+				{"probe_uuid":"probe-1","region":"us-east-1","status":"success","response_time":140,"resolution":"1h","sample_count":60,"success_count":59,"min_response_time":90,"max_response_time":900}
+			],
+			"retention":{"raw_days":7,"rollup_days":30,"rollup_resolution":"1h","source":"organization"},
+			"raw_since":"2026-03-01T00:00:00Z",
+			"available_since":"2026-02-06T00:00:00Z"
+		},"meta":{"page":1,"limit":50,"total_items":2,"total_pages":1}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	history, meta, err := client.Probes.ListHistory(context.Background(), "probe-1", &ProbeHistoryOptions{StartTime: &start, Region: "us-east-1"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, meta.TotalItems)
+	require.Len(t, history.Results, 2)
+	assert.False(t, history.Results[0].IsRollup())
+	assert.True(t, history.Results[1].IsRollup())
+	assert.Equal(t, 140, history.Results[1].ResponseTime)
+	assert.Equal(t, 60, history.Results[1].SampleCount)
+	assert.Equal(t, ProbeRetentionSourceOrganization, history.Retention.Source)
+	assert.True(t, history.Truncated())
+}
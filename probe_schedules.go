@@ -0,0 +1,185 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Reasons reported by ProbeEffectiveState
+const (
+	ProbeStateReasonActive         = "active"
+	ProbeStateReasonDisabled       = "disabled"        // The probe itself is disabled
+	ProbeStateReasonScheduledPause = "scheduled_pause" // Inside a ScheduleRule pause window
+)
+
+// ScheduleRule is a recurring window during which a probe is paused, for
+// example during nightly maintenance. Each occurrence starts at a time given
+// by either Cron or RRule, evaluated in Timezone, and lasts DurationMinutes;
+// the probe resumes automatically when it ends.
+type ScheduleRule struct {
+	ID              uint       `json:"id,omitempty"`
+	ProbeUUID       string     `json:"probe_uuid,omitempty"`
+	Name            string     `json:"name"`
+	Cron            string     `json:"cron,omitempty"`     // Five-field cron expression, e.g. "0 2 * * *" for 02:00 daily
+	RRule           string     `json:"rrule,omitempty"`    // RFC 5545 recurrence rule, e.g. "FREQ=WEEKLY;BYDAY=SU;BYHOUR=3;BYMINUTE=0"
+	DurationMinutes int        `json:"duration_minutes"`   // Length of each pause window
+	Timezone        string     `json:"timezone,omitempty"` // IANA time zone, e.g. "Europe/Berlin"; default UTC
+	StartsAt        *time.Time `json:"starts_at,omitempty"`
+	EndsAt          *time.Time `json:"ends_at,omitempty"` // No windows start after this time
+	Enabled         bool       `json:"enabled"`
+	NextPauseAt     *time.Time `json:"next_pause_at,omitempty"` // Start of the next window, set by the API
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+// Validate checks the rule locally
+func (r *ScheduleRule) Validate() error {
+	switch {
+	case r.Cron == "" && r.RRule == "":
+		return fmt.Errorf("either cron or rrule is required")
+	case r.Cron != "" && r.RRule != "":
+		return fmt.Errorf("cron and rrule are mutually exclusive")
+	case r.Cron != "" && len(strings.Fields(r.Cron)) != 5:
+		return fmt.Errorf("cron expression %q must have five fields", r.Cron)
+	case r.RRule != "" && !strings.Contains(strings.ToUpper(r.RRule), "FREQ="):
+		return fmt.Errorf("rrule %q must contain FREQ", r.RRule)
+	}
+	if r.DurationMinutes <= 0 {
+		return fmt.Errorf("duration_minutes must be positive")
+	}
+	if r.Timezone != "" {
+		if _, err := time.LoadLocation(r.Timezone); err != nil {
+			return fmt.Errorf("invalid timezone %q: %w", r.Timezone, err)
+		}
+	}
+	if r.StartsAt != nil && r.EndsAt != nil && !r.EndsAt.After(*r.StartsAt) {
+		return fmt.Errorf("ends_at must be after starts_at")
+	}
+	return nil
+}
+
+// ProbeEffectiveState reports whether a probe runs at a given time once its
+// enabled flag and pause schedules are taken into account
+type ProbeEffectiveState struct {
+	ProbeUUID   string     `json:"probe_uuid"`
+	At          time.Time  `json:"at"`
+	Running     bool       `json:"running"`
+	Reason      string     `json:"reason"`                 // ProbeStateReason* constant
+	ScheduleID  *uint      `json:"schedule_id,omitempty"`  // Rule whose window pauses the probe
+	PausedUntil *time.Time `json:"paused_until,omitempty"` // End of the current pause window
+	NextPauseAt *time.Time `json:"next_pause_at,omitempty"`
+}
+
+// CreateSchedule adds a recurring pause window to a probe
+func (s *ProbesService) CreateSchedule(ctx context.Context, probeUUID string, rule *ScheduleRule) (*ScheduleRule, error) {
+	if rule == nil {
+		return nil, fmt.Errorf("schedule rule is required")
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp StandardResponse
+	resp.Data = &ScheduleRule{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v2/probes/%s/schedules", probeUUID),
+		Body:   rule,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if created, ok := resp.Data.(*ScheduleRule); ok {
+		return created, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// ListSchedules lists a probe's pause schedules
+func (s *ProbesService) ListSchedules(ctx context.Context, probeUUID string) ([]*ScheduleRule, error) {
+	var resp StandardResponse
+	var rules []*ScheduleRule
+	resp.Data = &rules
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v2/probes/%s/schedules", probeUUID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// UpdateSchedule replaces a probe's pause schedule
+func (s *ProbesService) UpdateSchedule(ctx context.Context, probeUUID string, scheduleID uint, rule *ScheduleRule) (*ScheduleRule, error) {
+	if rule == nil {
+		return nil, fmt.Errorf("schedule rule is required")
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp StandardResponse
+	resp.Data = &ScheduleRule{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v2/probes/%s/schedules/%d", probeUUID, scheduleID),
+		Body:   rule,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if updated, ok := resp.Data.(*ScheduleRule); ok {
+		return updated, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// DeleteSchedule removes a probe's pause schedule. A probe paused by the
+// schedule's current window resumes immediately.
+func (s *ProbesService) DeleteSchedule(ctx context.Context, probeUUID string, scheduleID uint) error {
+	_, err := s.client.Do(ctx, &Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v2/probes/%s/schedules/%d", probeUUID, scheduleID),
+	})
+	return err
+}
+
+// GetEffectiveState reports whether a probe will run at a given time, taking
+// its enabled flag and pause schedules into account. A zero at checks the
+// current time.
+func (s *ProbesService) GetEffectiveState(ctx context.Context, probeUUID string, at time.Time) (*ProbeEffectiveState, error) {
+	query := make(map[string]string)
+	if !at.IsZero() {
+		query["at"] = at.Format(time.RFC3339)
+	}
+
+	var resp StandardResponse
+	resp.Data = &ProbeEffectiveState{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v2/probes/%s/effective-state", probeUUID),
+		Query:  query,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if state, ok := resp.Data.(*ProbeEffectiveState); ok {
+		return state, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
@@ -0,0 +1,93 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleRuleValidate(t *testing.T) {
+	assert.NoError(t, (&ScheduleRule{Name: "nightly", Cron: "0 2 * * *", DurationMinutes: 60, Timezone: "UTC"}).Validate())
+	assert.NoError(t, (&ScheduleRule{Name: "sunday", RRule: "FREQ=WEEKLY;BYDAY=SU;BYHOUR=3", DurationMinutes: 120}).Validate())
+
+	starts := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	for name, rule := range map[string]*ScheduleRule{
+		"no recurrence":   {DurationMinutes: 60},
+		"both":            {Cron: "0 2 * * *", RRule: "FREQ=DAILY", DurationMinutes: 60},
+		"short cron":      {Cron: "0 2 * *", DurationMinutes: 60},
+		"rrule sans freq": {RRule: "BYDAY=SU", DurationMinutes: 60},
+		"no duration":     {Cron: "0 2 * * *"},
+		"bad timezone":    {Cron: "0 2 * * *", DurationMinutes: 60, Timezone: "Mars/Olympus_Mons"},
+		"ends first":      {Cron: "0 2 * * *", DurationMinutes: 60, StartsAt: &starts, EndsAt: &starts},
+	} {
+		assert.Error(t, rule.Validate(), name)
+	}
+}
+
+func TestProbesService_Schedules(t *testing.T) {
+	var requests []string
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v2/probes/probe-1/schedules", "PUT /v2/probes/probe-1/schedules/3":
+			var rule ScheduleRule
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&rule))
+			rule.ID, rule.ProbeUUID = 3, "probe-1"
+			json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "data": rule})
+		case "GET /v2/probes/probe-1/schedules":
+			w.Write([]byte(`{"status":"success","data":[{"id":3,"name":"nightly","cron":"0 2 * * *","duration_minutes":60,"enabled":true,"next_pause_at":"2026-10-16T02:00:00Z"}]}`))
+		case "DELETE /v2/probes/probe-1/schedules/3":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /v2/probes/probe-1/effective-state":
+			query = r.URL.RawQuery
+			w.Write([]byte(`{"status":"success","data":{"probe_uuid":"probe-1","at":"2026-10-16T02:30:00Z","running":false,"reason":"scheduled_pause","schedule_id":3,"paused_until":"2026-10-16T03:00:00Z"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	rule := &ScheduleRule{Name: "nightly", Cron: "0 2 * * *", DurationMinutes: 60, Timezone: "UTC", Enabled: true}
+	created, err := client.Probes.CreateSchedule(ctx, "probe-1", rule)
+	require.NoError(t, err)
+	assert.Equal(t, uint(3), created.ID)
+	assert.Equal(t, "0 2 * * *", created.Cron)
+
+	rules, err := client.Probes.ListSchedules(ctx, "probe-1")
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	require.NotNil(t, rules[0].NextPauseAt)
+	assert.Equal(t, 2, rules[0].NextPauseAt.Hour())
+
+	rule.DurationMinutes = 90
+	updated, err := client.Probes.UpdateSchedule(ctx, "probe-1", 3, rule)
+	require.NoError(t, err)
+	assert.Equal(t, 90, updated.DurationMinutes)
+
+	state, err := client.Probes.GetEffectiveState(ctx, "probe-1", time.Date(2026, 10, 16, 2, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, "at=2026-10-16T02%3A30%3A00Z", query)
+	assert.False(t, state.Running)
+	assert.Equal(t, ProbeStateReasonScheduledPause, state.Reason)
+	require.NotNil(t, state.ScheduleID)
+	assert.Equal(t, uint(3), *state.ScheduleID)
+
+	require.NoError(t, client.Probes.DeleteSchedule(ctx, "probe-1", 3))
+
+	_, err = client.Probes.CreateSchedule(ctx, "probe-1", &ScheduleRule{Name: "broken", Cron: "nightly", DurationMinutes: 60})
+	assert.Error(t, err)
+	assert.Len(t, requests, 5, "invalid rules are rejected locally")
+}
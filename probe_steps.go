@@ -0,0 +1,172 @@
+package nexmonyx
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Sources a ProbeStepExtract or ProbeStepAssertion reads from
+const (
+	ProbeStepSourceStatus       = "status"        // HTTP status code
+	ProbeStepSourceHeader       = "header"        // Response header named by Path
+	ProbeStepSourceJSON         = "json"          // JSON body field at Path, e.g. "data.items.0.id"
+	ProbeStepSourceBody         = "body"          // Raw body; for extraction Path is a regexp with one capture group
+	ProbeStepSourceResponseTime = "response_time" // Step duration in milliseconds, for assertions
+)
+
+// Assertion operators. Numeric operators compare values as numbers.
+const (
+	ProbeAssertEquals      = "equals"
+	ProbeAssertNotEquals   = "not_equals"
+	ProbeAssertContains    = "contains"
+	ProbeAssertMatches     = "matches" // Value is a regexp
+	ProbeAssertExists      = "exists"
+	ProbeAssertLessThan    = "less_than"
+	ProbeAssertGreaterThan = "greater_than"
+)
+
+// ProbeStep is one HTTP request in a scripted (multi-step) probe, such as a
+// login flow. Steps run in order and share a cookie jar. Variables extracted
+// by earlier steps are substituted into later steps' URL, headers, and body
+// with {{name}}.
+type ProbeStep struct {
+	Name            string               `json:"name"`
+	Method          string               `json:"method,omitempty"` // Default GET
+	URL             string               `json:"url"`
+	Headers         map[string]string    `json:"headers,omitempty"`
+	Body            string               `json:"body,omitempty"`
+	FollowRedirects *bool                `json:"follow_redirects,omitempty"` // Default true
+	Timeout         int                  `json:"timeout,omitempty"`          // Seconds; default is the probe timeout
+	Extract         []ProbeStepExtract   `json:"extract,omitempty"`
+	Assertions      []ProbeStepAssertion `json:"assertions,omitempty"`
+}
+
+// ProbeStepExtract stores a value from a step's response in a variable
+type ProbeStepExtract struct {
+	Name   string `json:"name"`   // Variable name, referenced as {{name}}
+	Source string `json:"source"` // ProbeStepSource* constant other than response_time
+	Path   string `json:"path,omitempty"`
+}
+
+// ProbeStepAssertion checks a value from a step's response. A step fails on
+// its first failed assertion and later steps do not run.
+type ProbeStepAssertion struct {
+	Source   string `json:"source"` // ProbeStepSource* constant
+	Path     string `json:"path,omitempty"`
+	Operator string `json:"operator"` // ProbeAssert* constant
+	Value    string `json:"value,omitempty"`
+}
+
+var (
+	probeVariableName      = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	probeVariableReference = regexp.MustCompile(`\{\{\s*([^{}]*?)\s*\}\}`)
+)
+
+// ProbeStepVariables returns the names of the variables referenced with
+// {{name}} in s
+func ProbeStepVariables(s string) []string {
+	var names []string
+	for _, match := range probeVariableReference.FindAllStringSubmatch(s, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// ValidateProbeSteps checks scripted probe steps locally, including that
+// every variable is extracted by an earlier step or is one of predefined,
+// such as credentials supplied by the executor
+func ValidateProbeSteps(steps []ProbeStep, predefined ...string) []ValidationIssue {
+	var v validationIssues
+	defined := make(map[string]bool)
+	for _, name := range predefined {
+		defined[name] = true
+	}
+
+	for i, step := range steps {
+		field := fmt.Sprintf("steps[%d]", i)
+
+		switch strings.ToUpper(step.Method) {
+		case "", "GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS":
+		default:
+			v.addError(field+".method", "is not a valid HTTP method")
+		}
+		if step.URL == "" {
+			v.addError(field+".url", "is required")
+		} else if u, err := url.Parse(probeVariableReference.ReplaceAllString(step.URL, "x")); err != nil ||
+			(u.Scheme != "http" && u.Scheme != "https" && !strings.HasPrefix(step.URL, "{{")) {
+			v.addError(field+".url", "must be an http or https URL")
+		}
+		if step.Timeout < 0 {
+			v.addError(field+".timeout", "must not be negative")
+		}
+
+		references := ProbeStepVariables(step.URL + step.Body)
+		headers := make([]string, 0, len(step.Headers))
+		for name := range step.Headers {
+			headers = append(headers, name)
+		}
+		sort.Strings(headers)
+		for _, name := range headers {
+			references = append(references, ProbeStepVariables(name+step.Headers[name])...)
+		}
+		for _, name := range references {
+			if !defined[name] {
+				v.addError(field, "uses {{%s}} before a step extracts it", name)
+			}
+		}
+
+		for j, extract := range step.Extract {
+			extractField := fmt.Sprintf("%s.extract[%d]", field, j)
+			if !probeVariableName.MatchString(extract.Name) {
+				v.addError(extractField+".name", "must be a letter or underscore followed by letters, digits, or underscores")
+			}
+			switch extract.Source {
+			case ProbeStepSourceStatus:
+			case ProbeStepSourceHeader, ProbeStepSourceJSON:
+				if extract.Path == "" {
+					v.addError(extractField+".path", "is required for %s", extract.Source)
+				}
+			case ProbeStepSourceBody:
+				if re, err := regexp.Compile(extract.Path); err != nil || re.NumSubexp() != 1 {
+					v.addError(extractField+".path", "must be a regexp with one capture group")
+				}
+			default:
+				v.addError(extractField+".source", "must be one of: status, header, json, body")
+			}
+			defined[extract.Name] = true
+		}
+
+		for j, assertion := range step.Assertions {
+			assertionField := fmt.Sprintf("%s.assertions[%d]", field, j)
+			switch assertion.Source {
+			case ProbeStepSourceStatus, ProbeStepSourceBody, ProbeStepSourceResponseTime:
+			case ProbeStepSourceHeader, ProbeStepSourceJSON:
+				if assertion.Path == "" {
+					v.addError(assertionField+".path", "is required for %s", assertion.Source)
+				}
+			default:
+				v.addError(assertionField+".source", "must be one of: status, header, json, body, response_time")
+			}
+			switch assertion.Operator {
+			case ProbeAssertEquals, ProbeAssertNotEquals, ProbeAssertContains, ProbeAssertExists,
+				ProbeAssertLessThan, ProbeAssertGreaterThan:
+			case ProbeAssertMatches:
+				if _, err := regexp.Compile(assertion.Value); err != nil {
+					v.addError(assertionField+".value", "is not a valid regexp")
+				}
+			default:
+				v.addError(assertionField+".operator", "must be one of: equals, not_equals, contains, matches, exists, less_than, greater_than")
+			}
+		}
+	}
+	return v
+}
+
+// Validate checks the configuration locally. Only scripted probe steps are
+// checked.
+func (c *ProbeConfig) Validate() []ValidationIssue {
+	return ValidateProbeSteps(c.Steps)
+}
@@ -0,0 +1,78 @@
+package nexmonyx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbeStepVariables(t *testing.T) {
+	assert.Equal(t, []string{"host", "token"}, ProbeStepVariables("https://{{host}}/api?t={{ token }}"))
+	assert.Nil(t, ProbeStepVariables("https://example.com"))
+}
+
+func TestValidateProbeSteps(t *testing.T) {
+	valid := []ProbeStep{
+		{
+			Name:    "login",
+			Method:  "post",
+			URL:     "https://example.com/login",
+			Body:    `{"password":"{{password}}"}`,
+			Extract: []ProbeStepExtract{{Name: "token", Source: ProbeStepSourceJSON, Path: "data.token"}},
+		},
+		{
+			URL:        "https://example.com/me",
+			Headers:    map[string]string{"Authorization": "Bearer {{token}}"},
+			Assertions: []ProbeStepAssertion{{Source: ProbeStepSourceBody, Operator: ProbeAssertMatches, Value: `"id":\d+`}},
+		},
+	}
+	assert.Empty(t, ValidateProbeSteps(valid, "password"))
+
+	issues := ValidateProbeSteps(valid)
+	assert.True(t, HasValidationErrors(issues))
+	assert.Equal(t, "steps[0]", issues[0].Field)
+
+	tests := []struct {
+		name  string
+		step  ProbeStep
+		field string
+	}{
+		{"method", ProbeStep{Method: "FETCH", URL: "https://example.com"}, "steps[0].method"},
+		{"missing url", ProbeStep{}, "steps[0].url"},
+		{"scheme", ProbeStep{URL: "ftp://example.com"}, "steps[0].url"},
+		{"timeout", ProbeStep{URL: "https://example.com", Timeout: -1}, "steps[0].timeout"},
+		{"extract name", ProbeStep{URL: "https://example.com", Extract: []ProbeStepExtract{{Name: "1x", Source: ProbeStepSourceStatus}}}, "steps[0].extract[0].name"},
+		{"extract path", ProbeStep{URL: "https://example.com", Extract: []ProbeStepExtract{{Name: "x", Source: ProbeStepSourceHeader}}}, "steps[0].extract[0].path"},
+		{"extract regexp", ProbeStep{URL: "https://example.com", Extract: []ProbeStepExtract{{Name: "x", Source: ProbeStepSourceBody, Path: "no group"}}}, "steps[0].extract[0].path"},
+		{"extract source", ProbeStep{URL: "https://example.com", Extract: []ProbeStepExtract{{Name: "x", Source: ProbeStepSourceResponseTime}}}, "steps[0].extract[0].source"},
+		{"assertion source", ProbeStep{URL: "https://example.com", Assertions: []ProbeStepAssertion{{Source: "cookie", Operator: ProbeAssertExists}}}, "steps[0].assertions[0].source"},
+		{"assertion operator", ProbeStep{URL: "https://example.com", Assertions: []ProbeStepAssertion{{Source: ProbeStepSourceStatus, Operator: "is"}}}, "steps[0].assertions[0].operator"},
+		{"assertion regexp", ProbeStep{URL: "https://example.com", Assertions: []ProbeStepAssertion{{Source: ProbeStepSourceBody, Operator: ProbeAssertMatches, Value: "("}}}, "steps[0].assertions[0].value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := ValidateProbeSteps([]ProbeStep{tt.step})
+			if assert.Len(t, issues, 1) {
+				assert.Equal(t, tt.field, issues[0].Field)
+			}
+		})
+	}
+}
+
+func TestProbeCreateRequest_Validate_Steps(t *testing.T) {
+	req := &ProbeCreateRequest{
+		Name:     "checkout",
+		Type:     "http",
+		Target:   "https://shop.example.com",
+		Interval: 60,
+		Timeout:  10,
+		Configuration: map[string]interface{}{
+			"steps": []ProbeStep{{URL: "https://shop.example.com/cart/{{cart}}"}},
+		},
+	}
+	var fields []string
+	for _, issue := range req.Validate() {
+		fields = append(fields, issue.Field)
+	}
+	assert.Contains(t, fields, "configuration.steps[0]")
+}
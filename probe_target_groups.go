@@ -0,0 +1,165 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ProbeTargetGroupsService handles groups of targets that a single probe checks
+type ProbeTargetGroupsService struct {
+	client *Client
+}
+
+// ProbeTargetGroup is a set of endpoints checked by one logical probe. A probe
+// created with ProbeCreateRequest.TargetGroupID runs against every target in
+// the group and reports one result per target.
+type ProbeTargetGroup struct {
+	ID             uint          `json:"id,omitempty"`
+	OrganizationID uint          `json:"organization_id,omitempty"`
+	Name           string        `json:"name"`
+	Description    string        `json:"description,omitempty"`
+	Targets        []ProbeTarget `json:"targets"`
+	ProbeCount     int           `json:"probe_count,omitempty"` // Probes bound to the group, set by the API
+	CreatedAt      *time.Time    `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time    `json:"updated_at,omitempty"`
+}
+
+// ProbeTarget is one endpoint in a target group
+type ProbeTarget struct {
+	Target string            `json:"target"`           // URL, host, or host:port, as for a single-target probe
+	Name   string            `json:"name,omitempty"`   // Display name, e.g. "api-eu-1"
+	Labels map[string]string `json:"labels,omitempty"` // e.g. {"region": "eu", "tier": "edge"}
+}
+
+// Validate checks the group locally
+func (g *ProbeTargetGroup) Validate() error {
+	if g.Name == "" {
+		return fmt.Errorf("target group name is required")
+	}
+	if len(g.Targets) == 0 {
+		return fmt.Errorf("at least one target is required")
+	}
+	seen := make(map[string]bool, len(g.Targets))
+	for i, target := range g.Targets {
+		if target.Target == "" {
+			return fmt.Errorf("targets[%d]: target is required", i)
+		}
+		if seen[target.Target] {
+			return fmt.Errorf("targets[%d]: duplicate target %q", i, target.Target)
+		}
+		seen[target.Target] = true
+	}
+	return nil
+}
+
+// Create creates a probe target group
+func (s *ProbeTargetGroupsService) Create(ctx context.Context, group *ProbeTargetGroup) (*ProbeTargetGroup, error) {
+	if group == nil {
+		return nil, fmt.Errorf("target group is required")
+	}
+	if err := group.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp StandardResponse
+	resp.Data = &ProbeTargetGroup{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v2/probes/target-groups",
+		Body:   group,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if created, ok := resp.Data.(*ProbeTargetGroup); ok {
+		return created, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Get retrieves a probe target group by ID
+func (s *ProbeTargetGroupsService) Get(ctx context.Context, groupID uint) (*ProbeTargetGroup, error) {
+	var resp StandardResponse
+	resp.Data = &ProbeTargetGroup{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v2/probes/target-groups/%d", groupID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if group, ok := resp.Data.(*ProbeTargetGroup); ok {
+		return group, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// List retrieves the organization's probe target groups
+func (s *ProbeTargetGroupsService) List(ctx context.Context, opts *ListOptions) ([]*ProbeTargetGroup, *PaginationMeta, error) {
+	var resp PaginatedResponse
+	var groups []*ProbeTargetGroup
+	resp.Data = &groups
+
+	req := &Request{
+		Method: "GET",
+		Path:   "/v2/probes/target-groups",
+		Result: &resp,
+	}
+
+	if opts != nil {
+		req.Query = opts.ToQuery()
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return groups, resp.Meta, nil
+}
+
+// Update replaces a probe target group. Probes bound to the group pick up the
+// new targets on their next run.
+func (s *ProbeTargetGroupsService) Update(ctx context.Context, groupID uint, group *ProbeTargetGroup) (*ProbeTargetGroup, error) {
+	if group == nil {
+		return nil, fmt.Errorf("target group is required")
+	}
+	if err := group.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp StandardResponse
+	resp.Data = &ProbeTargetGroup{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v2/probes/target-groups/%d", groupID),
+		Body:   group,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if updated, ok := resp.Data.(*ProbeTargetGroup); ok {
+		return updated, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Delete deletes a probe target group. The API rejects deleting a group that
+// probes are still bound to.
+func (s *ProbeTargetGroupsService) Delete(ctx context.Context, groupID uint) error {
+	_, err := s.client.Do(ctx, &Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v2/probes/target-groups/%d", groupID),
+	})
+	return err
+}
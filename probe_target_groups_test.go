@@ -0,0 +1,123 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbeTargetGroupValidate(t *testing.T) {
+	assert.NoError(t, (&ProbeTargetGroup{Name: "edge", Targets: []ProbeTarget{{Target: "https://a.example.com"}, {Target: "https://b.example.com"}}}).Validate())
+
+	for name, group := range map[string]*ProbeTargetGroup{
+		"no name":      {Targets: []ProbeTarget{{Target: "https://a.example.com"}}},
+		"no targets":   {Name: "edge"},
+		"empty target": {Name: "edge", Targets: []ProbeTarget{{Name: "a"}}},
+		"duplicate":    {Name: "edge", Targets: []ProbeTarget{{Target: "https://a.example.com"}, {Target: "https://a.example.com"}}},
+	} {
+		assert.Error(t, group.Validate(), name)
+	}
+}
+
+func TestProbeTargetGroupsService(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v2/probes/target-groups", "PUT /v2/probes/target-groups/4":
+			var group ProbeTargetGroup
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&group))
+			group.ID = 4
+			json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "data": group})
+		case "GET /v2/probes/target-groups":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data":   []ProbeTargetGroup{{ID: 4, Name: "edge"}},
+				"meta":   PaginationMeta{Page: 1, TotalPages: 1},
+			})
+		case "GET /v2/probes/target-groups/4":
+			w.Write([]byte(`{"status":"success","data":{"id":4,"name":"edge","probe_count":1,"targets":[{"target":"https://edge-1.example.com","labels":{"region":"eu"}}]}}`))
+		case "DELETE /v2/probes/target-groups/4":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	group := &ProbeTargetGroup{Name: "edge"}
+	for i := 1; i <= 50; i++ {
+		group.Targets = append(group.Targets, ProbeTarget{Target: fmt.Sprintf("https://edge-%d.example.com", i)})
+	}
+	created, err := client.ProbeTargetGroups.Create(ctx, group)
+	require.NoError(t, err)
+	assert.Equal(t, uint(4), created.ID)
+	assert.Len(t, created.Targets, 50)
+
+	groups, _, err := client.ProbeTargetGroups.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, groups, 1)
+
+	fetched, err := client.ProbeTargetGroups.Get(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 1, fetched.ProbeCount)
+	assert.Equal(t, "eu", fetched.Targets[0].Labels["region"])
+
+	group.Targets = group.Targets[:10]
+	updated, err := client.ProbeTargetGroups.Update(ctx, 4, group)
+	require.NoError(t, err)
+	assert.Len(t, updated.Targets, 10)
+
+	require.NoError(t, client.ProbeTargetGroups.Delete(ctx, 4))
+
+	_, err = client.ProbeTargetGroups.Create(ctx, &ProbeTargetGroup{Name: "empty"})
+	assert.Error(t, err)
+	assert.Len(t, requests, 5, "invalid groups are rejected locally")
+}
+
+func TestProbesService_TargetGroupProbes(t *testing.T) {
+	var body map[string]interface{}
+	var query map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v1/probes":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			w.Write([]byte(`{"status":"success","data":{"probe":{"uuid":"probe-1","name":"edge","target_group_id":4}}}`))
+		case "GET /v1/monitoring/probe-results":
+			query = map[string]string{"probe_uuid": r.URL.Query().Get("probe_uuid"), "target": r.URL.Query().Get("target")}
+			w.Write([]byte(`{"status":"success","data":[{"probe_uuid":"probe-1","target":"https://edge-7.example.com","status":"failed"}],"meta":{"page":1,"total_pages":1}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	probe, err := client.Probes.Create(ctx, &ProbeCreateRequest{Name: "edge", Type: "https", Interval: 60, RegionCode: "eu-west", Enabled: true, TargetGroupID: 4})
+	require.NoError(t, err)
+	assert.Equal(t, float64(4), body["target_group_id"])
+	require.NotNil(t, probe.TargetGroupID)
+	assert.Equal(t, uint(4), *probe.TargetGroupID)
+
+	results, _, err := client.Probes.ListResults(ctx, "probe-1", &ProbeResultListOptions{Target: "https://edge-7.example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"probe_uuid": "probe-1", "target": "https://edge-7.example.com"}, query)
+	require.Len(t, results, 1)
+	assert.Equal(t, "https://edge-7.example.com", results[0].Target)
+}
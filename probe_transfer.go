@@ -0,0 +1,151 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+)
+
+// ProbeTransferOptions controls what moves with a probe to another organization
+type ProbeTransferOptions struct {
+	// MigrateHistory moves the probe's stored results to the target
+	// organization. Otherwise the history stays with the source organization
+	// and expires under its retention.
+	MigrateHistory bool
+
+	// CopyTargetGroup copies the probe's ProbeTargetGroup into the target
+	// organization. Transferring a probe that checks a target group fails
+	// without it, since groups belong to an organization.
+	CopyTargetGroup bool
+
+	// DryRun reports what would be moved without changing anything
+	DryRun bool
+}
+
+// ProbeTransferReport describes what was moved by a transfer. Alert channels
+// belong to the source organization, so they are detached from the probe and
+// listed in DetachedAlertChannels.
+type ProbeTransferReport struct {
+	ProbeUUID             string      `json:"probe_uuid"`
+	SourceOrganizationID  uint        `json:"source_organization_id"`
+	TargetOrganizationID  uint        `json:"target_organization_id"`
+	DryRun                bool        `json:"dry_run"`
+	HistoryMigrated       bool        `json:"history_migrated"`
+	ResultsMigrated       int64       `json:"results_migrated"`    // Stored results moved with the probe
+	ResultsLeftBehind     int64       `json:"results_left_behind"` // Stored results kept by the source organization
+	SchedulesMoved        int         `json:"schedules_moved"`
+	TargetGroupID         *uint       `json:"target_group_id,omitempty"` // Copy of the probe's target group in the target organization
+	DetachedAlertChannels []string    `json:"detached_alert_channels,omitempty"`
+	TransferredAt         *CustomTime `json:"transferred_at,omitempty"` // Unset for dry runs
+}
+
+// ProbeBulkTransferResult holds the reports of a TransferMany call, along with
+// the error for every probe that could not be transferred
+type ProbeBulkTransferResult struct {
+	Reports []*ProbeTransferReport
+	Errors  map[string]error
+}
+
+// Failed reports whether any probe could not be transferred
+func (r *ProbeBulkTransferResult) Failed() bool {
+	return len(r.Errors) > 0
+}
+
+// probeTransferBody builds the request body shared by Transfer and TransferMany
+func probeTransferBody(targetOrgID uint, opts *ProbeTransferOptions) (map[string]interface{}, error) {
+	if targetOrgID == 0 {
+		return nil, fmt.Errorf("target organization ID is required")
+	}
+	if opts == nil {
+		opts = &ProbeTransferOptions{}
+	}
+	return map[string]interface{}{
+		"target_organization_id": targetOrgID,
+		"migrate_history":        opts.MigrateHistory,
+		"copy_target_group":      opts.CopyTargetGroup,
+		"dry_run":                opts.DryRun,
+	}, nil
+}
+
+// Transfer moves a probe to another organization, keeping its UUID,
+// schedules and retention settings. The caller needs access to both
+// organizations.
+// Authentication: JWT Token required
+// Endpoint: POST /v2/probes/{uuid}/transfer
+func (s *ProbesService) Transfer(ctx context.Context, probeUUID string, targetOrgID uint, opts *ProbeTransferOptions) (*ProbeTransferReport, error) {
+	body, err := probeTransferBody(targetOrgID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp StandardResponse
+	resp.Data = &ProbeTransferReport{}
+
+	_, err = s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v2/probes/%s/transfer", probeUUID),
+		Body:   body,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if report, ok := resp.Data.(*ProbeTransferReport); ok {
+		return report, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// TransferMany moves several probes to another organization in one request.
+// Probes are transferred independently: per-probe failures are reported in
+// Errors rather than failing the whole call.
+// Authentication: JWT Token required
+// Endpoint: POST /v2/probes/transfer
+func (s *ProbesService) TransferMany(ctx context.Context, probeUUIDs []string, targetOrgID uint, opts *ProbeTransferOptions) (*ProbeBulkTransferResult, error) {
+	body, err := probeTransferBody(targetOrgID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	uuids := make([]string, 0, len(probeUUIDs))
+	seen := make(map[string]bool, len(probeUUIDs))
+	for _, uuid := range probeUUIDs {
+		if uuid == "" || seen[uuid] {
+			continue
+		}
+		seen[uuid] = true
+		uuids = append(uuids, uuid)
+	}
+
+	result := &ProbeBulkTransferResult{Errors: make(map[string]error)}
+	if len(uuids) == 0 {
+		return result, nil
+	}
+	body["probe_uuids"] = uuids
+
+	var resp struct {
+		Status string `json:"status"`
+		Data   struct {
+			Reports []*ProbeTransferReport `json:"reports"`
+			Errors  []struct {
+				ProbeUUID string `json:"probe_uuid"`
+				Error     string `json:"error"`
+			} `json:"errors"`
+		} `json:"data"`
+	}
+	_, err = s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v2/probes/transfer",
+		Body:   body,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.Reports = resp.Data.Reports
+	for _, failure := range resp.Data.Errors {
+		result.Errors[failure.ProbeUUID] = &APIError{Status: "error", Message: failure.Error}
+	}
+	return result, nil
+}
@@ -0,0 +1,65 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbesService_Transfer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, float64(42), body["target_organization_id"])
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v2/probes/probe-1/transfer":
+			assert.Equal(t, true, body["migrate_history"])
+			assert.Equal(t, false, body["dry_run"])
+			w.Write([]byte(`{"status":"success","data":{"probe_uuid":"probe-1","source_organization_id":7,"target_organization_id":42,
+				"history_migrated":true,"results_migrated":1200,"schedules_moved":2,"detached_alert_channels":["ops-slack"],
+				"transferred_at":"2026-10-15T10:00:00Z"}}`))
+		case "POST /v2/probes/transfer":
+			assert.Equal(t, []interface{}{"probe-1", "probe-2"}, body["probe_uuids"])
+			assert.Equal(t, true, body["dry_run"])
+			w.Write([]byte(`{"status":"success","data":{
+				"reports":[{"probe_uuid":"probe-1","source_organization_id":7,"target_organization_id":42,"dry_run":true,"results_left_behind":1200}],
+				"errors":[{"probe_uuid":"probe-2","error":"probe uses a target group"}]}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	report, err := client.Probes.Transfer(ctx, "probe-1", 42, &ProbeTransferOptions{MigrateHistory: true})
+	require.NoError(t, err)
+	assert.Equal(t, uint(7), report.SourceOrganizationID)
+	assert.Equal(t, int64(1200), report.ResultsMigrated)
+	assert.Equal(t, 2, report.SchedulesMoved)
+	assert.Equal(t, []string{"ops-slack"}, report.DetachedAlertChannels)
+	assert.NotNil(t, report.TransferredAt)
+
+	result, err := client.Probes.TransferMany(ctx, []string{"probe-1", "probe-2", "probe-1", ""}, 42, &ProbeTransferOptions{DryRun: true})
+	require.NoError(t, err)
+	assert.True(t, result.Failed())
+	require.Len(t, result.Reports, 1)
+	assert.True(t, result.Reports[0].DryRun)
+	assert.Equal(t, int64(1200), result.Reports[0].ResultsLeftBehind)
+	assert.Contains(t, result.Errors["probe-2"].Error(), "target group")
+
+	_, err = client.Probes.Transfer(ctx, "probe-1", 0, nil)
+	assert.Error(t, err)
+	result, err = client.Probes.TransferMany(ctx, nil, 42, nil)
+	require.NoError(t, err)
+	assert.False(t, result.Failed())
+}
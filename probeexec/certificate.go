@@ -0,0 +1,50 @@
+package probeexec
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+
+	nexmonyx "github.com/nexmonyx/go-sdk/v2"
+)
+
+// CertificateFromTLS extracts the metadata of the leaf certificate and chain
+// a server presented, for ProbeExecutionResult.Certificate. It returns nil for
+// plain HTTP connections.
+func CertificateFromTLS(state *tls.ConnectionState) *nexmonyx.ProbeCertificate {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return nil
+	}
+
+	leaf := state.PeerCertificates[0]
+	certificate := &nexmonyx.ProbeCertificate{
+		Subject:           leaf.Subject.String(),
+		Issuer:            leaf.Issuer.String(),
+		SerialNumber:      leaf.SerialNumber.String(),
+		SANs:              append([]string(nil), leaf.DNSNames...),
+		NotBefore:         leaf.NotBefore,
+		NotAfter:          leaf.NotAfter,
+		FingerprintSHA256: fingerprint(leaf),
+	}
+	for _, ip := range leaf.IPAddresses {
+		certificate.SANs = append(certificate.SANs, ip.String())
+	}
+	for _, cert := range state.PeerCertificates[1:] {
+		certificate.Chain = append(certificate.Chain, nexmonyx.CertificateChainEntry{
+			Subject:           cert.Subject.String(),
+			Issuer:            cert.Issuer.String(),
+			SerialNumber:      cert.SerialNumber.String(),
+			NotBefore:         cert.NotBefore,
+			NotAfter:          cert.NotAfter,
+			FingerprintSHA256: fingerprint(cert),
+			IsCA:              cert.IsCA,
+		})
+	}
+	return certificate
+}
+
+func fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,438 @@
+// Package probeexec runs scripted (multi-step) HTTP probes defined with
+// nexmonyx.ProbeStep, such as a login flow: post credentials, follow the
+// redirect, and assert a field of the JSON response.
+//
+// Steps run in order against a shared cookie jar. Values extracted from one
+// response are substituted into later requests with {{name}}. The run stops
+// at the first failed step, and every step that ran reports its own timing.
+//
+// Example:
+//
+//	executor := probeexec.New(nil)
+//	executor.Variables = map[string]string{"password": os.Getenv("PROBE_PASSWORD")}
+//
+//	steps, err := probeexec.StepsFromAssignment(assignment)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	result, err := executor.Run(ctx, steps)
+//	if err != nil {
+//	    log.Fatal(err) // The steps are invalid
+//	}
+//	for _, step := range result.Steps {
+//	    fmt.Printf("%s: %d in %s\n", step.Name, step.StatusCode, step.Timing.Total)
+//	}
+//	err = client.Monitoring.SubmitResults(ctx, []nexmonyx.ProbeExecutionResult{result.ExecutionResult(assignment)})
+package probeexec
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptrace"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	nexmonyx "github.com/nexmonyx/go-sdk/v2"
+)
+
+// ProbeType identifies scripted probe results in ProbeExecutionResult.Details
+const ProbeType = "scripted"
+
+// DefaultMaxBodySize is how much of each response is read for extraction and
+// assertions when Executor.MaxBodySize is zero
+const DefaultMaxBodySize = 1 << 20
+
+// Executor runs scripted probes
+type Executor struct {
+	// HTTPClient sends the requests. Its Jar and CheckRedirect are replaced
+	// for each run. Defaults to a client with a 30 second timeout.
+	HTTPClient *http.Client
+
+	// Variables are available to every step, e.g. credentials as {{password}}
+	Variables map[string]string
+
+	// MaxBodySize limits how much of each response is read. Defaults to
+	// DefaultMaxBodySize.
+	MaxBodySize int64
+}
+
+// New creates an executor using httpClient, or a default client when nil
+func New(httpClient *http.Client) *Executor {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Executor{HTTPClient: httpClient}
+}
+
+// Result is the outcome of a scripted probe run
+type Result struct {
+	Success   bool              `json:"success"`
+	Error     string            `json:"error,omitempty"` // First failure, prefixed with the step name
+	StartedAt time.Time         `json:"started_at"`
+	Duration  time.Duration     `json:"duration"`
+	Steps     []StepResult      `json:"steps"`               // Steps that ran, in order
+	Variables map[string]string `json:"variables,omitempty"` // Extracted values
+}
+
+// StepResult is the outcome of one step
+type StepResult struct {
+	Name       string            `json:"name"`
+	Method     string            `json:"method"`
+	URL        string            `json:"url"` // After variable substitution
+	StatusCode int               `json:"status_code,omitempty"`
+	Success    bool              `json:"success"`
+	Error      string            `json:"error,omitempty"`
+	Timing     Timing            `json:"timing"`
+	Extracted  map[string]string `json:"extracted,omitempty"`
+
+	// Certificate served over HTTPS, after redirects
+	Certificate *nexmonyx.ProbeCertificate `json:"certificate,omitempty"`
+}
+
+// Timing breaks down how long a step took. Connection phases are zero when
+// a kept-alive connection was reused.
+type Timing struct {
+	DNS       time.Duration `json:"dns"`
+	Connect   time.Duration `json:"connect"`
+	TLS       time.Duration `json:"tls"`
+	FirstByte time.Duration `json:"first_byte"` // From sending the request
+	Total     time.Duration `json:"total"`      // Including reading the body
+}
+
+// Run executes the steps in order and stops at the first failed step. The
+// error is only set when the steps are invalid; failures while running are
+// reported in the result.
+func (e *Executor) Run(ctx context.Context, steps []nexmonyx.ProbeStep) (*Result, error) {
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("at least one step is required")
+	}
+	predefined := make([]string, 0, len(e.Variables))
+	for name := range e.Variables {
+		predefined = append(predefined, name)
+	}
+	if issues := nexmonyx.ValidateProbeSteps(steps, predefined...); nexmonyx.HasValidationErrors(issues) {
+		return nil, fmt.Errorf("invalid probe steps: %s", issues[0])
+	}
+
+	jar, _ := cookiejar.New(nil) // Only fails with invalid options
+	result := &Result{StartedAt: time.Now(), Variables: make(map[string]string), Success: true}
+	variables := make(map[string]string, len(e.Variables))
+	for name, value := range e.Variables {
+		variables[name] = value
+	}
+
+	for i, step := range steps {
+		if step.Name == "" {
+			step.Name = fmt.Sprintf("step %d", i+1)
+		}
+		stepResult := e.runStep(ctx, jar, step, variables)
+		for name, value := range stepResult.Extracted {
+			variables[name] = value
+			result.Variables[name] = value
+		}
+		result.Steps = append(result.Steps, stepResult)
+		if !stepResult.Success {
+			result.Success = false
+			result.Error = stepResult.Name + ": " + stepResult.Error
+			break
+		}
+	}
+
+	result.Duration = time.Since(result.StartedAt)
+	return result, nil
+}
+
+func (e *Executor) runStep(ctx context.Context, jar http.CookieJar, step nexmonyx.ProbeStep, variables map[string]string) StepResult {
+	method := strings.ToUpper(step.Method)
+	if method == "" {
+		method = http.MethodGet
+	}
+	result := StepResult{Name: step.Name, Method: method, URL: substitute(step.URL, variables)}
+	fail := func(format string, args ...interface{}) StepResult {
+		result.Error = fmt.Sprintf(format, args...)
+		return result
+	}
+
+	if step.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(step.Timeout)*time.Second)
+		defer cancel()
+	}
+
+	var timing Timing
+	var dnsStart, connectStart, tlsStart, wroteRequest time.Time
+	trace := &httptrace.ClientTrace{
+		DNSStart:             func(httptrace.DNSStartInfo) { dnsStart = time.Now() },
+		DNSDone:              func(httptrace.DNSDoneInfo) { timing.DNS = time.Since(dnsStart) },
+		ConnectStart:         func(string, string) { connectStart = time.Now() },
+		ConnectDone:          func(string, string, error) { timing.Connect = time.Since(connectStart) },
+		TLSHandshakeStart:    func() { tlsStart = time.Now() },
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { timing.TLS = time.Since(tlsStart) },
+		WroteRequest:         func(httptrace.WroteRequestInfo) { wroteRequest = time.Now() },
+		GotFirstResponseByte: func() { timing.FirstByte = time.Since(wroteRequest) },
+	}
+
+	var body io.Reader
+	if step.Body != "" {
+		body = strings.NewReader(substitute(step.Body, variables))
+	}
+	req, err := http.NewRequestWithContext(httptrace.WithClientTrace(ctx, trace), method, result.URL, body)
+	if err != nil {
+		return fail("invalid request: %v", err)
+	}
+	for name, value := range step.Headers {
+		req.Header.Set(substitute(name, variables), substitute(value, variables))
+	}
+
+	client := http.Client{Timeout: 30 * time.Second}
+	if e.HTTPClient != nil {
+		client = *e.HTTPClient
+	}
+	client.Jar = jar
+	client.CheckRedirect = nil
+	if step.FollowRedirects != nil && !*step.FollowRedirects {
+		client.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		timing.Total = time.Since(start)
+		result.Timing = timing
+		return fail("request failed: %v", err)
+	}
+	maxBody := e.MaxBodySize
+	if maxBody <= 0 {
+		maxBody = DefaultMaxBodySize
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBody))
+	resp.Body.Close()
+	timing.Total = time.Since(start)
+	result.Timing = timing
+	result.StatusCode = resp.StatusCode
+	result.Certificate = CertificateFromTLS(resp.TLS)
+	if err != nil {
+		return fail("failed to read response: %v", err)
+	}
+
+	r := &response{status: resp.StatusCode, header: resp.Header, body: data, elapsed: timing.Total}
+
+	for _, extract := range step.Extract {
+		value, ok := r.extract(extract)
+		if !ok {
+			return fail("extract %s: %s %s not found", extract.Name, extract.Source, extract.Path)
+		}
+		if result.Extracted == nil {
+			result.Extracted = make(map[string]string)
+		}
+		result.Extracted[extract.Name] = value
+	}
+	for _, assertion := range step.Assertions {
+		if err := r.check(assertion); err != nil {
+			return fail("%v", err)
+		}
+	}
+
+	result.Success = true
+	return result
+}
+
+var variableReference = regexp.MustCompile(`\{\{\s*([^{}]*?)\s*\}\}`)
+
+func substitute(s string, variables map[string]string) string {
+	return variableReference.ReplaceAllStringFunc(s, func(ref string) string {
+		return variables[variableReference.FindStringSubmatch(ref)[1]]
+	})
+}
+
+// response is a step's response as read for extraction and assertions
+type response struct {
+	status  int
+	header  http.Header
+	body    []byte
+	elapsed time.Duration
+
+	parsed    interface{}
+	parsedErr error
+	isParsed  bool
+}
+
+// value returns the value the source and path refer to
+func (r *response) value(source, path string) (string, bool) {
+	switch source {
+	case nexmonyx.ProbeStepSourceStatus:
+		return strconv.Itoa(r.status), true
+	case nexmonyx.ProbeStepSourceHeader:
+		values := r.header.Values(path)
+		if len(values) == 0 {
+			return "", false
+		}
+		return values[0], true
+	case nexmonyx.ProbeStepSourceJSON:
+		if !r.isParsed {
+			r.isParsed = true
+			r.parsedErr = json.Unmarshal(r.body, &r.parsed)
+		}
+		if r.parsedErr != nil {
+			return "", false
+		}
+		return lookupJSON(r.parsed, path)
+	case nexmonyx.ProbeStepSourceBody:
+		return string(r.body), true
+	case nexmonyx.ProbeStepSourceResponseTime:
+		return strconv.FormatInt(r.elapsed.Milliseconds(), 10), true
+	}
+	return "", false
+}
+
+func (r *response) extract(extract nexmonyx.ProbeStepExtract) (string, bool) {
+	if extract.Source != nexmonyx.ProbeStepSourceBody {
+		return r.value(extract.Source, extract.Path)
+	}
+	match := regexp.MustCompile(extract.Path).FindSubmatch(r.body) // Validated by Run
+	if match == nil {
+		return "", false
+	}
+	return string(match[1]), true
+}
+
+func (r *response) check(assertion nexmonyx.ProbeStepAssertion) error {
+	subject := assertion.Source
+	if assertion.Path != "" {
+		subject += " " + assertion.Path
+	}
+	actual, ok := r.value(assertion.Source, assertion.Path)
+	if assertion.Operator == nexmonyx.ProbeAssertExists {
+		if !ok {
+			return fmt.Errorf("%s does not exist", subject)
+		}
+		return nil
+	}
+	if !ok {
+		return fmt.Errorf("%s not found", subject)
+	}
+
+	var passed bool
+	switch assertion.Operator {
+	case nexmonyx.ProbeAssertEquals:
+		passed = actual == assertion.Value
+	case nexmonyx.ProbeAssertNotEquals:
+		passed = actual != assertion.Value
+	case nexmonyx.ProbeAssertContains:
+		passed = strings.Contains(actual, assertion.Value)
+	case nexmonyx.ProbeAssertMatches:
+		passed = regexp.MustCompile(assertion.Value).MatchString(actual) // Validated by Run
+	case nexmonyx.ProbeAssertLessThan, nexmonyx.ProbeAssertGreaterThan:
+		got, err1 := strconv.ParseFloat(actual, 64)
+		want, err2 := strconv.ParseFloat(assertion.Value, 64)
+		if err1 != nil || err2 != nil {
+			return fmt.Errorf("%s: cannot compare %q and %q as numbers", subject, actual, assertion.Value)
+		}
+		passed = got < want
+		if assertion.Operator == nexmonyx.ProbeAssertGreaterThan {
+			passed = got > want
+		}
+	}
+	if !passed {
+		if assertion.Source == nexmonyx.ProbeStepSourceBody && len(actual) > 100 {
+			actual = actual[:100] + "..."
+		}
+		return fmt.Errorf("%s: expected %s %q, got %q", subject, assertion.Operator, assertion.Value, actual)
+	}
+	return nil
+}
+
+// lookupJSON follows a dot-separated path of object keys and array indexes
+func lookupJSON(value interface{}, path string) (string, bool) {
+	for _, part := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			next, ok := v[part]
+			if !ok {
+				return "", false
+			}
+			value = next
+		case []interface{}:
+			i, err := strconv.Atoi(part)
+			if err != nil || i < 0 || i >= len(v) {
+				return "", false
+			}
+			value = v[i]
+		default:
+			return "", false
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case nil:
+		return "null", true
+	default:
+		data, _ := json.Marshal(v)
+		return string(data), true
+	}
+}
+
+// StepsFromAssignment decodes the steps in an assignment's
+// Configuration["steps"]. It returns nil when the probe is not scripted.
+func StepsFromAssignment(assignment *nexmonyx.ProbeAssignment) ([]nexmonyx.ProbeStep, error) {
+	if assignment == nil || assignment.Configuration["steps"] == nil {
+		return nil, nil
+	}
+	if steps, ok := assignment.Configuration["steps"].([]nexmonyx.ProbeStep); ok {
+		return steps, nil
+	}
+	data, err := json.Marshal(assignment.Configuration["steps"])
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode steps: %w", err)
+	}
+	var steps []nexmonyx.ProbeStep
+	if err := json.Unmarshal(data, &steps); err != nil {
+		return nil, fmt.Errorf("invalid steps in probe %s: %w", assignment.ProbeUUID, err)
+	}
+	return steps, nil
+}
+
+// ExecutionResult converts the result to a ProbeExecutionResult for
+// Monitoring.SubmitResults. Per-step results are kept in Details["steps"].
+func (r *Result) ExecutionResult(assignment *nexmonyx.ProbeAssignment) nexmonyx.ProbeExecutionResult {
+	execution := nexmonyx.ProbeExecutionResult{
+		ExecutedAt:   r.StartedAt,
+		Status:       "success",
+		ResponseTime: int(r.Duration.Milliseconds()),
+		TotalTime:    int(r.Duration.Milliseconds()),
+		Error:        r.Error,
+		Details: map[string]interface{}{
+			"probe_type": ProbeType,
+			"steps":      r.Steps,
+		},
+	}
+	if !r.Success {
+		execution.Status = "failed"
+	}
+	if assignment != nil {
+		execution.ProbeID = assignment.ProbeID
+		execution.ProbeUUID = assignment.ProbeUUID
+		execution.Region = assignment.Region
+	}
+	if n := len(r.Steps); n > 0 {
+		execution.StatusCode = r.Steps[n-1].StatusCode
+	}
+	for _, step := range r.Steps {
+		if step.Certificate != nil {
+			execution.Certificate = step.Certificate
+			break
+		}
+	}
+	return execution
+}
@@ -0,0 +1,198 @@
+package probeexec
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	nexmonyx "github.com/nexmonyx/go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLoginServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			require.NoError(t, r.ParseForm())
+			if r.PostForm.Get("user") != "probe" || r.PostForm.Get("password") != "s3cret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "abc", Path: "/"})
+			w.Header().Set("X-CSRF-Token", "tok-1")
+			http.Redirect(w, r, "/home", http.StatusFound)
+		case "/home":
+			if c, err := r.Cookie("session"); err != nil || c.Value != "abc" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"user":{"id":42,"name":"probe"},"items":[{"id":"a1"},{"id":"a2"}]}`))
+		case "/items/a2":
+			assert.Equal(t, "tok-1", r.Header.Get("X-CSRF-Token"))
+			w.Write([]byte(`<html>order #7731 shipped</html>`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestExecutor_Run_LoginFlow(t *testing.T) {
+	server := newLoginServer(t)
+	defer server.Close()
+
+	noRedirect := false
+	steps := []nexmonyx.ProbeStep{
+		{
+			Name:            "login",
+			Method:          "POST",
+			URL:             server.URL + "/login",
+			Headers:         map[string]string{"Content-Type": "application/x-www-form-urlencoded"},
+			Body:            "user=probe&password={{password}}",
+			FollowRedirects: &noRedirect,
+			Extract:         []nexmonyx.ProbeStepExtract{{Name: "csrf", Source: nexmonyx.ProbeStepSourceHeader, Path: "X-CSRF-Token"}},
+			Assertions: []nexmonyx.ProbeStepAssertion{
+				{Source: nexmonyx.ProbeStepSourceStatus, Operator: nexmonyx.ProbeAssertEquals, Value: "302"},
+				{Source: nexmonyx.ProbeStepSourceHeader, Path: "Location", Operator: nexmonyx.ProbeAssertEquals, Value: "/home"},
+			},
+		},
+		{
+			Name:    "home",
+			URL:     server.URL + "/home",
+			Extract: []nexmonyx.ProbeStepExtract{{Name: "item", Source: nexmonyx.ProbeStepSourceJSON, Path: "items.1.id"}},
+			Assertions: []nexmonyx.ProbeStepAssertion{
+				{Source: nexmonyx.ProbeStepSourceJSON, Path: "user.id", Operator: nexmonyx.ProbeAssertEquals, Value: "42"},
+				{Source: nexmonyx.ProbeStepSourceJSON, Path: "user", Operator: nexmonyx.ProbeAssertContains, Value: `"name":"probe"`},
+				{Source: nexmonyx.ProbeStepSourceResponseTime, Operator: nexmonyx.ProbeAssertLessThan, Value: "5000"},
+			},
+		},
+		{
+			Name:    "item",
+			URL:     server.URL + "/items/{{item}}",
+			Headers: map[string]string{"X-CSRF-Token": "{{csrf}}"},
+			Extract: []nexmonyx.ProbeStepExtract{{Name: "order", Source: nexmonyx.ProbeStepSourceBody, Path: `order #(\d+)`}},
+			Assertions: []nexmonyx.ProbeStepAssertion{
+				{Source: nexmonyx.ProbeStepSourceBody, Operator: nexmonyx.ProbeAssertMatches, Value: `shipped`},
+			},
+		},
+	}
+
+	executor := New(nil)
+	executor.Variables = map[string]string{"password": "s3cret"}
+	result, err := executor.Run(context.Background(), steps)
+	require.NoError(t, err)
+
+	assert.True(t, result.Success, result.Error)
+	require.Len(t, result.Steps, 3)
+	assert.Equal(t, 302, result.Steps[0].StatusCode)
+	assert.Equal(t, 200, result.Steps[1].StatusCode)
+	assert.Equal(t, server.URL+"/items/a2", result.Steps[2].URL)
+	assert.Equal(t, map[string]string{"csrf": "tok-1", "item": "a2", "order": "7731"}, result.Variables)
+	for _, step := range result.Steps {
+		assert.True(t, step.Success)
+		assert.Positive(t, step.Timing.Total)
+	}
+	assert.NotContains(t, result.Variables, "password", "predefined variables are not reported")
+}
+
+func TestExecutor_Run_StopsAtFirstFailure(t *testing.T) {
+	server := newLoginServer(t)
+	defer server.Close()
+
+	steps := []nexmonyx.ProbeStep{
+		{
+			Name:       "home",
+			URL:        server.URL + "/home",
+			Assertions: []nexmonyx.ProbeStepAssertion{{Source: nexmonyx.ProbeStepSourceStatus, Operator: nexmonyx.ProbeAssertEquals, Value: "200"}},
+		},
+		{URL: server.URL + "/items/a1"},
+	}
+
+	result, err := New(nil).Run(context.Background(), steps)
+	require.NoError(t, err)
+
+	assert.False(t, result.Success)
+	require.Len(t, result.Steps, 1)
+	assert.Equal(t, 401, result.Steps[0].StatusCode)
+	assert.Equal(t, `home: status: expected equals "200", got "401"`, result.Error)
+
+	execution := result.ExecutionResult(&nexmonyx.ProbeAssignment{ProbeID: 7, ProbeUUID: "probe-1", Region: "eu-west"})
+	assert.Equal(t, "failed", execution.Status)
+	assert.Equal(t, uint(7), execution.ProbeID)
+	assert.Equal(t, "probe-1", execution.ProbeUUID)
+	assert.Equal(t, "eu-west", execution.Region)
+	assert.Equal(t, 401, execution.StatusCode)
+	assert.Equal(t, result.Error, execution.Error)
+	assert.Equal(t, ProbeType, execution.Details["probe_type"])
+	assert.Len(t, execution.Details["steps"], 1)
+}
+
+func TestExecutor_Run_InvalidSteps(t *testing.T) {
+	_, err := New(nil).Run(context.Background(), nil)
+	assert.Error(t, err)
+
+	_, err = New(nil).Run(context.Background(), []nexmonyx.ProbeStep{{URL: "https://example.com/{{token}}"}})
+	assert.ErrorContains(t, err, "{{token}}")
+}
+
+func TestExecutor_Run_MissingJSONField(t *testing.T) {
+	server := newLoginServer(t)
+	defer server.Close()
+
+	// Log in first so /home returns JSON
+	steps := []nexmonyx.ProbeStep{
+		{Method: "POST", URL: server.URL + "/login", Headers: map[string]string{"Content-Type": "application/x-www-form-urlencoded"}, Body: "user=probe&password=s3cret"},
+		{
+			URL:        server.URL + "/home",
+			Assertions: []nexmonyx.ProbeStepAssertion{{Source: nexmonyx.ProbeStepSourceJSON, Path: "user.email", Operator: nexmonyx.ProbeAssertExists}},
+		},
+	}
+
+	result, err := New(nil).Run(context.Background(), steps)
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	require.Len(t, result.Steps, 2)
+	assert.Equal(t, "step 2", result.Steps[1].Name)
+	assert.Equal(t, "json user.email does not exist", result.Steps[1].Error)
+}
+
+func TestStepsFromAssignment(t *testing.T) {
+	steps, err := StepsFromAssignment(&nexmonyx.ProbeAssignment{})
+	require.NoError(t, err)
+	assert.Nil(t, steps)
+
+	// Assignments decoded from JSON carry generic values
+	steps, err = StepsFromAssignment(&nexmonyx.ProbeAssignment{Configuration: map[string]interface{}{
+		"steps": []interface{}{map[string]interface{}{"name": "home", "url": "https://example.com"}},
+	}})
+	require.NoError(t, err)
+	require.Len(t, steps, 1)
+	assert.Equal(t, "https://example.com", steps[0].URL)
+}
+
+func TestExecutor_Run_Certificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	result, err := New(server.Client()).Run(context.Background(), []nexmonyx.ProbeStep{{URL: server.URL}})
+	require.NoError(t, err)
+	require.True(t, result.Success, result.Error)
+
+	cert := result.Steps[0].Certificate
+	require.NotNil(t, cert)
+	leaf := server.Certificate()
+	assert.Equal(t, leaf.NotAfter, cert.NotAfter)
+	assert.Equal(t, leaf.SerialNumber.String(), cert.SerialNumber)
+	assert.Contains(t, cert.SANs, "example.com")
+	assert.Contains(t, cert.SANs, "127.0.0.1")
+	assert.Len(t, cert.FingerprintSHA256, 64)
+	assert.Equal(t, cert, result.ExecutionResult(nil).Certificate)
+}
+
+func TestCertificateFromTLS_PlainHTTP(t *testing.T) {
+	assert.Nil(t, CertificateFromTLS(nil))
+}
@@ -9,6 +9,31 @@ import (
 
 // Create creates a new probe
 func (s *ProbesService) Create(ctx context.Context, req *ProbeCreateRequest) (*MonitoringProbe, error) {
+	body := probeCreateBody(req)
+
+	var result struct {
+		Status string `json:"status"`
+		Data   struct {
+			Probe MonitoringProbe `json:"probe"`
+		} `json:"data"`
+		Message string `json:"message"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v1/probes",
+		Body:   body,
+		Result: &result,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.Data.Probe, nil
+}
+
+// probeCreateBody converts a ProbeCreateRequest to the body the API expects
+func probeCreateBody(req *ProbeCreateRequest) map[string]interface{} {
 	// Convert ProbeCreateRequest to map to match API expectations
 	config := make(map[string]interface{})
 
@@ -56,26 +81,14 @@ func (s *ProbesService) Create(ctx context.Context, req *ProbeCreateRequest) (*M
 	if req.Name != "" {
 		body["description"] = req.Name // Use name as description if not provided
 	}
-
-	var result struct {
-		Status string `json:"status"`
-		Data   struct {
-			Probe MonitoringProbe `json:"probe"`
-		} `json:"data"`
-		Message string `json:"message"`
+	if len(req.Tags) > 0 {
+		body["tags"] = req.Tags
 	}
-
-	_, err := s.client.Do(ctx, &Request{
-		Method: "POST",
-		Path:   "/v1/probes",
-		Body:   body,
-		Result: &result,
-	})
-	if err != nil {
-		return nil, err
+	if req.TargetGroupID != 0 {
+		body["target_group_id"] = req.TargetGroupID
 	}
 
-	return &result.Data.Probe, nil
+	return body
 }
 
 // List returns all probes
@@ -168,6 +181,27 @@ func (s *ProbesService) Delete(ctx context.Context, uuid string) error {
 	return err
 }
 
+// Undelete restores a soft-deleted probe. Deleted probes are listed with
+// ListOptions.OnlyDeleted.
+func (s *ProbesService) Undelete(ctx context.Context, uuid string) (*MonitoringProbe, error) {
+	var resp StandardResponse
+	resp.Data = &MonitoringProbe{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v2/probes/%s/undelete", uuid),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if probe, ok := resp.Data.(*MonitoringProbe); ok {
+		return probe, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
 // GetHealth returns the health status of a probe
 func (s *ProbesService) GetHealth(ctx context.Context, uuid string) (*ProbeHealth, error) {
 	var result struct {
@@ -187,9 +221,17 @@ func (s *ProbesService) GetHealth(ctx context.Context, uuid string) (*ProbeHealt
 	return result.Data, nil
 }
 
-// ListResults returns probe execution results
+// ListResults returns probe execution results. For probes bound to a target
+// group, set opts.Target to list the results for one target.
 func (s *ProbesService) ListResults(ctx context.Context, uuid string, opts *ProbeResultListOptions) ([]*ProbeResult, *PaginationMeta, error) {
-	return s.client.Monitoring.ListProbeResults(ctx, opts)
+	filtered := ProbeResultListOptions{}
+	if opts != nil {
+		filtered = *opts
+	}
+	if filtered.ProbeUUID == "" {
+		filtered.ProbeUUID = uuid
+	}
+	return s.client.Monitoring.ListProbeResults(ctx, &filtered)
 }
 
 // GetAvailableRegions returns available monitoring regions
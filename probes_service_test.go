@@ -165,7 +165,7 @@ func TestProbesService_Create(t *testing.T) {
 
 				if tt.statusCode == http.StatusOK {
 					assert.Equal(t, tt.request.Name, body["name"])
-					assert.Equal(t, tt.request.Type, body["type"])
+					assert.Equal(t, string(tt.request.Type), body["type"])
 					assert.Equal(t, float64(tt.request.Interval), body["frequency"])
 					assert.Equal(t, tt.request.Enabled, body["enabled"])
 
@@ -0,0 +1,226 @@
+package nexmonyx
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const defaultTopProcesses = 25
+
+// ProcessNode is a process in a process tree
+type ProcessNode struct {
+	Process  *ProcessMetrics
+	Children []*ProcessNode // Ordered by PID
+}
+
+// TotalCPUPercent returns the CPU usage of the process and its descendants
+func (n *ProcessNode) TotalCPUPercent() float64 {
+	total := n.Process.CPUPercent
+	for _, child := range n.Children {
+		total += child.TotalCPUPercent()
+	}
+	return total
+}
+
+// TotalMemoryRSS returns the resident memory of the process and its descendants
+func (n *ProcessNode) TotalMemoryRSS() int64 {
+	total := n.Process.MemoryRSS
+	for _, child := range n.Children {
+		total += child.TotalMemoryRSS()
+	}
+	return total
+}
+
+// Walk calls fn for the process and its descendants, depth first, with the
+// depth relative to n
+func (n *ProcessNode) Walk(fn func(node *ProcessNode, depth int)) {
+	n.walk(fn, 0)
+}
+
+func (n *ProcessNode) walk(fn func(*ProcessNode, int), depth int) {
+	fn(n, depth)
+	for _, child := range n.Children {
+		child.walk(fn, depth+1)
+	}
+}
+
+// BuildProcessTree links processes by ParentPID. Processes whose parent is
+// not in the list, such as init or processes of a trimmed list, become roots.
+// Roots and children are ordered by PID.
+func BuildProcessTree(processes []ProcessMetrics) []*ProcessNode {
+	nodes := make(map[int]*ProcessNode, len(processes))
+	for i := range processes {
+		nodes[processes[i].PID] = &ProcessNode{Process: &processes[i]}
+	}
+
+	var roots []*ProcessNode
+	for i := range processes {
+		node := nodes[processes[i].PID]
+		parent, ok := nodes[processes[i].ParentPID]
+		if !ok || parent == node {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	byPID := func(list []*ProcessNode) {
+		sort.Slice(list, func(i, j int) bool { return list[i].Process.PID < list[j].Process.PID })
+	}
+	byPID(roots)
+	for _, node := range nodes {
+		byPID(node.Children)
+	}
+	return roots
+}
+
+// containerIDPattern matches the container ID in cgroup paths written by
+// Docker ("/docker/<id>", "docker-<id>.scope"), containerd, CRI-O and Podman
+var containerIDPattern = regexp.MustCompile(`(?:^|[/-])([0-9a-f]{64})(?:\.scope)?(?:/|$)`)
+
+// ContainerIDFromCgroup extracts a container ID from a cgroup path, e.g.
+// "/kubepods.slice/.../cri-containerd-<id>.scope". It returns "" for
+// processes that are not in a container.
+func ContainerIDFromCgroup(cgroup string) string {
+	if match := containerIDPattern.FindStringSubmatch(cgroup); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// ProcessGroupMetrics aggregates the processes of a cgroup or container
+type ProcessGroupMetrics struct {
+	Cgroup        string  `json:"cgroup,omitempty"`
+	ContainerID   string  `json:"container_id,omitempty"`
+	ProcessCount  int     `json:"process_count"`
+	CPUPercent    float64 `json:"cpu_percent"`
+	MemoryPercent float64 `json:"memory_percent"`
+	MemoryRSS     int64   `json:"memory_rss"`
+	NumThreads    int     `json:"num_threads"`
+}
+
+// AggregateProcessesByCgroup sums CPU and memory per container, or per cgroup
+// for processes outside containers, ordered by CPU usage. Processes without a
+// cgroup are not included.
+func AggregateProcessesByCgroup(processes []ProcessMetrics) []ProcessGroupMetrics {
+	groups := make(map[string]*ProcessGroupMetrics)
+	var order []string
+	for _, proc := range processes {
+		containerID := proc.ContainerID
+		if containerID == "" {
+			containerID = ContainerIDFromCgroup(proc.Cgroup)
+		}
+		key := "cgroup:" + proc.Cgroup
+		if containerID != "" {
+			key = "container:" + containerID
+		} else if proc.Cgroup == "" {
+			continue
+		}
+
+		group, ok := groups[key]
+		if !ok {
+			group = &ProcessGroupMetrics{Cgroup: proc.Cgroup, ContainerID: containerID}
+			groups[key] = group
+			order = append(order, key)
+		} else if group.Cgroup != proc.Cgroup {
+			// A container's processes may sit in nested cgroups; keep the common prefix
+			group.Cgroup = commonCgroupPrefix(group.Cgroup, proc.Cgroup)
+		}
+		group.ProcessCount++
+		group.CPUPercent += proc.CPUPercent
+		group.MemoryPercent += proc.MemoryPercent
+		group.MemoryRSS += proc.MemoryRSS
+		group.NumThreads += proc.NumThreads
+	}
+
+	result := make([]ProcessGroupMetrics, 0, len(order))
+	for _, key := range order {
+		result = append(result, *groups[key])
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CPUPercent > result[j].CPUPercent })
+	return result
+}
+
+func commonCgroupPrefix(a, b string) string {
+	as, bs := strings.Split(a, "/"), strings.Split(b, "/")
+	n := 0
+	for n < len(as) && n < len(bs) && as[n] == bs[n] {
+		n++
+	}
+	return strings.Join(as[:n], "/")
+}
+
+// ProcessTrimOptions configures TrimProcesses
+type ProcessTrimOptions struct {
+	// TopCPU keeps this many processes with the highest CPU usage (default: 25)
+	TopCPU int
+
+	// TopMemory keeps this many processes with the highest resident memory (default: 25)
+	TopMemory int
+
+	// KeepAncestors also keeps the parents of kept processes up to the root,
+	// so BuildProcessTree shows where they were started from
+	KeepAncestors bool
+}
+
+// TrimProcesses returns the top processes by CPU and by memory, in their
+// original order, to keep submissions small on hosts running thousands of
+// processes
+func TrimProcesses(processes []ProcessMetrics, opts *ProcessTrimOptions) []ProcessMetrics {
+	if opts == nil {
+		opts = &ProcessTrimOptions{}
+	}
+	topCPU, topMemory := opts.TopCPU, opts.TopMemory
+	if topCPU <= 0 {
+		topCPU = defaultTopProcesses
+	}
+	if topMemory <= 0 {
+		topMemory = defaultTopProcesses
+	}
+	if len(processes) <= topCPU && len(processes) <= topMemory {
+		return processes
+	}
+
+	keep := make(map[int]bool)
+	index := make([]int, len(processes))
+	for i := range index {
+		index[i] = i
+	}
+	sort.SliceStable(index, func(a, b int) bool { return processes[index[a]].CPUPercent > processes[index[b]].CPUPercent })
+	for _, i := range index[:min(topCPU, len(index))] {
+		keep[i] = true
+	}
+	sort.SliceStable(index, func(a, b int) bool { return processes[index[a]].MemoryRSS > processes[index[b]].MemoryRSS })
+	for _, i := range index[:min(topMemory, len(index))] {
+		keep[i] = true
+	}
+
+	if opts.KeepAncestors {
+		byPID := make(map[int]int, len(processes))
+		for i, proc := range processes {
+			byPID[proc.PID] = i
+		}
+		for i := range keep {
+			for parent, ok := byPID[processes[i].ParentPID]; ok && !keep[parent]; parent, ok = byPID[processes[parent].ParentPID] {
+				keep[parent] = true
+			}
+		}
+	}
+
+	trimmed := make([]ProcessMetrics, 0, len(keep))
+	for i, proc := range processes {
+		if keep[i] {
+			trimmed = append(trimmed, proc)
+		}
+	}
+	return trimmed
+}
+
+// SetProcesses aggregates all processes into ProcessGroups and keeps only the
+// top processes in Processes, so per-container and per-cgroup totals stay
+// accurate while the payload stays small
+func (m *ComprehensiveMetricsRequest) SetProcesses(processes []ProcessMetrics, opts *ProcessTrimOptions) {
+	m.ProcessGroups = AggregateProcessesByCgroup(processes)
+	m.Processes = TrimProcesses(processes, opts)
+}
@@ -0,0 +1,102 @@
+package nexmonyx
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testContainerID = strings.Repeat("ab12", 16)
+
+func newTestProcesses() []ProcessMetrics {
+	return []ProcessMetrics{
+		{PID: 1, Name: "systemd", CPUPercent: 0.1, MemoryRSS: 12 << 20, Cgroup: "/init.scope"},
+		{PID: 812, ParentPID: 1, Name: "nginx", CPUPercent: 0.5, MemoryRSS: 8 << 20, Cgroup: "/system.slice/nginx.service"},
+		{PID: 813, ParentPID: 812, Name: "nginx", CPUPercent: 35, MemoryRSS: 40 << 20, NumThreads: 1, Cgroup: "/system.slice/nginx.service"},
+		{PID: 814, ParentPID: 812, Name: "nginx", CPUPercent: 30, MemoryRSS: 40 << 20, NumThreads: 1, Cgroup: "/system.slice/nginx.service"},
+		{PID: 900, ParentPID: 1, Name: "containerd-shim", CPUPercent: 0.2, MemoryRSS: 10 << 20, Cgroup: "/system.slice/containerd.service"},
+		{PID: 901, ParentPID: 900, Name: "java", CPUPercent: 120, MemoryRSS: 2 << 30, NumThreads: 80,
+			Cgroup: "/kubepods.slice/kubepods-burstable.slice/cri-containerd-" + testContainerID + ".scope"},
+		{PID: 950, ParentPID: 901, Name: "sh", CPUPercent: 0, MemoryRSS: 1 << 20,
+			Cgroup: "/kubepods.slice/kubepods-burstable.slice/cri-containerd-" + testContainerID + ".scope"},
+		{PID: 2000, ParentPID: 4242, Name: "orphan", CPUPercent: 1, MemoryRSS: 1 << 20},
+	}
+}
+
+func TestBuildProcessTree(t *testing.T) {
+	roots := BuildProcessTree(newTestProcesses())
+	require.Len(t, roots, 2, "processes with a missing parent become roots")
+	assert.Equal(t, 1, roots[0].Process.PID)
+	assert.Equal(t, 2000, roots[1].Process.PID)
+
+	nginx := roots[0].Children[0]
+	assert.Equal(t, 812, nginx.Process.PID)
+	assert.Len(t, nginx.Children, 2)
+	assert.InDelta(t, 65.5, nginx.TotalCPUPercent(), 1e-9)
+	assert.Equal(t, int64(88<<20), nginx.TotalMemoryRSS())
+
+	var lines []string
+	roots[0].Walk(func(node *ProcessNode, depth int) {
+		lines = append(lines, strings.Repeat("  ", depth)+node.Process.Name)
+	})
+	assert.Equal(t, []string{"systemd", "  nginx", "    nginx", "    nginx", "  containerd-shim", "    java", "      sh"}, lines)
+}
+
+func TestContainerIDFromCgroup(t *testing.T) {
+	for cgroup, want := range map[string]string{
+		"/docker/" + testContainerID:                                    testContainerID,
+		"/system.slice/docker-" + testContainerID + ".scope":            testContainerID,
+		"/machine.slice/libpod-" + testContainerID + ".scope/container": testContainerID,
+		"/kubepods/burstable/pod1234/" + testContainerID:                testContainerID,
+		"/system.slice/nginx.service":                                   "",
+		"":                                                              "",
+	} {
+		assert.Equal(t, want, ContainerIDFromCgroup(cgroup), cgroup)
+	}
+}
+
+func TestAggregateProcessesByCgroup(t *testing.T) {
+	groups := AggregateProcessesByCgroup(newTestProcesses())
+	require.Len(t, groups, 4)
+
+	assert.Equal(t, testContainerID, groups[0].ContainerID)
+	assert.Equal(t, 2, groups[0].ProcessCount)
+	assert.Equal(t, 120.0, groups[0].CPUPercent)
+	assert.Equal(t, 80, groups[0].NumThreads)
+
+	assert.Equal(t, ProcessGroupMetrics{
+		Cgroup: "/system.slice/nginx.service", ProcessCount: 3, CPUPercent: 65.5, MemoryRSS: 88 << 20, NumThreads: 2,
+	}, groups[1])
+}
+
+func TestTrimProcesses(t *testing.T) {
+	processes := newTestProcesses()
+
+	trimmed := TrimProcesses(processes, &ProcessTrimOptions{TopCPU: 2, TopMemory: 1})
+	var pids []int
+	for _, proc := range trimmed {
+		pids = append(pids, proc.PID)
+	}
+	assert.Equal(t, []int{813, 901}, pids)
+
+	trimmed = TrimProcesses(processes, &ProcessTrimOptions{TopCPU: 2, TopMemory: 1, KeepAncestors: true})
+	pids = nil
+	for _, proc := range trimmed {
+		pids = append(pids, proc.PID)
+	}
+	assert.Equal(t, []int{1, 812, 813, 900, 901}, pids)
+
+	assert.Len(t, TrimProcesses(processes, nil), len(processes), "short lists are kept whole")
+
+	metrics := ComprehensiveMetricsRequest{ServerUUID: "srv-1", CollectedAt: "2026-03-01T00:00:00Z"}
+	metrics.SetProcesses(processes, &ProcessTrimOptions{TopCPU: 1, TopMemory: 1})
+	assert.Len(t, metrics.Processes, 1)
+	assert.Len(t, metrics.ProcessGroups, 4, "groups cover every process")
+	assert.Empty(t, metrics.Validate())
+
+	metrics.ProcessGroups[0].ContainerID, metrics.ProcessGroups[0].Cgroup = "", ""
+	metrics.Processes[0].ParentPID = -1
+	assert.ElementsMatch(t, []string{"process_groups[0]", "processes[0].parent_pid"}, issueFields(metrics.Validate(), ValidationSeverityError))
+}
@@ -2,6 +2,7 @@ package nexmonyx
 
 import (
 	"context"
+	"fmt"
 )
 
 // RegionsService handles monitoring region operations
@@ -37,3 +38,218 @@ func (s *RegionsService) List(ctx context.Context) ([]*PublicRegion, error) {
 
 	return result.Data, nil
 }
+
+// RegionCreateRequest represents a request to create a monitoring region
+type RegionCreateRequest struct {
+	Code        string                 `json:"code"`
+	Name        string                 `json:"name"`
+	Status      RegionStatus           `json:"status,omitempty"`
+	Location    string                 `json:"location,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Enabled     bool                   `json:"enabled"`
+	Priority    int                    `json:"priority,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// RegionUpdateRequest represents a request to update a monitoring region.
+// Only non-nil fields are changed.
+type RegionUpdateRequest struct {
+	Name        *string                `json:"name,omitempty"`
+	Location    *string                `json:"location,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	Enabled     *bool                  `json:"enabled,omitempty"`
+	Priority    *int                   `json:"priority,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// RegionCapacity represents agent counts and probe load for a monitoring
+// region, used by the monitoring-controller to rebalance probes
+type RegionCapacity struct {
+	Region                 string       `json:"region"`
+	Status                 RegionStatus `json:"status"`
+	TotalAgents            int          `json:"total_agents"`
+	HealthyAgents          int          `json:"healthy_agents"`
+	DegradedAgents         int          `json:"degraded_agents"`
+	UnhealthyAgents        int          `json:"unhealthy_agents"`
+	AssignedProbes         int          `json:"assigned_probes"`
+	ExecutionsPerMinute    float64      `json:"executions_per_minute"`     // Current probe load
+	MaxExecutionsPerMinute float64      `json:"max_executions_per_minute"` // Load the healthy agents can sustain
+	Utilization            float64      `json:"utilization"`               // percentage of capacity in use
+	UpdatedAt              *CustomTime  `json:"updated_at,omitempty"`
+}
+
+// Create creates a monitoring region (admin only)
+// POST /v1/admin/regions
+func (s *RegionsService) Create(ctx context.Context, req *RegionCreateRequest) (*MonitoringRegion, error) {
+	if req == nil || req.Code == "" {
+		return nil, fmt.Errorf("region code is required")
+	}
+
+	var resp StandardResponse
+	resp.Data = &MonitoringRegion{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v1/admin/regions",
+		Body:   req,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if region, ok := resp.Data.(*MonitoringRegion); ok {
+		return region, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Get retrieves a monitoring region by code (admin only)
+// GET /v1/admin/regions/{code}
+func (s *RegionsService) Get(ctx context.Context, code string) (*MonitoringRegion, error) {
+	if code == "" {
+		return nil, fmt.Errorf("region code is required")
+	}
+
+	var resp StandardResponse
+	resp.Data = &MonitoringRegion{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/admin/regions/%s", code),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if region, ok := resp.Data.(*MonitoringRegion); ok {
+		return region, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// ListAll returns every monitoring region, including inactive and disabled
+// ones, with full administrative details (admin only). Use List for the
+// public region catalog.
+// GET /v1/admin/regions
+func (s *RegionsService) ListAll(ctx context.Context, opts *ListOptions) ([]*MonitoringRegion, *PaginationMeta, error) {
+	var resp PaginatedResponse
+	var regions []*MonitoringRegion
+	resp.Data = &regions
+
+	req := &Request{
+		Method: "GET",
+		Path:   "/v1/admin/regions",
+		Result: &resp,
+	}
+
+	if opts != nil {
+		req.Query = opts.ToQuery()
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return regions, resp.Meta, nil
+}
+
+// Update updates a monitoring region (admin only)
+// PUT /v1/admin/regions/{code}
+func (s *RegionsService) Update(ctx context.Context, code string, req *RegionUpdateRequest) (*MonitoringRegion, error) {
+	if code == "" {
+		return nil, fmt.Errorf("region code is required")
+	}
+
+	var resp StandardResponse
+	resp.Data = &MonitoringRegion{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v1/admin/regions/%s", code),
+		Body:   req,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if region, ok := resp.Data.(*MonitoringRegion); ok {
+		return region, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Delete deletes a monitoring region (admin only)
+// DELETE /v1/admin/regions/{code}
+func (s *RegionsService) Delete(ctx context.Context, code string) error {
+	if code == "" {
+		return fmt.Errorf("region code is required")
+	}
+
+	var resp StandardResponse
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/admin/regions/%s", code),
+		Result: &resp,
+	})
+	return err
+}
+
+// SetStatus moves a monitoring region between active and maintenance (admin only).
+// Probes are not scheduled in a region under maintenance.
+// PUT /v1/admin/regions/{code}/status
+func (s *RegionsService) SetStatus(ctx context.Context, code string, status RegionStatus) (*MonitoringRegion, error) {
+	if code == "" {
+		return nil, fmt.Errorf("region code is required")
+	}
+	if status != RegionStatusActive && status != RegionStatusMaintenance {
+		return nil, fmt.Errorf("invalid region status %q: must be %q or %q", status, RegionStatusActive, RegionStatusMaintenance)
+	}
+
+	var resp StandardResponse
+	resp.Data = &MonitoringRegion{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v1/admin/regions/%s/status", code),
+		Body:   map[string]RegionStatus{"status": status},
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if region, ok := resp.Data.(*MonitoringRegion); ok {
+		return region, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// GetCapacity retrieves agent counts and probe load for a monitoring region
+// GET /v1/admin/regions/{code}/capacity
+func (s *RegionsService) GetCapacity(ctx context.Context, code string) (*RegionCapacity, error) {
+	if code == "" {
+		return nil, fmt.Errorf("region code is required")
+	}
+
+	var resp StandardResponse
+	resp.Data = &RegionCapacity{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/admin/regions/%s/capacity", code),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if capacity, ok := resp.Data.(*RegionCapacity); ok {
+		return capacity, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
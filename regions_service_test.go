@@ -146,3 +146,128 @@ func TestRegionsService_List_EmptyResponse(t *testing.T) {
 		t.Errorf("expected 0 regions, got %d", len(regions))
 	}
 }
+
+func TestRegionsService_Admin(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v1/admin/regions":
+			if r.Method == http.MethodGet {
+				w.Write([]byte(`{"status":"success","data":[{"id":1,"code":"us-east-1","status":"active","enabled":true},{"id":2,"code":"eu-west-1","status":"maintenance","enabled":true}],"meta":{"page":1,"total_items":2}}`))
+				return
+			}
+			var req RegionCreateRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("failed to decode request: %v", err)
+			}
+			if req.Code != "ap-south-1" {
+				t.Errorf("expected code ap-south-1, got %s", req.Code)
+			}
+			w.Write([]byte(`{"status":"success","data":{"id":3,"code":"ap-south-1","name":"Asia Pacific (Mumbai)","status":"active","enabled":true}}`))
+		case "/v1/admin/regions/ap-south-1/status":
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request: %v", err)
+			}
+			if body["status"] != "maintenance" {
+				t.Errorf("expected status maintenance, got %s", body["status"])
+			}
+			w.Write([]byte(`{"status":"success","data":{"id":3,"code":"ap-south-1","status":"maintenance"}}`))
+		case "/v1/admin/regions/ap-south-1/capacity":
+			w.Write([]byte(`{"status":"success","data":{"region":"ap-south-1","total_agents":4,"healthy_agents":3,"unhealthy_agents":1,"assigned_probes":120,"executions_per_minute":240,"max_executions_per_minute":300,"utilization":80}}`))
+		default:
+			w.Write([]byte(`{"status":"success","data":{"id":3,"code":"ap-south-1","name":"Mumbai","status":"active"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	ctx := context.Background()
+
+	region, err := client.Regions.Create(ctx, &RegionCreateRequest{Code: "ap-south-1", Name: "Asia Pacific (Mumbai)", Enabled: true})
+	if err != nil {
+		t.Fatalf("failed to create region: %v", err)
+	}
+	if region.Code != "ap-south-1" || region.Status != RegionStatusActive {
+		t.Errorf("unexpected region: %+v", region)
+	}
+
+	regions, meta, err := client.Regions.ListAll(ctx, nil)
+	if err != nil {
+		t.Fatalf("failed to list regions: %v", err)
+	}
+	if len(regions) != 2 || regions[1].Status != RegionStatusMaintenance || meta.TotalItems != 2 {
+		t.Errorf("unexpected regions: %d, meta %+v", len(regions), meta)
+	}
+
+	if _, err := client.Regions.Get(ctx, "ap-south-1"); err != nil {
+		t.Fatalf("failed to get region: %v", err)
+	}
+
+	name := "Mumbai"
+	updated, err := client.Regions.Update(ctx, "ap-south-1", &RegionUpdateRequest{Name: &name})
+	if err != nil {
+		t.Fatalf("failed to update region: %v", err)
+	}
+	if updated.Name != "Mumbai" {
+		t.Errorf("expected name Mumbai, got %s", updated.Name)
+	}
+
+	region, err = client.Regions.SetStatus(ctx, "ap-south-1", RegionStatusMaintenance)
+	if err != nil {
+		t.Fatalf("failed to set region status: %v", err)
+	}
+	if region.Status != RegionStatusMaintenance {
+		t.Errorf("expected status maintenance, got %s", region.Status)
+	}
+
+	capacity, err := client.Regions.GetCapacity(ctx, "ap-south-1")
+	if err != nil {
+		t.Fatalf("failed to get region capacity: %v", err)
+	}
+	if capacity.HealthyAgents != 3 || capacity.AssignedProbes != 120 || capacity.Utilization != 80 {
+		t.Errorf("unexpected capacity: %+v", capacity)
+	}
+
+	if err := client.Regions.Delete(ctx, "ap-south-1"); err != nil {
+		t.Fatalf("failed to delete region: %v", err)
+	}
+
+	expected := []string{
+		"POST /v1/admin/regions",
+		"GET /v1/admin/regions",
+		"GET /v1/admin/regions/ap-south-1",
+		"PUT /v1/admin/regions/ap-south-1",
+		"PUT /v1/admin/regions/ap-south-1/status",
+		"GET /v1/admin/regions/ap-south-1/capacity",
+		"DELETE /v1/admin/regions/ap-south-1",
+	}
+	if len(requests) != len(expected) {
+		t.Fatalf("expected %d requests, got %v", len(expected), requests)
+	}
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("request %d: expected %s, got %s", i, expected[i], requests[i])
+		}
+	}
+}
+
+func TestRegionsService_SetStatus_Invalid(t *testing.T) {
+	client, err := NewClient(&Config{BaseURL: "http://localhost"})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if _, err := client.Regions.SetStatus(context.Background(), "us-east-1", RegionStatusInactive); err == nil {
+		t.Error("expected error for inactive status")
+	}
+	if _, err := client.Regions.GetCapacity(context.Background(), ""); err == nil {
+		t.Error("expected error for empty region code")
+	}
+}
@@ -0,0 +1,192 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+)
+
+// RemoteClusterCreateRequest represents a request to register a remote cluster
+// that will run a private monitoring agent
+type RemoteClusterCreateRequest struct {
+	Name         string                 `json:"name"`
+	Endpoint     string                 `json:"endpoint,omitempty"`
+	Region       string                 `json:"region"`
+	Capabilities []string               `json:"capabilities,omitempty"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// RemoteClusterUpdateRequest represents a request to update a remote cluster.
+// Only non-nil fields are changed.
+type RemoteClusterUpdateRequest struct {
+	Name         *string                `json:"name,omitempty"`
+	Endpoint     *string                `json:"endpoint,omitempty"`
+	Region       *string                `json:"region,omitempty"`
+	Capabilities []string               `json:"capabilities,omitempty"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// RemoteClusterCredentials represents the agent credentials issued for a remote
+// cluster. The secret is only returned once.
+type RemoteClusterCredentials struct {
+	ClusterID uint        `json:"cluster_id"`
+	KeyID     string      `json:"key_id"`
+	SecretKey string      `json:"secret_key"`
+	FullToken string      `json:"full_token"`
+	RotatedAt *CustomTime `json:"rotated_at,omitempty"`
+	// Previous credentials stop working at this time, leaving a window to roll out the new ones
+	PreviousExpiresAt *CustomTime `json:"previous_expires_at,omitempty"`
+}
+
+// RemoteClusterEnrollment represents everything needed to install a private
+// monitoring agent into a remote cluster
+type RemoteClusterEnrollment struct {
+	ClusterID    uint   `json:"cluster_id"`
+	Namespace    string `json:"namespace"`
+	AgentVersion string `json:"agent_version"`
+	// Manifest is a multi-document Kubernetes YAML manifest, ready for kubectl apply.
+	// It embeds Token as a Secret.
+	Manifest     string      `json:"manifest"`
+	Token        string      `json:"token"` // Single-use enrollment token
+	ExpiresAt    *CustomTime `json:"expires_at,omitempty"`
+	ApplyCommand string      `json:"apply_command,omitempty"` // e.g. kubectl apply -f -
+}
+
+// Create registers a remote cluster
+func (s *RemoteClustersService) Create(ctx context.Context, req *RemoteClusterCreateRequest) (*RemoteCluster, error) {
+	var resp StandardResponse
+	resp.Data = &RemoteCluster{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v1/remote-clusters",
+		Body:   req,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster, ok := resp.Data.(*RemoteCluster); ok {
+		return cluster, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Get retrieves a remote cluster by ID
+func (s *RemoteClustersService) Get(ctx context.Context, id uint) (*RemoteCluster, error) {
+	var resp StandardResponse
+	resp.Data = &RemoteCluster{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/remote-clusters/%d", id),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster, ok := resp.Data.(*RemoteCluster); ok {
+		return cluster, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// List retrieves the organization's remote clusters
+func (s *RemoteClustersService) List(ctx context.Context, opts *ListOptions) ([]*RemoteCluster, *PaginationMeta, error) {
+	var resp PaginatedResponse
+	var clusters []*RemoteCluster
+	resp.Data = &clusters
+
+	req := &Request{
+		Method: "GET",
+		Path:   "/v1/remote-clusters",
+		Result: &resp,
+	}
+
+	if opts != nil {
+		req.Query = opts.ToQuery()
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clusters, resp.Meta, nil
+}
+
+// Update updates a remote cluster
+func (s *RemoteClustersService) Update(ctx context.Context, id uint, req *RemoteClusterUpdateRequest) (*RemoteCluster, error) {
+	var resp StandardResponse
+	resp.Data = &RemoteCluster{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v1/remote-clusters/%d", id),
+		Body:   req,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster, ok := resp.Data.(*RemoteCluster); ok {
+		return cluster, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Delete deletes a remote cluster and revokes its agent credentials
+func (s *RemoteClustersService) Delete(ctx context.Context, id uint) error {
+	var resp StandardResponse
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/remote-clusters/%d", id),
+		Result: &resp,
+	})
+	return err
+}
+
+// RotateCredentials issues new agent credentials for a remote cluster. The
+// previous credentials keep working until PreviousExpiresAt.
+func (s *RemoteClustersService) RotateCredentials(ctx context.Context, id uint) (*RemoteClusterCredentials, error) {
+	var resp StandardResponse
+	resp.Data = &RemoteClusterCredentials{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/remote-clusters/%d/rotate-credentials", id),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if creds, ok := resp.Data.(*RemoteClusterCredentials); ok {
+		return creds, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// GetEnrollmentManifest retrieves a Kubernetes manifest and enrollment token
+// for installing a private monitoring agent into a remote cluster
+func (s *RemoteClustersService) GetEnrollmentManifest(ctx context.Context, id uint) (*RemoteClusterEnrollment, error) {
+	var resp StandardResponse
+	resp.Data = &RemoteClusterEnrollment{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/remote-clusters/%d/enrollment-manifest", id),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if enrollment, ok := resp.Data.(*RemoteClusterEnrollment); ok {
+		return enrollment, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
@@ -0,0 +1,83 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteClustersService(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/v1/remote-clusters":
+			var req RemoteClusterCreateRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "dc-east", req.Name)
+			w.Write([]byte(`{"status":"success","data":{"id":7,"name":"dc-east","region":"us-east-1","status":"pending"}}`))
+		case r.Method == "GET" && r.URL.Path == "/v1/remote-clusters":
+			assert.Equal(t, "2", r.URL.Query().Get("page"))
+			w.Write([]byte(`{"status":"success","data":[{"id":7,"name":"dc-east"}],"meta":{"page":2,"total_items":11}}`))
+		case r.URL.Path == "/v1/remote-clusters/7/rotate-credentials":
+			w.Write([]byte(`{"status":"success","data":{"cluster_id":7,"key_id":"mak_2","secret_key":"s","full_token":"mak_2.s","previous_expires_at":"2026-01-01T00:00:00Z"}}`))
+		case r.URL.Path == "/v1/remote-clusters/7/enrollment-manifest":
+			w.Write([]byte(`{"status":"success","data":{"cluster_id":7,"namespace":"nexmonyx","manifest":"apiVersion: v1\nkind: Namespace\n---\napiVersion: v1\nkind: Secret\n","token":"enroll-123","apply_command":"kubectl apply -f -"}}`))
+		default:
+			w.Write([]byte(`{"status":"success","data":{"id":7,"name":"dc-east-2","region":"us-east-1","status":"connected"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	cluster, err := client.RemoteClusters.Create(ctx, &RemoteClusterCreateRequest{Name: "dc-east", Region: "us-east-1"})
+	require.NoError(t, err)
+	assert.Equal(t, uint(7), cluster.ID)
+
+	clusters, meta, err := client.RemoteClusters.List(ctx, &ListOptions{Page: 2})
+	require.NoError(t, err)
+	require.Len(t, clusters, 1)
+	assert.Equal(t, 11, meta.TotalItems)
+
+	cluster, err = client.RemoteClusters.Get(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "connected", cluster.Status)
+
+	name := "dc-east-2"
+	cluster, err = client.RemoteClusters.Update(ctx, 7, &RemoteClusterUpdateRequest{Name: &name})
+	require.NoError(t, err)
+	assert.Equal(t, "dc-east-2", cluster.Name)
+
+	creds, err := client.RemoteClusters.RotateCredentials(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "mak_2.s", creds.FullToken)
+	require.NotNil(t, creds.PreviousExpiresAt)
+	assert.Equal(t, 2026, creds.PreviousExpiresAt.Year())
+
+	enrollment, err := client.RemoteClusters.GetEnrollmentManifest(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "enroll-123", enrollment.Token)
+	assert.Contains(t, enrollment.Manifest, "kind: Secret")
+
+	require.NoError(t, client.RemoteClusters.Delete(ctx, 7))
+
+	assert.Equal(t, []string{
+		"POST /v1/remote-clusters",
+		"GET /v1/remote-clusters",
+		"GET /v1/remote-clusters/7",
+		"PUT /v1/remote-clusters/7",
+		"POST /v1/remote-clusters/7/rotate-credentials",
+		"GET /v1/remote-clusters/7/enrollment-manifest",
+		"DELETE /v1/remote-clusters/7",
+	}, requests)
+}
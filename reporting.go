@@ -1,8 +1,11 @@
+//go:build !nexmonyx_agent
+
 package nexmonyx
 
 import (
 	"context"
 	"fmt"
+	"io"
 )
 
 // ReportingService handles report generation and scheduling operations
@@ -124,6 +127,20 @@ func (s *ReportingService) DownloadReport(ctx context.Context, reportID uint) ([
 	return resp.Body, nil
 }
 
+// DownloadReportTo streams the generated report file to w without buffering it in memory
+// Authentication: JWT Token required
+// Endpoint: GET /v1/reports/{id}/download
+// Parameters:
+//   - reportID: Report ID
+//   - w: Destination for the report content (e.g. an *os.File)
+// Returns: Number of bytes written
+func (s *ReportingService) DownloadReportTo(ctx context.Context, reportID uint, w io.Writer) (int64, error) {
+	return s.client.download(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/reports/%d/download", reportID),
+	}, w)
+}
+
 // ScheduleReport creates a scheduled report with recurring execution
 // Authentication: JWT Token required
 // Endpoint: POST /v1/reports/schedule
@@ -702,3 +719,65 @@ func (s *ReportingService) GetUptimeReport(ctx context.Context, opts *QuickRepor
 
 	return resp.Data, nil
 }
+
+// ReportOperation returns an Operation that tracks generation of a report.
+// Progress is read from GetReportStatus; the full Report is fetched once generation finishes.
+//
+// Example:
+//
+//	report, _ := client.Reporting.GenerateReport(ctx, config)
+//	completed, err := client.Reporting.ReportOperation(report.ID, nil).Wait(ctx)
+func (s *ReportingService) ReportOperation(reportID uint, opts *OperationOptions) *Operation[Report] {
+	return NewOperation(fmt.Sprintf("report-%d", reportID), func(ctx context.Context) (*Report, OperationStatus, error) {
+		reportStatus, err := s.GetReportStatus(ctx, reportID)
+		if err != nil {
+			return nil, OperationStatus{}, err
+		}
+		if reportStatus == nil {
+			return nil, OperationStatus{}, ErrUnexpectedResponse
+		}
+
+		status := OperationStatus{
+			State:    normalizeOperationState(reportStatus.Status),
+			Progress: reportStatus.Progress,
+			Message:  reportStatus.Message,
+		}
+		if reportStatus.Error != "" {
+			status.Message = reportStatus.Error
+		}
+		if !status.IsTerminal() {
+			return nil, status, nil
+		}
+
+		report, err := s.GetReport(ctx, reportID)
+		if err != nil {
+			return nil, OperationStatus{}, err
+		}
+		return report, status, nil
+	}, opts)
+}
+
+// WaitForReportCompletion polls a report until generation finishes or ctx is done
+// Parameters:
+//   - reportID: Report ID returned by GenerateReport
+//   - opts: Optional polling configuration (interval, backoff, progress callback)
+// Returns: Completed Report, or an *OperationError if generation failed
+//
+// Example:
+//
+//	report, _ := client.Reporting.GenerateReport(ctx, config)
+//	completed, err := client.Reporting.WaitForReportCompletion(ctx, report.ID, nil)
+//	if err == nil {
+//	    f, _ := os.Create("report.pdf")
+//	    defer f.Close()
+//	    _, err = client.Reporting.DownloadReportTo(ctx, completed.ID, f)
+//	}
+func (s *ReportingService) WaitForReportCompletion(ctx context.Context, reportID uint, opts *OperationOptions) (*Report, error) {
+	return s.ReportOperation(reportID, opts).Wait(ctx)
+}
+
+// WaitForReport waits for a report started with Reporting.GenerateReport to
+// finish generating and returns the completed report
+func (c *Client) WaitForReport(ctx context.Context, reportID uint, opts *PollOptions) (*Report, error) {
+	return waitForResult(ctx, c, c.Reporting.ReportOperation(reportID, nil), opts)
+}
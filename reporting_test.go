@@ -1,10 +1,15 @@
+//go:build !nexmonyx_agent
+
 package nexmonyx
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -194,6 +199,91 @@ func TestReportingService_DownloadReport(t *testing.T) {
 	assert.Equal(t, expectedContent, content)
 }
 
+func TestReportingService_DownloadReportTo(t *testing.T) {
+	expectedContent := []byte("month,servers,cost\n2026-09,42,1234.56\n")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/reports/7/download", r.URL.Path)
+		assert.NotEmpty(t, r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.WriteHeader(http.StatusOK)
+		w.Write(expectedContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	written, err := client.Reporting.DownloadReportTo(context.Background(), 7, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(expectedContent)), written)
+	assert.Equal(t, expectedContent, buf.Bytes())
+}
+
+func TestReportingService_DownloadReportTo_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status":"error","message":"report not found"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	_, err = client.Reporting.DownloadReportTo(context.Background(), 404, &buf)
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+	assert.Zero(t, buf.Len())
+}
+
+func TestReportingService_WaitForReportCompletion(t *testing.T) {
+	var statusCalls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/reports/3/status":
+			statusCalls++
+			status := ReportStatus{ReportID: 3, Status: "generating", Progress: 40}
+			if statusCalls > 2 {
+				status = ReportStatus{ReportID: 3, Status: "failed", Error: "no data for period"}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": status})
+		case "/v1/reports/3":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": Report{ID: 3, Status: "failed", CreatedAt: CustomTime{Time: time.Now()}},
+			})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	var progress []string
+	_, err = client.Reporting.WaitForReportCompletion(context.Background(), 3, &OperationOptions{
+		PollInterval: time.Millisecond,
+		OnProgress:   func(s OperationStatus) { progress = append(progress, s.State) },
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no data for period")
+	assert.Equal(t, []string{OperationStateRunning, OperationStateRunning, OperationStateFailed}, progress)
+}
+
 func TestReportingService_ScheduleReport(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "POST", r.Method)
@@ -384,3 +474,87 @@ func TestReportingService_ErrorHandling(t *testing.T) {
 		})
 	}
 }
+
+func TestEventBus_Download(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("report-bytes"))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, nil, Config{BaseURL: server.URL, RetryCount: 3})
+
+	var ends []RequestEvent
+	client.Events().OnRequestEnd(func(e RequestEvent) { ends = append(ends, e) })
+
+	_, err := client.Reporting.DownloadReportTo(context.Background(), 7, io.Discard)
+	require.NoError(t, err)
+
+	require.Len(t, ends, 1)
+	assert.Equal(t, "/v1/reports/7/download", ends[0].Path)
+	assert.Equal(t, http.StatusOK, ends[0].StatusCode)
+}
+
+func TestReportingService_ReportOperation(t *testing.T) {
+	var statusCalls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/reports/42/status":
+			status := "generating"
+			if atomic.AddInt32(&statusCalls, 1) > 1 {
+				status = "completed"
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": ReportStatus{ReportID: 42, Status: status, Progress: 50},
+			})
+		case "/v1/reports/42":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": Report{ID: 42, Status: "completed", FileURL: "https://files.example.com/42.pdf", CreatedAt: CustomTime{Time: time.Now()}},
+			})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	report, err := client.Reporting.ReportOperation(42, fastOperationOptions()).Wait(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint(42), report.ID)
+	assert.Equal(t, "https://files.example.com/42.pdf", report.FileURL)
+}
+
+func TestClient_WaitForReport(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/reports/9/status":
+			status := ReportStatus{ReportID: 9, Status: "generating", Progress: 50}
+			if polls.Add(1) > 1 {
+				status = ReportStatus{ReportID: 9, Status: "completed", Progress: 100}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "data": status})
+		case "/v1/reports/9":
+			w.Write([]byte(`{"status":"success","data":{"id":9,"name":"Monthly uptime","status":"completed"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	var progress []int
+	report, err := client.WaitForReport(context.Background(), 9, &PollOptions{
+		Interval:   time.Millisecond,
+		OnProgress: func(s OperationStatus) { progress = append(progress, s.Progress) },
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Monthly uptime", report.Name)
+	assert.Equal(t, []int{50, 100}, progress)
+}
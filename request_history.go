@@ -0,0 +1,120 @@
+package nexmonyx
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRequestHistorySize = 50
+	maxRequestSummaryError    = 512
+)
+
+// RequestSummary is a redacted record of a completed API request
+type RequestSummary struct {
+	Time       time.Time     `json:"time"`
+	Method     string        `json:"method"`
+	Path       string        `json:"path"`
+	StatusCode int           `json:"status_code,omitempty"`
+	Attempts   int           `json:"attempts,omitempty"`
+	Duration   time.Duration `json:"duration_ns"`
+	Error      string        `json:"error,omitempty"` // Credentials are replaced with [REDACTED]
+}
+
+// requestHistory keeps the most recent request summaries in a fixed-size ring buffer
+type requestHistory struct {
+	mu      sync.Mutex
+	entries []RequestSummary
+	next    int
+	full    bool
+}
+
+// newRequestHistory returns a history holding size entries, or nil when size is not positive
+func newRequestHistory(size int) *requestHistory {
+	if size <= 0 {
+		return nil
+	}
+	return &requestHistory{entries: make([]RequestSummary, size)}
+}
+
+func (h *requestHistory) add(summary RequestSummary) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.entries[h.next] = summary
+	h.next = (h.next + 1) % len(h.entries)
+	if h.next == 0 {
+		h.full = true
+	}
+}
+
+// snapshot returns the recorded summaries, oldest first
+func (h *requestHistory) snapshot() []RequestSummary {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.full {
+		out := make([]RequestSummary, h.next)
+		copy(out, h.entries[:h.next])
+		return out
+	}
+
+	out := make([]RequestSummary, 0, len(h.entries))
+	out = append(out, h.entries[h.next:]...)
+	return append(out, h.entries[:h.next]...)
+}
+
+// History returns summaries of the client's most recent requests, oldest first,
+// with credentials redacted from error messages. It returns nil when
+// Config.RequestHistorySize is negative. Each client keeps its own history;
+// clients derived with WithToken and similar methods start empty.
+func (c *Client) History() []RequestSummary {
+	if c.history == nil {
+		return nil
+	}
+	return c.history.snapshot()
+}
+
+// recordRequest adds a completed request to the client's history
+func (c *Client) recordRequest(event RequestEvent) {
+	if c.history == nil {
+		return
+	}
+
+	summary := RequestSummary{
+		Time:       time.Now().UTC(),
+		Method:     event.Method,
+		Path:       event.Path,
+		StatusCode: event.StatusCode,
+		Attempts:   event.Attempt,
+		Duration:   event.Duration,
+	}
+	if event.Err != nil {
+		summary.Error = c.config.Auth.redact(event.Err.Error())
+		if len(summary.Error) > maxRequestSummaryError {
+			summary.Error = summary.Error[:maxRequestSummaryError] + "..."
+		}
+	}
+
+	c.history.add(summary)
+}
+
+// redact replaces every configured credential in s with [REDACTED]
+func (a AuthConfig) redact(s string) string {
+	for _, secret := range []string{
+		a.Token,
+		a.UnifiedAPIKey,
+		a.APIKeySecret,
+		a.APIKey,
+		a.APISecret,
+		a.ServerSecret,
+		a.MonitoringKey,
+		a.RegistrationKey,
+	} {
+		if secret != "" {
+			s = strings.ReplaceAll(s, secret, "[REDACTED]")
+		}
+	}
+	return s
+}
@@ -0,0 +1,91 @@
+package nexmonyx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestHistory_KeepsMostRecent(t *testing.T) {
+	h := newRequestHistory(3)
+	assert.Empty(t, h.snapshot())
+
+	for _, path := range []string{"/a", "/b", "/c", "/d", "/e"} {
+		h.add(RequestSummary{Path: path})
+	}
+
+	var paths []string
+	for _, s := range h.snapshot() {
+		paths = append(paths, s.Path)
+	}
+	assert.Equal(t, []string{"/c", "/d", "/e"}, paths)
+}
+
+func TestClient_History(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/denied" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"message":"bad token secret-token"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:            server.URL,
+		Auth:               AuthConfig{Token: "secret-token"},
+		RequestHistorySize: 2,
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, client.History())
+	assert.Empty(t, client.History())
+
+	for _, path := range []string{"/v1/first", "/v1/second", "/v1/denied"} {
+		client.Do(context.Background(), &Request{Method: "GET", Path: path})
+	}
+
+	history := client.History()
+	require.Len(t, history, 2)
+	assert.Equal(t, "/v1/second", history[0].Path)
+	assert.Equal(t, http.StatusOK, history[0].StatusCode)
+	assert.Equal(t, 1, history[0].Attempts)
+	assert.Greater(t, history[0].Duration.Nanoseconds(), int64(0))
+
+	assert.Equal(t, "/v1/denied", history[1].Path)
+	assert.Equal(t, http.StatusUnauthorized, history[1].StatusCode)
+	assert.Contains(t, history[1].Error, "[REDACTED]")
+	assert.NotContains(t, history[1].Error, "secret-token")
+
+	history[0].Path = "modified"
+	assert.Equal(t, "/v1/second", client.History()[0].Path, "History must return a copy")
+}
+
+func TestClient_History_Disabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, RequestHistorySize: -1})
+	require.NoError(t, err)
+
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/servers"})
+	require.NoError(t, err)
+	assert.Nil(t, client.History())
+
+	_, err = CollectSupportBundle(client)
+	assert.NoError(t, err)
+}
+
+func TestClient_History_DefaultSize(t *testing.T) {
+	client, err := NewClient(&Config{})
+	require.NoError(t, err)
+	assert.Equal(t, defaultRequestHistorySize, client.Config().RequestHistorySize)
+}
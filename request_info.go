@@ -0,0 +1,134 @@
+package nexmonyx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RequestIDHeader is the response header carrying the ID the API assigned to
+// a request. Quote it in support tickets.
+const RequestIDHeader = "X-Request-ID"
+
+// RequestInfo identifies the request an error was returned for
+type RequestInfo struct {
+	Method     string `json:"method"`
+	Path       string `json:"path"`
+	Attempts   int    `json:"attempts"`              // Including retries
+	StatusCode int    `json:"status_code,omitempty"` // Zero when no response was received
+	RequestID  string `json:"request_id,omitempty"`  // From the RequestIDHeader of the last response
+}
+
+// String formats the request as "GET /v1/servers (3 attempts, request ID abc)"
+func (i *RequestInfo) String() string {
+	var details []string
+	if i.Attempts == 1 {
+		details = append(details, "1 attempt")
+	} else if i.Attempts > 1 {
+		details = append(details, fmt.Sprintf("%d attempts", i.Attempts))
+	}
+	if i.RequestID != "" {
+		details = append(details, "request ID "+i.RequestID)
+	}
+	if len(details) == 0 {
+		return i.Method + " " + i.Path
+	}
+	return fmt.Sprintf("%s %s (%s)", i.Method, i.Path, strings.Join(details, ", "))
+}
+
+// RequestInfoFromError returns the request err was returned for, or nil when
+// err did not come from an API request. Errors wrapped with fmt.Errorf are
+// unwrapped.
+//
+// Example:
+//
+//	if _, err := client.Servers.GetByUUID(ctx, uuid); err != nil {
+//	    if info := nexmonyx.RequestInfoFromError(err); info != nil {
+//	        log.Printf("%s failed: %v", info, err)
+//	    }
+//	}
+func RequestInfoFromError(err error) *RequestInfo {
+	var carrier interface{ RequestInfo() *RequestInfo }
+	if errors.As(err, &carrier) {
+		return carrier.RequestInfo()
+	}
+	return nil
+}
+
+// RequestError is returned when a request could not be completed, for example
+// because the connection failed or the context deadline was exceeded. The
+// underlying error is available with errors.Is and errors.As.
+type RequestError struct {
+	Info RequestInfo
+	Err  error
+}
+
+// Error implements the error interface
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("request failed: %s: %v", e.Info.String(), e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// RequestInfo returns the request that failed
+func (e *RequestError) RequestInfo() *RequestInfo {
+	return &e.Info
+}
+
+// requestInfo is embedded in the SDK error types returned for API error
+// responses so that they carry the request they were returned for
+type requestInfo struct {
+	info *RequestInfo
+}
+
+// RequestInfo returns the request the error was returned for, or nil when the
+// error was not returned by the client
+func (r requestInfo) RequestInfo() *RequestInfo {
+	return r.info
+}
+
+func (r *requestInfo) setRequestInfo(info *RequestInfo) {
+	r.info = info
+}
+
+// newRequestInfo describes req and the last attempt's response, which may be nil
+func newRequestInfo(req *Request, resp *resty.Response) *RequestInfo {
+	info := &RequestInfo{Method: req.Method, Path: req.Path}
+	if resp == nil {
+		return info
+	}
+	if resp.Request != nil {
+		info.Attempts = resp.Request.Attempt
+	}
+	if resp.RawResponse != nil {
+		info.StatusCode = resp.StatusCode()
+		info.RequestID = resp.Header().Get(RequestIDHeader)
+	}
+	return info
+}
+
+// requestError wraps an error returned by resty with the request it was
+// returned for
+func requestError(req *Request, resp *resty.Response, err error) error {
+	return &RequestError{Info: *newRequestInfo(req, resp), Err: err}
+}
+
+// withRequestInfo attaches the request to an SDK error type built from an
+// error response
+func withRequestInfo(err error, req *Request, resp *resty.Response) error {
+	setter, ok := err.(interface{ setRequestInfo(*RequestInfo) })
+	if !ok {
+		return err
+	}
+	info := newRequestInfo(req, resp)
+	if apiErr, ok := err.(*APIError); ok && info.RequestID == "" {
+		info.RequestID = apiErr.RequestID
+	}
+	setter.setRequestInfo(info)
+	return err
+}
@@ -0,0 +1,76 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestInfo_ErrorResponse(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RequestIDHeader, "req-404")
+		w.WriteHeader(http.StatusNotFound)
+	}), Config{RetryCount: 2})
+
+	_, err := client.Servers.GetByUUID(context.Background(), "missing")
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err), "typed errors are not wrapped")
+
+	info := RequestInfoFromError(fmt.Errorf("lookup: %w", err))
+	require.NotNil(t, info)
+	assert.Equal(t, "GET", info.Method)
+	assert.Equal(t, "/v1/server/missing/details", info.Path)
+	assert.Equal(t, 1, info.Attempts)
+	assert.Equal(t, http.StatusNotFound, info.StatusCode)
+	assert.Equal(t, "req-404", info.RequestID)
+	assert.Equal(t, "GET /v1/server/missing/details (1 attempt, request ID req-404)", info.String())
+}
+
+func TestRequestInfo_RetriedServerError(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"status":"error","error":"unavailable","error_code":"MAINTENANCE","message":"down for maintenance","request_id":"req-body"}`))
+	}), Config{RetryCount: 2})
+
+	_, err := client.Do(context.Background(), &Request{Method: "POST", Path: "/v1/metrics"})
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+
+	info := apiErr.RequestInfo()
+	require.NotNil(t, info)
+	assert.Equal(t, 3, info.Attempts)
+	assert.Equal(t, "req-body", info.RequestID, "falls back to the request ID in the body")
+}
+
+func TestRequestInfo_Timeout(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RequestIDHeader, "req-slow")
+		<-r.Context().Done()
+	}), Config{RetryCount: 2})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.Do(ctx, &Request{Method: "GET", Path: "/v1/slow"})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	var reqErr *RequestError
+	require.ErrorAs(t, err, &reqErr)
+	assert.Equal(t, "GET", reqErr.Info.Method)
+	assert.Equal(t, "/v1/slow", reqErr.Info.Path)
+	assert.Zero(t, reqErr.Info.StatusCode)
+	assert.Contains(t, err.Error(), "request failed: GET /v1/slow")
+}
+
+func TestRequestInfoFromError_Unrelated(t *testing.T) {
+	assert.Nil(t, RequestInfoFromError(nil))
+	assert.Nil(t, RequestInfoFromError(errors.New("boom")))
+	assert.Nil(t, RequestInfoFromError(&NotFoundError{Message: "not found"}))
+}
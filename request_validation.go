@@ -0,0 +1,378 @@
+package nexmonyx
+
+import (
+	"fmt"
+	"time"
+)
+
+// Validation issue severities
+const (
+	ValidationSeverityError   = "error"   // The API would reject the request
+	ValidationSeverityWarning = "warning" // Accepted, but the value looks wrong
+)
+
+// ValidationIssue describes a problem found by a request's local Validate method
+type ValidationIssue struct {
+	Field    string `json:"field"` // Field path, e.g. "disks[0].used_bytes"
+	Message  string `json:"message"`
+	Severity string `json:"severity"`
+}
+
+// String returns the field path followed by the message
+func (i ValidationIssue) String() string {
+	return i.Field + " " + i.Message
+}
+
+// HasValidationErrors returns true if any issue has error severity
+func HasValidationErrors(issues []ValidationIssue) bool {
+	for _, issue := range issues {
+		if issue.Severity == ValidationSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// issueValidator is implemented by requests with a local Validate method
+type issueValidator interface {
+	Validate() []ValidationIssue
+}
+
+type validationIssues []ValidationIssue
+
+func (v *validationIssues) addError(field, format string, args ...interface{}) {
+	*v = append(*v, ValidationIssue{Field: field, Message: fmt.Sprintf(format, args...), Severity: ValidationSeverityError})
+}
+
+func (v *validationIssues) addWarning(field, format string, args ...interface{}) {
+	*v = append(*v, ValidationIssue{Field: field, Message: fmt.Sprintf(format, args...), Severity: ValidationSeverityWarning})
+}
+
+func (v *validationIssues) percent(field string, value float64) {
+	if value < 0 || value > 100 {
+		v.addError(field, "must be between 0 and 100")
+	}
+}
+
+func (v *validationIssues) nonNegative(field string, value int64) {
+	if value < 0 {
+		v.addError(field, "must not be negative")
+	}
+}
+
+// notAbove reports value exceeding limit. A zero limit is treated as unknown.
+func (v *validationIssues) notAbove(field string, value int64, limitField string, limit int64) {
+	if limit > 0 && value > limit {
+		v.addError(field, "must not exceed %s", limitField)
+	}
+}
+
+// validationResult converts issues into a local PayloadValidationResult
+func validationResult(issues []ValidationIssue) *PayloadValidationResult {
+	r := &PayloadValidationResult{Valid: true, Local: true}
+	for _, issue := range issues {
+		if issue.Severity == ValidationSeverityError {
+			r.addError(issue.Field, "%s", issue.Message)
+		} else {
+			r.addWarning(issue.Field, "%s", issue.Message)
+		}
+	}
+	return r
+}
+
+// Validate checks the payload locally for problems the API would reject:
+// missing fields, malformed timestamps, out-of-range percentages, negative
+// byte counts, and used values exceeding totals. It does not contact the API;
+// use Metrics.Validate for the server's checks.
+//
+// Example:
+//
+//	issues := metrics.Validate()
+//	if nexmonyx.HasValidationErrors(issues) {
+//	    for _, issue := range issues {
+//	        log.Printf("%s: %s", issue.Severity, issue)
+//	    }
+//	    return
+//	}
+func (m *ComprehensiveMetricsRequest) Validate() []ValidationIssue {
+	var v validationIssues
+
+	if m.ServerUUID == "" {
+		v.addError("server_uuid", "is required")
+	}
+	if m.CollectedAt == "" {
+		v.addError("collected_at", "is required")
+	} else if collectedAt, err := time.Parse(time.RFC3339, m.CollectedAt); err != nil {
+		v.addError("collected_at", "must be an RFC3339 timestamp")
+	} else if time.Until(collectedAt) > 5*time.Minute {
+		v.addWarning("collected_at", "is more than 5 minutes in the future")
+	}
+
+	if cpu := m.CPU; cpu != nil {
+		v.percent("cpu.usage_percent", cpu.UsagePercent)
+		v.percent("cpu.user_percent", cpu.UserPercent)
+		v.percent("cpu.system_percent", cpu.SystemPercent)
+		v.percent("cpu.idle_percent", cpu.IdlePercent)
+		v.percent("cpu.iowait_percent", cpu.IOWaitPercent)
+		v.percent("cpu.steal_percent", cpu.StealPercent)
+		for i, usage := range cpu.PerCoreUsage {
+			v.percent(fmt.Sprintf("cpu.per_core_usage[%d]", i), usage)
+		}
+		if cpu.CoreCount < 0 {
+			v.addError("cpu.core_count", "must not be negative")
+		}
+		if cpu.ThreadCount < 0 {
+			v.addError("cpu.thread_count", "must not be negative")
+		}
+		if cpu.LoadAverage1 < 0 || cpu.LoadAverage5 < 0 || cpu.LoadAverage15 < 0 {
+			v.addError("cpu.load_average", "must not be negative")
+		}
+		if cpu.CoreCount > 0 && len(cpu.PerCoreUsage) > cpu.CoreCount {
+			v.addWarning("cpu.per_core_usage", "has more entries than core_count")
+		}
+	}
+
+	if mem := m.Memory; mem != nil {
+		v.percent("memory.usage_percent", mem.UsagePercent)
+		v.percent("memory.swap_usage_percent", mem.SwapUsagePercent)
+		v.nonNegative("memory.total_bytes", mem.TotalBytes)
+		v.nonNegative("memory.used_bytes", mem.UsedBytes)
+		v.nonNegative("memory.free_bytes", mem.FreeBytes)
+		v.nonNegative("memory.available_bytes", mem.AvailableBytes)
+		v.nonNegative("memory.buffers_bytes", mem.BuffersBytes)
+		v.nonNegative("memory.cached_bytes", mem.CachedBytes)
+		v.nonNegative("memory.swap_total_bytes", mem.SwapTotalBytes)
+		v.nonNegative("memory.swap_used_bytes", mem.SwapUsedBytes)
+		v.nonNegative("memory.swap_free_bytes", mem.SwapFreeBytes)
+		if mem.UsedBytes > mem.TotalBytes {
+			v.addError("memory.used_bytes", "must not exceed total_bytes")
+		}
+		v.notAbove("memory.available_bytes", mem.AvailableBytes, "total_bytes", mem.TotalBytes)
+		if mem.SwapUsedBytes > mem.SwapTotalBytes {
+			v.addError("memory.swap_used_bytes", "must not exceed swap_total_bytes")
+		}
+	}
+
+	for i, disk := range m.Disks {
+		field := fmt.Sprintf("disks[%d]", i)
+		if disk.Device == "" {
+			v.addError(field+".device", "is required")
+		}
+		if disk.Mountpoint == "" {
+			v.addWarning(field+".mountpoint", "is empty")
+		}
+		v.percent(field+".usage_percent", disk.UsagePercent)
+		v.percent(field+".inodes_usage_percent", disk.InodesUsagePercent)
+		v.nonNegative(field+".total_bytes", disk.TotalBytes)
+		v.nonNegative(field+".used_bytes", disk.UsedBytes)
+		v.nonNegative(field+".free_bytes", disk.FreeBytes)
+		v.nonNegative(field+".inodes_total", disk.InodesTotal)
+		v.nonNegative(field+".inodes_used", disk.InodesUsed)
+		v.nonNegative(field+".inodes_free", disk.InodesFree)
+		if disk.UsedBytes > disk.TotalBytes {
+			v.addError(field+".used_bytes", "must not exceed total_bytes")
+		}
+		v.notAbove(field+".free_bytes", disk.FreeBytes, "total_bytes", disk.TotalBytes)
+		v.notAbove(field+".inodes_used", disk.InodesUsed, "inodes_total", disk.InodesTotal)
+	}
+
+	for i, nic := range m.Network {
+		field := fmt.Sprintf("network[%d]", i)
+		if nic.Interface == "" {
+			v.addError(field+".interface", "is required")
+		}
+		v.nonNegative(field+".bytes_recv", nic.BytesRecv)
+		v.nonNegative(field+".bytes_sent", nic.BytesSent)
+		v.nonNegative(field+".packets_recv", nic.PacketsRecv)
+		v.nonNegative(field+".packets_sent", nic.PacketsSent)
+		v.nonNegative(field+".errors_in", nic.ErrorsIn)
+		v.nonNegative(field+".errors_out", nic.ErrorsOut)
+		v.nonNegative(field+".drops_in", nic.DropsIn)
+		v.nonNegative(field+".drops_out", nic.DropsOut)
+
+		if bond := nic.Bond; bond != nil {
+			activeFound := bond.ActiveSlave == ""
+			for j, slave := range bond.Slaves {
+				v.nonNegative(fmt.Sprintf("%s.bond.slaves[%d].link_failure_count", field, j), slave.LinkFailureCount)
+				if slave.Interface == bond.ActiveSlave {
+					activeFound = true
+				}
+			}
+			if !activeFound {
+				v.addError(field+".bond.active_slave", "must be one of the bond's slaves")
+			}
+			if len(bond.Slaves) == 0 {
+				v.addWarning(field+".bond.slaves", "is empty")
+			}
+		}
+		if vlan := nic.VLAN; vlan != nil {
+			if vlan.ID < 1 || vlan.ID > 4094 {
+				v.addError(field+".vlan.id", "must be between 1 and 4094")
+			}
+			if vlan.Parent == "" {
+				v.addError(field+".vlan.parent", "is required")
+			}
+		}
+	}
+
+	for i, gpu := range m.GPU {
+		field := fmt.Sprintf("gpu[%d]", i)
+		v.percent(field+".utilization_percent", gpu.UtilizationPercent)
+		v.percent(field+".memory_utilization_percent", gpu.MemoryUtilizationPercent)
+		v.percent(field+".fan_speed_percent", gpu.FanSpeedPercent)
+		v.nonNegative(field+".memory_total_bytes", gpu.MemoryTotalBytes)
+		v.nonNegative(field+".memory_used_bytes", gpu.MemoryUsedBytes)
+		v.notAbove(field+".memory_used_bytes", gpu.MemoryUsedBytes, "memory_total_bytes", gpu.MemoryTotalBytes)
+		if gpu.PowerDrawWatts < 0 {
+			v.addError(field+".power_draw_watts", "must not be negative")
+		}
+		for j, proc := range gpu.Processes {
+			if proc.PID <= 0 {
+				v.addError(fmt.Sprintf("%s.processes[%d].pid", field, j), "must be positive")
+			}
+			v.nonNegative(fmt.Sprintf("%s.processes[%d].memory_used_bytes", field, j), proc.MemoryUsedBytes)
+		}
+	}
+
+	for i, proc := range m.Processes {
+		field := fmt.Sprintf("processes[%d]", i)
+		if proc.PID <= 0 {
+			v.addError(field+".pid", "must be positive")
+		}
+		// CPU percent is per core and may exceed 100 on multi-core hosts
+		if proc.CPUPercent < 0 {
+			v.addError(field+".cpu_percent", "must not be negative")
+		}
+		v.percent(field+".memory_percent", proc.MemoryPercent)
+		v.nonNegative(field+".memory_rss", proc.MemoryRSS)
+		v.nonNegative(field+".memory_vms", proc.MemoryVMS)
+		if proc.ParentPID < 0 {
+			v.addError(field+".parent_pid", "must not be negative")
+		}
+	}
+
+	for i, group := range m.ProcessGroups {
+		field := fmt.Sprintf("process_groups[%d]", i)
+		if group.Cgroup == "" && group.ContainerID == "" {
+			v.addError(field, "cgroup or container_id is required")
+		}
+		if group.CPUPercent < 0 {
+			v.addError(field+".cpu_percent", "must not be negative")
+		}
+		v.nonNegative(field+".memory_rss", group.MemoryRSS)
+	}
+
+	for i, container := range m.Containers {
+		field := fmt.Sprintf("containers[%d]", i)
+		if container.ID == "" {
+			v.addError(field+".id", "is required")
+		}
+		if container.CPUPercent < 0 {
+			v.addError(field+".cpu_percent", "must not be negative")
+		}
+		v.nonNegative(field+".restart_count", int64(container.RestartCount))
+		v.nonNegative(field+".memory_usage_bytes", container.MemoryUsageBytes)
+		v.nonNegative(field+".memory_limit_bytes", container.MemoryLimitBytes)
+		v.nonNegative(field+".network_rx_bytes", container.NetworkRxBytes)
+		v.nonNegative(field+".network_tx_bytes", container.NetworkTxBytes)
+		v.notAbove(field+".memory_usage_bytes", container.MemoryUsageBytes, "memory_limit_bytes", container.MemoryLimitBytes)
+	}
+
+	return v
+}
+
+// Validate checks the inventory locally for missing fields, negative
+// capacities, and inconsistent slot and core counts
+func (r *HardwareInventoryRequest) Validate() []ValidationIssue {
+	var v validationIssues
+
+	if r.ServerUUID == "" {
+		v.addError("server_uuid", "is required")
+	}
+	if r.CollectedAt.IsZero() {
+		v.addError("collected_at", "is required")
+	} else if time.Until(r.CollectedAt) > 5*time.Minute {
+		v.addWarning("collected_at", "is more than 5 minutes in the future")
+	}
+
+	hw := r.Hardware
+	for i, cpu := range hw.CPUs {
+		field := fmt.Sprintf("hardware.cpus[%d]", i)
+		if cpu.Cores < 0 {
+			v.addError(field+".cores", "must not be negative")
+		}
+		if cpu.Threads < 0 {
+			v.addError(field+".threads", "must not be negative")
+		} else if cpu.Threads > 0 && cpu.Threads < cpu.Cores {
+			v.addError(field+".threads", "must not be less than cores")
+		}
+		if cpu.BaseSpeedMHz < 0 || cpu.MaxSpeedMHz < 0 {
+			v.addError(field+".speed_mhz", "must not be negative")
+		}
+	}
+
+	if mem := hw.Memory; mem != nil {
+		v.nonNegative("hardware.memory.total_capacity", mem.TotalCapacity)
+		if mem.TotalSlots < 0 {
+			v.addError("hardware.memory.total_slots", "must not be negative")
+		}
+		v.notAbove("hardware.memory.used_slots", int64(mem.UsedSlots), "total_slots", int64(mem.TotalSlots))
+		v.notAbove("hardware.memory.available_slots", int64(mem.AvailableSlots), "total_slots", int64(mem.TotalSlots))
+		if mem.TotalSlots > 0 && mem.UsedSlots+mem.AvailableSlots > mem.TotalSlots {
+			v.addWarning("hardware.memory", "used_slots and available_slots add up to more than total_slots")
+		}
+	}
+
+	for i, module := range hw.MemoryModules {
+		v.nonNegative(fmt.Sprintf("hardware.memory_modules[%d].size", i), module.Size)
+	}
+
+	for i, device := range hw.Storage {
+		v.nonNegative(fmt.Sprintf("hardware.storage[%d].capacity", i), device.Capacity)
+	}
+	for i, device := range hw.StorageDevices {
+		v.nonNegative(fmt.Sprintf("hardware.storage_devices[%d].capacity", i), device.Capacity)
+	}
+
+	return v
+}
+
+// Validate checks the probe locally for missing fields, an unknown type, and
+// an interval or timeout the API would reject
+func (r *ProbeCreateRequest) Validate() []ValidationIssue {
+	var v validationIssues
+
+	if r.Name == "" {
+		v.addError("name", "is required")
+	}
+	if r.Type == "" {
+		v.addError("type", "is required")
+	} else if !r.Type.Valid() {
+		v.addError("type", "must be one of: icmp, http, https, tcp, heartbeat, browser")
+	}
+	if r.Target == "" && r.Type != ProbeTypeHeartbeat {
+		v.addError("target", "is required")
+	}
+	if r.Type == ProbeTypeTCP {
+		if _, ok := r.Configuration["port"]; !ok {
+			v.addWarning("configuration.port", "is not set")
+		}
+	}
+	if steps, ok := r.Configuration["steps"].([]ProbeStep); ok {
+		for _, issue := range ValidateProbeSteps(steps) {
+			issue.Field = "configuration." + issue.Field
+			v = append(v, issue)
+		}
+	}
+
+	if r.Interval <= 0 {
+		v.addError("interval", "must be positive")
+	}
+	if r.Timeout < 0 {
+		v.addError("timeout", "must not be negative")
+	} else if r.Interval > 0 && r.Timeout >= r.Interval {
+		v.addError("timeout", "must be less than interval")
+	}
+
+	return v
+}
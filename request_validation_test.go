@@ -0,0 +1,135 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func issueFields(issues []ValidationIssue, severity string) []string {
+	var fields []string
+	for _, issue := range issues {
+		if issue.Severity == severity {
+			fields = append(fields, issue.Field)
+		}
+	}
+	return fields
+}
+
+func TestComprehensiveMetricsRequest_Validate(t *testing.T) {
+	valid := &ComprehensiveMetricsRequest{
+		ServerUUID:  "srv-1",
+		CollectedAt: time.Now().UTC().Format(time.RFC3339),
+		CPU:         &CPUMetrics{UsagePercent: 42, CoreCount: 4, PerCoreUsage: []float64{10, 20, 30, 40}},
+		Memory:      &MemoryMetrics{TotalBytes: 1024, UsedBytes: 512, AvailableBytes: 512, SwapTotalBytes: 64, SwapUsedBytes: 32},
+		Disks:       []DiskMetrics{{Device: "/dev/sda1", Mountpoint: "/", TotalBytes: 100, UsedBytes: 50, FreeBytes: 50, InodesTotal: 10, InodesUsed: 5}},
+		Network:     []NetworkMetrics{{Interface: "eth0", BytesRecv: 1}},
+		Processes:   []ProcessMetrics{{PID: 1, CPUPercent: 250, MemoryPercent: 1}},
+	}
+	issues := valid.Validate()
+	assert.Empty(t, issues)
+	assert.False(t, HasValidationErrors(issues))
+
+	invalid := &ComprehensiveMetricsRequest{
+		CollectedAt: "yesterday",
+		CPU:         &CPUMetrics{UsagePercent: 101, IOWaitPercent: -1},
+		Memory:      &MemoryMetrics{TotalBytes: 1024, UsedBytes: 2048, SwapTotalBytes: 10, SwapUsedBytes: 20, CachedBytes: -1},
+		Disks:       []DiskMetrics{{Device: "/dev/sda1", TotalBytes: 100, UsedBytes: 50, InodesTotal: 10, InodesUsed: 11}},
+		Network:     []NetworkMetrics{{Interface: "eth0", BytesSent: -5}},
+		Processes:   []ProcessMetrics{{PID: 10, MemoryPercent: 120}},
+	}
+	issues = invalid.Validate()
+	assert.True(t, HasValidationErrors(issues))
+	assert.ElementsMatch(t, []string{
+		"server_uuid",
+		"collected_at",
+		"cpu.usage_percent",
+		"cpu.iowait_percent",
+		"memory.cached_bytes",
+		"memory.used_bytes",
+		"memory.swap_used_bytes",
+		"disks[0].inodes_used",
+		"network[0].bytes_sent",
+		"processes[0].memory_percent",
+	}, issueFields(issues, ValidationSeverityError))
+	assert.Equal(t, []string{"disks[0].mountpoint"}, issueFields(issues, ValidationSeverityWarning))
+	assert.Equal(t, "collected_at must be an RFC3339 timestamp", issues[1].String())
+}
+
+func TestHardwareInventoryRequest_Validate(t *testing.T) {
+	req := &HardwareInventoryRequest{
+		ServerUUID:  "srv-1",
+		CollectedAt: time.Now(),
+		Hardware: HardwareInventoryInfo{
+			CPUs:    []CPUInfo{{Cores: 8, Threads: 16}},
+			Memory:  &MemoryInfo{TotalCapacity: 1 << 34, TotalSlots: 4, UsedSlots: 2, AvailableSlots: 2},
+			Storage: []StorageDeviceInfo{{DeviceName: "nvme0n1", Capacity: 1 << 40}},
+		},
+	}
+	assert.Empty(t, req.Validate())
+
+	req = &HardwareInventoryRequest{
+		Hardware: HardwareInventoryInfo{
+			CPUs:          []CPUInfo{{Cores: 8, Threads: 4}},
+			Memory:        &MemoryInfo{TotalSlots: 2, UsedSlots: 3},
+			MemoryModules: []MemoryModuleInfo{{Size: -1}},
+			Storage:       []StorageDeviceInfo{{Capacity: -1}},
+		},
+	}
+	issues := req.Validate()
+	assert.ElementsMatch(t, []string{
+		"server_uuid",
+		"collected_at",
+		"hardware.cpus[0].threads",
+		"hardware.memory.used_slots",
+		"hardware.memory_modules[0].size",
+		"hardware.storage[0].capacity",
+	}, issueFields(issues, ValidationSeverityError))
+	assert.Equal(t, []string{"hardware.memory"}, issueFields(issues, ValidationSeverityWarning))
+}
+
+func TestProbeCreateRequest_Validate(t *testing.T) {
+	req := &ProbeCreateRequest{Name: "API", Type: "https", Target: "https://example.com", Interval: 60, Timeout: 10}
+	assert.Empty(t, req.Validate())
+
+	req = &ProbeCreateRequest{Name: "Heartbeat", Type: "heartbeat", Interval: 300}
+	assert.Empty(t, req.Validate(), "heartbeat probes have no target")
+
+	req = &ProbeCreateRequest{Type: "ftp", Interval: 30, Timeout: 30}
+	assert.ElementsMatch(t, []string{"name", "type", "target", "timeout"}, issueFields(req.Validate(), ValidationSeverityError))
+
+	req = &ProbeCreateRequest{Name: "DB", Type: "tcp", Target: "db.internal"}
+	issues := req.Validate()
+	assert.Equal(t, []string{"interval"}, issueFields(issues, ValidationSeverityError))
+	assert.Equal(t, []string{"configuration.port"}, issueFields(issues, ValidationSeverityWarning))
+}
+
+func TestDryRun_Local_ValidationIssues(t *testing.T) {
+	client, err := NewClient(&Config{
+		BaseURL: "http://127.0.0.1:0",
+		Auth:    AuthConfig{Token: "test-token"},
+		DryRun:  DryRunLocal,
+	})
+	require.NoError(t, err)
+
+	_, err = client.Do(context.Background(), &Request{
+		Method: "POST",
+		Path:   "/v2/metrics/comprehensive",
+		Body:   &ComprehensiveMetricsRequest{ServerUUID: "srv-1", CollectedAt: "now"},
+	})
+	var validation *ValidationError
+	require.True(t, errors.As(err, &validation))
+	assert.Contains(t, validation.Message, "collected_at")
+	assert.Equal(t, []string{"must be an RFC3339 timestamp"}, validation.Errors["collected_at"])
+
+	_, err = client.Do(context.Background(), &Request{
+		Method: "POST",
+		Path:   "/v2/metrics/comprehensive",
+		Body:   &ComprehensiveMetricsRequest{ServerUUID: "srv-1", CollectedAt: time.Now().Format(time.RFC3339)},
+	})
+	assert.True(t, errors.Is(err, ErrDryRun), "warnings do not block the dry run")
+}
@@ -2,6 +2,7 @@ package nexmonyx
 
 import (
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -61,6 +62,16 @@ type ListOptions struct {
 	TimeRange   string            `url:"time_range,omitempty"`
 	GroupBy     string            `url:"group_by,omitempty"`
 	Aggregation string            `url:"aggregation,omitempty"`
+
+	// Soft-deleted resources are hidden unless requested. Supported when
+	// listing servers, probes, and API keys.
+	IncludeDeleted bool `url:"include_deleted,omitempty"` // List deleted resources alongside the others
+	OnlyDeleted    bool `url:"only_deleted,omitempty"`    // List only deleted resources
+
+	// SortBy orders results by several keys, most significant first, such as
+	// the *Sort* constants. Prefix a key with "-" (see SortDesc) to sort it in
+	// descending order. When set, it replaces Sort and Order.
+	SortBy []string `url:"-"`
 }
 
 // ToQuery converts ListOptions to query parameters
@@ -79,11 +90,15 @@ func (lo *ListOptions) ToQuery() map[string]string {
 	if lo.PerPage > 0 {
 		params["per_page"] = strconv.Itoa(lo.PerPage)
 	}
-	if lo.Sort != "" {
-		params["sort"] = lo.Sort
-	}
-	if lo.Order != "" {
-		params["order"] = lo.Order
+	if len(lo.SortBy) > 0 {
+		params["sort"] = strings.Join(lo.SortBy, ",")
+	} else {
+		if lo.Sort != "" {
+			params["sort"] = lo.Sort
+		}
+		if lo.Order != "" {
+			params["order"] = lo.Order
+		}
 	}
 	if lo.Search != "" {
 		params["search"] = lo.Search
@@ -106,6 +121,20 @@ func (lo *ListOptions) ToQuery() map[string]string {
 	if lo.Aggregation != "" {
 		params["aggregation"] = lo.Aggregation
 	}
+	if len(lo.Fields) > 0 {
+		params["fields"] = strings.Join(lo.Fields, ",")
+	}
+	if len(lo.Expand) > 0 {
+		params["expand"] = strings.Join(lo.Expand, ",")
+	}
+	if len(lo.Include) > 0 {
+		params["include"] = strings.Join(lo.Include, ",")
+	}
+	if lo.OnlyDeleted {
+		params["only_deleted"] = "true"
+	} else if lo.IncludeDeleted {
+		params["include_deleted"] = "true"
+	}
 
 	// Add custom filters
 	for k, v := range lo.Filters {
@@ -723,11 +723,10 @@ func TestListOptions_AllParameters(t *testing.T) {
 	assert.Equal(t, "premium", query["tier"])
 	assert.Equal(t, "us-east-1", query["region"])
 
-	// Array fields (Fields, Expand, Include) are not included in ToQuery
-	// as they use struct tags for URL encoding
-	assert.Len(t, opts.Fields, 4)
-	assert.Len(t, opts.Expand, 3)
-	assert.Len(t, opts.Include, 3)
+	// Array fields (Fields, Expand, Include) are sent comma-separated
+	assert.Equal(t, "id,name,status,uptime", query["fields"])
+	assert.Equal(t, "organization,metrics,alerts", query["expand"])
+	assert.Equal(t, "metadata,tags,history", query["include"])
 }
 
 // TestListOptions_NilAndEmpty tests ListOptions with nil and empty values
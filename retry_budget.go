@@ -0,0 +1,118 @@
+package nexmonyx
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const defaultMinAttemptTimeout = time.Second
+
+type attemptBudgetKey struct{}
+
+// attemptBudget divides the time left before a request's deadline between the
+// attempts that may still be made, so a slow first attempt cannot leave nothing
+// for the retries
+type attemptBudget struct {
+	deadline   time.Time
+	attempts   int           // Total attempts allowed, including the first
+	minTimeout time.Duration // Floor for each attempt's share
+	attempt    atomic.Int32  // Current 1-based attempt, set before each attempt
+}
+
+// timeout returns the share of the remaining deadline for the current attempt.
+// The final attempt gets everything that is left.
+func (b *attemptBudget) timeout(now time.Time) time.Duration {
+	remaining := b.deadline.Sub(now)
+
+	left := b.attempts - int(b.attempt.Load()) + 1
+	if left <= 1 {
+		return remaining
+	}
+
+	share := remaining / time.Duration(left)
+	if share < b.minTimeout {
+		share = b.minTimeout
+	}
+	if share > remaining {
+		share = remaining
+	}
+	return share
+}
+
+// withAttemptBudget attaches a budget to ctx when deadline splitting is enabled
+// and ctx has a deadline
+func (c *Client) withAttemptBudget(ctx context.Context, req *Request) context.Context {
+	if !c.config.SplitDeadlineAcrossRetries {
+		return ctx
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return ctx
+	}
+
+	minTimeout := c.config.MinAttemptTimeout
+	if minTimeout <= 0 {
+		minTimeout = defaultMinAttemptTimeout
+	}
+
+	return context.WithValue(ctx, attemptBudgetKey{}, &attemptBudget{
+		deadline:   deadline,
+		attempts:   c.maxRetries(req.Method, req.Path) + 1,
+		minTimeout: minTimeout,
+	})
+}
+
+func attemptBudgetFrom(ctx context.Context) *attemptBudget {
+	budget, _ := ctx.Value(attemptBudgetKey{}).(*attemptBudget)
+	return budget
+}
+
+// trackAttempt is a resty OnBeforeRequest hook that records which attempt is
+// about to be sent
+func trackAttempt(_ *resty.Client, r *resty.Request) error {
+	if budget := attemptBudgetFrom(r.Context()); budget != nil {
+		budget.attempt.Store(int32(r.Attempt))
+	}
+	return nil
+}
+
+// budgetTransport applies the per-attempt timeout from the request's attempt
+// budget. The timeout is applied below resty so that an attempt timing out is
+// retried rather than treated as the caller's context expiring.
+type budgetTransport struct {
+	base http.RoundTripper
+}
+
+func (t *budgetTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	budget := attemptBudgetFrom(req.Context())
+	if budget == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), budget.timeout(time.Now()))
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The attempt lasts until the body has been read
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
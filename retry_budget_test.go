@@ -0,0 +1,105 @@
+package nexmonyx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSlowFirstAttemptServer stalls the first request until the client gives up
+// on it and answers every later request immediately
+func newSlowFirstAttemptServer(t *testing.T) (*httptest.Server, *int32) {
+	t.Helper()
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestClient_SplitDeadlineAcrossRetries(t *testing.T) {
+	server, calls := newSlowFirstAttemptServer(t)
+
+	client, err := NewClient(&Config{
+		BaseURL:                    server.URL,
+		RetryCount:                 2,
+		RetryWaitTime:              10 * time.Millisecond,
+		RetryMaxWait:               20 * time.Millisecond,
+		SplitDeadlineAcrossRetries: true,
+		MinAttemptTimeout:          50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 900*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = client.Do(ctx, &Request{Method: "GET", Path: "/v1/servers"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+	assert.Less(t, time.Since(start), 600*time.Millisecond, "first attempt should get about a third of the deadline")
+}
+
+func TestClient_DeadlineNotSplitByDefault(t *testing.T) {
+	server, calls := newSlowFirstAttemptServer(t)
+
+	client, err := NewClient(&Config{
+		BaseURL:       server.URL,
+		RetryCount:    2,
+		RetryWaitTime: 10 * time.Millisecond,
+		RetryMaxWait:  20 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+
+	_, err = client.Do(ctx, &Request{Method: "GET", Path: "/v1/servers"})
+	assert.Error(t, err, "the first attempt uses the whole deadline")
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func TestClient_SplitDeadline_DoesNotModifyCallerHTTPClient(t *testing.T) {
+	httpClient := &http.Client{}
+	_, err := NewClient(&Config{HTTPClient: httpClient, SplitDeadlineAcrossRetries: true})
+	require.NoError(t, err)
+	assert.Nil(t, httpClient.Transport)
+}
+
+func TestAttemptBudget_Timeout(t *testing.T) {
+	now := time.Now()
+	budget := &attemptBudget{
+		deadline:   now.Add(9 * time.Second),
+		attempts:   3,
+		minTimeout: time.Second,
+	}
+
+	budget.attempt.Store(1)
+	assert.Equal(t, 3*time.Second, budget.timeout(now))
+
+	budget.attempt.Store(2)
+	assert.Equal(t, 4500*time.Millisecond, budget.timeout(now))
+
+	budget.attempt.Store(3)
+	assert.Equal(t, 9*time.Second, budget.timeout(now), "the final attempt gets all remaining time")
+
+	budget.attempt.Store(1)
+	assert.Equal(t, time.Second, budget.timeout(now.Add(8*time.Second)), "floor applies when little time is left")
+	assert.Equal(t, 500*time.Millisecond, budget.timeout(now.Add(8500*time.Millisecond)), "floor never exceeds the remaining time")
+}
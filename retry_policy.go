@@ -0,0 +1,282 @@
+package nexmonyx
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	// NoRetries disables retries when used as a RetryRule or policy MaxRetries
+	NoRetries = -1
+
+	defaultRetryBudgetWindow = time.Minute
+
+	// policyRetryLimit is the retry count given to resty when a retry policy is
+	// configured; the policy stops retries long before it is reached
+	policyRetryLimit = 100
+)
+
+// RetryAttempt describes a failed attempt passed to a RequestRetryPolicy
+type RetryAttempt struct {
+	Method     string
+	Path       string
+	Attempt    int           // 1-based attempt that failed
+	StatusCode int           // HTTP status code, 0 when no response was received
+	Err        error         // Transport error, nil when a response was received
+	RetryAfter time.Duration // Parsed Retry-After header, 0 when absent
+}
+
+// RequestRetryPolicy decides which failed API requests are retried and how long
+// to wait between attempts. Set it on Config to replace the global RetryCount and
+// RetryWaitTime behavior. Implementations must be safe for concurrent use.
+type RequestRetryPolicy interface {
+	// RetryLimit returns the most retries a request to method and path may make.
+	// The client never asks Retry about attempts beyond this limit.
+	RetryLimit(method, path string) int
+
+	// Retry reports whether the failed attempt should be retried and how long
+	// to wait before the next attempt
+	Retry(attempt RetryAttempt) (wait time.Duration, retry bool)
+}
+
+// RetryRule overrides StandardRetryPolicy settings for matching requests.
+// Zero fields keep the policy's value.
+type RetryRule struct {
+	Method     string        // HTTP method to match, empty matches any
+	PathPrefix string        // Request path prefix to match, e.g. "/v1/metrics"; empty matches any
+	MaxRetries int           // NoRetries disables retries for matching requests
+	WaitTime   time.Duration // Base backoff before the first retry
+	MaxWait    time.Duration // Upper bound for a single wait
+}
+
+func (r *RetryRule) matches(method, path string) bool {
+	return (r.Method == "" || strings.EqualFold(r.Method, method)) && strings.HasPrefix(path, r.PathPrefix)
+}
+
+// StandardRetryPolicy retries transport errors, 5xx responses, and 429
+// responses with jittered exponential backoff. Retry-After headers are
+// honored; a request whose Retry-After exceeds MaxWait is not retried. Rules
+// are checked in order and the first match overrides the defaults. When
+// BudgetRetries is set, retries across all requests are limited to that many
+// per BudgetWindow, so an outage cannot multiply the load on the API.
+//
+// Example:
+//
+//	policy := &nexmonyx.StandardRetryPolicy{
+//	    Rules: []nexmonyx.RetryRule{
+//	        {Method: "POST", PathPrefix: "/v1/metrics", MaxRetries: 8, WaitTime: 200 * time.Millisecond},
+//	        {Method: "DELETE", MaxRetries: nexmonyx.NoRetries},
+//	    },
+//	    BudgetRetries: 100,
+//	}
+type StandardRetryPolicy struct {
+	MaxRetries    int           // Default retries per request (default: 3)
+	WaitTime      time.Duration // Base backoff before the first retry (default: 1s)
+	MaxWait       time.Duration // Upper bound for a single wait (default: 30s)
+	Rules         []RetryRule
+	BudgetRetries int           // Retries allowed per window across all requests, 0 for no limit
+	BudgetWindow  time.Duration // Budget window (default: 1m)
+
+	mu          sync.Mutex
+	windowStart time.Time
+	spent       int
+}
+
+// rule returns the effective settings for method and path
+func (p *StandardRetryPolicy) rule(method, path string) RetryRule {
+	effective := RetryRule{MaxRetries: p.MaxRetries, WaitTime: p.WaitTime, MaxWait: p.MaxWait}
+	for i := range p.Rules {
+		r := &p.Rules[i]
+		if !r.matches(method, path) {
+			continue
+		}
+		if r.MaxRetries != 0 {
+			effective.MaxRetries = r.MaxRetries
+		}
+		if r.WaitTime != 0 {
+			effective.WaitTime = r.WaitTime
+		}
+		if r.MaxWait != 0 {
+			effective.MaxWait = r.MaxWait
+		}
+		break
+	}
+
+	if effective.MaxRetries == 0 {
+		effective.MaxRetries = 3
+	}
+	if effective.WaitTime <= 0 {
+		effective.WaitTime = time.Second
+	}
+	if effective.MaxWait <= 0 {
+		effective.MaxWait = 30 * time.Second
+	}
+	return effective
+}
+
+// RetryLimit implements RequestRetryPolicy
+func (p *StandardRetryPolicy) RetryLimit(method, path string) int {
+	if n := p.rule(method, path).MaxRetries; n > 0 {
+		return n
+	}
+	return 0
+}
+
+// Retry implements RequestRetryPolicy
+func (p *StandardRetryPolicy) Retry(a RetryAttempt) (time.Duration, bool) {
+	if a.Err == nil && a.StatusCode < 500 && a.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	r := p.rule(a.Method, a.Path)
+	if a.RetryAfter > r.MaxWait {
+		return 0, false
+	}
+	if !p.spendBudget(time.Now()) {
+		return 0, false
+	}
+
+	wait := r.WaitTime
+	for i := 1; i < a.Attempt && wait < r.MaxWait; i++ {
+		wait *= 2
+	}
+	if wait > r.MaxWait {
+		wait = r.MaxWait
+	}
+	// Equal jitter: wait between half and all of the backoff
+	wait = wait/2 + rand.N(wait/2+1)
+
+	if wait < a.RetryAfter {
+		wait = a.RetryAfter
+	}
+	return wait, true
+}
+
+// spendBudget takes one retry from the current window's budget
+func (p *StandardRetryPolicy) spendBudget(now time.Time) bool {
+	if p.BudgetRetries <= 0 {
+		return true
+	}
+
+	window := p.BudgetWindow
+	if window <= 0 {
+		window = defaultRetryBudgetWindow
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if now.Sub(p.windowStart) >= window {
+		p.windowStart = now
+		p.spent = 0
+	}
+	if p.spent >= p.BudgetRetries {
+		return false
+	}
+	p.spent++
+	return true
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+type retryDecisionKey struct{}
+
+// retryDecision carries the policy's wait from the retry condition to the
+// retry-after callback, which resty calls separately
+type retryDecision struct {
+	mu   sync.Mutex
+	wait time.Duration
+}
+
+// withRetryDecision prepares ctx to carry retry decisions when a policy is set
+func (c *Client) withRetryDecision(ctx context.Context) context.Context {
+	if c.config.RetryPolicy == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, retryDecisionKey{}, &retryDecision{})
+}
+
+// maxRetries returns the retry limit that applies to method and path
+func (c *Client) maxRetries(method, path string) int {
+	if c.config.RetryPolicy == nil {
+		return c.client.RetryCount
+	}
+	return min(c.config.RetryPolicy.RetryLimit(method, path), policyRetryLimit)
+}
+
+// shouldRetry is the resty retry condition
+func (c *Client) shouldRetry(resp *resty.Response, err error) bool {
+	if resp == nil {
+		// A request middleware failed before anything was sent, e.g. the
+		// workload identity token exchange
+		return false
+	}
+
+	policy := c.config.RetryPolicy
+	if policy == nil {
+		return err != nil || resp.StatusCode() >= 500 || resp.StatusCode() == http.StatusTooManyRequests
+	}
+	if resp.Request == nil {
+		return false
+	}
+
+	trace, _ := resp.Request.Context().Value(requestTraceKey{}).(requestTrace)
+	if resp.Request.Attempt > c.maxRetries(trace.method, trace.path) {
+		return false
+	}
+
+	wait, retry := policy.Retry(RetryAttempt{
+		Method:     trace.method,
+		Path:       trace.path,
+		Attempt:    resp.Request.Attempt,
+		StatusCode: resp.StatusCode(),
+		Err:        err,
+		RetryAfter: parseRetryAfter(resp.Header().Get("Retry-After"), time.Now()),
+	})
+	if decision, ok := resp.Request.Context().Value(retryDecisionKey{}).(*retryDecision); ok {
+		decision.mu.Lock()
+		decision.wait = wait
+		decision.mu.Unlock()
+	}
+	return retry
+}
+
+// retryAfter is the resty retry-after callback. It uses the policy's wait when
+// a policy is set, lengthened while platform notices report a problem.
+func (c *Client) retryAfter(rc *resty.Client, resp *resty.Response) (time.Duration, error) {
+	wait, err := c.noticeRetryAfter(rc, resp)
+	if err != nil || resp == nil || resp.Request == nil {
+		return wait, err
+	}
+
+	if decision, ok := resp.Request.Context().Value(retryDecisionKey{}).(*retryDecision); ok {
+		decision.mu.Lock()
+		defer decision.mu.Unlock()
+		// resty treats 0 as "use the default backoff"
+		wait = max(wait, decision.wait, time.Nanosecond)
+	}
+	return wait, nil
+}
@@ -0,0 +1,126 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStandardRetryPolicy_Rules(t *testing.T) {
+	policy := &StandardRetryPolicy{
+		Rules: []RetryRule{
+			{Method: "POST", PathPrefix: "/v1/metrics", MaxRetries: 8},
+			{Method: "DELETE", MaxRetries: NoRetries},
+		},
+	}
+
+	assert.Equal(t, 8, policy.RetryLimit("POST", "/v1/metrics/comprehensive"))
+	assert.Equal(t, 3, policy.RetryLimit("POST", "/v1/servers"))
+	assert.Equal(t, 0, policy.RetryLimit("delete", "/v1/server/abc"))
+	assert.Equal(t, 3, policy.RetryLimit("GET", "/v1/metrics"))
+}
+
+func TestStandardRetryPolicy_Retry(t *testing.T) {
+	policy := &StandardRetryPolicy{WaitTime: 100 * time.Millisecond, MaxWait: time.Second}
+
+	_, retry := policy.Retry(RetryAttempt{Method: "GET", Path: "/v1/servers", Attempt: 1, StatusCode: http.StatusNotFound})
+	assert.False(t, retry, "client errors are not retried")
+
+	wait, retry := policy.Retry(RetryAttempt{Method: "GET", Path: "/v1/servers", Attempt: 1, Err: errors.New("connection reset")})
+	assert.True(t, retry)
+	assert.GreaterOrEqual(t, wait, 50*time.Millisecond)
+	assert.LessOrEqual(t, wait, 100*time.Millisecond)
+
+	wait, retry = policy.Retry(RetryAttempt{Method: "GET", Path: "/v1/servers", Attempt: 3, StatusCode: http.StatusBadGateway})
+	assert.True(t, retry)
+	assert.GreaterOrEqual(t, wait, 200*time.Millisecond)
+	assert.LessOrEqual(t, wait, 400*time.Millisecond)
+
+	wait, retry = policy.Retry(RetryAttempt{Method: "GET", Path: "/v1/servers", Attempt: 1, StatusCode: http.StatusTooManyRequests, RetryAfter: 700 * time.Millisecond})
+	assert.True(t, retry)
+	assert.Equal(t, 700*time.Millisecond, wait, "Retry-After is honored")
+
+	_, retry = policy.Retry(RetryAttempt{Method: "GET", Path: "/v1/servers", Attempt: 1, StatusCode: http.StatusTooManyRequests, RetryAfter: time.Minute})
+	assert.False(t, retry, "Retry-After beyond MaxWait gives up")
+}
+
+func TestStandardRetryPolicy_Budget(t *testing.T) {
+	policy := &StandardRetryPolicy{WaitTime: time.Millisecond, BudgetRetries: 2, BudgetWindow: time.Hour}
+	failed := RetryAttempt{Method: "GET", Path: "/v1/servers", Attempt: 1, StatusCode: http.StatusServiceUnavailable}
+
+	for i := 0; i < 2; i++ {
+		_, retry := policy.Retry(failed)
+		assert.True(t, retry)
+	}
+	_, retry := policy.Retry(failed)
+	assert.False(t, retry, "budget exhausted")
+
+	policy.windowStart = time.Now().Add(-2 * time.Hour)
+	_, retry = policy.Retry(failed)
+	assert.True(t, retry, "budget refills in the next window")
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 30*time.Second, parseRetryAfter("30", now))
+	assert.Equal(t, 90*time.Second, parseRetryAfter(now.Add(90*time.Second).Format(http.TimeFormat), now))
+	assert.Zero(t, parseRetryAfter("", now))
+	assert.Zero(t, parseRetryAfter("soon", now))
+	assert.Zero(t, parseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now))
+}
+
+func TestClient_RetryPolicy(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+		RetryPolicy: &StandardRetryPolicy{
+			MaxRetries: 2,
+			WaitTime:   time.Millisecond,
+			MaxWait:    5 * time.Millisecond,
+			Rules: []RetryRule{
+				{Method: "POST", PathPrefix: "/v1/metrics", MaxRetries: 5},
+				{Method: "DELETE", MaxRetries: NoRetries},
+			},
+		},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	tests := []struct {
+		method   string
+		path     string
+		attempts int32
+	}{
+		{"DELETE", "/v1/server/abc", 1},
+		{"GET", "/v1/servers", 3},
+		{"POST", "/v1/metrics/comprehensive", 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			attempts.Store(0)
+			var retries int
+			stop := client.Events().OnRetry(func(RequestEvent) { retries++ })
+			defer stop()
+
+			_, err := client.Do(ctx, &Request{Method: tt.method, Path: tt.path})
+			assert.Error(t, err)
+			assert.Equal(t, tt.attempts, attempts.Load())
+			assert.Equal(t, int(tt.attempts)-1, retries)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+)
+
+// ServerCredentialName is the name under which RegisterAndStore saves the
+// server credentials and NewClientFromStore loads them
+const ServerCredentialName = "server"
+
+// RegisterAndStore registers a server with a registration key and saves the
+// returned ServerUUID and ServerSecret in store under ServerCredentialName, so
+// later boots can create a client with NewClientFromStore.
+//
+// The API only returns the server secret once. If saving fails, the
+// registration response is returned together with the error so the caller can
+// still persist the credentials another way.
+//
+// Example:
+//
+//	store, _ := nexmonyx.NewCredentialStore(&nexmonyx.CredentialStoreOptions{DisableKeyring: true})
+//	client, err := nexmonyx.NewClientFromStore(store, config)
+//	if errors.Is(err, nexmonyx.ErrCredentialNotFound) {
+//	    // First boot
+//	    unauthenticated, _ := nexmonyx.NewClient(config)
+//	    if _, err = unauthenticated.Servers.RegisterAndStore(ctx, registrationKey, req, store); err == nil {
+//	        client, err = nexmonyx.NewClientFromStore(store, config)
+//	    }
+//	}
+func (s *ServersService) RegisterAndStore(ctx context.Context, registrationKey string, req *ServerCreateRequest, store CredentialStore) (*ServerRegistrationResponse, error) {
+	if store == nil {
+		return nil, fmt.Errorf("credential store is required")
+	}
+
+	resp, err := s.RegisterWithKeyFull(ctx, registrationKey, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.ServerUUID == "" || resp.ServerSecret == "" {
+		return resp, fmt.Errorf("registration response is missing the server credentials")
+	}
+
+	if err := SaveAuth(store, ServerCredentialName, &AuthConfig{
+		ServerUUID:   resp.ServerUUID,
+		ServerSecret: resp.ServerSecret,
+	}); err != nil {
+		return resp, fmt.Errorf("server %s registered but its credentials could not be stored: %w", resp.ServerUUID, err)
+	}
+	return resp, nil
+}
+
+// NewClientFromStore creates a client authenticated with the server
+// credentials saved by RegisterAndStore. The remaining settings are taken from
+// config, which may be nil. It returns an error matching ErrCredentialNotFound
+// when the server has not been registered yet.
+func NewClientFromStore(store CredentialStore, config *Config) (*Client, error) {
+	if store == nil {
+		return nil, fmt.Errorf("credential store is required")
+	}
+
+	auth, err := LoadAuth(store, ServerCredentialName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server credentials: %w", err)
+	}
+	if auth.ServerUUID == "" || auth.ServerSecret == "" {
+		return nil, fmt.Errorf("stored credentials %q have no server credentials", ServerCredentialName)
+	}
+
+	config = config.Clone()
+	config.Auth = AuthConfig{ServerUUID: auth.ServerUUID, ServerSecret: auth.ServerSecret}
+	return NewClient(config)
+}
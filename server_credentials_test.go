@@ -0,0 +1,72 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingCredentialStore rejects every write
+type failingCredentialStore struct{ CredentialStore }
+
+func (failingCredentialStore) Set(string, []byte) error { return errors.New("read-only file system") }
+
+func TestServersService_RegisterAndStore(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/register":
+			assert.Equal(t, "reg-key", r.Header.Get("X-Registration-Key"))
+			w.Write([]byte(`{"status":"success","data":{"server_uuid":"srv-1","server_secret":"s3cret","server":{"server_uuid":"srv-1"}}}`))
+		case "/v1/healthz":
+			assert.Equal(t, "srv-1", r.Header.Get("X-Server-UUID"))
+			assert.Equal(t, "s3cret", r.Header.Get("X-Server-Secret"))
+			healthHandler(w, r)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	store, err := NewCredentialStore(&CredentialStoreOptions{Dir: dir, DisableKeyring: true})
+	require.NoError(t, err)
+	config := &Config{BaseURL: server.URL}
+
+	// First boot: nothing stored yet
+	_, err = NewClientFromStore(store, config)
+	assert.True(t, errors.Is(err, ErrCredentialNotFound))
+
+	client, err := NewClient(config)
+	require.NoError(t, err)
+	resp, err := client.Servers.RegisterAndStore(context.Background(), "reg-key", &ServerCreateRequest{Hostname: "web-1"}, store)
+	require.NoError(t, err)
+	assert.Equal(t, "srv-1", resp.ServerUUID)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"+credentialFileExtension))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	info, err := os.Stat(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// Later boots
+	agent, err := NewClientFromStore(store, config)
+	require.NoError(t, err)
+	_, err = agent.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	require.NoError(t, err)
+
+	// The response is kept when the credentials cannot be stored
+	resp, err = client.Servers.RegisterAndStore(context.Background(), "reg-key", &ServerCreateRequest{Hostname: "web-1"}, failingCredentialStore{store})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "read-only file system")
+	require.NotNil(t, resp)
+	assert.Equal(t, "s3cret", resp.ServerSecret)
+}
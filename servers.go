@@ -107,7 +107,7 @@ func (s *ServersService) Update(ctx context.Context, id string, server *Server)
 	req := &ServerDetailsUpdateRequest{
 		Hostname:       server.Hostname,
 		MainIP:         server.MainIP,
-		Environment:    server.Environment,
+		Environment:    Environment(server.Environment),
 		Location:       server.Location,
 		Classification: server.Classification,
 		OS:             server.OS,
@@ -134,6 +134,27 @@ func (s *ServersService) Delete(ctx context.Context, id string) error {
 	return err
 }
 
+// Undelete restores a soft-deleted server. Deleted servers are listed with
+// ListOptions.OnlyDeleted.
+func (s *ServersService) Undelete(ctx context.Context, id string) (*Server, error) {
+	var resp StandardResponse
+	resp.Data = &Server{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/admin/server/%s/undelete", id),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if server, ok := resp.Data.(*Server); ok {
+		return server, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
 // RegisterServer registers a new server with credentials
 func (s *ServersService) Register(ctx context.Context, hostname string, organizationID uint) (*Server, error) {
 	var resp StandardResponse
@@ -670,5 +691,103 @@ func (s *ServersService) GetDetails(ctx context.Context, serverUUID string) (*Se
 	return nil, fmt.Errorf("unexpected response type")
 }
 
+// Archive decommissions a server. Alerting stops, the server no longer counts
+// towards billing, and its history is retained according to policy. A nil
+// policy uses the organization's default retention.
+func (s *ServersService) Archive(ctx context.Context, serverUUID string, policy *ServerRetentionPolicy) (*Server, error) {
+	if policy == nil {
+		policy = &ServerRetentionPolicy{}
+	}
+
+	var resp StandardResponse
+	resp.Data = &Server{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/server/%s/archive", serverUUID),
+		Body:   policy,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if server, ok := resp.Data.(*Server); ok {
+		return server, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// ListArchived retrieves archived servers, which are excluded from List
+func (s *ServersService) ListArchived(ctx context.Context, opts *ListOptions) ([]*Server, *PaginationMeta, error) {
+	var resp PaginatedResponse
+	var servers []*Server
+	resp.Data = &servers
 
+	req := &Request{
+		Method: "GET",
+		Path:   "/v2/servers/archived",
+		Result: &resp,
+	}
 
+	if opts != nil {
+		req.Query = opts.ToQuery()
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return servers, resp.Meta, nil
+}
+
+// Restore returns an archived server to active monitoring. History that has
+// already passed its retention period is not recovered.
+func (s *ServersService) Restore(ctx context.Context, serverUUID string) (*Server, error) {
+	var resp StandardResponse
+	resp.Data = &Server{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/server/%s/restore", serverUUID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if server, ok := resp.Data.(*Server); ok {
+		return server, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// ServerSecretRotation is returned when a server's secret is rotated
+type ServerSecretRotation struct {
+	ServerUUID              string      `json:"server_uuid"`
+	ServerSecret            string      `json:"server_secret"`
+	PreviousSecretExpiresAt *CustomTime `json:"previous_secret_expires_at,omitempty"` // Both secrets authenticate until then
+}
+
+// RotateSecret generates a new secret for a server. The previous secret stays
+// valid until PreviousSecretExpiresAt so the agent can be switched over with
+// WithServerCredentials or a CredentialStore before requests start failing.
+func (s *ServersService) RotateSecret(ctx context.Context, serverUUID string) (*ServerSecretRotation, error) {
+	var resp StandardResponse
+	resp.Data = &ServerSecretRotation{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/server/%s/rotate-secret", serverUUID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rotation, ok := resp.Data.(*ServerSecretRotation); ok {
+		return rotation, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -2964,3 +2965,121 @@ func TestServersService_ListInScope(t *testing.T) {
 		})
 	}
 }
+
+// TestServersService_Archive tests archiving a decommissioned server
+func TestServersService_Archive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/server/server-uuid-1/archive", r.URL.Path)
+
+		var policy ServerRetentionPolicy
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&policy))
+		assert.Equal(t, 90, policy.RetentionDays)
+		assert.Equal(t, "hardware retired", policy.Reason)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(StandardResponse{
+			Status: "success",
+			Data: &Server{
+				ServerUUID:      "server-uuid-1",
+				ArchivedAt:      &CustomTime{Time: time.Now()},
+				RetentionPolicy: &policy,
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	archived, err := client.Servers.Archive(context.Background(), "server-uuid-1", &ServerRetentionPolicy{
+		RetentionDays: 90,
+		Reason:        "hardware retired",
+	})
+	require.NoError(t, err)
+	assert.True(t, archived.IsArchived())
+	require.NotNil(t, archived.RetentionPolicy)
+	assert.Equal(t, 90, archived.RetentionPolicy.RetentionDays)
+}
+
+// TestServersService_ListArchived tests listing archived servers
+func TestServersService_ListArchived(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v2/servers/archived", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(PaginatedResponse{
+			Status: "success",
+			Data: []*Server{
+				{ServerUUID: "server-uuid-1", Hostname: "old-db-01"},
+			},
+			Meta: &PaginationMeta{Page: 2, Limit: 25, TotalItems: 26},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	servers, meta, err := client.Servers.ListArchived(context.Background(), &ListOptions{Page: 2, Limit: 25})
+	require.NoError(t, err)
+	require.Len(t, servers, 1)
+	assert.Equal(t, "old-db-01", servers[0].Hostname)
+	assert.Equal(t, 26, meta.TotalItems)
+}
+
+// TestServersService_Restore tests restoring an archived server
+func TestServersService_Restore(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/server/server-uuid-1/restore", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(StandardResponse{
+			Status: "success",
+			Data:   &Server{ServerUUID: "server-uuid-1", MonitoringEnabled: true},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	restored, err := client.Servers.Restore(context.Background(), "server-uuid-1")
+	require.NoError(t, err)
+	assert.False(t, restored.IsArchived())
+	assert.True(t, restored.MonitoringEnabled)
+}
+
+func TestServersService_RotateSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/server/server-uuid/rotate-secret", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{"server_uuid":"server-uuid","server_secret":"new-secret","previous_secret_expires_at":"2026-10-16T12:00:00Z"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{ServerUUID: "server-uuid", ServerSecret: "old-secret"},
+	})
+	require.NoError(t, err)
+
+	rotation, err := client.Servers.RotateSecret(context.Background(), "server-uuid")
+	require.NoError(t, err)
+	assert.Equal(t, "new-secret", rotation.ServerSecret)
+	require.NotNil(t, rotation.PreviousSecretExpiresAt)
+	assert.Equal(t, time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), rotation.PreviousSecretExpiresAt.Time.UTC())
+}
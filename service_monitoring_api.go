@@ -34,6 +34,8 @@ type ServiceStatusResponse struct {
 	LastUpdated  string                 `json:"last_updated"`
 	Services     []*ServiceMonitoringInfo  `json:"services"`
 	Summary      ServiceStatusSummary   `json:"summary"`
+
+	ListeningPorts []ListeningPort `json:"listening_ports,omitempty"`
 }
 
 // ServiceStatusSummary provides a summary of service states
@@ -185,6 +187,7 @@ func (s *ServiceMonitoringService) GetServiceLogs(ctx context.Context, serverID
 
 // GetFailedServices returns all failed services across servers in an organization
 func (s *ServiceMonitoringService) GetFailedServices(ctx context.Context, organizationID string) ([]*ServiceMonitoringInfo, error) {
+	organizationID = s.client.organizationID(ctx, organizationID)
 	path := fmt.Sprintf("/v1/organizations/%s/services/failed", organizationID)
 	
 	var services []*ServiceMonitoringInfo
@@ -202,6 +205,7 @@ func (s *ServiceMonitoringService) GetFailedServices(ctx context.Context, organi
 
 // CreateServiceAlert creates an alert rule for service monitoring
 func (s *ServiceMonitoringService) CreateServiceAlert(ctx context.Context, organizationID string, alertConfig ServiceAlertConfig) error {
+	organizationID = s.client.organizationID(ctx, organizationID)
 	path := fmt.Sprintf("/v1/organizations/%s/alerts/services", organizationID)
 	_, err := s.client.Do(ctx, &Request{
 		Method: "POST",
@@ -0,0 +1,26 @@
+//go:build nexmonyx_agent
+
+package nexmonyx
+
+// Agent builds (go build -tags nexmonyx_agent) leave out the analytics, machine
+// learning, reporting, and WebSocket command services, along with the
+// gorilla/websocket dependency. The Client fields remain so the struct has the
+// same shape in every build, but these services have no methods.
+
+// AnalyticsService is not available in agent builds
+type AnalyticsService struct {
+	client *Client
+}
+
+// MLService is not available in agent builds
+type MLService struct {
+	client *Client
+}
+
+// ReportingService is not available in agent builds
+type ReportingService struct {
+	client *Client
+}
+
+// WebSocketServiceImpl is not available in agent builds
+type WebSocketServiceImpl struct{}
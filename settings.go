@@ -7,6 +7,7 @@ import (
 
 // GetSettings retrieves settings for an organization
 func (s *SettingsService) Get(ctx context.Context, organizationID string) (*Settings, error) {
+	organizationID = s.client.organizationID(ctx, organizationID)
 	var resp StandardResponse
 	resp.Data = &Settings{}
 
@@ -27,6 +28,7 @@ func (s *SettingsService) Get(ctx context.Context, organizationID string) (*Sett
 
 // UpdateSettings updates settings for an organization
 func (s *SettingsService) Update(ctx context.Context, organizationID string, settings *Settings) (*Settings, error) {
+	organizationID = s.client.organizationID(ctx, organizationID)
 	var resp StandardResponse
 	resp.Data = &Settings{}
 
@@ -48,6 +50,7 @@ func (s *SettingsService) Update(ctx context.Context, organizationID string, set
 
 // GetNotificationSettings retrieves notification settings
 func (s *SettingsService) GetNotificationSettings(ctx context.Context, organizationID string) (*NotificationSettings, error) {
+	organizationID = s.client.organizationID(ctx, organizationID)
 	var resp StandardResponse
 	resp.Data = &NotificationSettings{}
 
@@ -68,6 +71,7 @@ func (s *SettingsService) GetNotificationSettings(ctx context.Context, organizat
 
 // UpdateNotificationSettings updates notification settings
 func (s *SettingsService) UpdateNotificationSettings(ctx context.Context, organizationID string, settings *NotificationSettings) (*NotificationSettings, error) {
+	organizationID = s.client.organizationID(ctx, organizationID)
 	var resp StandardResponse
 	resp.Data = &NotificationSettings{}
 
@@ -0,0 +1,169 @@
+package nexmonyx
+
+import (
+	"context"
+	"sync"
+)
+
+// CloseSummary reports the work Client.Close could not finish before its deadline
+type CloseSummary struct {
+	LoopsStopped      int // Background loops that stopped
+	LoopsAbandoned    int // Background loops still running at the deadline
+	InFlightAbandoned int // Requests still in flight at the deadline
+	DroppedLogEntries int // Log entries LogShippers could not send
+	SpoolBacklog      int // Metric submissions left spooled, from Config.SpoolBacklog
+}
+
+// Dropped reports whether any request, log entry, or loop was cut off
+func (s *CloseSummary) Dropped() bool {
+	return s.LoopsAbandoned > 0 || s.InFlightAbandoned > 0 || s.DroppedLogEntries > 0
+}
+
+// lifecycle tracks the in-flight requests and background loops of a client so
+// Close can drain them
+type lifecycle struct {
+	mu       sync.Mutex
+	closed   bool
+	inFlight int
+	idle     chan struct{} // Closed when the last in-flight request ends during Close
+	nextID   uint64
+	loops    map[uint64]*backgroundLoop
+}
+
+// backgroundLoop is a running ControllerHeartbeatLoop, LogShipper,
+// SiteLeaseLoop, or AdaptiveIntervals
+type backgroundLoop struct {
+	cancel  context.CancelFunc
+	done    chan struct{}
+	pending func() int // Items the loop still holds, e.g. buffered log entries
+}
+
+// beginRequest counts a request as in flight, or returns ErrClientClosed
+func (c *Client) beginRequest() error {
+	l := &c.lifecycle
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return ErrClientClosed
+	}
+	l.inFlight++
+	return nil
+}
+
+func (c *Client) endRequest() {
+	l := &c.lifecycle
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inFlight--
+	if l.inFlight == 0 && l.idle != nil {
+		close(l.idle)
+		l.idle = nil
+	}
+}
+
+// runBackground registers a background loop started with ctx. The returned
+// context is also cancelled by Close, which waits for stop to be called.
+// pending, if set, reports what the loop could not deliver once it stopped.
+func (c *Client) runBackground(ctx context.Context, pending func() int) (context.Context, func(), error) {
+	l := &c.lifecycle
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return nil, nil, ErrClientClosed
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	loop := &backgroundLoop{cancel: cancel, done: make(chan struct{}), pending: pending}
+	if l.loops == nil {
+		l.loops = make(map[uint64]*backgroundLoop)
+	}
+	l.nextID++
+	id := l.nextID
+	l.loops[id] = loop
+
+	stop := func() {
+		cancel()
+		l.mu.Lock()
+		delete(l.loops, id)
+		l.mu.Unlock()
+		close(loop.done)
+	}
+	return ctx, stop, nil
+}
+
+// Close shuts the client down for a graceful exit, e.g. on SIGTERM. It stops
+// the background loops started from this client (controller heartbeats, log
+// shippers, site leases, and adaptive intervals), letting each make its final
+// flush or release, then rejects new requests with ErrClientClosed and waits
+// for in-flight requests to finish. Work still running when ctx is done is
+// abandoned and counted in the summary, and ctx's error is returned. Clients
+// derived with WithToken and similar methods are closed separately.
+//
+// Example:
+//
+//	<-sigterm
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+//	defer cancel()
+//	summary, err := client.Close(ctx)
+//	if summary.Dropped() {
+//	    log.Printf("shutdown dropped work: %+v", summary)
+//	}
+func (c *Client) Close(ctx context.Context) (*CloseSummary, error) {
+	summary := &CloseSummary{}
+	l := &c.lifecycle
+
+	// Loops flush through the client, so requests are accepted until they stop
+	l.mu.Lock()
+	loops := make([]*backgroundLoop, 0, len(l.loops))
+	for _, loop := range l.loops {
+		loops = append(loops, loop)
+		loop.cancel()
+	}
+	l.mu.Unlock()
+
+	var err error
+	for _, loop := range loops {
+		select {
+		case <-loop.done:
+			summary.LoopsStopped++
+		case <-ctx.Done():
+			err = ctx.Err()
+			summary.LoopsAbandoned++
+		}
+		if loop.pending != nil {
+			summary.DroppedLogEntries += loop.pending()
+		}
+	}
+
+	l.mu.Lock()
+	l.closed = true
+	var idle chan struct{}
+	if l.inFlight > 0 {
+		if l.idle == nil {
+			l.idle = make(chan struct{})
+		}
+		idle = l.idle
+	}
+	l.mu.Unlock()
+
+	if idle != nil {
+		select {
+		case <-idle:
+		case <-ctx.Done():
+			err = ctx.Err()
+			l.mu.Lock()
+			summary.InFlightAbandoned = l.inFlight
+			l.mu.Unlock()
+		}
+	}
+
+	if c.releaseTransport != nil {
+		c.releaseTransport()
+	} else {
+		c.client.GetClient().CloseIdleConnections()
+	}
+	if c.config.SpoolBacklog != nil {
+		summary.SpoolBacklog = c.config.SpoolBacklog()
+	}
+	return summary, err
+}
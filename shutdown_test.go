@@ -0,0 +1,137 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Close_DrainsInFlightRequests(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		healthHandler(w, r)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, SpoolBacklog: func() int { return 3 }})
+	require.NoError(t, err)
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := client.Do(context.Background(), &Request{Method: "POST", Path: "/v2/metrics/comprehensive"})
+		result <- err
+	}()
+	<-started
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	summary, err := client.Close(ctx)
+	require.NoError(t, err)
+	assert.NoError(t, <-result, "the in-flight request completes")
+	assert.False(t, summary.Dropped())
+	assert.Equal(t, 3, summary.SpoolBacklog)
+
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	assert.ErrorIs(t, err, ErrClientClosed)
+
+	// Closing again is a no-op
+	summary, err = client.Close(ctx)
+	require.NoError(t, err)
+	assert.False(t, summary.Dropped())
+}
+
+func TestClient_Close_Deadline(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		healthHandler(w, r)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client, err := NewClient(&Config{BaseURL: server.URL})
+	require.NoError(t, err)
+
+	go client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/healthz"})
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	summary, err := client.Close(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, summary.InFlightAbandoned)
+	assert.True(t, summary.Dropped())
+}
+
+func TestClient_Close_StopsBackgroundLoops(t *testing.T) {
+	logServer, batches, setFailing := newLogServer(t)
+	defer logServer.Close()
+	mux := http.NewServeMux()
+	mux.Handle("/v2/servers/srv-1/logs", logServer.Config.Handler)
+	mux.HandleFunc("/", http.NotFound)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	shipper := client.Logs.NewShipper("srv-1", &LogShipperOptions{FlushInterval: time.Hour})
+	intervals := client.AgentConfig.NewAdaptiveIntervals("srv-1", &AdaptiveIntervalOptions{RefreshInterval: time.Hour})
+
+	shipperDone := make(chan error, 1)
+	intervalsDone := make(chan error, 1)
+	go func() { shipperDone <- shipper.Run(context.Background()) }()
+	go func() { intervalsDone <- intervals.Run(context.Background()) }()
+	require.Eventually(t, func() bool {
+		client.lifecycle.mu.Lock()
+		defer client.lifecycle.mu.Unlock()
+		return len(client.lifecycle.loops) == 2
+	}, time.Second, time.Millisecond)
+
+	shipper.Add(LogEntry{Severity: LogSeverityInfo, Message: "shutting down"})
+	summary, err := client.Close(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, summary.LoopsStopped)
+	assert.Zero(t, summary.DroppedLogEntries)
+	assert.ErrorIs(t, <-shipperDone, context.Canceled)
+	assert.ErrorIs(t, <-intervalsDone, context.Canceled)
+	require.Len(t, batches(), 1, "buffered entries are flushed on close")
+	assert.Equal(t, "shutting down", batches()[0][0].Message)
+
+	assert.ErrorIs(t, shipper.Run(context.Background()), ErrClientClosed)
+
+	// Entries that cannot be flushed are reported as dropped
+	client, err = NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	setFailing(true)
+	shipper = client.Logs.NewShipper("srv-1", &LogShipperOptions{FlushInterval: time.Second})
+	go func() { shipperDone <- shipper.Run(context.Background()) }()
+	require.Eventually(t, func() bool {
+		client.lifecycle.mu.Lock()
+		defer client.lifecycle.mu.Unlock()
+		return len(client.lifecycle.loops) == 1
+	}, time.Second, time.Millisecond)
+	shipper.Add(LogEntry{Severity: LogSeverityInfo, Message: "lost"})
+	shipper.Add(LogEntry{Severity: LogSeverityInfo, Message: "also lost"})
+
+	summary, err = client.Close(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, summary.DroppedLogEntries)
+	assert.True(t, summary.Dropped())
+	assert.True(t, errors.Is(<-shipperDone, context.Canceled))
+}
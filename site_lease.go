@@ -0,0 +1,337 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// FencingTokenHeader carries the site and fencing token of the lease a
+// mutating request was made under, as "site:token". The API rejects requests
+// whose token is older than the site's current lease.
+const FencingTokenHeader = "X-Nexmonyx-Fencing-Token"
+
+const defaultSiteLeaseTTL = 30 * time.Second
+
+// SiteLease grants one agent of a site the right to execute probes and submit
+// metrics. Every acquisition increases the site's FencingToken, so requests
+// made under an earlier lease can be told apart and rejected.
+type SiteLease struct {
+	Site         string      `json:"site"`
+	HolderID     string      `json:"holder_id"`
+	FencingToken int64       `json:"fencing_token"`
+	TTLSeconds   int         `json:"ttl_seconds"`
+	AcquiredAt   *CustomTime `json:"acquired_at,omitempty"`
+	ExpiresAt    *CustomTime `json:"expires_at,omitempty"`
+}
+
+// HeldBy returns true if holderID holds the lease
+func (l *SiteLease) HeldBy(holderID string) bool {
+	return l.HolderID != "" && l.HolderID == holderID
+}
+
+type siteLeaseRequest struct {
+	HolderID     string `json:"holder_id"`
+	TTLSeconds   int    `json:"ttl_seconds"`
+	FencingToken int64  `json:"fencing_token,omitempty"`
+}
+
+func siteLeasePath(site string) string {
+	return fmt.Sprintf("/v1/monitoring/sites/%s/lease", url.PathEscape(site))
+}
+
+// AcquireSiteLease tries to acquire the site's lease for holderID, which must
+// be unique per agent. It returns the site's current lease either way; use
+// HeldBy to check whether holderID is now the active agent. A holder that
+// already has the lease keeps its fencing token.
+func (s *MonitoringService) AcquireSiteLease(ctx context.Context, site, holderID string, ttl time.Duration) (*SiteLease, error) {
+	if site == "" || holderID == "" {
+		return nil, fmt.Errorf("site and holder ID are required")
+	}
+	if ttl <= 0 {
+		ttl = defaultSiteLeaseTTL
+	}
+
+	var resp StandardResponse
+	resp.Data = &SiteLease{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   siteLeasePath(site),
+		Body:   &siteLeaseRequest{HolderID: holderID, TTLSeconds: int(ttl / time.Second)},
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if lease, ok := resp.Data.(*SiteLease); ok {
+		return lease, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// RenewLease extends a held lease by its TTL. An error matching
+// ErrSiteLeaseLost means the lease expired or was taken over; the caller must
+// stop acting as the active agent.
+func (s *MonitoringService) RenewLease(ctx context.Context, lease *SiteLease) (*SiteLease, error) {
+	var resp StandardResponse
+	resp.Data = &SiteLease{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   siteLeasePath(lease.Site),
+		Body:   &siteLeaseRequest{HolderID: lease.HolderID, TTLSeconds: lease.TTLSeconds, FencingToken: lease.FencingToken},
+		Result: &resp,
+	})
+	if err != nil {
+		if isLeaseLost(err) {
+			return nil, fmt.Errorf("%w: site %s token %d: %v", ErrSiteLeaseLost, lease.Site, lease.FencingToken, err)
+		}
+		return nil, err
+	}
+
+	if renewed, ok := resp.Data.(*SiteLease); ok {
+		return renewed, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// ReleaseLease gives up a held lease so the standby can take over without
+// waiting for it to expire. Releasing a lease that was already lost succeeds.
+func (s *MonitoringService) ReleaseLease(ctx context.Context, lease *SiteLease) error {
+	_, err := s.client.Do(ctx, &Request{
+		Method: "DELETE",
+		Path:   siteLeasePath(lease.Site),
+		Query: map[string]string{
+			"holder_id":     lease.HolderID,
+			"fencing_token": strconv.FormatInt(lease.FencingToken, 10),
+		},
+	})
+	if err != nil && isLeaseLost(err) {
+		return nil
+	}
+	return err
+}
+
+// isLeaseLost reports whether a lease request failed because the lease is no
+// longer held: it expired (404) or another holder has it (409)
+func isLeaseLost(err error) bool {
+	if IsNotFound(err) || IsConflict(err) {
+		return true
+	}
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode == "HTTP_409"
+}
+
+type fencingTokenKey struct{}
+
+// WithFencingToken returns a context whose mutating requests carry the lease's
+// fencing token in the FencingTokenHeader, so the API rejects them once a
+// newer lease has been granted for the site
+func WithFencingToken(ctx context.Context, lease *SiteLease) context.Context {
+	return context.WithValue(ctx, fencingTokenKey{}, fmt.Sprintf("%s:%d", lease.Site, lease.FencingToken))
+}
+
+// setFencingToken adds the context's fencing token to mutating requests
+func setFencingToken(ctx context.Context, req *Request, r *resty.Request) {
+	if token, ok := ctx.Value(fencingTokenKey{}).(string); ok && isMutatingMethod(req.Method) {
+		r.SetHeader(FencingTokenHeader, token)
+	}
+}
+
+// SiteLeaseOptions configures a SiteLeaseLoop
+type SiteLeaseOptions struct {
+	// TTL of the lease (default: 30s). The standby takes over at most TTL
+	// after the active agent stops renewing.
+	TTL time.Duration
+
+	// RenewInterval between renewals and, while standing by, acquisition
+	// attempts (default: TTL/3)
+	RenewInterval time.Duration
+
+	// OnActive is called when the lease is acquired
+	OnActive func(lease *SiteLease)
+
+	// OnStandby is called when the lease is lost or can no longer be renewed
+	// before it expires
+	OnStandby func()
+
+	// OnError is called when a lease request fails. The loop keeps running.
+	OnError func(error)
+}
+
+// SiteLeaseLoop keeps one agent of a site active and the others on standby.
+// The active agent renews the lease; standbys retry acquisition until it
+// expires or is released. It is safe for concurrent use.
+//
+// An agent that cannot renew steps down once its lease may have expired,
+// measured from when the request was sent, so two agents never both consider
+// themselves active. Requests made with Context additionally carry the
+// fencing token, so the API rejects stale submissions outright.
+//
+// Example:
+//
+//	loop := client.Monitoring.NewSiteLeaseLoop("fra-1", hostname, nil)
+//	go loop.Run(ctx)
+//
+//	// In the probe loop
+//	if !loop.IsActive() {
+//	    continue
+//	}
+//	err := client.Monitoring.SubmitResults(loop.Context(ctx), results)
+type SiteLeaseLoop struct {
+	service  *MonitoringService
+	site     string
+	holderID string
+	options  SiteLeaseOptions
+
+	mu         sync.RWMutex
+	lease      *SiteLease
+	validUntil time.Time
+}
+
+// NewSiteLeaseLoop creates a lease loop for holderID at site. Call Run to start it.
+func (s *MonitoringService) NewSiteLeaseLoop(site, holderID string, opts *SiteLeaseOptions) *SiteLeaseLoop {
+	options := SiteLeaseOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.TTL <= 0 {
+		options.TTL = defaultSiteLeaseTTL
+	}
+	if options.RenewInterval <= 0 {
+		options.RenewInterval = options.TTL / 3
+	}
+
+	return &SiteLeaseLoop{
+		service:  s,
+		site:     site,
+		holderID: holderID,
+		options:  options,
+	}
+}
+
+// Run acquires or renews the lease immediately and then every renew interval
+// until ctx is done. A held lease is released before Run returns ctx's error.
+func (l *SiteLeaseLoop) Run(ctx context.Context) error {
+	ctx, stop, err := l.service.client.runBackground(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	ticker := time.NewTicker(l.options.RenewInterval)
+	defer ticker.Stop()
+
+	for {
+		l.Tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			l.release()
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick renews the lease if held, or tries to acquire it otherwise
+func (l *SiteLeaseLoop) Tick(ctx context.Context) {
+	sent := time.Now()
+	current := l.Lease()
+
+	var lease *SiteLease
+	var err error
+	if current != nil {
+		lease, err = l.service.RenewLease(ctx, current)
+	} else {
+		lease, err = l.service.AcquireSiteLease(ctx, l.site, l.holderID, l.options.TTL)
+		if err == nil && !lease.HeldBy(l.holderID) {
+			return
+		}
+	}
+
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		if l.options.OnError != nil {
+			l.options.OnError(err)
+		}
+		if current != nil && (errors.Is(err, ErrSiteLeaseLost) || time.Now().After(l.expiry())) {
+			l.stepDown()
+		}
+		return
+	}
+
+	l.mu.Lock()
+	l.lease = lease
+	l.validUntil = sent.Add(l.options.TTL)
+	l.mu.Unlock()
+
+	if current == nil && l.options.OnActive != nil {
+		l.options.OnActive(lease)
+	}
+}
+
+// IsActive returns true while this agent holds an unexpired lease
+func (l *SiteLeaseLoop) IsActive() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.lease != nil && time.Now().Before(l.validUntil)
+}
+
+// Lease returns the held lease, or nil on standby
+func (l *SiteLeaseLoop) Lease() *SiteLease {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.lease
+}
+
+// Context returns ctx carrying the held lease's fencing token. On standby it
+// returns ctx unchanged.
+func (l *SiteLeaseLoop) Context(ctx context.Context) context.Context {
+	if lease := l.Lease(); lease != nil {
+		return WithFencingToken(ctx, lease)
+	}
+	return ctx
+}
+
+func (l *SiteLeaseLoop) expiry() time.Time {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.validUntil
+}
+
+func (l *SiteLeaseLoop) stepDown() {
+	l.mu.Lock()
+	wasActive := l.lease != nil
+	l.lease = nil
+	l.validUntil = time.Time{}
+	l.mu.Unlock()
+
+	if wasActive && l.options.OnStandby != nil {
+		l.options.OnStandby()
+	}
+}
+
+// release gives up a held lease when Run stops
+func (l *SiteLeaseLoop) release() {
+	lease := l.Lease()
+	if lease == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), l.options.RenewInterval)
+	defer cancel()
+	if err := l.service.ReleaseLease(ctx, lease); err != nil && l.options.OnError != nil {
+		l.options.OnError(err)
+	}
+	l.stepDown()
+}
@@ -0,0 +1,178 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// leaseServer implements the site lease API for one site and rejects result
+// submissions with a stale fencing token
+type leaseServer struct {
+	mu     sync.Mutex
+	holder string
+	token  int64
+	stale  int
+}
+
+func (s *leaseServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+
+	writeLease := func() {
+		json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "data": SiteLease{
+			Site: "fra-1", HolderID: s.holder, FencingToken: s.token, TTLSeconds: 30,
+		}})
+	}
+	conflict := func() {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"status":"error","message":"lease held by another agent"}`))
+	}
+
+	switch r.Method + " " + r.URL.Path {
+	case "POST /v1/monitoring/sites/fra-1/lease":
+		var req siteLeaseRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if s.holder == "" {
+			s.holder = req.HolderID
+			s.token++
+		}
+		writeLease()
+	case "PUT /v1/monitoring/sites/fra-1/lease":
+		var req siteLeaseRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.HolderID != s.holder || req.FencingToken != s.token {
+			conflict()
+			return
+		}
+		writeLease()
+	case "DELETE /v1/monitoring/sites/fra-1/lease":
+		if r.URL.Query().Get("holder_id") != s.holder || r.URL.Query().Get("fencing_token") != fmt.Sprint(s.token) {
+			conflict()
+			return
+		}
+		s.holder = ""
+		w.Write([]byte(`{"status":"success"}`))
+	case "POST /v1/monitoring/results":
+		if r.Header.Get(FencingTokenHeader) != fmt.Sprintf("fra-1:%d", s.token) {
+			s.stale++
+			conflict()
+			return
+		}
+		w.Write([]byte(`{"status":"success"}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// expire simulates the active agent's lease expiring without a release
+func (s *leaseServer) expire() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.holder = ""
+}
+
+func TestMonitoringService_SiteLease(t *testing.T) {
+	leases := &leaseServer{}
+	server := httptest.NewServer(leases)
+	defer server.Close()
+	agentA := newTestClient(t, nil, Config{BaseURL: server.URL, Auth: AuthConfig{MonitoringKey: "MON_test"}})
+	agentB := newTestClient(t, nil, Config{BaseURL: server.URL, Auth: AuthConfig{MonitoringKey: "MON_test"}})
+	ctx := context.Background()
+
+	leaseA, err := agentA.Monitoring.AcquireSiteLease(ctx, "fra-1", "agent-a", 30*time.Second)
+	require.NoError(t, err)
+	assert.True(t, leaseA.HeldBy("agent-a"))
+	assert.Equal(t, int64(1), leaseA.FencingToken)
+
+	current, err := agentB.Monitoring.AcquireSiteLease(ctx, "fra-1", "agent-b", 30*time.Second)
+	require.NoError(t, err)
+	assert.False(t, current.HeldBy("agent-b"), "the standby does not get the lease")
+
+	leaseA, err = agentA.Monitoring.RenewLease(ctx, leaseA)
+	require.NoError(t, err)
+	require.NoError(t, agentA.Monitoring.SubmitResults(WithFencingToken(ctx, leaseA), nil))
+
+	// The active agent stalls, its lease expires and the standby takes over
+	leases.expire()
+	leaseB, err := agentB.Monitoring.AcquireSiteLease(ctx, "fra-1", "agent-b", 30*time.Second)
+	require.NoError(t, err)
+	assert.True(t, leaseB.HeldBy("agent-b"))
+	assert.Equal(t, int64(2), leaseB.FencingToken)
+
+	// The stalled agent's submissions and renewals are fenced off
+	assert.Error(t, agentA.Monitoring.SubmitResults(WithFencingToken(ctx, leaseA), nil))
+	assert.Equal(t, 1, leases.stale)
+	_, err = agentA.Monitoring.RenewLease(ctx, leaseA)
+	assert.True(t, errors.Is(err, ErrSiteLeaseLost))
+	assert.NoError(t, agentA.Monitoring.ReleaseLease(ctx, leaseA), "releasing a lost lease succeeds")
+
+	require.NoError(t, agentB.Monitoring.ReleaseLease(ctx, leaseB))
+	_, err = agentA.Monitoring.AcquireSiteLease(ctx, "", "agent-a", 0)
+	assert.Error(t, err)
+}
+
+func TestSiteLeaseLoop(t *testing.T) {
+	leases := &leaseServer{}
+	server := httptest.NewServer(leases)
+	defer server.Close()
+	ctx := context.Background()
+
+	var events []string
+	var mu sync.Mutex
+	record := func(event string) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+	}
+	newLoop := func(holder string) *SiteLeaseLoop {
+		return newTestClient(t, nil, Config{BaseURL: server.URL, Auth: AuthConfig{MonitoringKey: "MON_test"}}).Monitoring.NewSiteLeaseLoop("fra-1", holder, &SiteLeaseOptions{
+			OnActive:  func(lease *SiteLease) { record(fmt.Sprintf("%s active %d", holder, lease.FencingToken)) },
+			OnStandby: func() { record(holder + " standby") },
+		})
+	}
+	loopA, loopB := newLoop("agent-a"), newLoop("agent-b")
+
+	loopA.Tick(ctx)
+	loopB.Tick(ctx)
+	assert.True(t, loopA.IsActive())
+	assert.False(t, loopB.IsActive())
+	assert.Equal(t, ctx, loopB.Context(ctx))
+
+	leases.expire()
+	loopB.Tick(ctx)
+	loopA.Tick(ctx)
+	assert.False(t, loopA.IsActive())
+	assert.True(t, loopB.IsActive())
+	assert.Nil(t, loopA.Lease())
+
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan error)
+	go func() { done <- loopB.Run(runCtx) }()
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.False(t, loopB.IsActive())
+
+	loopA.Tick(ctx)
+	assert.True(t, loopA.IsActive())
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{
+		"agent-a active 1",
+		"agent-b active 2",
+		"agent-a standby",
+		"agent-b standby",
+		"agent-a active 3",
+	}, events)
+}
@@ -0,0 +1,313 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// SMART device types
+const (
+	SMARTDeviceTypeATA  = "ata"
+	SMARTDeviceTypeSCSI = "scsi"
+	SMARTDeviceTypeNVMe = "nvme"
+)
+
+// Well-known ATA SMART attribute IDs
+const (
+	SMARTAttrReallocatedSectors = 5
+	SMARTAttrPowerOnHours       = 9
+	SMARTAttrPowerCycleCount    = 12
+	SMARTAttrWearLevelingCount  = 177
+	SMARTAttrTemperature        = 194
+	SMARTAttrPendingSectors     = 197
+	SMARTAttrUncorrectable      = 198
+	SMARTAttrCRCErrors          = 199
+	SMARTAttrSSDLifeLeft        = 231
+	SMARTAttrMediaWearout       = 233
+)
+
+// NVMe critical warning bits
+const (
+	NVMeWarningSpare          = 1 << 0 // Available spare below threshold
+	NVMeWarningTemperature    = 1 << 1 // Temperature outside thresholds
+	NVMeWarningReliability    = 1 << 2 // Degraded by media or internal errors
+	NVMeWarningReadOnly       = 1 << 3 // Media placed in read-only mode
+	NVMeWarningVolatileBackup = 1 << 4 // Volatile memory backup failed
+)
+
+// SMARTReport is a full SMART reading of one device, as reported by smartctl
+type SMARTReport struct {
+	Device          string    `json:"device"`      // e.g. "sda", "nvme0n1"
+	DeviceType      string    `json:"device_type"` // ata, scsi, nvme
+	Model           string    `json:"model,omitempty"`
+	Serial          string    `json:"serial,omitempty"`
+	Firmware        string    `json:"firmware,omitempty"`
+	CapacityBytes   int64     `json:"capacity_bytes,omitempty"`
+	RotationRateRPM int       `json:"rotation_rate_rpm,omitempty"` // 0 for solid state
+	CollectedAt     time.Time `json:"collected_at"`
+
+	// Passed is the device's overall health self-assessment
+	Passed             bool  `json:"passed"`
+	TemperatureCelsius int   `json:"temperature_celsius,omitempty"`
+	PowerOnHours       int64 `json:"power_on_hours,omitempty"`
+	PowerCycles        int64 `json:"power_cycles,omitempty"`
+
+	Attributes    []SMARTAttribute    `json:"attributes,omitempty"`  // ATA attribute table
+	NVMeHealth    *NVMeHealthLog      `json:"nvme_health,omitempty"` // NVMe SMART/health log
+	ErrorCounters *SMARTErrorCounters `json:"error_counters,omitempty"`
+	SelfTests     []SMARTSelfTest     `json:"self_tests,omitempty"` // Most recent first
+}
+
+// SMARTAttribute is one row of an ATA SMART attribute table
+type SMARTAttribute struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Value      int    `json:"value"` // Normalized, higher is better
+	Worst      int    `json:"worst"`
+	Threshold  int    `json:"threshold"`
+	RawValue   int64  `json:"raw_value"`
+	RawString  string `json:"raw_string,omitempty"`
+	Flags      string `json:"flags,omitempty"`
+	Prefailure bool   `json:"prefailure"`            // Pre-failure rather than old-age attribute
+	WhenFailed string `json:"when_failed,omitempty"` // "", "now", or "past"
+}
+
+// Failing returns true if the normalized value is at or below its threshold
+func (a *SMARTAttribute) Failing() bool {
+	return a.Threshold > 0 && a.Value <= a.Threshold
+}
+
+// NVMeHealthLog is the NVMe SMART/health information log page
+type NVMeHealthLog struct {
+	CriticalWarning         int     `json:"critical_warning"`
+	TemperatureCelsius      int     `json:"temperature_celsius"`
+	AvailableSpare          float64 `json:"available_spare"` // Percent
+	AvailableSpareThreshold float64 `json:"available_spare_threshold"`
+	PercentageUsed          float64 `json:"percentage_used"` // Of rated endurance, may exceed 100
+	DataUnitsRead           int64   `json:"data_units_read"` // Units of 512,000 bytes
+	DataUnitsWritten        int64   `json:"data_units_written"`
+	HostReadCommands        int64   `json:"host_read_commands"`
+	HostWriteCommands       int64   `json:"host_write_commands"`
+	ControllerBusyMinutes   int64   `json:"controller_busy_minutes"`
+	PowerCycles             int64   `json:"power_cycles"`
+	PowerOnHours            int64   `json:"power_on_hours"`
+	UnsafeShutdowns         int64   `json:"unsafe_shutdowns"`
+	MediaErrors             int64   `json:"media_errors"`
+	ErrorLogEntries         int64   `json:"error_log_entries"`
+	WarningTempMinutes      int64   `json:"warning_temp_minutes,omitempty"`
+	CriticalTempMinutes     int64   `json:"critical_temp_minutes,omitempty"`
+	TemperatureSensors      []int   `json:"temperature_sensors,omitempty"`
+}
+
+// HasWarning returns true if the given critical warning bit is set
+func (l *NVMeHealthLog) HasWarning(bit int) bool {
+	return l.CriticalWarning&bit != 0
+}
+
+// SMARTErrorCounters are the device's error logs and counters: the ATA error
+// log count and SCSI read/write/verify error counter logs
+type SMARTErrorCounters struct {
+	ErrorLogCount     int64 `json:"error_log_count,omitempty"`
+	CRCErrors         int64 `json:"crc_errors,omitempty"`
+	ReadCorrected     int64 `json:"read_corrected,omitempty"`
+	ReadUncorrected   int64 `json:"read_uncorrected,omitempty"`
+	WriteCorrected    int64 `json:"write_corrected,omitempty"`
+	WriteUncorrected  int64 `json:"write_uncorrected,omitempty"`
+	VerifyUncorrected int64 `json:"verify_uncorrected,omitempty"`
+	GrownDefects      int64 `json:"grown_defects,omitempty"` // SCSI grown defect list length
+}
+
+// Uncorrected returns the total of uncorrected read, write and verify errors
+func (c *SMARTErrorCounters) Uncorrected() int64 {
+	return c.ReadUncorrected + c.WriteUncorrected + c.VerifyUncorrected
+}
+
+// SMARTSelfTest is an entry of the device's self-test log
+type SMARTSelfTest struct {
+	Type          string `json:"type"`   // short, extended, conveyance
+	Status        string `json:"status"` // e.g. "completed", "aborted", "read failure"
+	Passed        bool   `json:"passed"`
+	LifetimeHours int64  `json:"lifetime_hours"`
+	FirstErrorLBA *int64 `json:"first_error_lba,omitempty"`
+}
+
+// Attribute returns the ATA attribute with the given ID, or nil
+func (r *SMARTReport) Attribute(id int) *SMARTAttribute {
+	for i := range r.Attributes {
+		if r.Attributes[i].ID == id {
+			return &r.Attributes[i]
+		}
+	}
+	return nil
+}
+
+// FailingAttributes returns the attributes at or below their threshold
+func (r *SMARTReport) FailingAttributes() []SMARTAttribute {
+	var failing []SMARTAttribute
+	for _, attr := range r.Attributes {
+		if attr.Failing() {
+			failing = append(failing, attr)
+		}
+	}
+	return failing
+}
+
+// ReallocatedSectors returns the reallocated sector count, or the grown defect
+// list length for SCSI devices
+func (r *SMARTReport) ReallocatedSectors() int64 {
+	if attr := r.Attribute(SMARTAttrReallocatedSectors); attr != nil {
+		return attr.RawValue
+	}
+	if r.ErrorCounters != nil {
+		return r.ErrorCounters.GrownDefects
+	}
+	return 0
+}
+
+// PendingSectors returns the count of sectors waiting to be reallocated
+func (r *SMARTReport) PendingSectors() int64 {
+	if attr := r.Attribute(SMARTAttrPendingSectors); attr != nil {
+		return attr.RawValue
+	}
+	return 0
+}
+
+// WearLevelPercent returns the percentage of rated endurance used, from the
+// NVMe health log or the SSD wear attributes. It returns false for devices
+// that do not report wear, such as hard disks.
+func (r *SMARTReport) WearLevelPercent() (float64, bool) {
+	if r.NVMeHealth != nil {
+		return r.NVMeHealth.PercentageUsed, true
+	}
+	for _, id := range []int{SMARTAttrWearLevelingCount, SMARTAttrSSDLifeLeft, SMARTAttrMediaWearout} {
+		if attr := r.Attribute(id); attr != nil {
+			return float64(100 - attr.Value), true
+		}
+	}
+	return 0, false
+}
+
+// SMARTHistory is the SMART trend of one device
+type SMARTHistory struct {
+	ServerUUID string              `json:"server_uuid"`
+	Device     string              `json:"device"`
+	Serial     string              `json:"serial,omitempty"`
+	Points     []SMARTHistoryPoint `json:"points"` // Oldest first
+}
+
+// SMARTHistoryPoint is a SMART reading summarized for trending
+type SMARTHistoryPoint struct {
+	Timestamp          time.Time `json:"timestamp"`
+	Passed             bool      `json:"passed"`
+	TemperatureCelsius int       `json:"temperature_celsius,omitempty"`
+	PowerOnHours       int64     `json:"power_on_hours,omitempty"`
+	ReallocatedSectors int64     `json:"reallocated_sectors"`
+	PendingSectors     int64     `json:"pending_sectors"`
+	MediaErrors        int64     `json:"media_errors,omitempty"`
+	WearLevelPercent   *float64  `json:"wear_level_percent,omitempty"`
+}
+
+// ReallocatedGrowth returns how many sectors were reallocated over the history
+func (h *SMARTHistory) ReallocatedGrowth() int64 {
+	if len(h.Points) < 2 {
+		return 0
+	}
+	return h.Points[len(h.Points)-1].ReallocatedSectors - h.Points[0].ReallocatedSectors
+}
+
+// WearRatePerDay returns the average wear level increase per day between the
+// first and last points reporting wear. It returns false without two such points.
+func (h *SMARTHistory) WearRatePerDay() (float64, bool) {
+	var first, last *SMARTHistoryPoint
+	for i := range h.Points {
+		if h.Points[i].WearLevelPercent == nil {
+			continue
+		}
+		if first == nil {
+			first = &h.Points[i]
+		}
+		last = &h.Points[i]
+	}
+	if first == nil || first == last {
+		return 0, false
+	}
+	days := last.Timestamp.Sub(first.Timestamp).Hours() / 24
+	if days <= 0 {
+		return 0, false
+	}
+	return (*last.WearLevelPercent - *first.WearLevelPercent) / days, true
+}
+
+// EstimatedWearOut projects when the device reaches 100% of its rated
+// endurance at its current wear rate. It returns false if wear is not
+// increasing.
+func (h *SMARTHistory) EstimatedWearOut() (time.Time, bool) {
+	rate, ok := h.WearRatePerDay()
+	if !ok || rate <= 0 {
+		return time.Time{}, false
+	}
+	var last *SMARTHistoryPoint
+	for i := range h.Points {
+		if h.Points[i].WearLevelPercent != nil {
+			last = &h.Points[i]
+		}
+	}
+	remaining := (100 - *last.WearLevelPercent) / rate
+	return last.Timestamp.Add(time.Duration(remaining * 24 * float64(time.Hour))), true
+}
+
+// SubmitSMART submits full SMART reports for a server's devices, including
+// attribute tables, NVMe health logs and error counters
+func (s *SmartHealthService) SubmitSMART(ctx context.Context, serverUUID string, reports []SMARTReport) error {
+	if serverUUID == "" {
+		return fmt.Errorf("server UUID is required")
+	}
+	for i, report := range reports {
+		if report.Device == "" {
+			return fmt.Errorf("reports[%d]: device is required", i)
+		}
+	}
+
+	var resp StandardResponse
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v2/servers/%s/smart", url.PathEscape(serverUUID)),
+		Body:   map[string]interface{}{"reports": reports},
+		Result: &resp,
+	})
+	return err
+}
+
+// GetSMARTHistory retrieves the SMART trend of a device, e.g. "sda", for
+// tracking reallocated sectors and wear level. A nil timeRange returns the
+// API's default window.
+func (s *SmartHealthService) GetSMARTHistory(ctx context.Context, serverUUID, device string, timeRange *QueryTimeRange) (*SMARTHistory, error) {
+	if serverUUID == "" || device == "" {
+		return nil, fmt.Errorf("server UUID and device are required")
+	}
+
+	query := map[string]string{"device": device}
+	if timeRange != nil {
+		query["start"], query["end"] = timeRange.ToStrings()
+	}
+
+	var resp StandardResponse
+	resp.Data = &SMARTHistory{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v2/servers/%s/smart/history", url.PathEscape(serverUUID)),
+		Query:  query,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if history, ok := resp.Data.(*SMARTHistory); ok {
+		return history, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
@@ -0,0 +1,132 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMARTReport_Helpers(t *testing.T) {
+	hdd := SMARTReport{
+		Device:     "sda",
+		DeviceType: SMARTDeviceTypeATA,
+		Attributes: []SMARTAttribute{
+			{ID: SMARTAttrReallocatedSectors, Name: "Reallocated_Sector_Ct", Value: 5, Threshold: 10, RawValue: 1904, Prefailure: true},
+			{ID: SMARTAttrPendingSectors, Name: "Current_Pending_Sector", Value: 100, RawValue: 8},
+		},
+	}
+	assert.Equal(t, int64(1904), hdd.ReallocatedSectors())
+	assert.Equal(t, int64(8), hdd.PendingSectors())
+	require.Len(t, hdd.FailingAttributes(), 1)
+	assert.Equal(t, "Reallocated_Sector_Ct", hdd.FailingAttributes()[0].Name)
+	_, ok := hdd.WearLevelPercent()
+	assert.False(t, ok)
+
+	ssd := SMARTReport{Attributes: []SMARTAttribute{{ID: SMARTAttrWearLevelingCount, Value: 93, Threshold: 0}}}
+	wear, ok := ssd.WearLevelPercent()
+	assert.True(t, ok)
+	assert.Equal(t, 7.0, wear)
+
+	nvme := SMARTReport{NVMeHealth: &NVMeHealthLog{PercentageUsed: 12, CriticalWarning: NVMeWarningSpare | NVMeWarningReadOnly}}
+	wear, _ = nvme.WearLevelPercent()
+	assert.Equal(t, 12.0, wear)
+	assert.True(t, nvme.NVMeHealth.HasWarning(NVMeWarningReadOnly))
+	assert.False(t, nvme.NVMeHealth.HasWarning(NVMeWarningTemperature))
+
+	scsi := SMARTReport{ErrorCounters: &SMARTErrorCounters{GrownDefects: 3, ReadUncorrected: 1, VerifyUncorrected: 2}}
+	assert.Equal(t, int64(3), scsi.ReallocatedSectors())
+	assert.Equal(t, int64(3), scsi.ErrorCounters.Uncorrected())
+}
+
+func TestSMARTHistory_Trends(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	wear := func(v float64) *float64 { return &v }
+	history := SMARTHistory{Points: []SMARTHistoryPoint{
+		{Timestamp: start, ReallocatedSectors: 10, WearLevelPercent: wear(20)},
+		{Timestamp: start.AddDate(0, 0, 5), ReallocatedSectors: 12},
+		{Timestamp: start.AddDate(0, 0, 10), ReallocatedSectors: 16, WearLevelPercent: wear(21)},
+	}}
+
+	assert.Equal(t, int64(6), history.ReallocatedGrowth())
+	rate, ok := history.WearRatePerDay()
+	require.True(t, ok)
+	assert.InDelta(t, 0.1, rate, 1e-9)
+	wearOut, ok := history.EstimatedWearOut()
+	require.True(t, ok)
+	assert.Equal(t, start.AddDate(0, 0, 800), wearOut.Round(time.Hour))
+
+	flat := SMARTHistory{Points: history.Points[:2]}
+	_, ok = flat.WearRatePerDay()
+	assert.False(t, ok)
+	_, ok = flat.EstimatedWearOut()
+	assert.False(t, ok)
+}
+
+func TestSmartHealthService_SubmitSMART(t *testing.T) {
+	reports := []SMARTReport{{
+		Device:      "nvme0n1",
+		DeviceType:  SMARTDeviceTypeNVMe,
+		Model:       "Samsung SSD 980 PRO 1TB",
+		CollectedAt: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		Passed:      true,
+		NVMeHealth:  &NVMeHealthLog{TemperatureCelsius: 41, AvailableSpare: 100, AvailableSpareThreshold: 10, PercentageUsed: 3, MediaErrors: 0},
+		SelfTests:   []SMARTSelfTest{{Type: "short", Status: "completed", Passed: true, LifetimeHours: 1200}},
+	}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v2/servers/srv-1/smart", r.URL.Path)
+		var body struct {
+			Reports []SMARTReport `json:"reports"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, reports, body.Reports)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{ServerUUID: "srv-1", ServerSecret: "secret"}})
+	require.NoError(t, err)
+
+	require.NoError(t, client.SmartHealth.SubmitSMART(context.Background(), "srv-1", reports))
+	assert.Error(t, client.SmartHealth.SubmitSMART(context.Background(), "", reports))
+	assert.Error(t, client.SmartHealth.SubmitSMART(context.Background(), "srv-1", []SMARTReport{{}}))
+}
+
+func TestSmartHealthService_GetSMARTHistory(t *testing.T) {
+	timeRange := Last30Days()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v2/servers/srv-1/smart/history", r.URL.Path)
+		assert.Equal(t, "sda", r.URL.Query().Get("device"))
+		start, _ := timeRange.ToStrings()
+		assert.Equal(t, start, r.URL.Query().Get("start"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{"server_uuid":"srv-1","device":"sda","points":[
+			{"timestamp":"2026-03-01T00:00:00Z","passed":true,"reallocated_sectors":0,"pending_sectors":0},
+			{"timestamp":"2026-03-02T00:00:00Z","passed":true,"reallocated_sectors":24,"pending_sectors":3}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	history, err := client.SmartHealth.GetSMARTHistory(context.Background(), "srv-1", "sda", timeRange)
+	require.NoError(t, err)
+	assert.Equal(t, "sda", history.Device)
+	require.Len(t, history.Points, 2)
+	assert.Equal(t, int64(24), history.ReallocatedGrowth())
+
+	_, err = client.SmartHealth.GetSMARTHistory(context.Background(), "srv-1", "", nil)
+	assert.Error(t, err)
+}
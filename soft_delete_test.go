@@ -0,0 +1,78 @@
+package nexmonyx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListOptions_DeletedQuery(t *testing.T) {
+	assert.NotContains(t, (&ListOptions{}).ToQuery(), "include_deleted")
+	assert.Equal(t, "true", (&ListOptions{IncludeDeleted: true}).ToQuery()["include_deleted"])
+
+	query := (&ListOptions{IncludeDeleted: true, OnlyDeleted: true}).ToQuery()
+	assert.Equal(t, "true", query["only_deleted"])
+	assert.NotContains(t, query, "include_deleted", "only_deleted takes precedence")
+
+	assert.False(t, (&GormModel{}).IsDeleted())
+	assert.False(t, (&GormModel{DeletedAt: &CustomTime{}}).IsDeleted())
+	assert.True(t, (&GormModel{DeletedAt: &CustomTime{Time: time.Now()}}).IsDeleted())
+}
+
+func TestSoftDeletedResources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/servers":
+			assert.Equal(t, "true", r.URL.Query().Get("only_deleted"))
+			w.Write([]byte(`{"status":"success","data":[{"server_uuid":"srv-1","deleted_at":"2026-03-01T00:00:00Z"}]}`))
+		case "GET /v2/probes", "GET /v2/api-keys":
+			assert.Equal(t, "true", r.URL.Query().Get("include_deleted"))
+			w.Write([]byte(`{"status":"success","data":[]}`))
+		case "POST /v1/admin/server/srv-1/undelete":
+			w.Write([]byte(`{"status":"success","data":{"server_uuid":"srv-1"}}`))
+		case "POST /v2/probes/probe-1/undelete":
+			w.Write([]byte(`{"status":"success","data":{"uuid":"probe-1"}}`))
+		case "POST /v2/api-keys/key-1/undelete":
+			w.Write([]byte(`{"status":"success","data":{"key_id":"key-1"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":"error","message":"not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	servers, _, err := client.Servers.List(ctx, &ListOptions{OnlyDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, servers, 1)
+	assert.True(t, servers[0].IsDeleted())
+
+	_, _, err = client.Probes.List(ctx, &ListOptions{IncludeDeleted: true})
+	require.NoError(t, err)
+	_, _, err = client.APIKeys.ListUnified(ctx, &ListUnifiedAPIKeysOptions{ListOptions: ListOptions{IncludeDeleted: true}})
+	require.NoError(t, err)
+
+	restored, err := client.Servers.Undelete(ctx, "srv-1")
+	require.NoError(t, err)
+	assert.False(t, restored.IsDeleted())
+
+	probe, err := client.Probes.Undelete(ctx, "probe-1")
+	require.NoError(t, err)
+	assert.Equal(t, "probe-1", probe.ProbeUUID)
+
+	key, err := client.APIKeys.UndeleteUnified(ctx, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", key.KeyID)
+
+	_, err = client.Probes.Undelete(ctx, "probe-2")
+	assert.True(t, IsNotFound(err))
+}
@@ -0,0 +1,39 @@
+package nexmonyx
+
+import (
+	"context"
+	"strings"
+)
+
+// SetSpoolOnly switches the client into or out of spool-only mode. While it
+// is on, requests fail immediately with ErrSpoolOnly instead of waiting for
+// timeouts and retries against an API that is unreachable, so the agent can
+// spool its submissions for a later Metrics.Backfill. Heartbeats are still
+// sent, so that recovery can be detected.
+//
+// A HeartbeatMonitor with SpoolWhenOffline set manages the mode automatically.
+func (c *Client) SetSpoolOnly(spoolOnly bool) {
+	c.spoolOnly.Store(spoolOnly)
+}
+
+// SpoolOnly reports whether the client is in spool-only mode
+func (c *Client) SpoolOnly() bool {
+	return c.spoolOnly.Load()
+}
+
+type spoolBypassKey struct{}
+
+// withSpoolBypass marks ctx's requests as allowed in spool-only mode
+func withSpoolBypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, spoolBypassKey{}, true)
+}
+
+// spoolOnlyBlocks reports whether req must fail with ErrSpoolOnly. Heartbeats,
+// identified by their path or sent by a HeartbeatMonitor, are let through.
+func (c *Client) spoolOnlyBlocks(ctx context.Context, req *Request) bool {
+	if !c.spoolOnly.Load() || strings.HasSuffix(req.Path, "/heartbeat") {
+		return false
+	}
+	bypass, _ := ctx.Value(spoolBypassKey{}).(bool)
+	return !bypass
+}
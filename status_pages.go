@@ -0,0 +1,392 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
+
+// Status page component statuses, matching those shown by the statusclient package
+const (
+	StatusPageComponentOperational = "operational"
+	StatusPageComponentDegraded    = "degraded_performance"
+	StatusPageComponentPartial     = "partial_outage"
+	StatusPageComponentMajor       = "major_outage"
+	StatusPageComponentMaintenance = "maintenance"
+)
+
+// Status page incident statuses
+const (
+	StatusPageIncidentInvestigating = "investigating"
+	StatusPageIncidentIdentified    = "identified"
+	StatusPageIncidentMonitoring    = "monitoring"
+	StatusPageIncidentResolved      = "resolved"
+)
+
+// Status page incident impacts
+const (
+	StatusPageImpactNone     = "none"
+	StatusPageImpactMinor    = "minor"
+	StatusPageImpactMajor    = "major"
+	StatusPageImpactCritical = "critical"
+)
+
+// statusPageSlugPattern matches the lowercase, hyphenated slugs used in public status page URLs
+var statusPageSlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// StatusPage represents a public status page managed by the organization
+type StatusPage struct {
+	ID               uint                  `json:"id"`
+	OrganizationID   uint                  `json:"organization_id"`
+	Name             string                `json:"name"`
+	Slug             string                `json:"slug"`
+	Title            string                `json:"title"`
+	Description      string                `json:"description,omitempty"`
+	CustomDomain     string                `json:"custom_domain,omitempty"`
+	Theme            StatusPageTheme       `json:"theme"`
+	IsPublic         bool                  `json:"is_public"`
+	ShowDetailedInfo bool                  `json:"show_detailed_info"`
+	ContactInfo      StatusPageContact     `json:"contact_info"`
+	SocialLinks      StatusPageSocial      `json:"social_links"`
+	Status           string                `json:"status"` // Worst status of the page's components
+	Components       []StatusPageComponent `json:"components,omitempty"`
+	CreatedAt        *CustomTime           `json:"created_at"`
+	UpdatedAt        *CustomTime           `json:"updated_at"`
+}
+
+// StatusPageTheme customizes the appearance of a status page
+type StatusPageTheme struct {
+	PrimaryColor    string `json:"primary_color,omitempty"`
+	SecondaryColor  string `json:"secondary_color,omitempty"`
+	BackgroundColor string `json:"background_color,omitempty"`
+	TextColor       string `json:"text_color,omitempty"`
+	LogoURL         string `json:"logo_url,omitempty"`
+	FaviconURL      string `json:"favicon_url,omitempty"`
+	CustomCSS       string `json:"custom_css,omitempty"`
+}
+
+// StatusPageContact is the support contact shown on a status page
+type StatusPageContact struct {
+	Email   string `json:"email,omitempty"`
+	Phone   string `json:"phone,omitempty"`
+	Website string `json:"website,omitempty"`
+}
+
+// StatusPageSocial links a status page to the organization's social accounts
+type StatusPageSocial struct {
+	Twitter  string `json:"twitter,omitempty"`
+	Facebook string `json:"facebook,omitempty"`
+	LinkedIn string `json:"linkedin,omitempty"`
+}
+
+// StatusPageComponent is a part of the service shown on a status page. Its
+// status follows its linked probes, or is set manually when it has none.
+type StatusPageComponent struct {
+	ID           uint        `json:"id"`
+	StatusPageID uint        `json:"status_page_id"`
+	Name         string      `json:"name"`
+	Description  string      `json:"description,omitempty"`
+	Group        string      `json:"group,omitempty"`
+	Status       string      `json:"status"`
+	DisplayOrder int         `json:"display_order"`
+	ProbeUUIDs   []string    `json:"probe_uuids,omitempty"`
+	CreatedAt    *CustomTime `json:"created_at"`
+	UpdatedAt    *CustomTime `json:"updated_at"`
+}
+
+// CreateStatusPageRequest represents a request to create a status page
+type CreateStatusPageRequest struct {
+	Name             string            `json:"name"`
+	Slug             string            `json:"slug"` // Lowercase letters, digits, and hyphens
+	Title            string            `json:"title,omitempty"`
+	Description      string            `json:"description,omitempty"`
+	CustomDomain     string            `json:"custom_domain,omitempty"`
+	Theme            StatusPageTheme   `json:"theme"`
+	Probes           []string          `json:"probes,omitempty"` // Probe UUIDs, each shown as a component
+	IsPublic         bool              `json:"is_public"`
+	ShowDetailedInfo bool              `json:"show_detailed_info"`
+	ContactInfo      StatusPageContact `json:"contact_info"`
+	SocialLinks      StatusPageSocial  `json:"social_links"`
+}
+
+// UpdateStatusPageRequest represents a request to update a status page
+type UpdateStatusPageRequest struct {
+	Name             *string            `json:"name,omitempty"`
+	Slug             *string            `json:"slug,omitempty"`
+	Title            *string            `json:"title,omitempty"`
+	Description      *string            `json:"description,omitempty"`
+	CustomDomain     *string            `json:"custom_domain,omitempty"`
+	Theme            *StatusPageTheme   `json:"theme,omitempty"`
+	IsPublic         *bool              `json:"is_public,omitempty"`
+	ShowDetailedInfo *bool              `json:"show_detailed_info,omitempty"`
+	ContactInfo      *StatusPageContact `json:"contact_info,omitempty"`
+	SocialLinks      *StatusPageSocial  `json:"social_links,omitempty"`
+}
+
+// StatusPageComponentRequest represents a request to add a component to a status page
+type StatusPageComponentRequest struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description,omitempty"`
+	Group        string   `json:"group,omitempty"`
+	Status       string   `json:"status,omitempty"` // Default: operational
+	DisplayOrder int      `json:"display_order,omitempty"`
+	ProbeUUIDs   []string `json:"probe_uuids,omitempty"`
+}
+
+// StatusPageIncident is an incident published on a status page
+type StatusPageIncident struct {
+	ID           uint                       `json:"id"`
+	StatusPageID uint                       `json:"status_page_id"`
+	Title        string                     `json:"title"`
+	Status       string                     `json:"status"`
+	Impact       string                     `json:"impact"`
+	ComponentIDs []uint                     `json:"component_ids,omitempty"`
+	Updates      []StatusPageIncidentUpdate `json:"updates,omitempty"` // Newest first
+	StartedAt    *CustomTime                `json:"started_at"`
+	ResolvedAt   *CustomTime                `json:"resolved_at,omitempty"`
+}
+
+// StatusPageIncidentUpdate is a message posted on a status page incident
+type StatusPageIncidentUpdate struct {
+	ID        uint        `json:"id"`
+	Status    string      `json:"status"`
+	Message   string      `json:"message"`
+	CreatedAt *CustomTime `json:"created_at"`
+}
+
+// PublishIncidentUpdateRequest posts an update to a status page incident, or
+// opens a new incident when IncidentID is zero
+type PublishIncidentUpdateRequest struct {
+	IncidentID uint   `json:"-"`
+	Title      string `json:"title,omitempty"`  // Required for new incidents
+	Status     string `json:"status"`           // investigating, identified, monitoring, resolved
+	Impact     string `json:"impact,omitempty"` // none, minor, major, critical
+	Message    string `json:"message"`
+
+	// ComponentIDs are the affected components. ComponentStatus, when set, is
+	// applied to them; resolving an incident returns them to operational.
+	ComponentIDs    []uint `json:"component_ids,omitempty"`
+	ComponentStatus string `json:"component_status,omitempty"`
+
+	// NotifySubscribers emails and notifies the page's subscribers
+	NotifySubscribers bool `json:"notify_subscribers"`
+}
+
+// Validate checks the update locally before it is published
+func (r *PublishIncidentUpdateRequest) Validate() error {
+	if r.IncidentID == 0 && r.Title == "" {
+		return fmt.Errorf("title is required for a new incident")
+	}
+	if r.Message == "" {
+		return fmt.Errorf("message is required")
+	}
+	switch r.Status {
+	case StatusPageIncidentInvestigating, StatusPageIncidentIdentified, StatusPageIncidentMonitoring, StatusPageIncidentResolved:
+	default:
+		return fmt.Errorf("invalid incident status %q", r.Status)
+	}
+	switch r.Impact {
+	case "", StatusPageImpactNone, StatusPageImpactMinor, StatusPageImpactMajor, StatusPageImpactCritical:
+	default:
+		return fmt.Errorf("invalid incident impact %q", r.Impact)
+	}
+	if r.ComponentStatus != "" && !isStatusPageComponentStatus(r.ComponentStatus) {
+		return fmt.Errorf("invalid component status %q", r.ComponentStatus)
+	}
+	return nil
+}
+
+func isStatusPageComponentStatus(status string) bool {
+	switch status {
+	case StatusPageComponentOperational, StatusPageComponentDegraded, StatusPageComponentPartial,
+		StatusPageComponentMajor, StatusPageComponentMaintenance:
+		return true
+	}
+	return false
+}
+
+// Create creates a status page. The organization's package limits how many
+// status pages it can have (OrganizationPackage.MaxStatusPages).
+func (s *StatusPagesService) Create(ctx context.Context, req *CreateStatusPageRequest) (*StatusPage, error) {
+	if req == nil || req.Name == "" {
+		return nil, fmt.Errorf("status page name is required")
+	}
+	if !statusPageSlugPattern.MatchString(req.Slug) {
+		return nil, fmt.Errorf("invalid status page slug %q: use lowercase letters, digits, and hyphens", req.Slug)
+	}
+
+	var resp StandardResponse
+	resp.Data = &StatusPage{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v1/status-pages",
+		Body:   req,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if page, ok := resp.Data.(*StatusPage); ok {
+		return page, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Get retrieves a status page by ID, including its components
+func (s *StatusPagesService) Get(ctx context.Context, pageID uint) (*StatusPage, error) {
+	var resp StandardResponse
+	resp.Data = &StatusPage{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/status-pages/%d", pageID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if page, ok := resp.Data.(*StatusPage); ok {
+		return page, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// List retrieves the organization's status pages
+func (s *StatusPagesService) List(ctx context.Context, opts *ListOptions) ([]*StatusPage, *PaginationMeta, error) {
+	var resp PaginatedResponse
+	var pages []*StatusPage
+	resp.Data = &pages
+
+	req := &Request{
+		Method: "GET",
+		Path:   "/v1/status-pages",
+		Result: &resp,
+	}
+
+	if opts != nil {
+		req.Query = opts.ToQuery()
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pages, resp.Meta, nil
+}
+
+// Update updates a status page
+func (s *StatusPagesService) Update(ctx context.Context, pageID uint, req *UpdateStatusPageRequest) (*StatusPage, error) {
+	if req != nil && req.Slug != nil && !statusPageSlugPattern.MatchString(*req.Slug) {
+		return nil, fmt.Errorf("invalid status page slug %q: use lowercase letters, digits, and hyphens", *req.Slug)
+	}
+
+	var resp StandardResponse
+	resp.Data = &StatusPage{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v1/status-pages/%d", pageID),
+		Body:   req,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if page, ok := resp.Data.(*StatusPage); ok {
+		return page, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Delete deletes a status page and its components and incidents
+func (s *StatusPagesService) Delete(ctx context.Context, pageID uint) error {
+	var resp StandardResponse
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/status-pages/%d", pageID),
+		Result: &resp,
+	})
+	return err
+}
+
+// AddComponent adds a component to a status page
+func (s *StatusPagesService) AddComponent(ctx context.Context, pageID uint, req *StatusPageComponentRequest) (*StatusPageComponent, error) {
+	if req == nil || req.Name == "" {
+		return nil, fmt.Errorf("component name is required")
+	}
+	if req.Status != "" && !isStatusPageComponentStatus(req.Status) {
+		return nil, fmt.Errorf("invalid component status %q", req.Status)
+	}
+
+	return s.createComponent(ctx, fmt.Sprintf("/v1/status-pages/%d/components", pageID), req)
+}
+
+// LinkProbe shows a probe on a status page as a component named after the
+// probe, whose status follows the probe's results
+func (s *StatusPagesService) LinkProbe(ctx context.Context, pageID uint, probeUUID string) (*StatusPageComponent, error) {
+	if probeUUID == "" {
+		return nil, fmt.Errorf("probe UUID is required")
+	}
+
+	return s.createComponent(ctx, fmt.Sprintf("/v1/status-pages/%d/probes", pageID), map[string]string{"probe_uuid": probeUUID})
+}
+
+func (s *StatusPagesService) createComponent(ctx context.Context, path string, body interface{}) (*StatusPageComponent, error) {
+	var resp StandardResponse
+	resp.Data = &StatusPageComponent{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   path,
+		Body:   body,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if component, ok := resp.Data.(*StatusPageComponent); ok {
+		return component, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// PublishIncidentUpdate posts an update to a status page incident, or opens
+// a new incident when req.IncidentID is zero, and returns the incident
+func (s *StatusPagesService) PublishIncidentUpdate(ctx context.Context, pageID uint, req *PublishIncidentUpdateRequest) (*StatusPageIncident, error) {
+	if req == nil {
+		return nil, fmt.Errorf("incident update is required")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/status-pages/%d/incidents", pageID)
+	if req.IncidentID != 0 {
+		path = fmt.Sprintf("%s/%d/updates", path, req.IncidentID)
+	}
+
+	var resp StandardResponse
+	resp.Data = &StatusPageIncident{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   path,
+		Body:   req,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if incident, ok := resp.Data.(*StatusPageIncident); ok {
+		return incident, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
@@ -0,0 +1,154 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusPagesService_CRUD(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v1/status-pages":
+			var req CreateStatusPageRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, []string{"probe-1"}, req.Probes)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data":   StatusPage{ID: 7, Name: req.Name, Slug: req.Slug, IsPublic: req.IsPublic, Status: StatusPageComponentOperational},
+			})
+		case "GET /v1/status-pages":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data":   []StatusPage{{ID: 7, Slug: "acme"}},
+				"meta":   PaginationMeta{Page: 1, TotalPages: 1},
+			})
+		case "GET /v1/status-pages/7":
+			w.Write([]byte(`{"status":"success","data":{"id":7,"slug":"acme","components":[{"id":3,"name":"API","status":"operational"}]}}`))
+		case "PUT /v1/status-pages/7":
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"title": "Acme Status", "is_public": false}, body)
+			w.Write([]byte(`{"status":"success","data":{"id":7,"title":"Acme Status"}}`))
+		case "DELETE /v1/status-pages/7":
+			w.Write([]byte(`{"status":"success"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	page, err := client.StatusPages.Create(ctx, &CreateStatusPageRequest{Name: "Acme", Slug: "acme", Probes: []string{"probe-1"}, IsPublic: true})
+	require.NoError(t, err)
+	assert.Equal(t, uint(7), page.ID)
+	assert.True(t, page.IsPublic)
+
+	pages, _, err := client.StatusPages.List(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, pages, 1)
+
+	page, err = client.StatusPages.Get(ctx, 7)
+	require.NoError(t, err)
+	require.Len(t, page.Components, 1)
+	assert.Equal(t, "API", page.Components[0].Name)
+
+	title, public := "Acme Status", false
+	page, err = client.StatusPages.Update(ctx, 7, &UpdateStatusPageRequest{Title: &title, IsPublic: &public})
+	require.NoError(t, err)
+	assert.Equal(t, "Acme Status", page.Title)
+
+	require.NoError(t, client.StatusPages.Delete(ctx, 7))
+
+	_, err = client.StatusPages.Create(ctx, &CreateStatusPageRequest{Name: "Acme", Slug: "Acme Status"})
+	assert.Error(t, err)
+	slug := "bad_slug"
+	_, err = client.StatusPages.Update(ctx, 7, &UpdateStatusPageRequest{Slug: &slug})
+	assert.Error(t, err)
+	assert.Len(t, requests, 5, "invalid slugs are rejected locally")
+}
+
+func TestStatusPagesService_ComponentsAndIncidents(t *testing.T) {
+	bodies := map[string]map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		bodies[r.URL.Path] = body
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v1/status-pages/7/components":
+			w.Write([]byte(`{"status":"success","data":{"id":3,"status_page_id":7,"name":"API","status":"operational"}}`))
+		case "/v1/status-pages/7/probes":
+			w.Write([]byte(`{"status":"success","data":{"id":4,"status_page_id":7,"name":"Checkout","probe_uuids":["probe-1"]}}`))
+		case "/v1/status-pages/7/incidents":
+			w.Write([]byte(`{"status":"success","data":{"id":11,"title":"Elevated errors","status":"investigating"}}`))
+		case "/v1/status-pages/7/incidents/11/updates":
+			w.Write([]byte(`{"status":"success","data":{"id":11,"status":"resolved","updates":[{"id":2,"status":"resolved"},{"id":1,"status":"investigating"}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	component, err := client.StatusPages.AddComponent(ctx, 7, &StatusPageComponentRequest{Name: "API", Group: "Core"})
+	require.NoError(t, err)
+	assert.Equal(t, uint(3), component.ID)
+	assert.Equal(t, "Core", bodies["/v1/status-pages/7/components"]["group"])
+
+	component, err = client.StatusPages.LinkProbe(ctx, 7, "probe-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"probe-1"}, component.ProbeUUIDs)
+	assert.Equal(t, map[string]interface{}{"probe_uuid": "probe-1"}, bodies["/v1/status-pages/7/probes"])
+
+	incident, err := client.StatusPages.PublishIncidentUpdate(ctx, 7, &PublishIncidentUpdateRequest{
+		Title:           "Elevated errors",
+		Status:          StatusPageIncidentInvestigating,
+		Impact:          StatusPageImpactMinor,
+		Message:         "We are investigating elevated API error rates.",
+		ComponentIDs:    []uint{3},
+		ComponentStatus: StatusPageComponentPartial,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint(11), incident.ID)
+	assert.Equal(t, "partial_outage", bodies["/v1/status-pages/7/incidents"]["component_status"])
+
+	incident, err = client.StatusPages.PublishIncidentUpdate(ctx, 7, &PublishIncidentUpdateRequest{
+		IncidentID: 11,
+		Status:     StatusPageIncidentResolved,
+		Message:    "Error rates are back to normal.",
+	})
+	require.NoError(t, err)
+	assert.Len(t, incident.Updates, 2)
+	assert.NotContains(t, bodies["/v1/status-pages/7/incidents/11/updates"], "incident_id")
+
+	for _, req := range []*PublishIncidentUpdateRequest{
+		{Status: StatusPageIncidentInvestigating, Message: "new incident without a title"},
+		{IncidentID: 11, Status: StatusPageIncidentResolved},
+		{IncidentID: 11, Status: "fixed", Message: "done"},
+		{IncidentID: 11, Status: StatusPageIncidentResolved, Message: "done", ComponentStatus: "down"},
+	} {
+		_, err = client.StatusPages.PublishIncidentUpdate(ctx, 7, req)
+		assert.Error(t, err)
+	}
+	_, err = client.StatusPages.AddComponent(ctx, 7, &StatusPageComponentRequest{Name: "API", Status: "down"})
+	assert.Error(t, err)
+	_, err = client.StatusPages.LinkProbe(ctx, 7, "")
+	assert.Error(t, err)
+}
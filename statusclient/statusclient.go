@@ -0,0 +1,337 @@
+// Package statusclient reads the public JSON of Nexmonyx status pages.
+//
+// It needs no credentials and depends only on the standard library, so it can
+// be embedded in customer-facing applications that should not carry API keys
+// or the full SDK.
+//
+// Example:
+//
+//	client, err := statusclient.NewClient(nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	summary, err := client.GetSummary(ctx, "acme")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%s: %s\n", summary.Page.Name, summary.Page.Status)
+//	for _, incident := range summary.ActiveIncidents {
+//	    fmt.Printf("  %s (%s)\n", incident.Title, incident.Status)
+//	}
+package statusclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the public Nexmonyx API
+	DefaultBaseURL = "https://api.nexmonyx.com"
+
+	defaultTimeout   = 10 * time.Second
+	defaultUserAgent = "nexmonyx-statusclient"
+	maxErrorBody     = 64 * 1024
+)
+
+// Overall page and component statuses
+const (
+	StatusOperational         = "operational"
+	StatusDegradedPerformance = "degraded_performance"
+	StatusPartialOutage       = "partial_outage"
+	StatusMajorOutage         = "major_outage"
+	StatusMaintenance         = "maintenance"
+)
+
+// Incident statuses
+const (
+	IncidentInvestigating = "investigating"
+	IncidentIdentified    = "identified"
+	IncidentMonitoring    = "monitoring"
+	IncidentResolved      = "resolved"
+)
+
+// ErrNotFound is matched by errors for status pages or incidents that do not
+// exist or are not public
+var ErrNotFound = errors.New("status page not found")
+
+// Config configures a Client. All fields are optional.
+type Config struct {
+	// BaseURL of the Nexmonyx API (default: DefaultBaseURL)
+	BaseURL string
+
+	// HTTPClient used for requests (default: a client with Timeout)
+	HTTPClient *http.Client
+
+	// Timeout for requests when HTTPClient is not set (default: 10s)
+	Timeout time.Duration
+
+	// UserAgent sent with requests (default: "nexmonyx-statusclient")
+	UserAgent string
+}
+
+// Client reads public status page data. It is safe for concurrent use.
+type Client struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+	userAgent  string
+}
+
+// NewClient creates a status page client. A nil config uses the defaults.
+func NewClient(config *Config) (*Client, error) {
+	cfg := Config{}
+	if config != nil {
+		cfg = *config
+	}
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultBaseURL
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.UserAgent == "" {
+		cfg.UserAgent = defaultUserAgent
+	}
+
+	baseURL, err := url.Parse(strings.TrimRight(cfg.BaseURL, "/"))
+	if err != nil || baseURL.Scheme == "" || baseURL.Host == "" {
+		return nil, fmt.Errorf("invalid base URL %q", cfg.BaseURL)
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: cfg.Timeout}
+	}
+
+	return &Client{baseURL: baseURL, httpClient: httpClient, userAgent: cfg.UserAgent}, nil
+}
+
+// StatusPage describes a public status page and its overall status
+type StatusPage struct {
+	Slug        string    `json:"slug"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	URL         string    `json:"url,omitempty"`
+	LogoURL     string    `json:"logo_url,omitempty"`
+	TimeZone    string    `json:"time_zone,omitempty"`
+	Status      string    `json:"status"` // operational, degraded_performance, partial_outage, major_outage, maintenance
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Component is a monitored part of the service shown on a status page
+type Component struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	Description   string    `json:"description,omitempty"`
+	Group         string    `json:"group,omitempty"`
+	Status        string    `json:"status"`
+	UptimePercent float64   `json:"uptime_percent,omitempty"` // Over the page's uptime window
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// IsOperational returns true if the component is fully operational
+func (c *Component) IsOperational() bool {
+	return c.Status == StatusOperational
+}
+
+// Incident is a published incident or maintenance on a status page
+type Incident struct {
+	ID           string           `json:"id"`
+	Title        string           `json:"title"`
+	Status       string           `json:"status"` // investigating, identified, monitoring, resolved
+	Impact       string           `json:"impact"` // none, minor, major, critical
+	Maintenance  bool             `json:"maintenance,omitempty"`
+	ComponentIDs []string         `json:"component_ids,omitempty"`
+	Updates      []IncidentUpdate `json:"updates,omitempty"` // Newest first
+	StartedAt    time.Time        `json:"started_at"`
+	ResolvedAt   *time.Time       `json:"resolved_at,omitempty"`
+	ScheduledFor *time.Time       `json:"scheduled_for,omitempty"` // Start of scheduled maintenance
+}
+
+// IsResolved returns true once the incident is resolved
+func (i *Incident) IsResolved() bool {
+	return i.Status == IncidentResolved
+}
+
+// IncidentUpdate is a status message posted on an incident
+type IncidentUpdate struct {
+	Status    string    `json:"status"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Summary is everything needed to render a status page in one request
+type Summary struct {
+	Page                 StatusPage  `json:"page"`
+	Components           []Component `json:"components"`
+	ActiveIncidents      []Incident  `json:"active_incidents"`
+	ScheduledMaintenance []Incident  `json:"scheduled_maintenance,omitempty"`
+}
+
+// IncidentListOptions filters and paginates incident history
+type IncidentListOptions struct {
+	Page   int       // 1-based (default: 1)
+	Limit  int       // Per page (default: server default)
+	Since  time.Time // Only incidents started at or after Since
+	Status string    // Only incidents with this status
+}
+
+// Pagination describes a page of incident history
+type Pagination struct {
+	Page       int  `json:"page"`
+	Limit      int  `json:"limit"`
+	TotalItems int  `json:"total_items"`
+	TotalPages int  `json:"total_pages"`
+	HasMore    bool `json:"has_more"`
+}
+
+// Error is returned for non-2xx responses
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	return fmt.Sprintf("status page API error (%d): %s", e.StatusCode, e.Message)
+}
+
+// Is reports whether target is ErrNotFound and the response was a 404
+func (e *Error) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// GetStatusPage retrieves a status page and its overall status
+func (c *Client) GetStatusPage(ctx context.Context, slug string) (*StatusPage, error) {
+	var page StatusPage
+	if err := c.get(ctx, slug, "", nil, &page, nil); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// GetSummary retrieves the page, its components, and active incidents and
+// maintenance in one request
+func (c *Client) GetSummary(ctx context.Context, slug string) (*Summary, error) {
+	var summary Summary
+	if err := c.get(ctx, slug, "/summary", nil, &summary, nil); err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// ListComponents retrieves the components shown on a status page
+func (c *Client) ListComponents(ctx context.Context, slug string) ([]Component, error) {
+	var components []Component
+	if err := c.get(ctx, slug, "/components", nil, &components, nil); err != nil {
+		return nil, err
+	}
+	return components, nil
+}
+
+// ListIncidents retrieves the incident history of a status page, newest first
+func (c *Client) ListIncidents(ctx context.Context, slug string, opts *IncidentListOptions) ([]Incident, *Pagination, error) {
+	query := url.Values{}
+	if opts != nil {
+		if opts.Page > 0 {
+			query.Set("page", strconv.Itoa(opts.Page))
+		}
+		if opts.Limit > 0 {
+			query.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if !opts.Since.IsZero() {
+			query.Set("since", opts.Since.UTC().Format(time.RFC3339))
+		}
+		if opts.Status != "" {
+			query.Set("status", opts.Status)
+		}
+	}
+
+	var incidents []Incident
+	var meta Pagination
+	if err := c.get(ctx, slug, "/incidents", query, &incidents, &meta); err != nil {
+		return nil, nil, err
+	}
+	return incidents, &meta, nil
+}
+
+// GetIncident retrieves an incident with all of its updates
+func (c *Client) GetIncident(ctx context.Context, slug, incidentID string) (*Incident, error) {
+	if incidentID == "" {
+		return nil, fmt.Errorf("incident ID is required")
+	}
+
+	var incident Incident
+	if err := c.get(ctx, slug, "/incidents/"+url.PathEscape(incidentID), nil, &incident, nil); err != nil {
+		return nil, err
+	}
+	return &incident, nil
+}
+
+// get fetches /v1/public/status-pages/{slug}{suffix} and decodes the
+// response envelope's data, and meta if requested
+func (c *Client) get(ctx context.Context, slug, suffix string, query url.Values, data, meta interface{}) error {
+	if slug == "" {
+		return fmt.Errorf("status page slug is required")
+	}
+
+	u := *c.baseURL
+	u.Path += "/v1/public/status-pages/" + url.PathEscape(slug) + suffix
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return &Error{StatusCode: resp.StatusCode, Message: errorMessage(body, resp.Status)}
+	}
+
+	envelope := struct {
+		Data interface{} `json:"data"`
+		Meta interface{} `json:"meta,omitempty"`
+	}{Data: data, Meta: meta}
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// errorMessage extracts the message from an API error body
+func errorMessage(body []byte, fallback string) string {
+	var errBody struct {
+		Message string `json:"message"`
+		Error   string `json:"error"`
+	}
+	if json.Unmarshal(body, &errBody) == nil {
+		if errBody.Message != "" {
+			return errBody.Message
+		}
+		if errBody.Error != "" {
+			return errBody.Error
+		}
+	}
+	if text := strings.TrimSpace(string(body)); text != "" && len(text) < 512 {
+		return text
+	}
+	return fallback
+}
@@ -0,0 +1,114 @@
+package statusclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"), "no credentials are sent")
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v1/public/status-pages/acme":
+			w.Write([]byte(`{"status":"success","data":{"slug":"acme","name":"Acme","status":"partial_outage","updated_at":"2026-01-01T00:00:00Z"}}`))
+		case "/v1/public/status-pages/acme/summary":
+			w.Write([]byte(`{"status":"success","data":{
+				"page":{"slug":"acme","name":"Acme","status":"partial_outage"},
+				"components":[{"id":"api","name":"API","status":"operational"},{"id":"web","name":"Dashboard","status":"partial_outage"}],
+				"active_incidents":[{"id":"inc-1","title":"Slow dashboard","status":"identified","impact":"minor","component_ids":["web"]}]
+			}}`))
+		case "/v1/public/status-pages/acme/components":
+			w.Write([]byte(`{"status":"success","data":[{"id":"api","name":"API","status":"operational","uptime_percent":99.98}]}`))
+		case "/v1/public/status-pages/acme/incidents":
+			assert.Equal(t, "2", r.URL.Query().Get("page"))
+			assert.Equal(t, "resolved", r.URL.Query().Get("status"))
+			assert.Equal(t, "2026-01-01T00:00:00Z", r.URL.Query().Get("since"))
+			w.Write([]byte(`{"status":"success","data":[{"id":"inc-0","title":"DNS","status":"resolved","resolved_at":"2026-01-02T00:00:00Z"}],
+				"meta":{"page":2,"limit":20,"total_items":21,"total_pages":2}}`))
+		case "/v1/public/status-pages/acme/incidents/inc-1":
+			w.Write([]byte(`{"status":"success","data":{"id":"inc-1","title":"Slow dashboard","status":"identified",
+				"updates":[{"status":"identified","message":"Database failover in progress"}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":"error","message":"status page not found"}`))
+		}
+	}))
+}
+
+func TestClient(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL + "/"})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	page, err := client.GetStatusPage(ctx, "acme")
+	require.NoError(t, err)
+	assert.Equal(t, "Acme", page.Name)
+	assert.Equal(t, StatusPartialOutage, page.Status)
+
+	summary, err := client.GetSummary(ctx, "acme")
+	require.NoError(t, err)
+	require.Len(t, summary.Components, 2)
+	assert.True(t, summary.Components[0].IsOperational())
+	assert.False(t, summary.Components[1].IsOperational())
+	require.Len(t, summary.ActiveIncidents, 1)
+	assert.Equal(t, []string{"web"}, summary.ActiveIncidents[0].ComponentIDs)
+
+	components, err := client.ListComponents(ctx, "acme")
+	require.NoError(t, err)
+	assert.Equal(t, 99.98, components[0].UptimePercent)
+
+	incidents, meta, err := client.ListIncidents(ctx, "acme", &IncidentListOptions{
+		Page:   2,
+		Status: IncidentResolved,
+		Since:  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.Len(t, incidents, 1)
+	assert.True(t, incidents[0].IsResolved())
+	require.NotNil(t, incidents[0].ResolvedAt)
+	assert.Equal(t, 21, meta.TotalItems)
+
+	incident, err := client.GetIncident(ctx, "acme", "inc-1")
+	require.NoError(t, err)
+	require.Len(t, incident.Updates, 1)
+	assert.Equal(t, "Database failover in progress", incident.Updates[0].Message)
+}
+
+func TestClient_Errors(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.GetStatusPage(ctx, "missing")
+	assert.True(t, errors.Is(err, ErrNotFound))
+	var apiErr *Error
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "status page not found", apiErr.Message)
+
+	_, err = client.GetStatusPage(ctx, "")
+	assert.Error(t, err)
+	_, err = client.GetIncident(ctx, "acme", "")
+	assert.Error(t, err)
+
+	_, err = NewClient(&Config{BaseURL: "not a url"})
+	assert.Error(t, err)
+
+	client, err = NewClient(nil)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultBaseURL, client.baseURL.String())
+}
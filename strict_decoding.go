@@ -0,0 +1,203 @@
+package nexmonyx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// SchemaMismatchError is returned in strict decoding mode when a response has
+// fields the SDK models do not know. The response has still been decoded into
+// the result. It matches ErrSchemaMismatch with errors.Is.
+type SchemaMismatchError struct {
+	// Fields are the JSON paths of the unknown fields, sorted, e.g.
+	// "data.new_field". Array indices are written as [] and each path is
+	// listed once.
+	Fields []string
+	requestInfo
+}
+
+// Error implements the error interface
+func (e *SchemaMismatchError) Error() string {
+	fields := strings.Join(e.Fields, ", ")
+	if e.info != nil {
+		return fmt.Sprintf("schema mismatch: %s %s: unknown fields %s", e.info.Method, e.info.Path, fields)
+	}
+	return "schema mismatch: unknown fields " + fields
+}
+
+// Is reports whether target is ErrSchemaMismatch
+func (e *SchemaMismatchError) Is(target error) bool {
+	return target == ErrSchemaMismatch
+}
+
+type strictDecodingKey struct{}
+
+// WithStrictDecoding returns a context whose requests are decoded strictly or
+// not, overriding Config.StrictDecoding
+//
+// Example:
+//
+//	ctx = nexmonyx.WithStrictDecoding(ctx, true)
+//	_, err := client.Servers.GetByUUID(ctx, uuid)
+//	var mismatch *nexmonyx.SchemaMismatchError
+//	if errors.As(err, &mismatch) {
+//	    t.Errorf("SDK models are missing %v", mismatch.Fields)
+//	}
+func WithStrictDecoding(ctx context.Context, strict bool) context.Context {
+	return context.WithValue(ctx, strictDecodingKey{}, strict)
+}
+
+// StrictDecodingFromContext returns the setting made with WithStrictDecoding
+func StrictDecodingFromContext(ctx context.Context) (bool, bool) {
+	strict, ok := ctx.Value(strictDecodingKey{}).(bool)
+	return strict, ok
+}
+
+// checkSchema returns a *SchemaMismatchError when strict decoding applies to
+// ctx and body has fields that result has no place for
+func (c *Client) checkSchema(ctx context.Context, body []byte, result interface{}) error {
+	strict := c.config.StrictDecoding
+	if s, ok := StrictDecodingFromContext(ctx); ok {
+		strict = s
+	}
+	if !strict || result == nil || len(body) == 0 {
+		return nil
+	}
+
+	var tree interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if dec.Decode(&tree) != nil {
+		// Not JSON, so not decoded into result either
+		return nil
+	}
+
+	unknown := map[string]bool{}
+	findUnknownFields(tree, reflect.ValueOf(result), "", unknown)
+	if len(unknown) == 0 {
+		return nil
+	}
+	fields := make([]string, 0, len(unknown))
+	for field := range unknown {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return &SchemaMismatchError{Fields: fields}
+}
+
+// findUnknownFields walks node alongside the Go value it was decoded into, the
+// same way as coerceJSON, and records the paths of object members that no
+// struct field matches
+func findUnknownFields(node interface{}, v reflect.Value, path string, unknown map[string]bool) {
+	if !v.IsValid() || node == nil {
+		return
+	}
+	t := v.Type()
+
+	// Types with their own decoding decide what they accept
+	if t.Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(jsonUnmarshalerType) ||
+		t.Implements(textUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			findUnknownFields(node, reflect.New(t.Elem()).Elem(), path, unknown)
+			return
+		}
+		findUnknownFields(node, v.Elem(), path, unknown)
+
+	case reflect.Interface:
+		// Empty interfaces accept anything
+		if !v.IsNil() {
+			findUnknownFields(node, v.Elem(), path, unknown)
+		}
+
+	case reflect.Struct:
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return
+		}
+		fields := map[string]reflect.Value{}
+		collectStructFields(v, fields)
+		for key, item := range obj {
+			field, ok := fields[key]
+			if !ok {
+				for name, f := range fields {
+					if strings.EqualFold(key, name) {
+						field, ok = f, true
+						break
+					}
+				}
+			}
+			if !ok {
+				unknown[joinJSONPath(path, key)] = true
+				continue
+			}
+			findUnknownFields(item, field, joinJSONPath(path, key), unknown)
+		}
+
+	case reflect.Slice, reflect.Array:
+		arr, ok := node.([]interface{})
+		if !ok {
+			return
+		}
+		elem := reflect.New(t.Elem()).Elem()
+		for _, item := range arr {
+			findUnknownFields(item, elem, path+"[]", unknown)
+		}
+
+	case reflect.Map:
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return
+		}
+		elem := reflect.New(t.Elem()).Elem()
+		for k, item := range obj {
+			findUnknownFields(item, elem, joinJSONPath(path, k), unknown)
+		}
+	}
+}
+
+// collectStructFields maps the JSON names of the struct v's fields, including
+// fields promoted from embedded structs, to their values
+func collectStructFields(v reflect.Value, fields map[string]reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			embedded := v.Field(i)
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					embedded = reflect.New(embedded.Type().Elem())
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				collectStructFields(embedded, fields)
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if _, ok := fields[name]; !ok {
+			fields[name] = v.Field(i)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrictDecoding(t *testing.T) {
+	client := newTestClient(t, jsonResponse(`{
+		"status": "success",
+		"trace_id": "abc",
+		"data": {
+			"id": 42,
+			"server_uuid": "srv-1",
+			"Hostname": "web-1",
+			"power_state": "on",
+			"organization": {"id": 7, "name": "Acme", "region": "eu"}
+		}
+	}`), Config{StrictDecoding: true})
+
+	server, err := client.Servers.Get(context.Background(), "srv-1")
+	assert.Nil(t, server)
+	require.ErrorIs(t, err, ErrSchemaMismatch)
+
+	var mismatch *SchemaMismatchError
+	require.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, []string{"data.organization.region", "data.power_state", "trace_id"}, mismatch.Fields)
+	assert.Equal(t, "GET", mismatch.RequestInfo().Method)
+	assert.Contains(t, err.Error(), "GET /v1/server/srv-1/details: unknown fields data.organization.region, data.power_state")
+
+	// Per-call override
+	server, err = client.Servers.Get(WithStrictDecoding(context.Background(), false), "srv-1")
+	require.NoError(t, err)
+	assert.Equal(t, "web-1", server.Hostname, "member names match case-insensitively")
+}
+
+func TestStrictDecoding_PerCall(t *testing.T) {
+	client := newTestClient(t, jsonResponse(`{
+		"status": "success",
+		"data": [{"id": 1, "server_uuid": "a", "extra": true}],
+		"meta": {"page": 1, "total_items": 1}
+	}`), Config{})
+
+	_, _, err := client.Servers.List(context.Background(), nil)
+	require.NoError(t, err, "strict decoding is opt-in")
+
+	_, _, err = client.Servers.List(WithStrictDecoding(context.Background(), true), nil)
+	var mismatch *SchemaMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	assert.Equal(t, []string{"data[].extra"}, mismatch.Fields)
+}
+
+func TestStrictDecoding_WithTolerantDecoding(t *testing.T) {
+	client := newTestClient(t, jsonResponse(looselyTypedServer), Config{StrictDecoding: true, TolerantDecoding: true})
+
+	server, err := client.Servers.Get(context.Background(), "srv-1")
+	require.NoError(t, err, "converted values are not unknown fields")
+	assert.Equal(t, uint(42), server.ID)
+}
@@ -0,0 +1,154 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubmissionReceiptHeader carries the receipt ID of an accepted metrics
+// submission when the response body does not include it
+const SubmissionReceiptHeader = "X-Nexmonyx-Receipt-ID"
+
+// Submission processing statuses
+const (
+	SubmissionStatusQueued     = "queued"
+	SubmissionStatusProcessing = "processing"
+	SubmissionStatusCompleted  = "completed"
+	SubmissionStatusPartial    = "partial" // Processed, but some metrics were rejected
+	SubmissionStatusFailed     = "failed"
+)
+
+// SubmissionReceipt identifies an accepted metrics submission. Ingestion
+// happens asynchronously; use Metrics.GetSubmissionStatus to follow it.
+type SubmissionReceipt struct {
+	ReceiptID  string      `json:"receipt_id"`
+	Status     string      `json:"status,omitempty"`
+	ReceivedAt *CustomTime `json:"received_at,omitempty"`
+}
+
+// SubmissionStatus represents the server-side processing state of a metrics submission
+type SubmissionStatus struct {
+	ReceiptID        string            `json:"receipt_id"`
+	ServerUUID       string            `json:"server_uuid"`
+	Status           string            `json:"status"` // queued, processing, completed, partial, failed
+	MetricsAccepted  int               `json:"metrics_accepted"`
+	MetricsRejected  int               `json:"metrics_rejected"`
+	Errors           []SubmissionError `json:"errors,omitempty"`
+	ReceivedAt       *CustomTime       `json:"received_at,omitempty"`
+	ProcessedAt      *CustomTime       `json:"processed_at,omitempty"`
+	ProcessingTimeMs int64             `json:"processing_time_ms,omitempty"`
+}
+
+// SubmissionError describes why part of a submission was rejected during ingestion
+type SubmissionError struct {
+	Field   string `json:"field,omitempty"` // e.g. "disks[2].usage_percent"
+	Message string `json:"message"`
+}
+
+// IsProcessed returns true once processing has finished, successfully or not
+func (s *SubmissionStatus) IsProcessed() bool {
+	return s.Status == SubmissionStatusCompleted || s.Status == SubmissionStatusPartial || s.Status == SubmissionStatusFailed
+}
+
+// Failed returns true if any of the submission was not ingested
+func (s *SubmissionStatus) Failed() bool {
+	return s.Status == SubmissionStatusFailed || s.Status == SubmissionStatusPartial
+}
+
+// SubmitComprehensiveFull submits comprehensive metrics for a server and
+// returns the submission receipt. The receipt ID is empty when the API does
+// not issue receipts.
+func (s *MetricsService) SubmitComprehensiveFull(ctx context.Context, metrics *ComprehensiveMetricsRequest) (*SubmissionReceipt, error) {
+	// If using server authentication and ServerUUID is not set in the request,
+	// automatically populate it from the client configuration
+	if s.client.config.Auth.ServerUUID != "" && metrics.ServerUUID == "" {
+		metrics.ServerUUID = s.client.config.Auth.ServerUUID
+	}
+
+	var resp StandardResponse
+	receipt := &SubmissionReceipt{}
+	resp.Data = receipt
+
+	httpResp, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v2/metrics/comprehensive",
+		Body:   metrics,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if receipt.ReceiptID == "" && httpResp.Headers != nil {
+		receipt.ReceiptID = httpResp.Headers.Get(SubmissionReceiptHeader)
+	}
+	return receipt, nil
+}
+
+// GetSubmissionStatus retrieves the processing status of a metrics submission
+func (s *MetricsService) GetSubmissionStatus(ctx context.Context, receiptID string) (*SubmissionStatus, error) {
+	if receiptID == "" {
+		return nil, fmt.Errorf("receipt ID is required")
+	}
+
+	var resp StandardResponse
+	resp.Data = &SubmissionStatus{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v2/metrics/submissions/%s", receiptID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if status, ok := resp.Data.(*SubmissionStatus); ok {
+		return status, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// SubmissionOperation returns an Operation that tracks a submission until it
+// is processed. Partially ingested submissions succeed with the rejection
+// count in the status message; check SubmissionStatus.Failed.
+func (s *MetricsService) SubmissionOperation(receiptID string, opts *OperationOptions) *Operation[SubmissionStatus] {
+	return NewOperation("submission-"+receiptID, func(ctx context.Context) (*SubmissionStatus, OperationStatus, error) {
+		status, err := s.GetSubmissionStatus(ctx, receiptID)
+		if err != nil {
+			return nil, OperationStatus{}, err
+		}
+
+		opStatus := OperationStatus{State: OperationStatePending}
+		switch status.Status {
+		case SubmissionStatusProcessing:
+			opStatus.State = OperationStateRunning
+		case SubmissionStatusCompleted:
+			opStatus.State = OperationStateSucceeded
+		case SubmissionStatusPartial:
+			opStatus.State = OperationStateSucceeded
+			opStatus.Message = fmt.Sprintf("%d metrics rejected", status.MetricsRejected)
+		case SubmissionStatusFailed:
+			opStatus.State = OperationStateFailed
+			if len(status.Errors) > 0 {
+				opStatus.Message = status.Errors[0].Message
+			}
+		}
+		return status, opStatus, nil
+	}, opts)
+}
+
+// WaitForSubmission polls a submission until it is processed or ctx is done
+// Returns: Final SubmissionStatus, or an *OperationError if ingestion failed
+//
+// Example:
+//
+//	receipt, err := client.Metrics.SubmitComprehensiveFull(ctx, metrics)
+//	...
+//	status, err := client.Metrics.WaitForSubmission(ctx, receipt.ReceiptID, nil)
+//	if err != nil || status.Failed() {
+//	    alertIngestionFailure(receipt.ReceiptID, status, err)
+//	}
+func (s *MetricsService) WaitForSubmission(ctx context.Context, receiptID string, opts *OperationOptions) (*SubmissionStatus, error) {
+	return s.SubmissionOperation(receiptID, opts).Wait(ctx)
+}
@@ -0,0 +1,93 @@
+package nexmonyx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsService_SubmitComprehensiveFull(t *testing.T) {
+	var submissions atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/metrics/comprehensive", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		if submissions.Add(1) == 1 {
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"status":"success","data":{"receipt_id":"rcpt-1","status":"queued","received_at":"2026-01-01T00:00:00Z"}}`))
+			return
+		}
+		w.Header().Set(SubmissionReceiptHeader, "rcpt-header")
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"status":"success","message":"accepted"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{ServerUUID: "srv-1", ServerSecret: "secret"},
+	})
+	require.NoError(t, err)
+
+	metrics := &ComprehensiveMetricsRequest{}
+	receipt, err := client.Metrics.SubmitComprehensiveFull(context.Background(), metrics)
+	require.NoError(t, err)
+	assert.Equal(t, "rcpt-1", receipt.ReceiptID)
+	assert.Equal(t, SubmissionStatusQueued, receipt.Status)
+	assert.Equal(t, "srv-1", metrics.ServerUUID)
+
+	// Receipt only in the response header
+	receipt, err = client.Metrics.SubmitComprehensiveFull(context.Background(), &ComprehensiveMetricsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "rcpt-header", receipt.ReceiptID)
+}
+
+func TestMetricsService_WaitForSubmission(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/metrics/submissions/rcpt-1":
+			if polls.Add(1) < 3 {
+				w.Write([]byte(`{"status":"success","data":{"receipt_id":"rcpt-1","status":"processing"}}`))
+				return
+			}
+			w.Write([]byte(`{"status":"success","data":{"receipt_id":"rcpt-1","status":"partial","metrics_accepted":40,"metrics_rejected":2,"errors":[{"field":"disks[2].usage_percent","message":"out of range"}]}}`))
+		case "/v2/metrics/submissions/rcpt-2":
+			w.Write([]byte(`{"status":"success","data":{"receipt_id":"rcpt-2","status":"failed","errors":[{"message":"server not found"}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+	opts := &OperationOptions{PollInterval: time.Millisecond}
+
+	status, err := client.Metrics.WaitForSubmission(ctx, "rcpt-1", opts)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), polls.Load())
+	assert.True(t, status.IsProcessed())
+	assert.True(t, status.Failed())
+	assert.Equal(t, 2, status.MetricsRejected)
+	require.Len(t, status.Errors, 1)
+	assert.Equal(t, "disks[2].usage_percent", status.Errors[0].Field)
+
+	status, err = client.Metrics.WaitForSubmission(ctx, "rcpt-2", opts)
+	var opErr *OperationError
+	require.True(t, errors.As(err, &opErr))
+	assert.Equal(t, "server not found", opErr.Message)
+	require.NotNil(t, status)
+	assert.Equal(t, SubmissionStatusFailed, status.Status)
+
+	_, err = client.Metrics.GetSubmissionStatus(ctx, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,154 @@
+package nexmonyx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"time"
+)
+
+// SupportBundle collects the information needed to diagnose an SDK issue.
+// Credentials and custom header values are never included.
+type SupportBundle struct {
+	GeneratedAt    time.Time           `json:"generated_at"`
+	SDKVersion     string              `json:"sdk_version"`
+	GoVersion      string              `json:"go_version"`
+	Config         SupportBundleConfig `json:"config"`
+	Environment    SupportBundleEnv    `json:"environment"`
+	Dependencies   map[string]string   `json:"dependencies,omitempty"` // Module path -> version from the binary's build info
+	RecentRequests []RequestSummary    `json:"recent_requests"`
+}
+
+// SupportBundleConfig is the redacted client configuration included in a SupportBundle
+type SupportBundleConfig struct {
+	BaseURL          string   `json:"base_url"`
+	AuthMethod       string   `json:"auth_method"`
+	ServerUUID       string   `json:"server_uuid,omitempty"`
+	Timeout          string   `json:"timeout"`
+	RetryCount       int      `json:"retry_count"`
+	RetryWaitTime    string   `json:"retry_wait_time"`
+	RetryMaxWait     string   `json:"retry_max_wait"`
+	Debug            bool     `json:"debug"`
+	CustomHTTPClient bool     `json:"custom_http_client"`
+	CustomTransport  bool     `json:"custom_transport"`
+	CustomTLS        bool     `json:"custom_tls"`
+	ProxyURL         string   `json:"proxy_url,omitempty"`      // Without credentials
+	CustomHeaders    []string `json:"custom_headers,omitempty"` // Header names only
+}
+
+// SupportBundleEnv describes the process the SDK is running in
+type SupportBundleEnv struct {
+	OS           string `json:"os"`
+	Arch         string `json:"arch"`
+	NumCPU       int    `json:"num_cpu"`
+	GOMAXPROCS   int    `json:"gomaxprocs"`
+	NumGoroutine int    `json:"num_goroutine"`
+	Hostname     string `json:"hostname,omitempty"`
+}
+
+// CollectSupportBundle gathers the client's redacted configuration, SDK and Go
+// versions, recent request summaries, and environment details into a JSON
+// document suitable for attaching to an SDK bug report.
+//
+// Example:
+//
+//	bundle, err := nexmonyx.CollectSupportBundle(client)
+//	if err == nil {
+//	    os.WriteFile("nexmonyx-support.json", bundle, 0o600)
+//	}
+func CollectSupportBundle(client *Client) ([]byte, error) {
+	if client == nil || client.config == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+
+	config := client.config
+	bundle := SupportBundle{
+		GeneratedAt: time.Now().UTC(),
+		SDKVersion:  Version,
+		GoVersion:   runtime.Version(),
+		Config: SupportBundleConfig{
+			BaseURL:          redactURL(config.BaseURL),
+			AuthMethod:       config.Auth.method(),
+			ServerUUID:       config.Auth.ServerUUID,
+			Timeout:          config.Timeout.String(),
+			RetryCount:       config.RetryCount,
+			RetryWaitTime:    config.RetryWaitTime.String(),
+			RetryMaxWait:     config.RetryMaxWait.String(),
+			Debug:            config.Debug,
+			CustomHTTPClient: config.HTTPClient != nil,
+			CustomTransport:  config.Transport != nil,
+			CustomTLS:        config.TLSConfig != nil,
+		},
+		Environment: SupportBundleEnv{
+			OS:           runtime.GOOS,
+			Arch:         runtime.GOARCH,
+			NumCPU:       runtime.NumCPU(),
+			GOMAXPROCS:   runtime.GOMAXPROCS(0),
+			NumGoroutine: runtime.NumGoroutine(),
+		},
+		RecentRequests: client.History(),
+	}
+
+	if config.ProxyURL != "" {
+		bundle.Config.ProxyURL = redactURL(config.ProxyURL)
+	}
+	for name := range config.Headers {
+		bundle.Config.CustomHeaders = append(bundle.Config.CustomHeaders, name)
+	}
+	sort.Strings(bundle.Config.CustomHeaders)
+	if hostname, err := os.Hostname(); err == nil {
+		bundle.Environment.Hostname = hostname
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		bundle.Dependencies = make(map[string]string, len(info.Deps))
+		for _, dep := range info.Deps {
+			bundle.Dependencies[dep.Path] = dep.Version
+		}
+	}
+
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode support bundle: %w", err)
+	}
+
+	return data, nil
+}
+
+// method names the authentication method NewClient selects for a, following
+// the same priority order
+func (a AuthConfig) method() string {
+	switch {
+	case a.Token != "":
+		return "jwt"
+	case a.UnifiedAPIKey != "" && a.APIKeySecret != "":
+		return "unified_api_key_secret"
+	case a.UnifiedAPIKey != "":
+		return "unified_api_key"
+	case a.RegistrationKey != "":
+		return "registration_key"
+	case a.APIKey != "" && a.APISecret != "":
+		return "api_key"
+	case a.ServerUUID != "" && a.ServerSecret != "":
+		return "server_credentials"
+	case a.MonitoringKey != "":
+		return "monitoring_key"
+	default:
+		return "none"
+	}
+}
+
+// redactURL strips user info and query parameters, which may carry credentials
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "[INVALID URL]"
+	}
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
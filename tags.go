@@ -276,6 +276,175 @@ func (s *TagsService) CreateInheritanceRule(ctx context.Context, req *TagInherit
 	return resp.Data, nil
 }
 
+// ListInheritanceRules retrieves the organization's tag inheritance rules
+// Authentication: JWT Token required
+// Endpoint: GET /v1/tag-inheritance/rules
+// Parameters:
+//   - opts: Filtering options (enabled, source_type, target_type)
+//
+// Returns array of inheritance rules and total count
+func (s *TagsService) ListInheritanceRules(ctx context.Context, opts *TagInheritanceRuleListOptions) ([]*TagInheritanceRule, int, error) {
+	var resp struct {
+		Data struct {
+			Rules []*TagInheritanceRule `json:"rules"`
+			Total int                   `json:"total"`
+		} `json:"data"`
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	}
+
+	req := &Request{
+		Method: "GET",
+		Path:   "/v1/tag-inheritance/rules",
+		Result: &resp,
+	}
+
+	if opts != nil {
+		req.Query = opts.ToQuery()
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return resp.Data.Rules, resp.Data.Total, nil
+}
+
+// GetInheritanceRule retrieves a tag inheritance rule by ID
+// Authentication: JWT Token required
+// Endpoint: GET /v1/tag-inheritance/rules/{ruleID}
+// Parameters:
+//   - ruleID: Inheritance rule ID
+//
+// Returns the inheritance rule including its last run status
+func (s *TagsService) GetInheritanceRule(ctx context.Context, ruleID uint) (*TagInheritanceRule, error) {
+	var resp struct {
+		Data    *TagInheritanceRule `json:"data"`
+		Status  string              `json:"status"`
+		Message string              `json:"message"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/tag-inheritance/rules/%d", ruleID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// UpdateInheritanceRule updates a tag inheritance rule
+// Authentication: JWT Token required
+// Endpoint: PUT /v1/tag-inheritance/rules/{ruleID}
+// Parameters:
+//   - ruleID: Inheritance rule ID
+//   - req: Fields to change; nil fields are left unchanged
+//
+// Returns the updated inheritance rule
+func (s *TagsService) UpdateInheritanceRule(ctx context.Context, ruleID uint, req *TagInheritanceRuleUpdateRequest) (*TagInheritanceRule, error) {
+	var resp struct {
+		Data    *TagInheritanceRule `json:"data"`
+		Status  string              `json:"status"`
+		Message string              `json:"message"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v1/tag-inheritance/rules/%d", ruleID),
+		Body:   req,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// DeleteInheritanceRule deletes a tag inheritance rule
+// Authentication: JWT Token required
+// Endpoint: DELETE /v1/tag-inheritance/rules/{ruleID}
+// Parameters:
+//   - ruleID: Inheritance rule ID
+//
+// Tags already propagated by the rule are not removed
+func (s *TagsService) DeleteInheritanceRule(ctx context.Context, ruleID uint) error {
+	var resp StandardResponse
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/tag-inheritance/rules/%d", ruleID),
+		Result: &resp,
+	})
+	return err
+}
+
+// RunInheritanceRule runs a tag inheritance rule immediately instead of waiting for its schedule
+// Authentication: JWT Token required
+// Endpoint: POST /v1/tag-inheritance/rules/{ruleID}/run
+// Parameters:
+//   - ruleID: Inheritance rule ID
+//
+// Returns the run that was started; poll GetInheritanceRuleRuns for its outcome
+func (s *TagsService) RunInheritanceRule(ctx context.Context, ruleID uint) (*TagInheritanceRuleRun, error) {
+	var resp struct {
+		Data    *TagInheritanceRuleRun `json:"data"`
+		Status  string                 `json:"status"`
+		Message string                 `json:"message"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/tag-inheritance/rules/%d/run", ruleID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// GetInheritanceRuleRuns retrieves the run history of a tag inheritance rule, newest first
+// Authentication: JWT Token required
+// Endpoint: GET /v1/tag-inheritance/rules/{ruleID}/runs
+// Parameters:
+//   - ruleID: Inheritance rule ID
+//   - opts: Pagination options
+//
+// Returns array of rule runs and total count
+func (s *TagsService) GetInheritanceRuleRuns(ctx context.Context, ruleID uint, opts *ListOptions) ([]*TagInheritanceRuleRun, int, error) {
+	var resp struct {
+		Data struct {
+			Runs  []*TagInheritanceRuleRun `json:"runs"`
+			Total int                      `json:"total"`
+		} `json:"data"`
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	}
+
+	req := &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/tag-inheritance/rules/%d/runs", ruleID),
+		Result: &resp,
+	}
+
+	if opts != nil {
+		req.Query = opts.ToQuery()
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return resp.Data.Runs, resp.Data.Total, nil
+}
+
 // SetOrganizationTag sets a tag at the organization level with inheritance options
 // Authentication: JWT Token required
 // Endpoint: POST /v1/tag-inheritance/organization-tags
@@ -500,6 +500,88 @@ func TestTagsService_CreateInheritanceRule(t *testing.T) {
 	assert.Equal(t, "Auto-tag rule", rule.Name)
 }
 
+func TestTagsService_InheritanceRuleLifecycle(t *testing.T) {
+	ts := CustomTime{time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/v1/tag-inheritance/rules":
+			assert.Equal(t, "true", r.URL.Query().Get("enabled"))
+			assert.Equal(t, "organization", r.URL.Query().Get("source_type"))
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data": map[string]interface{}{
+					"rules": []*TagInheritanceRule{{ID: 3, Name: "Org defaults", Enabled: true, CreatedAt: ts, UpdatedAt: ts}},
+					"total": 1,
+				},
+			})
+		case r.Method == "GET" && r.URL.Path == "/v1/tag-inheritance/rules/3":
+			json.NewEncoder(w).Encode(StandardResponse{Status: "success", Data: &TagInheritanceRule{ID: 3, Name: "Org defaults", CreatedAt: ts, UpdatedAt: ts}})
+		case r.Method == "PUT" && r.URL.Path == "/v1/tag-inheritance/rules/3":
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			assert.Equal(t, map[string]interface{}{"enabled": false}, req)
+			json.NewEncoder(w).Encode(StandardResponse{Status: "success", Data: &TagInheritanceRule{ID: 3, Enabled: false, CreatedAt: ts, UpdatedAt: ts}})
+		case r.Method == "POST" && r.URL.Path == "/v1/tag-inheritance/rules/3/run":
+			w.WriteHeader(http.StatusAccepted)
+			json.NewEncoder(w).Encode(StandardResponse{Status: "success", Data: &TagInheritanceRuleRun{ID: 9, RuleID: 3, Status: "pending", TriggeredBy: "manual", StartedAt: ts}})
+		case r.Method == "GET" && r.URL.Path == "/v1/tag-inheritance/rules/3/runs":
+			assert.Equal(t, "2", r.URL.Query().Get("page"))
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data": map[string]interface{}{
+					"runs": []*TagInheritanceRuleRun{
+						{ID: 9, RuleID: 3, Status: "completed", ProcessedCount: 40, AppliedCount: 12, StartedAt: ts},
+					},
+					"total": 21,
+				},
+			})
+		case r.Method == "DELETE" && r.URL.Path == "/v1/tag-inheritance/rules/3":
+			json.NewEncoder(w).Encode(StandardResponse{Status: "success"})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	enabled := true
+	rules, total, err := client.Tags.ListInheritanceRules(ctx, &TagInheritanceRuleListOptions{Enabled: &enabled, SourceType: "organization"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, rules, 1)
+
+	rule, err := client.Tags.GetInheritanceRule(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "Org defaults", rule.Name)
+
+	disabled := false
+	rule, err = client.Tags.UpdateInheritanceRule(ctx, 3, &TagInheritanceRuleUpdateRequest{Enabled: &disabled})
+	require.NoError(t, err)
+	assert.False(t, rule.Enabled)
+
+	run, err := client.Tags.RunInheritanceRule(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "manual", run.TriggeredBy)
+
+	runs, total, err := client.Tags.GetInheritanceRuleRuns(ctx, 3, &ListOptions{Page: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 21, total)
+	require.Len(t, runs, 1)
+	assert.Equal(t, 12, runs[0].AppliedCount)
+
+	require.NoError(t, client.Tags.DeleteInheritanceRule(ctx, 3))
+}
+
 func TestTagsService_SetOrganizationTag(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "POST", r.Method)
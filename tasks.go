@@ -178,3 +178,126 @@ func (s *TasksService) CancelTask(ctx context.Context, taskID uint) error {
 
 	return nil
 }
+
+// RetryTask re-queues a failed or cancelled task for execution
+// Authentication: JWT Token required
+// Endpoint: POST /v1/tasks/{id}/retry
+// Parameters:
+//   - taskID: Task ID
+// Returns: Updated Task object
+func (s *TasksService) RetryTask(ctx context.Context, taskID uint) (*Task, error) {
+	var resp struct {
+		Data    *Task  `json:"data"`
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/tasks/%d/retry", taskID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// ListTaskExecutions retrieves the execution history of a task
+// Authentication: JWT Token required
+// Endpoint: GET /v1/tasks/{id}/executions
+// Parameters:
+//   - taskID: Task ID
+//   - opts: Optional pagination options
+// Returns: Array of TaskExecution objects with pagination metadata
+func (s *TasksService) ListTaskExecutions(ctx context.Context, taskID uint, opts *PaginationOptions) ([]TaskExecution, *PaginationMeta, error) {
+	var resp struct {
+		Data []TaskExecution `json:"data"`
+		Meta *PaginationMeta `json:"meta"`
+	}
+
+	queryParams := make(map[string]string)
+	if opts != nil {
+		if opts.Page > 0 {
+			queryParams["page"] = fmt.Sprintf("%d", opts.Page)
+		}
+		if opts.Limit > 0 {
+			queryParams["limit"] = fmt.Sprintf("%d", opts.Limit)
+		}
+	}
+
+	req := &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/tasks/%d/executions", taskID),
+		Result: &resp,
+	}
+	if len(queryParams) > 0 {
+		req.Query = queryParams
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Data, resp.Meta, nil
+}
+
+// GetTaskStatistics retrieves aggregated task execution statistics
+// Authentication: JWT Token required
+// Endpoint: GET /v1/tasks/statistics
+// Returns: TaskStatistics with counts by status, type, and priority
+func (s *TasksService) GetTaskStatistics(ctx context.Context) (*TaskStatistics, error) {
+	var resp struct {
+		Data    *TaskStatistics `json:"data"`
+		Status  string          `json:"status"`
+		Message string          `json:"message"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   "/v1/tasks/statistics",
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// TaskOperation returns an Operation that tracks a task until it completes
+func (s *TasksService) TaskOperation(taskID uint, opts *OperationOptions) *Operation[Task] {
+	return NewOperation(fmt.Sprintf("task-%d", taskID), func(ctx context.Context) (*Task, OperationStatus, error) {
+		task, err := s.GetTask(ctx, taskID)
+		if err != nil {
+			return nil, OperationStatus{}, err
+		}
+		if task == nil {
+			return nil, OperationStatus{}, ErrUnexpectedResponse
+		}
+
+		return task, OperationStatus{
+			State:    normalizeOperationState(task.Status),
+			Progress: task.Progress,
+			Message:  task.ErrorMessage,
+		}, nil
+	}, opts)
+}
+
+// WaitForTaskCompletion polls a task until it completes, fails, or is cancelled, or ctx is done
+// Parameters:
+//   - taskID: Task ID
+//   - opts: Optional polling configuration (interval, backoff, progress callback)
+// Returns: Completed Task, or an *OperationError if the task failed or was cancelled
+//
+// Example:
+//
+//	task, _ := client.Tasks.CreateTask(ctx, config)
+//	ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+//	defer cancel()
+//	completed, err := client.Tasks.WaitForTaskCompletion(ctx, task.ID, nil)
+func (s *TasksService) WaitForTaskCompletion(ctx context.Context, taskID uint, opts *OperationOptions) (*Task, error) {
+	return s.TaskOperation(taskID, opts).Wait(ctx)
+}
@@ -370,6 +370,140 @@ func TestTasksService_CancelTask(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestTasksService_RetryTask(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/tasks/12/retry", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data": Task{
+				ID:           12,
+				Status:       "pending",
+				CurrentRetry: 1,
+				CreatedAt:    CustomTime{Time: time.Now()},
+				UpdatedAt:    CustomTime{Time: time.Now()},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	task, err := client.Tasks.RetryTask(context.Background(), 12)
+	require.NoError(t, err)
+	assert.Equal(t, "pending", task.Status)
+	assert.Equal(t, 1, task.CurrentRetry)
+}
+
+func TestTasksService_ListTaskExecutions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/tasks/12/executions", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []TaskExecution{
+				{ID: 1, TaskID: 12, Status: "failed", ErrorMessage: "timeout", StartedAt: CustomTime{Time: time.Now()}},
+				{ID: 2, TaskID: 12, Status: "completed", DurationMs: 1500, StartedAt: CustomTime{Time: time.Now()}},
+			},
+			"meta": PaginationMeta{Page: 2, TotalItems: 12},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	executions, meta, err := client.Tasks.ListTaskExecutions(context.Background(), 12, &PaginationOptions{Page: 2})
+	require.NoError(t, err)
+	require.Len(t, executions, 2)
+	assert.Equal(t, "timeout", executions[0].ErrorMessage)
+	assert.Equal(t, 1500, executions[1].DurationMs)
+	assert.Equal(t, 12, meta.TotalItems)
+}
+
+func TestTasksService_GetTaskStatistics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/tasks/statistics", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": TaskStatistics{
+				TotalTasks:     20,
+				CompletedTasks: 15,
+				FailedTasks:    5,
+				SuccessRate:    75.0,
+				TasksByType:    map[string]int{"data_export": 20},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	stats, err := client.Tasks.GetTaskStatistics(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 20, stats.TotalTasks)
+	assert.Equal(t, 75.0, stats.SuccessRate)
+	assert.Equal(t, 20, stats.TasksByType["data_export"])
+}
+
+func TestTasksService_WaitForTaskCompletion(t *testing.T) {
+	tests := []struct {
+		name        string
+		finalStatus string
+		expectError bool
+	}{
+		{name: "completed", finalStatus: "completed"},
+		{name: "failed", finalStatus: "failed", expectError: true},
+		{name: "cancelled", finalStatus: "cancelled", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v1/tasks/8", r.URL.Path)
+				calls++
+				status := "running"
+				if calls > 1 {
+					status = tt.finalStatus
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"data": Task{
+						ID:        8,
+						Status:    status,
+						CreatedAt: CustomTime{Time: time.Now()},
+						UpdatedAt: CustomTime{Time: time.Now()},
+					},
+				})
+			}))
+			defer server.Close()
+
+			client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+			require.NoError(t, err)
+
+			task, err := client.Tasks.WaitForTaskCompletion(context.Background(), 8, &OperationOptions{PollInterval: time.Millisecond})
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.finalStatus)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "completed", task.Status)
+			assert.Equal(t, 2, calls)
+		})
+	}
+}
+
 func TestTasksService_ErrorHandling(t *testing.T) {
 	tests := []struct {
 		name          string
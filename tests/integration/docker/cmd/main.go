@@ -29,6 +29,15 @@ type MockAPIServer struct {
 	nextID      int
 	authEnabled bool
 	authToken   string
+
+	// Resources served on the SDK's own routes, see resources.go
+	metrics   map[string][]map[string]interface{}
+	hardware  *collection
+	sdkProbes *collection
+	agents    *collection
+	incidents *collection
+	apiKeys   *collection
+	scenario  *scenarioState
 }
 
 // NewMockAPIServer creates and starts a new mock API server
@@ -42,6 +51,13 @@ func NewMockAPIServer(addr string, authToken string, logf func(string, ...interf
 		nextID:      1000,
 		authEnabled: authToken != "",
 		authToken:   authToken,
+		metrics:     make(map[string][]map[string]interface{}),
+		hardware:    newCollection(),
+		sdkProbes:   newCollection(),
+		agents:      newCollection(),
+		incidents:   newCollection(),
+		apiKeys:     newCollection(),
+		scenario:    &scenarioState{},
 	}
 
 	// Create HTTP server
@@ -50,7 +66,7 @@ func NewMockAPIServer(addr string, authToken string, logf func(string, ...interf
 
 	mock.Server = http.Server{
 		Addr:         addr,
-		Handler:      mux,
+		Handler:      mock.scenario.middleware(mux),
 		ReadTimeout:  15 * time.Second,
 		WriteTimeout: 15 * time.Second,
 		IdleTimeout:  60 * time.Second,
@@ -96,12 +112,20 @@ func (m *MockAPIServer) registerRoutes(mux *http.ServeMux) {
 	// Metrics endpoints
 	mux.HandleFunc("/api/v1/metrics", m.handleMetrics)
 	mux.HandleFunc("/api/v1/metrics/submit", m.handleMetricsSubmit)
+
+	// Routes used by the SDK itself
+	m.registerSDKRoutes(mux)
+
+	// Fault injection controls
+	mux.HandleFunc("GET /_mock/scenario", m.scenario.handleGet)
+	mux.HandleFunc("POST /_mock/scenario", m.scenario.handleSet)
+	mux.HandleFunc("DELETE /_mock/scenario", m.scenario.handleReset)
 }
 
 // Middleware for authentication check
 func (m *MockAPIServer) requireAuth(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if m.authEnabled {
+		if m.authEnabled && !hasAgentCredentials(r) {
 			authHeader := r.Header.Get("Authorization")
 			if authHeader == "" {
 				http.Error(w, `{"error":"missing authorization header"}`, http.StatusUnauthorized)
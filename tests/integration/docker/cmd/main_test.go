@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	nexmonyx "github.com/nexmonyx/go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMock(t *testing.T) (*httptest.Server, *nexmonyx.Client) {
+	t.Helper()
+	mock := NewMockAPIServer("", "test-token", t.Logf)
+	server := httptest.NewServer(mock.Server.Handler)
+	t.Cleanup(server.Close)
+
+	client, err := nexmonyx.NewClient(&nexmonyx.Config{
+		BaseURL:       server.URL,
+		Auth:          nexmonyx.AuthConfig{Token: "test-token"},
+		RetryCount:    2,
+		RetryWaitTime: time.Millisecond,
+		RetryMaxWait:  time.Millisecond,
+	})
+	require.NoError(t, err)
+	return server, client
+}
+
+func setScenario(t *testing.T, server *httptest.Server, scenario Scenario) {
+	t.Helper()
+	body, _ := json.Marshal(scenario)
+	resp, err := http.Post(server.URL+"/_mock/scenario", "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestMockAPIServer_SDKRoutes(t *testing.T) {
+	_, client := newTestMock(t)
+	ctx := context.Background()
+
+	// Metrics
+	require.NoError(t, client.Metrics.Submit(ctx, "srv-1", []*nexmonyx.Metric{{Name: "cpu", Value: 12.5}}))
+	metrics, _, err := client.Metrics.Get(ctx, "srv-1", nil)
+	require.NoError(t, err)
+	assert.Len(t, metrics, 1)
+
+	// Probes and assignments
+	probe, err := client.Probes.Create(ctx, &nexmonyx.ProbeCreateRequest{Name: "home", Type: "http", Target: "https://example.com", Interval: 60, Timeout: 10, Enabled: true})
+	require.NoError(t, err)
+	assert.Equal(t, "http", probe.Type)
+	fetched, err := client.Probes.Get(ctx, probe.ProbeUUID)
+	require.NoError(t, err)
+	assert.Equal(t, "home", fetched.Name)
+	assignments, err := client.Monitoring.GetAssignedProbes(ctx, "eu-west")
+	require.NoError(t, err)
+	require.Len(t, assignments, 1)
+	assert.Equal(t, probe.ProbeUUID, assignments[0].ProbeUUID)
+
+	// Monitoring agent heartbeats
+	require.NoError(t, client.Monitoring.Heartbeat(ctx, nexmonyx.NodeInfo{AgentID: "agent-1", Region: "eu-west", Status: "healthy"}))
+	agents, _, err := client.Monitoring.ListAgents(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, agents, 1)
+	assert.Equal(t, "agent-1", agents[0].UUID)
+
+	// Incidents
+	incident, err := client.Incidents.CreateIncident(ctx, nexmonyx.CreateIncidentRequest{Title: "API down", Severity: nexmonyx.IncidentSeverityCritical})
+	require.NoError(t, err)
+	resolved, err := client.Incidents.ResolveIncident(ctx, incident.ID)
+	require.NoError(t, err)
+	assert.Equal(t, nexmonyx.IncidentStatusResolved, resolved.Status)
+	list, err := client.Incidents.ListIncidents(ctx, &nexmonyx.IncidentListOptions{Status: "resolved"})
+	require.NoError(t, err)
+	assert.Len(t, list.Incidents, 1)
+
+	// API keys
+	created, err := client.APIKeys.CreateUnified(ctx, &nexmonyx.CreateUnifiedAPIKeyRequest{Name: "ci"})
+	require.NoError(t, err)
+	rotation, err := client.APIKeys.Rotate(ctx, created.KeyID)
+	require.NoError(t, err)
+	assert.NotEqual(t, created.KeyValue, rotation.KeyValue)
+	assert.NotNil(t, rotation.PreviousKeyExpiresAt)
+
+	_, err = client.APIKeys.GetUnified(ctx, "key-missing")
+	assert.True(t, nexmonyx.IsNotFound(err))
+}
+
+func TestMockAPIServer_ScenarioRetriesSucceed(t *testing.T) {
+	server, client := newTestMock(t)
+	setScenario(t, server, Scenario{PathPrefix: "/v2/api-keys", RateLimit: 1, ServerErrors: 1})
+
+	_, _, err := client.APIKeys.ListUnified(context.Background(), nil)
+	require.NoError(t, err, "the SDK retries past one 429 and one 503")
+
+	resp, err := http.Get(server.URL + "/_mock/scenario")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var status struct {
+		Data ScenarioStatus `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&status))
+	assert.Equal(t, map[string]int{"rate_limit": 1, "server_error": 1}, status.Data.Injected)
+}
+
+func TestMockAPIServer_ScenarioFailures(t *testing.T) {
+	server, client := newTestMock(t)
+	ctx := context.Background()
+
+	setScenario(t, server, Scenario{ServerErrors: 3, ServerErrorStatus: http.StatusBadGateway})
+	_, err := client.Incidents.ListIncidents(ctx, nil)
+	require.True(t, nexmonyx.IsServerError(err), "got %v", err)
+	info := nexmonyx.RequestInfoFromError(err)
+	require.NotNil(t, info)
+	assert.Equal(t, 3, info.Attempts)
+	assert.Equal(t, http.StatusBadGateway, info.StatusCode)
+	assert.NotEmpty(t, info.RequestID)
+
+	setScenario(t, server, Scenario{MalformedJSON: 1})
+	_, err = client.Incidents.GetIncident(ctx, 1)
+	assert.Error(t, err)
+
+	setScenario(t, server, Scenario{LatencyMS: 200})
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = client.Incidents.GetIncidentStats(timeoutCtx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	req, _ := http.NewRequest(http.MethodDelete, server.URL+"/_mock/scenario", nil)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	_, err = client.Incidents.ListIncidents(ctx, nil)
+	assert.NoError(t, err)
+}
+
+func TestMockAPIServer_ScenarioInvalid(t *testing.T) {
+	server, _ := newTestMock(t)
+	resp, err := http.Post(server.URL+"/_mock/scenario", "application/json", bytes.NewBufferString(`{"server_error_status":404}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
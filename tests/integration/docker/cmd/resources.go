@@ -0,0 +1,610 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// registerSDKRoutes registers the routes the SDK calls for metrics, hardware
+// inventory, probes, monitoring agent heartbeats, incidents, and API keys
+func (m *MockAPIServer) registerSDKRoutes(mux *http.ServeMux) {
+	// Metrics
+	mux.HandleFunc("POST /v1/metrics", m.requireAuth(m.handleMetricsSubmitV1))
+	mux.HandleFunc("POST /v1/metrics/aggregated", m.requireAuth(m.handleMetricsSubmitV1))
+	mux.HandleFunc("POST /v2/metrics/comprehensive", m.requireAuth(m.handleMetricsComprehensive))
+	mux.HandleFunc("GET /v1/metrics/server/{uuid}", m.requireAuth(m.handleMetricsList))
+	mux.HandleFunc("GET /v2/servers/{uuid}/metrics/latest", m.requireAuth(m.handleMetricsLatest))
+
+	// Hardware inventory
+	mux.HandleFunc("POST /v2/hardware/inventory", m.requireAuth(m.handleHardwareSubmit))
+	mux.HandleFunc("GET /v2/hardware/inventory/{uuid}/latest", m.requireAuth(m.handleHardwareGet))
+	mux.HandleFunc("GET /v1/hardware-inventory/{uuid}", m.requireAuth(m.handleHardwareGet))
+	mux.HandleFunc("GET /v1/hardware-inventory", m.requireAuth(m.listHandler(m.hardware)))
+
+	// Probes
+	mux.HandleFunc("POST /v1/probes", m.requireAuth(m.handleProbeCreate))
+	mux.HandleFunc("GET /v2/probes", m.requireAuth(m.listHandler(m.sdkProbes)))
+	mux.HandleFunc("GET /v2/probes/{id}", m.requireAuth(m.getHandler(m.sdkProbes)))
+	mux.HandleFunc("PATCH /v2/probes/{id}", m.requireAuth(m.updateHandler(m.sdkProbes)))
+	mux.HandleFunc("DELETE /v2/probes/{id}", m.requireAuth(m.deleteHandler(m.sdkProbes)))
+	mux.HandleFunc("GET /v1/monitoring/probes", m.requireAuth(m.handleAssignedProbes))
+	mux.HandleFunc("POST /v1/monitoring/results", m.requireAuth(m.handleAccepted))
+
+	// Heartbeats
+	mux.HandleFunc("POST /v1/monitoring/heartbeat", m.requireAuth(m.handleAgentHeartbeat))
+	mux.HandleFunc("GET /v1/monitoring/agents", m.requireAuth(m.listHandler(m.agents)))
+	mux.HandleFunc("POST /v1/heartbeat", m.requireAuth(m.handleAccepted))
+
+	// Incidents
+	mux.HandleFunc("POST /v1/incidents", m.requireAuth(m.handleIncidentCreate))
+	mux.HandleFunc("GET /v1/incidents", m.requireAuth(m.handleIncidentList))
+	mux.HandleFunc("GET /v1/incidents/{id}", m.requireAuth(m.getHandler(m.incidents)))
+	mux.HandleFunc("PUT /v1/incidents/{id}", m.requireAuth(m.updateHandler(m.incidents)))
+
+	// API keys
+	mux.HandleFunc("POST /v2/api-keys", m.requireAuth(m.handleAPIKeyCreate))
+	mux.HandleFunc("GET /v2/api-keys", m.requireAuth(m.listHandler(m.apiKeys)))
+	mux.HandleFunc("GET /v2/api-keys/{id}", m.requireAuth(m.getHandler(m.apiKeys)))
+	mux.HandleFunc("PUT /v2/api-keys/{id}", m.requireAuth(m.updateHandler(m.apiKeys)))
+	mux.HandleFunc("DELETE /v2/api-keys/{id}", m.requireAuth(m.deleteHandler(m.apiKeys)))
+	mux.HandleFunc("POST /v2/api-keys/{id}/revoke", m.requireAuth(m.handleAPIKeyRevoke))
+	mux.HandleFunc("POST /v2/api-keys/{id}/regenerate", m.requireAuth(m.handleAPIKeyRegenerate))
+	mux.HandleFunc("POST /v2/api-keys/{id}/rotate", m.requireAuth(m.handleAPIKeyRegenerate))
+}
+
+// collection is an in-memory set of resources kept in insertion order. It is
+// guarded by MockAPIServer.mu.
+type collection struct {
+	items map[string]map[string]interface{}
+	order []string
+}
+
+func newCollection() *collection {
+	return &collection{items: make(map[string]map[string]interface{})}
+}
+
+func (c *collection) put(id string, item map[string]interface{}) {
+	if _, exists := c.items[id]; !exists {
+		c.order = append(c.order, id)
+	}
+	c.items[id] = item
+}
+
+func (c *collection) get(id string) (map[string]interface{}, bool) {
+	item, ok := c.items[id]
+	return item, ok
+}
+
+func (c *collection) delete(id string) bool {
+	if _, ok := c.items[id]; !ok {
+		return false
+	}
+	delete(c.items, id)
+	for i, existing := range c.order {
+		if existing == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+func (c *collection) list() []map[string]interface{} {
+	items := make([]map[string]interface{}, 0, len(c.order))
+	for _, id := range c.order {
+		items = append(items, c.items[id])
+	}
+	return items
+}
+
+// hasAgentCredentials reports whether r authenticates with server credentials
+// or an access key pair, which the mock accepts without checking
+func hasAgentCredentials(r *http.Request) bool {
+	if r.Header.Get("X-Server-UUID") != "" && r.Header.Get("X-Server-Secret") != "" {
+		return true
+	}
+	return r.Header.Get("Access-Key") != "" && r.Header.Get("Access-Secret") != ""
+}
+
+// writeData writes data in the API's standard response envelope
+func writeData(w http.ResponseWriter, status int, data interface{}) {
+	writeEnvelope(w, status, map[string]interface{}{"status": "success", "data": data})
+}
+
+// writeError writes an error response the SDK maps to its typed errors
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeEnvelope(w, status, map[string]interface{}{"status": "error", "message": message})
+}
+
+// writePage writes one page of items with pagination metadata, using the
+// page and limit query parameters
+func writePage(w http.ResponseWriter, r *http.Request, items []map[string]interface{}) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit < 1 {
+		limit = 25
+	}
+	totalPages := (len(items) + limit - 1) / limit
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	start := (page - 1) * limit
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + limit
+	if end > len(items) {
+		end = len(items)
+	}
+
+	writeEnvelope(w, http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   items[start:end],
+		"meta": map[string]interface{}{
+			"page":        page,
+			"limit":       limit,
+			"per_page":    limit,
+			"total_items": len(items),
+			"total_pages": totalPages,
+			"has_more":    page < totalPages,
+		},
+	})
+}
+
+func writeEnvelope(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// readObject decodes a JSON object request body, writing a 400 response and
+// returning false when it is invalid
+func readObject(w http.ResponseWriter, r *http.Request) (map[string]interface{}, bool) {
+	var body map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body == nil {
+		writeError(w, http.StatusBadRequest, "request body must be a JSON object")
+		return nil, false
+	}
+	return body, true
+}
+
+// newID returns the next numeric resource ID. The caller must hold m.mu.
+func (m *MockAPIServer) newID() int {
+	m.nextID++
+	return m.nextID
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// listHandler serves a paginated list of the resources in c
+func (m *MockAPIServer) listHandler(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		m.mu.RLock()
+		items := c.list()
+		m.mu.RUnlock()
+		writePage(w, r, items)
+	}
+}
+
+// getHandler serves the resource in c named by the id path value
+func (m *MockAPIServer) getHandler(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		m.mu.RLock()
+		item, ok := c.get(r.PathValue("id"))
+		m.mu.RUnlock()
+		if !ok {
+			writeError(w, http.StatusNotFound, "resource not found")
+			return
+		}
+		writeData(w, http.StatusOK, item)
+	}
+}
+
+// updateHandler merges the request body into the resource in c named by the
+// id path value
+func (m *MockAPIServer) updateHandler(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		updates, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+
+		m.mu.Lock()
+		item, exists := c.get(r.PathValue("id"))
+		if exists {
+			for k, v := range updates {
+				if k != "id" {
+					item[k] = v
+				}
+			}
+			item["updated_at"] = now()
+		}
+		m.mu.Unlock()
+
+		if !exists {
+			writeError(w, http.StatusNotFound, "resource not found")
+			return
+		}
+		writeData(w, http.StatusOK, item)
+	}
+}
+
+// deleteHandler removes the resource in c named by the id path value
+func (m *MockAPIServer) deleteHandler(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		deleted := c.delete(r.PathValue("id"))
+		m.mu.Unlock()
+
+		if !deleted {
+			writeError(w, http.StatusNotFound, "resource not found")
+			return
+		}
+		writeData(w, http.StatusOK, nil)
+	}
+}
+
+// handleAccepted acknowledges submissions the mock does not keep
+func (m *MockAPIServer) handleAccepted(w http.ResponseWriter, r *http.Request) {
+	if _, ok := readObject(w, r); !ok {
+		return
+	}
+	writeData(w, http.StatusOK, nil)
+}
+
+// serverUUID returns the server a submission is for, from the body or the
+// server credentials
+func serverUUID(r *http.Request, body map[string]interface{}) string {
+	if uuid, ok := body["server_uuid"].(string); ok && uuid != "" {
+		return uuid
+	}
+	return r.Header.Get("X-Server-UUID")
+}
+
+// handleMetricsSubmitV1 handles POST /v1/metrics and /v1/metrics/aggregated
+func (m *MockAPIServer) handleMetricsSubmitV1(w http.ResponseWriter, r *http.Request) {
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	uuid := serverUUID(r, body)
+	if uuid == "" {
+		writeError(w, http.StatusBadRequest, "server_uuid is required")
+		return
+	}
+
+	m.mu.Lock()
+	m.metrics[uuid] = append(m.metrics[uuid], body)
+	m.mu.Unlock()
+
+	writeData(w, http.StatusOK, nil)
+}
+
+// handleMetricsComprehensive handles POST /v2/metrics/comprehensive
+func (m *MockAPIServer) handleMetricsComprehensive(w http.ResponseWriter, r *http.Request) {
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	uuid := serverUUID(r, body)
+	if uuid == "" {
+		writeError(w, http.StatusBadRequest, "server_uuid is required")
+		return
+	}
+
+	m.mu.Lock()
+	m.metrics[uuid] = append(m.metrics[uuid], body)
+	receiptID := fmt.Sprintf("rcpt-%d", m.newID())
+	m.mu.Unlock()
+
+	writeData(w, http.StatusAccepted, map[string]interface{}{
+		"receipt_id":  receiptID,
+		"status":      "queued",
+		"received_at": now(),
+	})
+}
+
+// handleMetricsList handles GET /v1/metrics/server/{uuid}
+func (m *MockAPIServer) handleMetricsList(w http.ResponseWriter, r *http.Request) {
+	m.mu.RLock()
+	items := append([]map[string]interface{}(nil), m.metrics[r.PathValue("uuid")]...)
+	m.mu.RUnlock()
+
+	writePage(w, r, items)
+}
+
+// handleMetricsLatest handles GET /v2/servers/{uuid}/metrics/latest
+func (m *MockAPIServer) handleMetricsLatest(w http.ResponseWriter, r *http.Request) {
+	uuid := r.PathValue("uuid")
+	m.mu.RLock()
+	submissions := m.metrics[uuid]
+	m.mu.RUnlock()
+
+	if len(submissions) == 0 {
+		writeError(w, http.StatusNotFound, "no metrics for server")
+		return
+	}
+	writeData(w, http.StatusOK, map[string]interface{}{
+		"server_uuid": uuid,
+		"metrics":     submissions[len(submissions)-1],
+	})
+}
+
+// handleHardwareSubmit handles POST /v2/hardware/inventory
+func (m *MockAPIServer) handleHardwareSubmit(w http.ResponseWriter, r *http.Request) {
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	uuid := serverUUID(r, body)
+	if uuid == "" {
+		writeError(w, http.StatusBadRequest, "server_uuid is required")
+		return
+	}
+
+	counts := make(map[string]int)
+	if hardware, ok := body["hardware"].(map[string]interface{}); ok {
+		for component, value := range hardware {
+			if list, ok := value.([]interface{}); ok {
+				counts[component] = len(list)
+			}
+		}
+	}
+	body["server_uuid"] = uuid
+	body["updated_at"] = now()
+
+	m.mu.Lock()
+	m.hardware.put(uuid, body)
+	m.mu.Unlock()
+
+	writeData(w, http.StatusOK, map[string]interface{}{
+		"server_uuid":      uuid,
+		"timestamp":        now(),
+		"component_counts": counts,
+	})
+}
+
+// handleHardwareGet serves the latest inventory submitted for a server
+func (m *MockAPIServer) handleHardwareGet(w http.ResponseWriter, r *http.Request) {
+	m.mu.RLock()
+	inventory, ok := m.hardware.get(r.PathValue("uuid"))
+	m.mu.RUnlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "no hardware inventory for server")
+		return
+	}
+	writeData(w, http.StatusOK, inventory)
+}
+
+// handleProbeCreate handles POST /v1/probes. The request uses the API's
+// field names, which are mapped to the ones probes are returned with.
+func (m *MockAPIServer) handleProbeCreate(w http.ResponseWriter, r *http.Request) {
+	probe, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	if name, _ := probe["name"].(string); name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	m.mu.Lock()
+	id := m.newID()
+	uuid := fmt.Sprintf("probe-%d", id)
+	probe["id"] = id
+	probe["uuid"] = uuid
+	probe["probe_type"] = probe["type"]
+	probe["interval"] = probe["frequency"]
+	if config, ok := probe["config"].(map[string]interface{}); ok {
+		probe["configuration"] = config
+		if target, ok := config["url"]; ok {
+			probe["target"] = target
+		} else if target, ok := config["host"]; ok {
+			probe["target"] = target
+		}
+	}
+	probe["created_at"] = now()
+	m.sdkProbes.put(uuid, probe)
+	m.mu.Unlock()
+
+	writeData(w, http.StatusCreated, map[string]interface{}{"probe": probe})
+}
+
+// handleAssignedProbes handles GET /v1/monitoring/probes, returning the
+// probes as assignments for the region in the query, or all of them
+func (m *MockAPIServer) handleAssignedProbes(w http.ResponseWriter, r *http.Request) {
+	region := r.URL.Query().Get("region")
+
+	m.mu.RLock()
+	assignments := make([]map[string]interface{}, 0)
+	for _, probe := range m.sdkProbes.list() {
+		if regions, ok := probe["regions"].([]interface{}); ok && region != "" && !containsRegion(regions, region) {
+			continue
+		}
+		assignments = append(assignments, map[string]interface{}{
+			"probe_id":      probe["id"],
+			"probe_uuid":    probe["uuid"],
+			"name":          probe["name"],
+			"type":          probe["type"],
+			"target":        probe["target"],
+			"interval":      probe["interval"],
+			"timeout":       probe["timeout"],
+			"enabled":       probe["enabled"],
+			"configuration": probe["configuration"],
+			"region":        region,
+		})
+	}
+	m.mu.RUnlock()
+
+	writeData(w, http.StatusOK, assignments)
+}
+
+// containsRegion reports whether regions includes region. A probe created
+// without a region has a single empty entry and runs everywhere.
+func containsRegion(regions []interface{}, region string) bool {
+	for _, r := range regions {
+		if r == region || r == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// handleAgentHeartbeat handles POST /v1/monitoring/heartbeat, recording the
+// agent so it is listed by GET /v1/monitoring/agents
+func (m *MockAPIServer) handleAgentHeartbeat(w http.ResponseWriter, r *http.Request) {
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	node, _ := body["node_info"].(map[string]interface{})
+	agentID, _ := node["agent_id"].(string)
+	if agentID == "" {
+		writeError(w, http.StatusBadRequest, "node_info.agent_id is required")
+		return
+	}
+
+	m.mu.Lock()
+	agent, exists := m.agents.get(agentID)
+	if !exists {
+		agent = map[string]interface{}{"id": m.newID(), "uuid": agentID}
+	}
+	agent["name"] = node["hostname"]
+	agent["region"] = node["region"]
+	agent["version"] = node["agent_version"]
+	agent["status"] = node["status"]
+	agent["last_heartbeat"] = now()
+	m.agents.put(agentID, agent)
+	m.mu.Unlock()
+
+	writeData(w, http.StatusOK, nil)
+}
+
+// handleIncidentCreate handles POST /v1/incidents
+func (m *MockAPIServer) handleIncidentCreate(w http.ResponseWriter, r *http.Request) {
+	incident, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	if title, _ := incident["title"].(string); title == "" {
+		writeError(w, http.StatusBadRequest, "title is required")
+		return
+	}
+
+	m.mu.Lock()
+	id := m.newID()
+	incident["id"] = id
+	incident["status"] = "active"
+	incident["started_at"] = now()
+	incident["created_at"] = now()
+	m.incidents.put(strconv.Itoa(id), incident)
+	m.mu.Unlock()
+
+	writeData(w, http.StatusCreated, incident)
+}
+
+// handleIncidentList handles GET /v1/incidents, which uses its own pagination
+// fields rather than the standard metadata
+func (m *MockAPIServer) handleIncidentList(w http.ResponseWriter, r *http.Request) {
+	status := r.URL.Query().Get("status")
+	severity := r.URL.Query().Get("severity")
+
+	m.mu.RLock()
+	incidents := make([]map[string]interface{}, 0)
+	for _, incident := range m.incidents.list() {
+		if (status == "" || incident["status"] == status) && (severity == "" || incident["severity"] == severity) {
+			incidents = append(incidents, incident)
+		}
+	}
+	m.mu.RUnlock()
+
+	writeData(w, http.StatusOK, map[string]interface{}{
+		"incidents": incidents,
+		"total":     len(incidents),
+		"page":      1,
+		"limit":     len(incidents),
+		"pages":     1,
+	})
+}
+
+// handleAPIKeyCreate handles POST /v2/api-keys
+func (m *MockAPIServer) handleAPIKeyCreate(w http.ResponseWriter, r *http.Request) {
+	key, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	if name, _ := key["name"].(string); name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	m.mu.Lock()
+	id := m.newID()
+	keyID := fmt.Sprintf("key-%d", id)
+	key["id"] = id
+	key["key_id"] = keyID
+	key["status"] = "active"
+	key["created_at"] = now()
+	m.apiKeys.put(keyID, key)
+	value := fmt.Sprintf("nxm_%s_%d", keyID, time.Now().UnixNano())
+	m.mu.Unlock()
+
+	writeData(w, http.StatusCreated, map[string]interface{}{
+		"key":        key,
+		"key_id":     keyID,
+		"key_value":  value,
+		"full_token": value,
+	})
+}
+
+// handleAPIKeyRevoke handles POST /v2/api-keys/{id}/revoke
+func (m *MockAPIServer) handleAPIKeyRevoke(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	key, ok := m.apiKeys.get(r.PathValue("id"))
+	if ok {
+		key["status"] = "revoked"
+	}
+	m.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "API key not found")
+		return
+	}
+	writeData(w, http.StatusOK, nil)
+}
+
+// handleAPIKeyRegenerate handles POST /v2/api-keys/{id}/regenerate and
+// /rotate. Rotated keys report when the previous value stops working.
+func (m *MockAPIServer) handleAPIKeyRegenerate(w http.ResponseWriter, r *http.Request) {
+	keyID := r.PathValue("id")
+	m.mu.RLock()
+	key, ok := m.apiKeys.get(keyID)
+	m.mu.RUnlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "API key not found")
+		return
+	}
+
+	value := fmt.Sprintf("nxm_%s_%d", keyID, time.Now().UnixNano())
+	resp := map[string]interface{}{
+		"key":        key,
+		"key_id":     keyID,
+		"key_value":  value,
+		"full_token": value,
+	}
+	if r.URL.Path == "/v2/api-keys/"+keyID+"/rotate" {
+		resp["previous_key_expires_at"] = time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	}
+	writeData(w, http.StatusOK, resp)
+}
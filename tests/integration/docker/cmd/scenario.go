@@ -0,0 +1,151 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Scenario injects faults into API responses so that integration tests can
+// exercise the SDK's retry and error handling. Counted faults are consumed by
+// matching requests in order: rate limits first, then server errors, then
+// malformed bodies. Latency applies to every matching request.
+type Scenario struct {
+	PathPrefix        string `json:"path_prefix,omitempty"`         // Only requests under this path are affected; empty matches all API routes
+	LatencyMS         int    `json:"latency_ms,omitempty"`          // Delay before each response
+	RateLimit         int    `json:"rate_limit,omitempty"`          // Answer this many requests with 429
+	RetryAfter        int    `json:"retry_after,omitempty"`         // Retry-After seconds sent with 429s; omitted when zero
+	ServerErrors      int    `json:"server_errors,omitempty"`       // Answer this many requests with ServerErrorStatus
+	ServerErrorStatus int    `json:"server_error_status,omitempty"` // Status for injected server errors (default: 503)
+	MalformedJSON     int    `json:"malformed_json,omitempty"`      // Answer this many requests with a truncated JSON body
+}
+
+// ScenarioStatus is returned by GET /_mock/scenario. The counts in Scenario
+// are the faults still to be injected.
+type ScenarioStatus struct {
+	Scenario Scenario       `json:"scenario"`
+	Injected map[string]int `json:"injected"` // Faults injected so far by kind
+}
+
+// scenarioState holds the active scenario
+type scenarioState struct {
+	mu       sync.Mutex
+	current  Scenario
+	injected map[string]int
+}
+
+// fault returns the fault to inject for r, consuming it, and the latency to add
+func (s *scenarioState) fault(r *http.Request) (string, Scenario) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sc := s.current
+	if !strings.HasPrefix(r.URL.Path, sc.PathPrefix) {
+		return "", Scenario{}
+	}
+
+	var kind string
+	switch {
+	case s.current.RateLimit > 0:
+		s.current.RateLimit--
+		kind = "rate_limit"
+	case s.current.ServerErrors > 0:
+		s.current.ServerErrors--
+		kind = "server_error"
+	case s.current.MalformedJSON > 0:
+		s.current.MalformedJSON--
+		kind = "malformed_json"
+	}
+	if kind != "" {
+		if s.injected == nil {
+			s.injected = make(map[string]int)
+		}
+		s.injected[kind]++
+	}
+	return kind, sc
+}
+
+// middleware applies the active scenario to every route except the health
+// checks and the /_mock/ controls
+func (s *scenarioState) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/_mock/") || r.URL.Path == "/health" || r.URL.Path == "/ready" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		kind, sc := s.fault(r)
+		if sc.LatencyMS > 0 {
+			select {
+			case <-time.After(time.Duration(sc.LatencyMS) * time.Millisecond):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		w.Header().Set("X-Request-ID", "mock-"+strconv.FormatInt(time.Now().UnixNano(), 36))
+		switch kind {
+		case "rate_limit":
+			if sc.RetryAfter > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(sc.RetryAfter))
+			}
+			writeError(w, http.StatusTooManyRequests, "rate limit exceeded (injected)")
+		case "server_error":
+			status := sc.ServerErrorStatus
+			if status == 0 {
+				status = http.StatusServiceUnavailable
+			}
+			writeError(w, status, "server error (injected)")
+		case "malformed_json":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"status":"success","data":{"id":`))
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// handleGet handles GET /_mock/scenario
+func (s *scenarioState) handleGet(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	status := ScenarioStatus{Scenario: s.current, Injected: make(map[string]int)}
+	for kind, n := range s.injected {
+		status.Injected[kind] = n
+	}
+	s.mu.Unlock()
+
+	writeData(w, http.StatusOK, status)
+}
+
+// handleSet handles POST /_mock/scenario, replacing the active scenario
+func (s *scenarioState) handleSet(w http.ResponseWriter, r *http.Request) {
+	var sc Scenario
+	if err := json.NewDecoder(r.Body).Decode(&sc); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid scenario: "+err.Error())
+		return
+	}
+	if sc.ServerErrorStatus != 0 && (sc.ServerErrorStatus < 500 || sc.ServerErrorStatus > 599) {
+		writeError(w, http.StatusBadRequest, "server_error_status must be a 5xx status")
+		return
+	}
+
+	s.mu.Lock()
+	s.current = sc
+	s.injected = nil
+	s.mu.Unlock()
+
+	writeData(w, http.StatusOK, sc)
+}
+
+// handleReset handles DELETE /_mock/scenario
+func (s *scenarioState) handleReset(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.current = Scenario{}
+	s.injected = nil
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,345 @@
+package nexmonyx
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// customTimeLayouts are the layouts CustomTime accepts before any registered
+// with RegisterTimeLayouts
+var customTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05.000Z",
+	"2006-01-02T15:04:05Z",
+	"2006-01-02 15:04:05",
+}
+
+// unixMillisThreshold separates Unix timestamps in seconds from those in
+// milliseconds: 1e12 seconds is tens of thousands of years away, while 1e12
+// milliseconds is September 2001
+const unixMillisThreshold = 1e12
+
+// customTimeSettings holds the process-wide CustomTime layouts and format.
+// Config.TimeLayouts and TimeFormat apply to a single client on top of them.
+var customTimeSettings = struct {
+	sync.RWMutex
+	layouts []string
+	format  string
+}{
+	format: time.RFC3339,
+}
+
+// RegisterTimeLayouts adds layouts, in time.Parse form, that CustomTime
+// accepts when decoding. They are tried after the built-in layouts, in the
+// order registered, and apply process-wide; use Config.TimeLayouts for the
+// layouts of one client.
+//
+// Example:
+//
+//	// The API of a self-hosted deployment sends "15/10/2026 14:03:00"
+//	nexmonyx.RegisterTimeLayouts("02/01/2006 15:04:05")
+func RegisterTimeLayouts(layouts ...string) {
+	customTimeSettings.Lock()
+	defer customTimeSettings.Unlock()
+	for _, layout := range layouts {
+		if layout != "" && !containsString(customTimeLayouts, layout) && !containsString(customTimeSettings.layouts, layout) {
+			customTimeSettings.layouts = append(customTimeSettings.layouts, layout)
+		}
+	}
+}
+
+// SetTimeFormat sets the layout CustomTime is encoded with, process-wide;
+// Config.TimeFormat overrides it for the request bodies of one client. The
+// default, time.RFC3339, drops fractional seconds; use time.RFC3339Nano to
+// keep them. An empty layout restores the default.
+func SetTimeFormat(layout string) {
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	customTimeSettings.Lock()
+	defer customTimeSettings.Unlock()
+	customTimeSettings.format = layout
+}
+
+func customTimeFormat() string {
+	customTimeSettings.RLock()
+	defer customTimeSettings.RUnlock()
+	return customTimeSettings.format
+}
+
+// parseCustomTime parses s with the built-in and registered layouts, falling
+// back to a Unix timestamp in seconds or milliseconds, with or without a
+// fractional part. The error is that of the first layout.
+func parseCustomTime(s string) (time.Time, error) {
+	t, firstErr := time.Parse(customTimeLayouts[0], s)
+	if firstErr == nil {
+		return t, nil
+	}
+	for _, layout := range customTimeLayouts[1:] {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	customTimeSettings.RLock()
+	layouts := customTimeSettings.layouts
+	customTimeSettings.RUnlock()
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	if t, ok := parseUnixTime(s); ok {
+		return t, nil
+	}
+	return time.Time{}, firstErr
+}
+
+// parseUnixTime parses a Unix timestamp, telling seconds and milliseconds
+// apart by magnitude
+func parseUnixTime(s string) (time.Time, bool) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if n >= unixMillisThreshold || n <= -unixMillisThreshold {
+			return time.UnixMilli(n).UTC(), true
+		}
+		return time.Unix(n, 0).UTC(), true
+	}
+
+	// Only plain decimals, not exponents, hex, or infinities
+	if strings.Trim(s, "+-.0123456789") != "" {
+		return time.Time{}, false
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	if math.Abs(f) >= unixMillisThreshold {
+		f /= 1000
+	}
+	sec, frac := math.Modf(f)
+	// Round to microseconds, the precision a float64 keeps for current times
+	return time.Unix(int64(sec), int64(math.Round(frac*1e6))*1e3).UTC(), true
+}
+
+var (
+	customTimeType    = reflect.TypeOf(CustomTime{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// timeLayoutUnmarshal decodes data into v like json.Unmarshal, or like
+// tolerantUnmarshal with Config.TolerantDecoding. When a CustomTime value
+// cannot be parsed, values that one of Config.TimeLayouts parses are
+// converted to RFC 3339 and decoding is retried.
+func (c *Client) timeLayoutUnmarshal(data []byte, v interface{}) error {
+	unmarshal := json.Unmarshal
+	if c.config.TolerantDecoding {
+		unmarshal = c.tolerantUnmarshal
+	}
+	err := unmarshal(data, v)
+	var parseErr *time.ParseError
+	if err == nil || !errors.As(err, &parseErr) {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var tree interface{}
+	if dec.Decode(&tree) != nil {
+		return err
+	}
+	fixed, mErr := json.Marshal(applyTimeLayouts(tree, reflect.ValueOf(v), c.config.TimeLayouts))
+	if mErr != nil {
+		return err
+	}
+	return unmarshal(fixed, v)
+}
+
+// applyTimeLayouts walks node alongside the Go value it will be decoded into,
+// the same way as coerceJSON, and rewrites the CustomTime strings that only
+// one of layouts parses
+func applyTimeLayouts(node interface{}, v reflect.Value, layouts []string) interface{} {
+	if !v.IsValid() || node == nil {
+		return node
+	}
+	t := v.Type()
+	if t.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return applyTimeLayouts(node, reflect.New(t.Elem()).Elem(), layouts)
+		}
+		return applyTimeLayouts(node, v.Elem(), layouts)
+	}
+
+	if t == customTimeType {
+		s, ok := node.(string)
+		if !ok {
+			return node
+		}
+		if _, err := parseCustomTime(s); err == nil {
+			return node
+		}
+		for _, layout := range layouts {
+			if parsed, err := time.Parse(layout, s); err == nil {
+				return parsed.Format(time.RFC3339Nano)
+			}
+		}
+		return node
+	}
+	if t.Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(jsonUnmarshalerType) ||
+		t.Implements(textUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return node
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return node
+		}
+		return applyTimeLayouts(node, v.Elem(), layouts)
+
+	case reflect.Struct:
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return node
+		}
+		fields := map[string]reflect.Value{}
+		collectStructFields(v, fields)
+		for key, item := range obj {
+			for name, field := range fields {
+				if key == name || strings.EqualFold(key, name) {
+					obj[key] = applyTimeLayouts(item, field, layouts)
+					break
+				}
+			}
+		}
+		return obj
+
+	case reflect.Slice, reflect.Array:
+		arr, ok := node.([]interface{})
+		if !ok {
+			return node
+		}
+		elem := reflect.New(t.Elem()).Elem()
+		for i := range arr {
+			arr[i] = applyTimeLayouts(arr[i], elem, layouts)
+		}
+		return arr
+
+	case reflect.Map:
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return node
+		}
+		elem := reflect.New(t.Elem()).Elem()
+		for k, item := range obj {
+			obj[k] = applyTimeLayouts(item, elem, layouts)
+		}
+		return obj
+	}
+	return node
+}
+
+// timeFormattedBody encodes a request body like json.Marshal, with its
+// CustomTime values in layout
+type timeFormattedBody struct {
+	body   interface{}
+	layout string
+}
+
+// MarshalJSON implements json.Marshaler
+func (b timeFormattedBody) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(b.body)
+	if err != nil || b.layout == customTimeFormat() {
+		return data, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var tree interface{}
+	if dec.Decode(&tree) != nil {
+		return data, nil
+	}
+	return json.Marshal(formatCustomTimes(tree, reflect.ValueOf(b.body), b.layout))
+}
+
+// formatCustomTimes walks node alongside the Go value it was encoded from and
+// replaces the encoding of each CustomTime value with one in layout
+func formatCustomTimes(node interface{}, v reflect.Value, layout string) interface{} {
+	if !v.IsValid() || node == nil {
+		return node
+	}
+	t := v.Type()
+	if t.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return node
+		}
+		return formatCustomTimes(node, v.Elem(), layout)
+	}
+
+	if t == customTimeType {
+		if _, ok := node.(string); ok {
+			return v.Interface().(CustomTime).Format(layout)
+		}
+		return node
+	}
+	if t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType) ||
+		t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType) {
+		return node
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return node
+		}
+		return formatCustomTimes(node, v.Elem(), layout)
+
+	case reflect.Struct:
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return node
+		}
+		fields := map[string]reflect.Value{}
+		collectStructFields(v, fields)
+		for key, item := range obj {
+			if field, ok := fields[key]; ok {
+				obj[key] = formatCustomTimes(item, field, layout)
+			}
+		}
+		return obj
+
+	case reflect.Slice, reflect.Array:
+		arr, ok := node.([]interface{})
+		if !ok {
+			return node
+		}
+		for i := 0; i < len(arr) && i < v.Len(); i++ {
+			arr[i] = formatCustomTimes(arr[i], v.Index(i), layout)
+		}
+		return arr
+
+	case reflect.Map:
+		obj, ok := node.(map[string]interface{})
+		if !ok || t.Key().Kind() != reflect.String {
+			return node
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			key := iter.Key().String()
+			if item, ok := obj[key]; ok {
+				obj[key] = formatCustomTimes(item, iter.Value(), layout)
+			}
+		}
+		return obj
+	}
+	return node
+}
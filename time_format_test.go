@@ -0,0 +1,128 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// restoreTimeSettings undoes RegisterTimeLayouts and SetTimeFormat calls
+// made by a test
+func restoreTimeSettings(t *testing.T) {
+	customTimeSettings.RLock()
+	layouts, format := customTimeSettings.layouts, customTimeSettings.format
+	customTimeSettings.RUnlock()
+	t.Cleanup(func() {
+		customTimeSettings.Lock()
+		customTimeSettings.layouts, customTimeSettings.format = layouts, format
+		customTimeSettings.Unlock()
+	})
+}
+
+func TestCustomTime_UnixTimestamps(t *testing.T) {
+	want := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	for input, expected := range map[string]time.Time{
+		`1792065600`:             want,
+		`"1792065600"`:           want,
+		`1792065600123`:          want.Add(123 * time.Millisecond),
+		`"1792065600123"`:        want.Add(123 * time.Millisecond),
+		`1792065600.25`:          want.Add(250 * time.Millisecond),
+		`1792065600123.5`:        want.Add(123500 * time.Microsecond),
+		`"2026-10-15T12:00:00Z"`: want,
+	} {
+		var ct CustomTime
+		require.NoError(t, json.Unmarshal([]byte(input), &ct), input)
+		assert.True(t, expected.Equal(ct.Time), "%s: got %s", input, ct.Time)
+	}
+
+	var ct CustomTime
+	require.NoError(t, json.Unmarshal([]byte(`null`), &ct))
+	assert.True(t, ct.IsZero())
+	assert.Error(t, json.Unmarshal([]byte(`"1e9"`), &ct))
+	assert.Error(t, json.Unmarshal([]byte(`"yesterday"`), &ct))
+}
+
+func TestCustomTime_RegisteredLayouts(t *testing.T) {
+	restoreTimeSettings(t)
+
+	var ct CustomTime
+	input := []byte(`"15/10/2026 14:03:00"`)
+	require.Error(t, json.Unmarshal(input, &ct))
+
+	RegisterTimeLayouts("02/01/2006 15:04:05", time.RFC3339)
+	require.NoError(t, json.Unmarshal(input, &ct))
+	assert.Equal(t, time.Date(2026, 10, 15, 14, 3, 0, 0, time.UTC), ct.Time)
+	assert.Equal(t, []string{"02/01/2006 15:04:05"}, customTimeSettings.layouts, "built-in and duplicate layouts are not added")
+}
+
+func TestCustomTime_Format(t *testing.T) {
+	restoreTimeSettings(t)
+
+	ct := CustomTime{Time: time.Date(2026, 10, 15, 12, 0, 0, 123456789, time.UTC)}
+	data, err := json.Marshal(ct)
+	require.NoError(t, err)
+	assert.Equal(t, `"2026-10-15T12:00:00Z"`, string(data))
+
+	SetTimeFormat(time.RFC3339Nano)
+	data, err = json.Marshal(ct)
+	require.NoError(t, err)
+	assert.Equal(t, `"2026-10-15T12:00:00.123456789Z"`, string(data))
+
+	SetTimeFormat("")
+	data, err = json.Marshal(ct)
+	require.NoError(t, err)
+	assert.Equal(t, `"2026-10-15T12:00:00Z"`, string(data))
+}
+
+func TestConfig_TimeLayouts(t *testing.T) {
+	handler := jsonResponse(`{
+		"status": "success",
+		"data": {"id": "42", "server_uuid": "srv-1", "created_at": "15/10/2026 14:03:00", "updated_at": 1792065600000}
+	}`)
+	client := newTestClient(t, handler, Config{TimeLayouts: []string{"02/01/2006 15:04:05"}, TolerantDecoding: true})
+	other := newTestClient(t, handler, Config{TolerantDecoding: true})
+
+	server, err := client.Servers.Get(context.Background(), "srv-1")
+	require.NoError(t, err)
+	assert.Equal(t, uint(42), server.ID, "combined with tolerant decoding")
+	assert.Equal(t, time.Date(2026, 10, 15, 14, 3, 0, 0, time.UTC), server.CreatedAt.Time)
+	assert.Equal(t, time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC), server.UpdatedAt.Time)
+
+	_, err = other.Servers.Get(context.Background(), "srv-1")
+	assert.Error(t, err, "layouts only apply to the client configured with them")
+}
+
+func TestConfig_TimeFormat(t *testing.T) {
+	var body string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	})
+	client := newTestClient(t, handler, Config{TimeFormat: time.RFC3339Nano})
+	other := newTestClient(t, handler, Config{})
+
+	at := &CustomTime{Time: time.Date(2026, 10, 15, 12, 0, 0, 123456789, time.UTC)}
+	req := map[string]interface{}{
+		"model":  GormModel{ID: 1, CreatedAt: at},
+		"times":  []CustomTime{*at},
+		"nested": map[string]*CustomTime{"at": at},
+	}
+
+	_, err := client.Do(context.Background(), &Request{Method: "POST", Path: "/v1/test", Body: req})
+	require.NoError(t, err)
+	assert.Equal(t, 3, strings.Count(body, `"2026-10-15T12:00:00.123456789Z"`), body)
+	assert.Contains(t, body, `"id":1`)
+
+	_, err = other.Do(context.Background(), &Request{Method: "POST", Path: "/v1/test", Body: req})
+	require.NoError(t, err)
+	assert.Equal(t, 3, strings.Count(body, `"2026-10-15T12:00:00Z"`), body)
+}
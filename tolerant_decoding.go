@@ -0,0 +1,240 @@
+package nexmonyx
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DecodeWarning describes a response value that did not match the SDK type and
+// was converted in tolerant decoding mode
+type DecodeWarning struct {
+	Field string // JSON path of the value, e.g. "data.items[3].id"
+	Value string // Value as received
+	Type  string // Go type it was converted to
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// tolerantUnmarshal decodes data into v like json.Unmarshal. When strict
+// decoding fails on a type mismatch, values are coerced to the types of v's
+// fields (numbers sent as strings and the reverse, "true"/"false" strings,
+// empty strings for numbers) and a warning is published for each conversion.
+func (c *Client) tolerantUnmarshal(data []byte, v interface{}) error {
+	err := json.Unmarshal(data, v)
+	var typeErr *json.UnmarshalTypeError
+	if err == nil || !errors.As(err, &typeErr) {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var tree interface{}
+	if dec.Decode(&tree) != nil {
+		return err
+	}
+
+	var warnings []DecodeWarning
+	tree = coerceJSON(tree, reflect.ValueOf(v), "", &warnings)
+	fixed, mErr := json.Marshal(tree)
+	if mErr != nil {
+		return err
+	}
+	if retryErr := json.Unmarshal(fixed, v); retryErr != nil {
+		return retryErr
+	}
+
+	for _, w := range warnings {
+		c.events.emitDecodeWarning(w)
+	}
+	return nil
+}
+
+// coerceJSON walks node alongside the Go value it will be decoded into and
+// converts scalars whose JSON type does not match the field type. Values are
+// used where available so interface fields holding a preset pointer, as with
+// StandardResponse.Data, are followed.
+func coerceJSON(node interface{}, v reflect.Value, path string, warnings *[]DecodeWarning) interface{} {
+	if !v.IsValid() || node == nil {
+		return node
+	}
+	t := v.Type()
+
+	// Types with their own decoding, such as CustomTime, are left alone
+	if t.Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(jsonUnmarshalerType) ||
+		t.Implements(textUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return node
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return coerceJSON(node, reflect.New(t.Elem()).Elem(), path, warnings)
+		}
+		return coerceJSON(node, v.Elem(), path, warnings)
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return node
+		}
+		return coerceJSON(node, v.Elem(), path, warnings)
+
+	case reflect.Struct:
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return node
+		}
+		coerceStruct(obj, v, path, warnings)
+		return obj
+
+	case reflect.Slice, reflect.Array:
+		arr, ok := node.([]interface{})
+		if !ok {
+			return node
+		}
+		elem := reflect.New(t.Elem()).Elem()
+		for i := range arr {
+			arr[i] = coerceJSON(arr[i], elem, fmt.Sprintf("%s[%d]", path, i), warnings)
+		}
+		return arr
+
+	case reflect.Map:
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return node
+		}
+		elem := reflect.New(t.Elem()).Elem()
+		for k, item := range obj {
+			obj[k] = coerceJSON(item, elem, joinJSONPath(path, k), warnings)
+		}
+		return obj
+	}
+
+	converted, ok := coerceScalar(node, t.Kind())
+	if ok {
+		*warnings = append(*warnings, DecodeWarning{Field: path, Value: fmt.Sprint(node), Type: t.String()})
+		return converted
+	}
+	return node
+}
+
+// coerceStruct coerces the members of obj that map to fields of the struct v,
+// including fields promoted from embedded structs
+func coerceStruct(obj map[string]interface{}, v reflect.Value, path string, warnings *[]DecodeWarning) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			embedded := v.Field(i)
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					embedded = reflect.New(embedded.Type().Elem())
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				coerceStruct(obj, embedded, path, warnings)
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		for key, item := range obj {
+			if key == name || strings.EqualFold(key, name) {
+				obj[key] = coerceJSON(item, v.Field(i), joinJSONPath(path, key), warnings)
+			}
+		}
+	}
+}
+
+// coerceScalar converts a JSON scalar to the representation kind expects. It
+// reports false when node already matches or cannot be converted.
+func coerceScalar(node interface{}, kind reflect.Kind) (interface{}, bool) {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var s string
+		switch n := node.(type) {
+		case string:
+			s = strings.TrimSpace(n)
+			if s == "" {
+				return nil, true
+			}
+		case json.Number:
+			s = n.String()
+			if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+				return node, false
+			}
+			if _, err := strconv.ParseUint(s, 10, 64); err == nil {
+				return node, false
+			}
+		default:
+			return node, false
+		}
+		if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return json.Number(s), true
+		}
+		if f, err := strconv.ParseFloat(s, 64); err == nil && f == math.Trunc(f) && math.Abs(f) < 1<<63 {
+			return json.Number(strconv.FormatInt(int64(f), 10)), true
+		}
+
+	case reflect.Float32, reflect.Float64:
+		if s, ok := node.(string); ok {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				return nil, true
+			}
+			if _, err := strconv.ParseFloat(s, 64); err == nil {
+				return json.Number(s), true
+			}
+		}
+
+	case reflect.String:
+		switch n := node.(type) {
+		case json.Number:
+			return n.String(), true
+		case bool:
+			return strconv.FormatBool(n), true
+		}
+
+	case reflect.Bool:
+		switch n := node.(type) {
+		case string:
+			if b, err := strconv.ParseBool(strings.TrimSpace(n)); err == nil {
+				return b, true
+			}
+		case json.Number:
+			if b, err := strconv.ParseBool(n.String()); err == nil {
+				return b, true
+			}
+		}
+	}
+	return node, false
+}
+
+func joinJSONPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,87 @@
+package nexmonyx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const looselyTypedServer = `{
+	"status": "success",
+	"data": {
+		"id": "42",
+		"server_uuid": "srv-1",
+		"organization_id": 7.0,
+		"hostname": 1234,
+		"cpu_cores": "",
+		"created_at": "2025-01-01T00:00:00Z",
+		"updated_at": "2025-01-01T00:00:00Z"
+	}
+}`
+
+func TestTolerantDecoding(t *testing.T) {
+	client := newTestClient(t, jsonResponse(looselyTypedServer), Config{TolerantDecoding: true})
+
+	var warnings []DecodeWarning
+	client.Events().OnDecodeWarning(func(w DecodeWarning) {
+		warnings = append(warnings, w)
+	})
+
+	server, err := client.Servers.Get(context.Background(), "srv-1")
+	require.NoError(t, err)
+	assert.Equal(t, uint(42), server.ID)
+	assert.Equal(t, uint(7), server.OrganizationID)
+	assert.Equal(t, "1234", server.Hostname)
+	assert.Equal(t, 0, server.CPUCores)
+	assert.Equal(t, 2025, server.CreatedAt.Year())
+
+	fields := make([]string, 0, len(warnings))
+	for _, w := range warnings {
+		fields = append(fields, w.Field)
+	}
+	assert.ElementsMatch(t, []string{"data.id", "data.organization_id", "data.hostname", "data.cpu_cores"}, fields)
+}
+
+func TestTolerantDecoding_Disabled(t *testing.T) {
+	client := newTestClient(t, jsonResponse(looselyTypedServer), Config{})
+
+	_, err := client.Servers.Get(context.Background(), "srv-1")
+	assert.Error(t, err)
+}
+
+func TestTolerantDecoding_Lists(t *testing.T) {
+	client := newTestClient(t, jsonResponse(`{
+		"status": "success",
+		"data": [{"id": "1", "server_uuid": "a"}, {"id": 2, "server_uuid": "b"}],
+		"meta": {"page": "1", "total_items": "2", "has_more": "false"}
+	}`), Config{TolerantDecoding: true})
+
+	servers, meta, err := client.Servers.List(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, servers, 2)
+	assert.Equal(t, uint(1), servers[0].ID)
+	assert.Equal(t, 2, meta.TotalItems)
+	assert.False(t, meta.HasMore)
+}
+
+func TestCoerceScalar(t *testing.T) {
+	client := &Client{events: &EventBus{}}
+
+	var target struct {
+		Count   int               `json:"count"`
+		Ratio   float64           `json:"ratio"`
+		Enabled bool              `json:"enabled"`
+		Labels  map[string]string `json:"labels"`
+	}
+	err := client.tolerantUnmarshal([]byte(`{"count":"3","ratio":"0.5","enabled":"true","labels":{"tier":1}}`), &target)
+	require.NoError(t, err)
+	assert.Equal(t, 3, target.Count)
+	assert.Equal(t, 0.5, target.Ratio)
+	assert.True(t, target.Enabled)
+	assert.Equal(t, "1", target.Labels["tier"])
+
+	err = client.tolerantUnmarshal([]byte(`{"count":"three"}`), &target)
+	assert.Error(t, err, "values that cannot be converted still fail")
+}
@@ -0,0 +1,154 @@
+package nexmonyx
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// usageOverviewPageSize is the page size AdminGetOverview uses to collect
+// every organization
+const usageOverviewPageSize = 100
+
+// UsageService records and reads organization usage metrics for the billing
+// pipeline. It is used by org-management-controller, which collects agent
+// counts and storage per organization and submits them with Record, and by
+// billing jobs that read them back. All methods require admin credentials;
+// organizations read their own usage through BillingUsage.
+type UsageService struct {
+	client *Client
+}
+
+// Record submits usage metrics collected for an organization. CollectedAt
+// defaults to the current time; with Config.ClockSkewCorrection it is
+// corrected when the request is encoded.
+// Authentication: Admin JWT Token or API Key required
+// Endpoint: POST /v1/admin/usage-metrics/record
+//
+// Example:
+//
+//	err := client.Usage.Record(ctx, &nexmonyx.UsageMetricsRecordRequest{
+//	    OrganizationID:   org.ID,
+//	    ActiveAgentCount: active,
+//	    TotalAgentCount:  total,
+//	    StorageUsedBytes: storageBytes,
+//	    RetentionDays:    30,
+//	})
+func (s *UsageService) Record(ctx context.Context, req *UsageMetricsRecordRequest) error {
+	if req == nil {
+		return fmt.Errorf("usage metrics are required")
+	}
+	if req.OrganizationID == 0 {
+		return fmt.Errorf("organization ID is required")
+	}
+	if req.ActiveAgentCount < 0 || req.TotalAgentCount < 0 || req.StorageUsedBytes < 0 {
+		return fmt.Errorf("usage metrics cannot be negative")
+	}
+	if req.ActiveAgentCount > req.TotalAgentCount {
+		return fmt.Errorf("active agent count %d exceeds total agent count %d", req.ActiveAgentCount, req.TotalAgentCount)
+	}
+
+	body := *req
+	if body.CollectedAt.IsZero() {
+		body.CollectedAt = time.Now().UTC()
+	}
+
+	var resp StandardResponse
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v1/admin/usage-metrics/record",
+		Body:   &body,
+		Result: &resp,
+	})
+	return err
+}
+
+// GetCurrent retrieves the latest usage metrics recorded for an organization
+// Authentication: Admin JWT Token or API Key required
+// Endpoint: GET /v1/admin/billing/organizations/:id/usage
+func (s *UsageService) GetCurrent(ctx context.Context, orgID uint) (*OrganizationUsageMetrics, error) {
+	if orgID == 0 {
+		return nil, fmt.Errorf("organization ID is required")
+	}
+	return s.client.BillingUsage.GetOrgCurrentUsage(ctx, orgID)
+}
+
+// GetHistory retrieves the daily usage metrics recorded for an organization
+// within timeRange, or the last 30 days when timeRange is nil
+// Authentication: Admin JWT Token or API Key required
+// Endpoint: GET /v1/admin/billing/organizations/:id/usage/history
+//
+// Example:
+//
+//	history, err := client.Usage.GetHistory(ctx, orgID, nexmonyx.Last7Days())
+func (s *UsageService) GetHistory(ctx context.Context, orgID uint, timeRange *QueryTimeRange) ([]UsageMetricsHistory, error) {
+	if orgID == 0 {
+		return nil, fmt.Errorf("organization ID is required")
+	}
+	start, end, err := usageRange(timeRange)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.BillingUsage.GetOrgUsageHistory(ctx, orgID, start, end, "")
+}
+
+// GetSummary retrieves usage aggregated over a billing period, such as
+// ThisMonth() or LastMonth(), or over the last 30 days when period is nil.
+// The summary's averages and maximums are what invoices are based on.
+// Authentication: Admin JWT Token or API Key required
+// Endpoint: GET /v1/admin/billing/organizations/:id/usage/summary
+//
+// Example:
+//
+//	summary, err := client.Usage.GetSummary(ctx, orgID, nexmonyx.LastMonth())
+//	if err == nil {
+//	    fmt.Printf("billable agents: %d\n", summary.MaxAgentCount)
+//	}
+func (s *UsageService) GetSummary(ctx context.Context, orgID uint, period *QueryTimeRange) (*UsageSummary, error) {
+	if orgID == 0 {
+		return nil, fmt.Errorf("organization ID is required")
+	}
+	start, end, err := usageRange(period)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.BillingUsage.GetOrgUsageSummary(ctx, orgID, start, end)
+}
+
+// AdminGetOverview retrieves current usage across all organizations. The
+// overview endpoint is paginated; AdminGetOverview requests every page and
+// returns the organizations of all of them.
+// Authentication: Admin JWT Token or API Key required
+// Endpoint: GET /v1/admin/billing/usage/overview
+func (s *UsageService) AdminGetOverview(ctx context.Context) (*OrganizationUsageOverview, error) {
+	var overview *OrganizationUsageOverview
+	for page := 1; ; page++ {
+		result, meta, err := s.client.BillingUsage.GetAllUsageOverview(ctx, &ListOptions{Page: page, Limit: usageOverviewPageSize})
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			result = &OrganizationUsageOverview{}
+		}
+		if overview == nil {
+			overview = result
+		} else {
+			overview.Organizations = append(overview.Organizations, result.Organizations...)
+		}
+		if meta == nil || len(result.Organizations) == 0 || (!meta.HasMore && page >= meta.TotalPages) {
+			return overview, nil
+		}
+	}
+}
+
+// usageRange returns the bounds of r, or zero times (the API's default range)
+// when r is nil
+func usageRange(r *QueryTimeRange) (start, end time.Time, err error) {
+	if r == nil {
+		return time.Time{}, time.Time{}, nil
+	}
+	if !r.Start.IsZero() && !r.End.IsZero() && r.End.Before(r.Start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("time range end %s is before start %s", r.End.Format(time.RFC3339), r.Start.Format(time.RFC3339))
+	}
+	return r.Start.UTC(), r.End.UTC(), nil
+}
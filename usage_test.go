@@ -0,0 +1,131 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageService_Record(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/admin/usage-metrics/record", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","message":"recorded"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	req := &UsageMetricsRecordRequest{OrganizationID: 7, ActiveAgentCount: 3, TotalAgentCount: 5, StorageUsedBytes: 1 << 30}
+	require.NoError(t, client.Usage.Record(context.Background(), req))
+	assert.Equal(t, 7.0, body["organization_id"])
+	assert.Equal(t, 3.0, body["active_agent_count"])
+	collectedAt, err := time.Parse(time.RFC3339Nano, body["collected_at"].(string))
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), collectedAt, time.Minute)
+	assert.True(t, req.CollectedAt.IsZero(), "caller's request is not modified")
+
+	err = client.Usage.Record(context.Background(), &UsageMetricsRecordRequest{ActiveAgentCount: 1, TotalAgentCount: 1})
+	assert.EqualError(t, err, "organization ID is required")
+	err = client.Usage.Record(context.Background(), &UsageMetricsRecordRequest{OrganizationID: 7, ActiveAgentCount: 6, TotalAgentCount: 5})
+	assert.Error(t, err)
+	assert.Error(t, client.Usage.Record(context.Background(), nil))
+}
+
+func TestUsageService_Record_ClockSkewCorrection(t *testing.T) {
+	var body map[string]interface{}
+	server := skewedServer(t, -10*time.Minute, &body)
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}, ClockSkewCorrection: true})
+	require.NoError(t, err)
+
+	// The first request measures the skew
+	req := &UsageMetricsRecordRequest{OrganizationID: 7, ActiveAgentCount: 3, TotalAgentCount: 5}
+	require.NoError(t, client.Usage.Record(context.Background(), req))
+	require.NoError(t, client.Usage.Record(context.Background(), req))
+
+	collectedAt, err := time.Parse(time.RFC3339Nano, body["collected_at"].(string))
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(-10*time.Minute), collectedAt, 2*time.Second)
+}
+
+func TestUsageService_GetHistoryAndSummary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/admin/billing/organizations/7/usage":
+			w.Write([]byte(`{"status":"success","data":{"organization_id":7,"active_agent_count":3,"storage_used_gb":1.5}}`))
+		case "/v1/admin/billing/organizations/7/usage/history":
+			assert.Equal(t, "2026-09-01T00:00:00Z", r.URL.Query().Get("start_date"))
+			assert.Equal(t, "2026-09-30T23:59:59Z", r.URL.Query().Get("end_date"))
+			w.Write([]byte(`{"status":"success","data":[{"organization_id":7,"active_agent_count":2},{"organization_id":7,"active_agent_count":3}]}`))
+		case "/v1/admin/billing/organizations/7/usage/summary":
+			assert.Empty(t, r.URL.Query().Get("start_date"))
+			w.Write([]byte(`{"status":"success","data":{"organization_id":7,"max_agent_count":4,"average_storage_gb":1.25}}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	current, err := client.Usage.GetCurrent(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, 3, current.ActiveAgentCount)
+
+	september := &QueryTimeRange{
+		Start: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2026, 9, 30, 23, 59, 59, 0, time.UTC),
+	}
+	history, err := client.Usage.GetHistory(ctx, 7, september)
+	require.NoError(t, err)
+	assert.Len(t, history, 2)
+
+	summary, err := client.Usage.GetSummary(ctx, 7, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 4, summary.MaxAgentCount)
+
+	_, err = client.Usage.GetSummary(ctx, 7, &QueryTimeRange{Start: september.End, End: september.Start})
+	assert.Error(t, err)
+	_, err = client.Usage.GetCurrent(ctx, 0)
+	assert.EqualError(t, err, "organization ID is required")
+}
+
+func TestUsageService_AdminGetOverview(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/admin/billing/usage/overview", r.URL.Path)
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"status":"success","data":{"total_organizations":3,"total_active_agents":9,
+			"organizations":[{"organization_id":%s}]},
+			"pagination":{"page":%s,"total_pages":3,"has_more":%t}}`, page, page, page != "3")
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: AuthConfig{Token: "test-token"}})
+	require.NoError(t, err)
+
+	overview, err := client.Usage.AdminGetOverview(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, pages)
+	assert.Equal(t, 3, overview.TotalOrganizations)
+	assert.Equal(t, 9, overview.TotalActiveAgents)
+	require.Len(t, overview.Organizations, 3)
+	assert.Equal(t, uint(3), overview.Organizations[2].OrganizationID)
+}
@@ -0,0 +1,55 @@
+package nexmonyx
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+)
+
+// SDKName is the product name the SDK sends in its User-Agent
+const SDKName = "nexmonyx-go-sdk"
+
+// ClientMetadataHeader carries Config.ClientMetadata as a JSON object
+const ClientMetadataHeader = "X-Client-Metadata"
+
+// UserAgent returns the User-Agent sent with every request, e.g.
+// "nexmonyx-go-sdk/2.13.0 my-agent/1.2.3" when Config.AppName is set
+func (c *Client) UserAgent() string {
+	return c.config.userAgent()
+}
+
+func (c *Config) userAgent() string {
+	name := userAgentToken(c.AppName)
+	if name == "" {
+		return userAgent
+	}
+	if version := userAgentToken(c.AppVersion); version != "" {
+		name += "/" + version
+	}
+	return userAgent + " " + name
+}
+
+// userAgentToken makes s usable as a User-Agent product name or version by
+// replacing spaces, slashes, and other separators with dashes
+func userAgentToken(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r > unicode.MaxASCII || unicode.IsSpace(r) || unicode.IsControl(r) || strings.ContainsRune(`/()<>@,;:\"[]?={}`, r) {
+			return '-'
+		}
+		return r
+	}, strings.TrimSpace(s))
+}
+
+// clientMetadataHeader encodes Config.ClientMetadata for ClientMetadataHeader,
+// or returns "" when there is none
+func (c *Config) clientMetadataHeader() string {
+	if len(c.ClientMetadata) == 0 {
+		return ""
+	}
+	// Map keys are sorted, so the header is stable across requests
+	data, err := json.Marshal(c.ClientMetadata)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
@@ -0,0 +1,85 @@
+package nexmonyx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_UserAgent(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		want   string
+	}{
+		{"default", Config{}, "nexmonyx-go-sdk/" + Version},
+		{"app", Config{AppName: "my-agent", AppVersion: "1.2.3"}, "nexmonyx-go-sdk/" + Version + " my-agent/1.2.3"},
+		{"no version", Config{AppName: "my-agent"}, "nexmonyx-go-sdk/" + Version + " my-agent"},
+		{"version without name", Config{AppVersion: "1.2.3"}, "nexmonyx-go-sdk/" + Version},
+		{"sanitized", Config{AppName: " My Agent/Pro ", AppVersion: "1.2 (beta)"}, "nexmonyx-go-sdk/" + Version + " My-Agent-Pro/1.2--beta-"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClient(&tt.config)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, client.UserAgent())
+		})
+	}
+}
+
+func TestClient_UserAgentAndMetadataHeaders(t *testing.T) {
+	var gotUserAgent, gotMetadata string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUserAgent = r.Header.Get("User-Agent")
+		gotMetadata = r.Header.Get(ClientMetadataHeader)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:        server.URL,
+		AppName:        "my-agent",
+		AppVersion:     "1.2.3",
+		ClientMetadata: map[string]string{"deployment": "prod", "agent_type": "linux"},
+	})
+	require.NoError(t, err)
+
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/ping"})
+	require.NoError(t, err)
+	assert.Equal(t, "nexmonyx-go-sdk/"+Version+" my-agent/1.2.3", gotUserAgent)
+	assert.JSONEq(t, `{"agent_type":"linux","deployment":"prod"}`, gotMetadata)
+
+	// Derived clients keep the application identity
+	derived := client.WithToken("token")
+	assert.Equal(t, client.UserAgent(), derived.UserAgent())
+}
+
+func TestClient_NoMetadataHeaderByDefault(t *testing.T) {
+	var present bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, present = r.Header[http.CanonicalHeaderKey(ClientMetadataHeader)]
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL})
+	require.NoError(t, err)
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/ping"})
+	require.NoError(t, err)
+	assert.False(t, present)
+}
+
+func TestConfig_Clone_ClientMetadata(t *testing.T) {
+	config := &Config{ClientMetadata: map[string]string{"deployment": "prod"}}
+	clone := config.Clone()
+	assert.Equal(t, config.ClientMetadata, clone.ClientMetadata)
+
+	clone.ClientMetadata["deployment"] = "staging"
+	assert.Equal(t, "prod", config.ClientMetadata["deployment"])
+}
@@ -490,7 +490,7 @@ func TestValidation_StringLength(t *testing.T) {
 				_, apiErr = client.Servers.UpdateServer(context.Background(), "uuid", req)
 			} else {
 				req := &ServerUpdateRequest{
-					Environment: tt.value, // Use environment for description testing
+					Environment: Environment(tt.value), // Use environment for description testing
 				}
 				_, apiErr = client.Servers.UpdateServer(context.Background(), "uuid", req)
 			}
@@ -0,0 +1,438 @@
+// Package vcr records real Nexmonyx API interactions to fixture files
+// ("cassettes") and replays them in tests, so that tests exercise real
+// response payloads without network access or credentials.
+//
+// A Recorder is an http.RoundTripper used as the client's Config.Transport.
+// Credentials are scrubbed from recorded headers and JSON bodies before the
+// cassette is written. During replay, requests are matched on method, path
+// and query, and a hash of the scrubbed body; each recorded interaction is
+// served once, in order.
+//
+// Example:
+//
+//	rec, err := vcr.New("testdata/list_servers.json", &vcr.Options{Mode: vcr.ModeAuto, Strict: true})
+//	if err != nil {
+//	    t.Fatal(err)
+//	}
+//	defer rec.Stop()
+//
+//	client, err := nexmonyx.NewClient(&nexmonyx.Config{
+//	    BaseURL:   "https://api.nexmonyx.com",
+//	    Auth:      nexmonyx.AuthConfig{Token: os.Getenv("NEXMONYX_TOKEN")},
+//	    Transport: rec,
+//	})
+//
+// Delete the cassette, or run with NEXMONYX_VCR_MODE=record, to record it
+// again against the live API.
+package vcr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ModeEnv overrides Options.Mode when set to "record", "replay", or "auto"
+const ModeEnv = "NEXMONYX_VCR_MODE"
+
+// Redacted replaces scrubbed values in cassettes
+const Redacted = "[REDACTED]"
+
+// Mode selects whether a Recorder records or replays
+type Mode int
+
+const (
+	// ModeAuto replays the cassette if it exists and records it otherwise
+	ModeAuto Mode = iota
+	// ModeReplay serves responses from the cassette, which must exist
+	ModeReplay
+	// ModeRecord sends requests to the API and overwrites the cassette on Stop
+	ModeRecord
+)
+
+// String returns the mode's name as accepted by ModeEnv
+func (m Mode) String() string {
+	switch m {
+	case ModeReplay:
+		return "replay"
+	case ModeRecord:
+		return "record"
+	default:
+		return "auto"
+	}
+}
+
+// Options configures a Recorder
+type Options struct {
+	// Mode selects recording or replay (default: ModeAuto). ModeEnv
+	// overrides it.
+	Mode Mode
+
+	// Strict fails replayed requests that match no unused interaction
+	// instead of sending them to Transport
+	Strict bool
+
+	// Transport sends requests while recording, and unmatched requests
+	// while replaying when Strict is false (default: http.DefaultTransport)
+	Transport http.RoundTripper
+
+	// ScrubHeaders are scrubbed in addition to the credential headers the
+	// SDK sends and cookies
+	ScrubHeaders []string
+
+	// ScrubFields are JSON object keys whose values are scrubbed, at any
+	// depth, in addition to the SDK's secret fields such as "secret",
+	// "password", and "full_token"
+	ScrubFields []string
+}
+
+// Cassette is the file format interactions are recorded in
+type Cassette struct {
+	Version      int           `json:"version"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is one recorded request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a scrubbed request. URL holds the path and query only,
+// so cassettes replay against any base URL.
+type RecordedRequest struct {
+	Method   string      `json:"method"`
+	URL      string      `json:"url"`
+	Headers  http.Header `json:"headers,omitempty"`
+	Body     string      `json:"body,omitempty"`
+	BodyHash string      `json:"body_hash,omitempty"` // SHA-256 of Body
+}
+
+// RecordedResponse is a scrubbed response
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// ErrUnexpectedRequest matches UnexpectedRequestError with errors.Is
+var ErrUnexpectedRequest = errors.New("vcr: unexpected request")
+
+// UnexpectedRequestError is returned in strict replay for a request that
+// matches no unused interaction
+type UnexpectedRequestError struct {
+	Method   string
+	URL      string
+	BodyHash string
+}
+
+// Error implements the error interface
+func (e *UnexpectedRequestError) Error() string {
+	return fmt.Sprintf("vcr: unexpected request %s %s (body hash %s)", e.Method, e.URL, e.BodyHash)
+}
+
+// Is reports whether target is ErrUnexpectedRequest
+func (e *UnexpectedRequestError) Is(target error) bool {
+	return target == ErrUnexpectedRequest
+}
+
+// Recorder records or replays API interactions. It is safe for concurrent use.
+type Recorder struct {
+	path      string
+	mode      Mode
+	strict    bool
+	transport http.RoundTripper
+	headers   map[string]bool
+	fields    map[string]bool
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// New returns a Recorder for the cassette at path. In replay, the cassette is
+// loaded immediately; a missing cassette is an error in ModeReplay.
+func New(path string, opts *Options) (*Recorder, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	mode := opts.Mode
+	switch os.Getenv(ModeEnv) {
+	case "":
+	case "record":
+		mode = ModeRecord
+	case "replay":
+		mode = ModeReplay
+	case "auto":
+		mode = ModeAuto
+	default:
+		return nil, fmt.Errorf("vcr: invalid %s %q", ModeEnv, os.Getenv(ModeEnv))
+	}
+
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		strict:    opts.Strict,
+		transport: opts.Transport,
+		headers:   make(map[string]bool),
+		fields:    make(map[string]bool),
+	}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+	for _, h := range []string{"Authorization", "Access-Key", "Access-Secret", "X-Server-Uuid", "X-Server-Secret",
+		"X-Registration-Key", "X-Api-Key", "X-Api-Secret", "Cookie", "Set-Cookie"} {
+		r.headers[h] = true
+	}
+	for _, h := range opts.ScrubHeaders {
+		r.headers[http.CanonicalHeaderKey(h)] = true
+	}
+	for _, f := range []string{"secret", "server_secret", "api_secret", "client_secret", "password", "token",
+		"access_token", "refresh_token", "key_value", "full_token", "private_key", "registration_key"} {
+		r.fields[f] = true
+	}
+	for _, f := range opts.ScrubFields {
+		r.fields[f] = true
+	}
+
+	if r.mode == ModeAuto {
+		r.mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			r.mode = ModeReplay
+		}
+	}
+	if r.mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("vcr: failed to read cassette: %w", err)
+		}
+		var cassette Cassette
+		if err := json.Unmarshal(data, &cassette); err != nil {
+			return nil, fmt.Errorf("vcr: invalid cassette %s: %w", path, err)
+		}
+		r.interactions = cassette.Interactions
+		r.used = make([]bool, len(cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Mode returns whether the recorder is recording or replaying
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("vcr: failed to read request body: %w", err)
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
+	}
+
+	recorded := RecordedRequest{
+		Method:  req.Method,
+		URL:     req.URL.RequestURI(),
+		Headers: r.scrubHeaders(req.Header),
+		Body:    r.scrubBody(body),
+	}
+	if recorded.Body != "" {
+		sum := sha256.Sum256([]byte(recorded.Body))
+		recorded.BodyHash = hex.EncodeToString(sum[:])
+	}
+
+	if r.mode == ModeReplay {
+		if resp := r.replay(req, &recorded); resp != nil {
+			return resp, nil
+		}
+		if r.strict {
+			return nil, &UnexpectedRequestError{Method: recorded.Method, URL: recorded.URL, BodyHash: recorded.BodyHash}
+		}
+		return r.transport.RoundTrip(req)
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("vcr: failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    r.scrubHeaders(resp.Header),
+			Body:       r.scrubBody(respBody),
+		},
+	})
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// replay returns the response of the first unused interaction matching
+// recorded, or nil when there is none
+func (r *Recorder) replay(req *http.Request, recorded *RecordedRequest) *http.Response {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.used[i] || !matches(&interaction.Request, recorded) {
+			continue
+		}
+		r.used[i] = true
+
+		resp := interaction.Response
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode)),
+			StatusCode:    resp.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        resp.Headers.Clone(),
+			Body:          io.NopCloser(strings.NewReader(resp.Body)),
+			ContentLength: int64(len(resp.Body)),
+			Request:       req,
+		}
+	}
+	return nil
+}
+
+// matches compares the method, path and query, and body hash of two requests.
+// Query parameters may be in any order.
+func matches(a, b *RecordedRequest) bool {
+	return a.Method == b.Method && a.BodyHash == b.BodyHash && canonicalURL(a.URL) == canonicalURL(b.URL)
+}
+
+func canonicalURL(uri string) string {
+	path, query, found := strings.Cut(uri, "?")
+	if !found {
+		return path
+	}
+	params := strings.Split(query, "&")
+	sort.Strings(params)
+	return path + "?" + strings.Join(params, "&")
+}
+
+// Unused returns the recorded interactions that have not been replayed, so
+// tests can assert that every expected call was made
+func (r *Recorder) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []Interaction
+	for i, interaction := range r.interactions {
+		if !r.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+	return unused
+}
+
+// Stop writes the cassette when recording. It does nothing when replaying.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	cassette := Cassette{Version: 1, Interactions: r.interactions}
+	data, err := json.MarshalIndent(cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("vcr: failed to encode cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("vcr: failed to create cassette directory: %w", err)
+	}
+	if err := os.WriteFile(r.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("vcr: failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+func (r *Recorder) scrubHeaders(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	scrubbed := header.Clone()
+	for name := range scrubbed {
+		if r.headers[http.CanonicalHeaderKey(name)] {
+			scrubbed[name] = []string{Redacted}
+		}
+	}
+	return scrubbed
+}
+
+// scrubBody replaces the values of secret fields in a JSON body. Bodies that
+// are not JSON are recorded as is.
+func (r *Recorder) scrubBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return string(body)
+	}
+	if !r.scrubValue(value) {
+		return string(body)
+	}
+	scrubbed, err := json.Marshal(value)
+	if err != nil {
+		return string(body)
+	}
+	return string(scrubbed)
+}
+
+// scrubValue scrubs secret fields in a decoded JSON value in place and
+// reports whether anything was replaced
+func (r *Recorder) scrubValue(value interface{}) bool {
+	changed := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			if r.fields[strings.ToLower(k)] {
+				if s, ok := field.(string); ok && s != "" && s != Redacted {
+					v[k] = Redacted
+					changed = true
+				}
+				continue
+			}
+			if r.scrubValue(field) {
+				changed = true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if r.scrubValue(item) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
@@ -0,0 +1,137 @@
+package vcr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	nexmonyx "github.com/nexmonyx/go-sdk/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAPIServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/v2/api-keys":
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			w.Write([]byte(`{"status":"success","data":{"key_id":"key-1","key_value":"nxm_live_value","full_token":"nxm_live_value","key":{"name":"` + req["name"].(string) + `"}}}`))
+		case r.Method == "GET" && r.URL.Path == "/v2/api-keys/key-1":
+			w.Write([]byte(`{"status":"success","data":{"key_id":"key-1","name":"ci"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newClient(t *testing.T, baseURL string, rec *Recorder) *nexmonyx.Client {
+	client, err := nexmonyx.NewClient(&nexmonyx.Config{
+		BaseURL:       baseURL,
+		Auth:          nexmonyx.AuthConfig{Token: "live-token"},
+		Transport:     rec,
+		RetryCount:    0,
+		RetryWaitTime: time.Millisecond,
+		RetryMaxWait:  time.Millisecond,
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	t.Setenv(ModeEnv, "")
+	path := filepath.Join(t.TempDir(), "cassettes", "api_keys.json")
+	ctx := context.Background()
+
+	// Record against the live server
+	server := newAPIServer(t)
+	rec, err := New(path, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ModeRecord, rec.Mode())
+
+	client := newClient(t, server.URL, rec)
+	created, err := client.APIKeys.CreateUnified(ctx, &nexmonyx.CreateUnifiedAPIKeyRequest{Name: "ci"})
+	require.NoError(t, err)
+	assert.Equal(t, "nxm_live_value", created.KeyValue)
+	_, err = client.APIKeys.GetUnified(ctx, "key-1")
+	require.NoError(t, err)
+	require.NoError(t, rec.Stop())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "live-token")
+	assert.NotContains(t, string(data), "nxm_live_value")
+	assert.Contains(t, string(data), Redacted)
+
+	// Replay without the server
+	server.Close()
+	rec, err = New(path, &Options{Strict: true})
+	require.NoError(t, err)
+	assert.Equal(t, ModeReplay, rec.Mode())
+
+	client = newClient(t, "https://api.example.com", rec)
+	created, err = client.APIKeys.CreateUnified(ctx, &nexmonyx.CreateUnifiedAPIKeyRequest{Name: "ci"})
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", created.KeyID)
+	assert.Equal(t, Redacted, created.KeyValue)
+	key, err := client.APIKeys.GetUnified(ctx, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, "ci", key.Name)
+	assert.Empty(t, rec.Unused())
+
+	// Each interaction is served once
+	_, err = client.APIKeys.GetUnified(ctx, "key-1")
+	assert.True(t, errors.Is(err, ErrUnexpectedRequest), "got %v", err)
+}
+
+func TestRecorder_StrictMatchesBody(t *testing.T) {
+	t.Setenv(ModeEnv, "")
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	server := newAPIServer(t)
+
+	rec, err := New(path, &Options{Mode: ModeRecord})
+	require.NoError(t, err)
+	_, err = newClient(t, server.URL, rec).APIKeys.CreateUnified(context.Background(), &nexmonyx.CreateUnifiedAPIKeyRequest{Name: "ci"})
+	require.NoError(t, err)
+	require.NoError(t, rec.Stop())
+
+	rec, err = New(path, &Options{Mode: ModeReplay, Strict: true})
+	require.NoError(t, err)
+	_, err = newClient(t, server.URL, rec).APIKeys.CreateUnified(context.Background(), &nexmonyx.CreateUnifiedAPIKeyRequest{Name: "other"})
+	var unexpected *UnexpectedRequestError
+	require.ErrorAs(t, err, &unexpected)
+	assert.Equal(t, "POST", unexpected.Method)
+	assert.Equal(t, "/v2/api-keys", unexpected.URL)
+	assert.Len(t, rec.Unused(), 1)
+
+	// Without Strict, unmatched requests reach the server
+	rec, err = New(path, &Options{Mode: ModeReplay})
+	require.NoError(t, err)
+	created, err := newClient(t, server.URL, rec).APIKeys.CreateUnified(context.Background(), &nexmonyx.CreateUnifiedAPIKeyRequest{Name: "other"})
+	require.NoError(t, err)
+	assert.Equal(t, "nxm_live_value", created.KeyValue)
+}
+
+func TestNew_MissingCassette(t *testing.T) {
+	t.Setenv(ModeEnv, "")
+	_, err := New(filepath.Join(t.TempDir(), "missing.json"), &Options{Mode: ModeReplay})
+	assert.Error(t, err)
+
+	t.Setenv(ModeEnv, "sometimes")
+	_, err = New("cassette.json", nil)
+	assert.Error(t, err)
+}
+
+func TestCanonicalURL(t *testing.T) {
+	assert.Equal(t, canonicalURL("/v1/servers?page=2&limit=10"), canonicalURL("/v1/servers?limit=10&page=2"))
+	assert.Equal(t, "/v1/servers", canonicalURL("/v1/servers"))
+}
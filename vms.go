@@ -17,12 +17,30 @@ type VMsService struct {
 //   - opts: Optional pagination options
 // Returns: Array of VirtualMachine objects with pagination metadata
 func (s *VMsService) List(ctx context.Context, opts *PaginationOptions) ([]VirtualMachine, *PaginationMeta, error) {
+	return s.list(ctx, opts, make(map[string]string))
+}
+
+// ListByHostServer retrieves the virtual machines running on a specific host server
+// Authentication: JWT Token required
+// Endpoint: GET /api/v1/vms?host_server_uuid={serverUUID}
+// Parameters:
+//   - serverUUID: UUID of the host server
+//   - opts: Optional pagination options
+// Returns: Array of VirtualMachine objects with pagination metadata
+func (s *VMsService) ListByHostServer(ctx context.Context, serverUUID string, opts *PaginationOptions) ([]VirtualMachine, *PaginationMeta, error) {
+	if serverUUID == "" {
+		return nil, nil, fmt.Errorf("server UUID is required")
+	}
+	return s.list(ctx, opts, map[string]string{"host_server_uuid": serverUUID})
+}
+
+// list retrieves virtual machines matching query with optional pagination
+func (s *VMsService) list(ctx context.Context, opts *PaginationOptions, query map[string]string) ([]VirtualMachine, *PaginationMeta, error) {
 	var resp struct {
 		Data []VirtualMachine `json:"data"`
 		Meta *PaginationMeta  `json:"meta"`
 	}
 
-	query := make(map[string]string)
 	if opts != nil {
 		if opts.Page > 0 {
 			query["page"] = fmt.Sprintf("%d", opts.Page)
@@ -96,6 +114,56 @@ func (s *VMsService) Get(ctx context.Context, vmID uint) (*VirtualMachine, error
 	return resp.Data, nil
 }
 
+// Update updates the configuration of a virtual machine
+// Authentication: JWT Token required
+// Endpoint: PUT /api/v2/organizations/{orgId}/virtual-machines/{vmId}
+// Parameters:
+//   - orgID: Organization ID
+//   - vmID: Virtual machine ID
+//   - config: Updated configuration (resource changes may require a restart)
+// Returns: Updated VirtualMachine object
+func (s *VMsService) Update(ctx context.Context, orgID uint, vmID uint, config *VMConfiguration) (*VirtualMachine, error) {
+	var resp struct {
+		Data *VirtualMachine `json:"data"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/api/v2/organizations/%d/virtual-machines/%d", orgID, vmID),
+		Body:   config,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// GetStatus retrieves the runtime status and resource usage of a virtual machine
+// Authentication: JWT Token required
+// Endpoint: GET /api/v2/organizations/{orgId}/virtual-machines/{vmId}/status
+// Parameters:
+//   - orgID: Organization ID
+//   - vmID: Virtual machine ID
+// Returns: VMStatus object with health and resource usage
+func (s *VMsService) GetStatus(ctx context.Context, orgID uint, vmID uint) (*VMStatus, error) {
+	var resp struct {
+		Data *VMStatus `json:"data"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/v2/organizations/%d/virtual-machines/%d/status", orgID, vmID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
 // Delete deletes a virtual machine
 // Authentication: JWT Token required
 // Endpoint: DELETE /api/v2/organizations/{orgId}/virtual-machines/{vmId}
@@ -196,3 +264,116 @@ func (s *VMsService) Restart(ctx context.Context, orgID uint, vmID uint, force b
 
 	return resp.Data, nil
 }
+
+// Pause suspends a running virtual machine, keeping its memory state
+// Authentication: JWT Token required
+// Endpoint: POST /api/v2/organizations/{orgId}/virtual-machines/{vmId}/pause
+// Parameters:
+//   - orgID: Organization ID
+//   - vmID: Virtual machine ID
+// Returns: VMOperation object with operation status
+func (s *VMsService) Pause(ctx context.Context, orgID uint, vmID uint) (*VMOperation, error) {
+	var resp struct {
+		Data *VMOperation `json:"data"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/api/v2/organizations/%d/virtual-machines/%d/pause", orgID, vmID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// Resume resumes a paused virtual machine
+// Authentication: JWT Token required
+// Endpoint: POST /api/v2/organizations/{orgId}/virtual-machines/{vmId}/resume
+// Parameters:
+//   - orgID: Organization ID
+//   - vmID: Virtual machine ID
+// Returns: VMOperation object with operation status
+func (s *VMsService) Resume(ctx context.Context, orgID uint, vmID uint) (*VMOperation, error) {
+	var resp struct {
+		Data *VMOperation `json:"data"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/api/v2/organizations/%d/virtual-machines/%d/resume", orgID, vmID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// GetOperation retrieves the current state of a VM lifecycle operation
+// Authentication: JWT Token required
+// Endpoint: GET /api/v2/organizations/{orgId}/virtual-machines/{vmId}/operations/{operationId}
+// Parameters:
+//   - orgID: Organization ID
+//   - vmID: Virtual machine ID
+//   - operationID: Operation ID returned by Start, Stop, Restart, Pause, or Resume
+// Returns: VMOperation object with current status and progress
+func (s *VMsService) GetOperation(ctx context.Context, orgID uint, vmID uint, operationID uint) (*VMOperation, error) {
+	var resp struct {
+		Data *VMOperation `json:"data"`
+	}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/v2/organizations/%d/virtual-machines/%d/operations/%d", orgID, vmID, operationID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// LifecycleOperation returns an Operation that tracks a VM lifecycle operation
+// started by Start, Stop, Restart, Pause, or Resume until it completes.
+//
+// Example:
+//
+//	vmOp, _ := client.VMs.Stop(ctx, orgID, vmID, false)
+//	finished, err := client.VMs.LifecycleOperation(orgID, vmOp, nil).Wait(ctx)
+func (s *VMsService) LifecycleOperation(orgID uint, op *VMOperation, opts *OperationOptions) *Operation[VMOperation] {
+	return NewOperation(fmt.Sprintf("vm-operation-%d", op.ID), func(ctx context.Context) (*VMOperation, OperationStatus, error) {
+		current, err := s.GetOperation(ctx, orgID, op.VMID, op.ID)
+		if err != nil {
+			return nil, OperationStatus{}, err
+		}
+		if current == nil {
+			return nil, OperationStatus{}, ErrUnexpectedResponse
+		}
+
+		status := OperationStatus{
+			State:    normalizeOperationState(current.Status),
+			Progress: current.Progress,
+			Message:  current.Message,
+		}
+		if current.ErrorDetails != "" {
+			status.Message = current.ErrorDetails
+		}
+		return current, status, nil
+	}, opts)
+}
+
+// WaitForOperation polls a VM lifecycle operation until it completes or ctx is done
+// Parameters:
+//   - orgID: Organization ID
+//   - vmID: Virtual machine ID
+//   - operationID: Operation ID returned by Start, Stop, Restart, Pause, or Resume
+//   - opts: Optional polling configuration (nil uses defaults)
+// Returns: Final VMOperation, or an *OperationError if the operation failed or was cancelled
+func (s *VMsService) WaitForOperation(ctx context.Context, orgID uint, vmID uint, operationID uint, opts *OperationOptions) (*VMOperation, error) {
+	return s.LifecycleOperation(orgID, &VMOperation{ID: operationID, VMID: vmID}, opts).Wait(ctx)
+}
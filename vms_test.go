@@ -312,6 +312,220 @@ func TestVMsService_Restart(t *testing.T) {
 	assert.Equal(t, 25, operation.Progress)
 }
 
+func TestVMsService_ListByHostServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/v1/vms", r.URL.Path)
+		assert.Equal(t, "host-uuid-1", r.URL.Query().Get("host_server_uuid"))
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+
+		response := struct {
+			Data []VirtualMachine `json:"data"`
+			Meta *PaginationMeta  `json:"meta"`
+		}{
+			Data: []VirtualMachine{
+				{
+					ID:             3,
+					Name:           "guest-01",
+					Status:         "running",
+					HostServerUUID: "host-uuid-1",
+					CreatedAt:      CustomTime{Time: time.Now()},
+					UpdatedAt:      CustomTime{Time: time.Now()},
+				},
+			},
+			Meta: &PaginationMeta{Page: 2, PerPage: 10, TotalItems: 11, TotalPages: 2},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	vms, meta, err := client.VMs.ListByHostServer(context.Background(), "host-uuid-1", &PaginationOptions{Page: 2})
+	require.NoError(t, err)
+	require.Len(t, vms, 1)
+	assert.Equal(t, "host-uuid-1", vms[0].HostServerUUID)
+	assert.Equal(t, 2, meta.Page)
+
+	_, _, err = client.VMs.ListByHostServer(context.Background(), "", nil)
+	assert.Error(t, err)
+}
+
+func TestVMsService_Update(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/api/v2/organizations/10/virtual-machines/1", r.URL.Path)
+
+		var reqBody VMConfiguration
+		json.NewDecoder(r.Body).Decode(&reqBody)
+		assert.Equal(t, 8, reqBody.CPUCores)
+
+		response := struct {
+			Data *VirtualMachine `json:"data"`
+		}{
+			Data: &VirtualMachine{
+				ID:        1,
+				Name:      reqBody.Name,
+				CPUCores:  reqBody.CPUCores,
+				MemoryMB:  reqBody.MemoryMB,
+				Status:    "running",
+				CreatedAt: CustomTime{Time: time.Now()},
+				UpdatedAt: CustomTime{Time: time.Now()},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	vm, err := client.VMs.Update(context.Background(), 10, 1, &VMConfiguration{
+		Name:     "web-server-01",
+		CPUCores: 8,
+		MemoryMB: 16384,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 8, vm.CPUCores)
+	assert.Equal(t, 16384, vm.MemoryMB)
+}
+
+func TestVMsService_GetStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/v2/organizations/10/virtual-machines/1/status", r.URL.Path)
+
+		response := struct {
+			Data *VMStatus `json:"data"`
+		}{
+			Data: &VMStatus{
+				VMID:            1,
+				Status:          "running",
+				Health:          "healthy",
+				CPUUsagePercent: 12.5,
+				UpdatedAt:       CustomTime{Time: time.Now()},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	status, err := client.VMs.GetStatus(context.Background(), 10, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "healthy", status.Health)
+	assert.Equal(t, 12.5, status.CPUUsagePercent)
+}
+
+func TestVMsService_PauseAndResume(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		var operationType string
+		switch r.URL.Path {
+		case "/api/v2/organizations/10/virtual-machines/1/pause":
+			operationType = "pause"
+		case "/api/v2/organizations/10/virtual-machines/1/resume":
+			operationType = "resume"
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		response := struct {
+			Data *VMOperation `json:"data"`
+		}{
+			Data: &VMOperation{
+				ID:            5,
+				VMID:          1,
+				OperationType: operationType,
+				Status:        "pending",
+				CreatedAt:     CustomTime{Time: time.Now()},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	operation, err := client.VMs.Pause(context.Background(), 10, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "pause", operation.OperationType)
+
+	operation, err = client.VMs.Resume(context.Background(), 10, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "resume", operation.OperationType)
+}
+
+func TestVMsService_WaitForOperation(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/v2/organizations/10/virtual-machines/1/operations/5", r.URL.Path)
+
+		polls++
+		status := "in_progress"
+		if polls >= 2 {
+			status = "completed"
+		}
+
+		response := struct {
+			Data *VMOperation `json:"data"`
+		}{
+			Data: &VMOperation{
+				ID:            5,
+				VMID:          1,
+				OperationType: "pause",
+				Status:        status,
+				Progress:      polls * 50,
+				CreatedAt:     CustomTime{Time: time.Now()},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{Token: "test-token"},
+	})
+	require.NoError(t, err)
+
+	operation, err := client.VMs.WaitForOperation(context.Background(), 10, 1, 5, &OperationOptions{
+		PollInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "completed", operation.Status)
+	assert.Equal(t, 100, operation.Progress)
+	assert.Equal(t, 2, polls)
+}
+
 func TestVMsService_ErrorHandling(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,314 @@
+package nexmonyx
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// WebhookSignatureHeader is the request header carrying the signature of a webhook delivery
+const WebhookSignatureHeader = "X-Nexmonyx-Signature"
+
+// WebhookDeliveryStatus represents the outcome of a webhook delivery attempt
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookSubscription represents an outbound webhook subscription
+type WebhookSubscription struct {
+	ID                  uint                  `json:"id"`
+	OrganizationID      uint                  `json:"organization_id"`
+	Name                string                `json:"name"`
+	URL                 string                `json:"url"`
+	EventTypes          []string              `json:"event_types"`
+	Filters             map[string]string     `json:"filters,omitempty"` // e.g. server_uuid, environment, severity
+	Headers             map[string]string     `json:"headers,omitempty"` // Extra headers sent with each delivery
+	Enabled             bool                  `json:"enabled"`
+	Secret              string                `json:"secret,omitempty"` // Only returned when the subscription is created
+	ConsecutiveFailures int                   `json:"consecutive_failures"`
+	LastDeliveryAt      *CustomTime           `json:"last_delivery_at,omitempty"`
+	LastDeliveryStatus  WebhookDeliveryStatus `json:"last_delivery_status,omitempty"`
+	CreatedAt           *CustomTime           `json:"created_at"`
+	UpdatedAt           *CustomTime           `json:"updated_at"`
+}
+
+// WebhookCreateRequest represents a request to create a webhook subscription
+type WebhookCreateRequest struct {
+	Name       string            `json:"name"`
+	URL        string            `json:"url"`
+	EventTypes []string          `json:"event_types"`
+	Secret     string            `json:"secret,omitempty"` // Generated by the API when empty
+	Filters    map[string]string `json:"filters,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Enabled    bool              `json:"enabled"`
+}
+
+// WebhookUpdateRequest represents a request to update a webhook subscription
+type WebhookUpdateRequest struct {
+	Name       *string            `json:"name,omitempty"`
+	URL        *string            `json:"url,omitempty"`
+	EventTypes *[]string          `json:"event_types,omitempty"`
+	Filters    *map[string]string `json:"filters,omitempty"`
+	Headers    *map[string]string `json:"headers,omitempty"`
+	Enabled    *bool              `json:"enabled,omitempty"`
+}
+
+// WebhookDelivery represents a single attempt to deliver an event to a webhook
+type WebhookDelivery struct {
+	ID             uint                  `json:"id"`
+	SubscriptionID uint                  `json:"subscription_id"`
+	EventID        string                `json:"event_id"`
+	EventType      string                `json:"event_type"`
+	Attempt        int                   `json:"attempt"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	ResponseCode   int                   `json:"response_code,omitempty"`
+	ResponseBody   string                `json:"response_body,omitempty"` // Truncated by the API
+	Error          string                `json:"error,omitempty"`
+	DurationMs     int64                 `json:"duration_ms"`
+	Redelivery     bool                  `json:"redelivery"`
+	DeliveredAt    *CustomTime           `json:"delivered_at,omitempty"`
+	NextRetryAt    *CustomTime           `json:"next_retry_at,omitempty"`
+}
+
+// WebhookDeliveryListOptions represents options for listing webhook deliveries
+type WebhookDeliveryListOptions struct {
+	ListOptions
+	Status    WebhookDeliveryStatus
+	EventType string
+}
+
+// ToQuery converts options to query parameters
+func (o *WebhookDeliveryListOptions) ToQuery() map[string]string {
+	params := o.ListOptions.ToQuery()
+	if o.Status != "" {
+		params["status"] = string(o.Status)
+	}
+	if o.EventType != "" {
+		params["event_type"] = o.EventType
+	}
+	return params
+}
+
+// WebhookSecretRotation is returned when a webhook's signing secret is rotated
+type WebhookSecretRotation struct {
+	Secret                  string      `json:"secret"`
+	PreviousSecretExpiresAt *CustomTime `json:"previous_secret_expires_at,omitempty"` // Deliveries are signed with both secrets until then
+}
+
+// WebhooksService handles outbound webhook subscription operations
+type WebhooksService struct {
+	client *Client
+}
+
+// Create creates a webhook subscription. The URL is checked against the
+// organization's webhook policy if it has been fetched with Policies.Get.
+func (s *WebhooksService) Create(ctx context.Context, req *WebhookCreateRequest) (*WebhookSubscription, error) {
+	if err := s.client.Policies.checkCached("POST", "/v1/webhooks", func(p *OrganizationPolicies) []ValidationIssue {
+		return p.Webhooks.Check(req.URL)
+	}); err != nil {
+		return nil, err
+	}
+
+	var resp StandardResponse
+	resp.Data = &WebhookSubscription{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   "/v1/webhooks",
+		Body:   req,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if webhook, ok := resp.Data.(*WebhookSubscription); ok {
+		return webhook, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// List retrieves the organization's webhook subscriptions
+func (s *WebhooksService) List(ctx context.Context, opts *ListOptions) ([]*WebhookSubscription, *PaginationMeta, error) {
+	var resp PaginatedResponse
+	var webhooks []*WebhookSubscription
+	resp.Data = &webhooks
+
+	req := &Request{
+		Method: "GET",
+		Path:   "/v1/webhooks",
+		Result: &resp,
+	}
+
+	if opts != nil {
+		req.Query = opts.ToQuery()
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return webhooks, resp.Meta, nil
+}
+
+// Get retrieves a webhook subscription by ID
+func (s *WebhooksService) Get(ctx context.Context, webhookID uint) (*WebhookSubscription, error) {
+	var resp StandardResponse
+	resp.Data = &WebhookSubscription{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/webhooks/%d", webhookID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if webhook, ok := resp.Data.(*WebhookSubscription); ok {
+		return webhook, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Update updates a webhook subscription. A new URL is checked against the
+// organization's webhook policy if it has been fetched with Policies.Get.
+func (s *WebhooksService) Update(ctx context.Context, webhookID uint, req *WebhookUpdateRequest) (*WebhookSubscription, error) {
+	if req != nil && req.URL != nil {
+		if err := s.client.Policies.checkCached("PUT", fmt.Sprintf("/v1/webhooks/%d", webhookID), func(p *OrganizationPolicies) []ValidationIssue {
+			return p.Webhooks.Check(*req.URL)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	var resp StandardResponse
+	resp.Data = &WebhookSubscription{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v1/webhooks/%d", webhookID),
+		Body:   req,
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if webhook, ok := resp.Data.(*WebhookSubscription); ok {
+		return webhook, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// Delete deletes a webhook subscription. Pending deliveries are discarded.
+func (s *WebhooksService) Delete(ctx context.Context, webhookID uint) error {
+	var resp StandardResponse
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/webhooks/%d", webhookID),
+		Result: &resp,
+	})
+	return err
+}
+
+// ListDeliveries retrieves delivery attempts for a webhook subscription, newest first
+func (s *WebhooksService) ListDeliveries(ctx context.Context, webhookID uint, opts *WebhookDeliveryListOptions) ([]*WebhookDelivery, *PaginationMeta, error) {
+	var resp PaginatedResponse
+	var deliveries []*WebhookDelivery
+	resp.Data = &deliveries
+
+	req := &Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/webhooks/%d/deliveries", webhookID),
+		Result: &resp,
+	}
+
+	if opts != nil {
+		req.Query = opts.ToQuery()
+	}
+
+	_, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return deliveries, resp.Meta, nil
+}
+
+// Redeliver sends a previous delivery's event to the webhook again and returns the new attempt
+func (s *WebhooksService) Redeliver(ctx context.Context, webhookID, deliveryID uint) (*WebhookDelivery, error) {
+	var resp StandardResponse
+	resp.Data = &WebhookDelivery{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/webhooks/%d/deliveries/%d/redeliver", webhookID, deliveryID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery, ok := resp.Data.(*WebhookDelivery); ok {
+		return delivery, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// RotateSecret generates a new signing secret for a webhook. The previous secret
+// stays valid until PreviousSecretExpiresAt so receivers can be updated first.
+func (s *WebhooksService) RotateSecret(ctx context.Context, webhookID uint) (*WebhookSecretRotation, error) {
+	var resp StandardResponse
+	resp.Data = &WebhookSecretRotation{}
+
+	_, err := s.client.Do(ctx, &Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/webhooks/%d/rotate-secret", webhookID),
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rotation, ok := resp.Data.(*WebhookSecretRotation); ok {
+		return rotation, nil
+	}
+	return nil, fmt.Errorf("unexpected response type")
+}
+
+// VerifyWebhookSignature checks the WebhookSignatureHeader value of a delivery
+// against its raw body. The signature has the form "sha256=<hex HMAC-SHA256 of
+// the body>". Several secrets may be given so that deliveries signed with either
+// the old or new secret are accepted while a rotation is in progress.
+func VerifyWebhookSignature(payload []byte, signature string, secrets ...string) error {
+	sig, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok {
+		return fmt.Errorf("unsupported webhook signature format")
+	}
+	got, err := hex.DecodeString(sig)
+	if err != nil {
+		return fmt.Errorf("malformed webhook signature: %w", err)
+	}
+
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(payload)
+		if hmac.Equal(got, mac.Sum(nil)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("webhook signature does not match")
+}
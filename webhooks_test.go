@@ -0,0 +1,177 @@
+package nexmonyx
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhooksService_Create(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/webhooks", r.URL.Path)
+
+		var req WebhookCreateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, []string{"probe.failed", "incident.created"}, req.EventTypes)
+		assert.Equal(t, "production", req.Filters["environment"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(StandardResponse{
+			Status: "success",
+			Data: &WebhookSubscription{
+				ID:         4,
+				Name:       req.Name,
+				URL:        req.URL,
+				EventTypes: req.EventTypes,
+				Enabled:    true,
+				Secret:     "whsec_generated",
+			},
+		})
+	}), Config{})
+
+	webhook, err := client.Webhooks.Create(context.Background(), &WebhookCreateRequest{
+		Name:       "Ops bridge",
+		URL:        "https://hooks.example.com/nexmonyx",
+		EventTypes: []string{"probe.failed", "incident.created"},
+		Filters:    map[string]string{"environment": "production"},
+		Enabled:    true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint(4), webhook.ID)
+	assert.Equal(t, "whsec_generated", webhook.Secret)
+}
+
+func TestWebhooksService_CRUD(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/v1/webhooks":
+			json.NewEncoder(w).Encode(PaginatedResponse{
+				Status: "success",
+				Data:   []*WebhookSubscription{{ID: 4, Name: "Ops bridge"}},
+				Meta:   &PaginationMeta{Page: 1, TotalItems: 1},
+			})
+		case r.Method == "GET" && r.URL.Path == "/v1/webhooks/4":
+			json.NewEncoder(w).Encode(StandardResponse{Status: "success", Data: &WebhookSubscription{ID: 4, Name: "Ops bridge"}})
+		case r.Method == "PUT" && r.URL.Path == "/v1/webhooks/4":
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			assert.Equal(t, map[string]interface{}{"enabled": false}, req)
+			json.NewEncoder(w).Encode(StandardResponse{Status: "success", Data: &WebhookSubscription{ID: 4, Enabled: false}})
+		case r.Method == "DELETE" && r.URL.Path == "/v1/webhooks/4":
+			json.NewEncoder(w).Encode(StandardResponse{Status: "success"})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}), Config{})
+	ctx := context.Background()
+
+	webhooks, meta, err := client.Webhooks.List(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Equal(t, 1, meta.TotalItems)
+
+	webhook, err := client.Webhooks.Get(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, "Ops bridge", webhook.Name)
+
+	disabled := false
+	webhook, err = client.Webhooks.Update(ctx, 4, &WebhookUpdateRequest{Enabled: &disabled})
+	require.NoError(t, err)
+	assert.False(t, webhook.Enabled)
+
+	require.NoError(t, client.Webhooks.Delete(ctx, 4))
+}
+
+func TestWebhooksService_Deliveries(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/v1/webhooks/4/deliveries":
+			assert.Equal(t, "failed", r.URL.Query().Get("status"))
+			assert.Equal(t, "probe.failed", r.URL.Query().Get("event_type"))
+			json.NewEncoder(w).Encode(PaginatedResponse{
+				Status: "success",
+				Data: []*WebhookDelivery{
+					{ID: 31, SubscriptionID: 4, EventType: "probe.failed", Status: WebhookDeliveryStatusFailed, ResponseCode: 502, Attempt: 3},
+				},
+				Meta: &PaginationMeta{Page: 1, TotalItems: 1},
+			})
+		case r.Method == "POST" && r.URL.Path == "/v1/webhooks/4/deliveries/31/redeliver":
+			json.NewEncoder(w).Encode(StandardResponse{
+				Status: "success",
+				Data:   &WebhookDelivery{ID: 32, SubscriptionID: 4, Status: WebhookDeliveryStatusSucceeded, ResponseCode: 200, Redelivery: true},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}), Config{})
+	ctx := context.Background()
+
+	deliveries, _, err := client.Webhooks.ListDeliveries(ctx, 4, &WebhookDeliveryListOptions{
+		Status:    WebhookDeliveryStatusFailed,
+		EventType: "probe.failed",
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, 502, deliveries[0].ResponseCode)
+
+	delivery, err := client.Webhooks.Redeliver(ctx, 4, 31)
+	require.NoError(t, err)
+	assert.True(t, delivery.Redelivery)
+	assert.Equal(t, WebhookDeliveryStatusSucceeded, delivery.Status)
+}
+
+func TestWebhooksService_RotateSecret(t *testing.T) {
+	expires := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/webhooks/4/rotate-secret", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(StandardResponse{
+			Status: "success",
+			Data: &WebhookSecretRotation{
+				Secret:                  "whsec_new",
+				PreviousSecretExpiresAt: &CustomTime{Time: expires},
+			},
+		})
+	}), Config{})
+
+	rotation, err := client.Webhooks.RotateSecret(context.Background(), 4)
+	require.NoError(t, err)
+	assert.Equal(t, "whsec_new", rotation.Secret)
+	require.NotNil(t, rotation.PreviousSecretExpiresAt)
+	assert.True(t, rotation.PreviousSecretExpiresAt.Equal(expires))
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	payload := []byte(`{"event_type":"probe.failed"}`)
+	sign := func(secret string) string {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(payload)
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	assert.NoError(t, VerifyWebhookSignature(payload, sign("old"), "old"))
+	assert.NoError(t, VerifyWebhookSignature(payload, sign("old"), "new", "old"), "previous secret accepted during rotation")
+	assert.Error(t, VerifyWebhookSignature(payload, sign("old"), "new"))
+	assert.Error(t, VerifyWebhookSignature([]byte(`{}`), sign("old"), "old"))
+	assert.Error(t, VerifyWebhookSignature(payload, "md5=abc", "old"))
+	assert.Error(t, VerifyWebhookSignature(payload, "sha256=zz", "old"))
+	assert.Error(t, VerifyWebhookSignature(payload, sign("old")))
+}
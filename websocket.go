@@ -1,9 +1,12 @@
+//go:build !nexmonyx_agent
+
 package nexmonyx
 
 import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
@@ -161,8 +164,18 @@ func (ws *WebSocketServiceImpl) Connect() error {
 	// Build WebSocket URL
 	wsURL := ws.buildWebSocketURL()
 
-	// Create WebSocket connection
-	dialer := websocket.DefaultDialer
+	// Create WebSocket connection, using the client's TLS and proxy settings
+	dialer := *websocket.DefaultDialer
+	if ws.client.config.TLSConfig != nil {
+		dialer.TLSClientConfig = ws.client.config.TLSConfig.Clone()
+	}
+	if ws.client.config.ProxyURL != "" {
+		proxy, err := parseProxyURL(ws.client.config.ProxyURL)
+		if err != nil {
+			return err
+		}
+		dialer.Proxy = http.ProxyURL(proxy)
+	}
 	conn, _, err := dialer.Dial(wsURL, nil)
 	if err != nil {
 		return fmt.Errorf("failed to connect to WebSocket: %w", err)
@@ -425,12 +438,15 @@ func (ws *WebSocketServiceImpl) sendCommand(ctx context.Context, serverUUID, com
 
 	// OPTIMIZATION: Check pending responses count to prevent unbounded growth
 	ws.responseMutex.Lock()
-	if len(ws.pendingResponses) >= maxPendingResponses {
+	if pending := len(ws.pendingResponses); pending >= maxPendingResponses {
 		ws.responseMutex.Unlock()
-		return nil, fmt.Errorf("too many pending commands (%d), circuit breaker activated", len(ws.pendingResponses))
+		err := fmt.Errorf("too many pending commands (%d), circuit breaker activated", pending)
+		ws.client.circuits.open(ws.client.events, CircuitEvent{Component: "websocket", Reason: err.Error()})
+		return nil, err
 	}
 	ws.pendingResponses[correlationID] = responseChan
 	ws.responseMutex.Unlock()
+	ws.client.circuits.close("websocket")
 
 	// Clean up on exit
 	defer func() {
@@ -1,3 +1,5 @@
+//go:build !nexmonyx_agent
+
 package nexmonyx
 
 import (
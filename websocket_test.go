@@ -1,8 +1,11 @@
+//go:build !nexmonyx_agent
+
 package nexmonyx
 
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -549,4 +552,28 @@ func TestWebSocketService_ErrorHandling(t *testing.T) {
 	_, err = wsService.AgentHealth(ctx, "server-uuid")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "not connected")
-}
\ No newline at end of file
+}
+
+func TestEventBus_CircuitOpen(t *testing.T) {
+	client, err := NewClient(&Config{
+		Auth: AuthConfig{ServerUUID: "server-uuid", ServerSecret: "secret"},
+	})
+	require.NoError(t, err)
+
+	ws, err := client.NewWebSocketService()
+	require.NoError(t, err)
+	ws.connected = true
+	for i := 0; i < maxPendingResponses; i++ {
+		ws.pendingResponses[fmt.Sprintf("pending-%d", i)] = make(chan *WSCommandResponse, 1)
+	}
+
+	var events []CircuitEvent
+	client.Events().OnCircuitOpen(func(e CircuitEvent) { events = append(events, e) })
+
+	_, err = ws.sendCommand(context.Background(), "server-uuid", "ping", nil)
+	require.Error(t, err)
+
+	require.Len(t, events, 1)
+	assert.Equal(t, "websocket", events[0].Component)
+	assert.Contains(t, events[0].Reason, "circuit breaker")
+}
@@ -0,0 +1,224 @@
+package nexmonyx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	// DefaultWorkloadIdentityTokenFile is the Kubernetes service account token
+	// read when WorkloadIdentityConfig.TokenFile is empty
+	DefaultWorkloadIdentityTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+	// TokenExchangePath is the endpoint that exchanges an OIDC token for a
+	// short-lived Nexmonyx credential
+	TokenExchangePath = "/v1/auth/token-exchange"
+
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	jwtTokenType           = "urn:ietf:params:oauth:token-type:jwt"
+
+	defaultWorkloadRefreshBefore = time.Minute
+)
+
+// WorkloadIdentityConfig configures workload identity federation: the client
+// exchanges an OIDC token issued to the workload, such as a projected
+// Kubernetes service account token, for a short-lived Nexmonyx credential and
+// exchanges again before it expires. No static secret is needed.
+//
+// Example:
+//
+//	client, err := nexmonyx.NewClient(&nexmonyx.Config{
+//	    Auth: nexmonyx.AuthConfig{
+//	        WorkloadIdentity: &nexmonyx.WorkloadIdentityConfig{
+//	            TokenFile: "/var/run/secrets/tokens/nexmonyx",
+//	        },
+//	    },
+//	})
+type WorkloadIdentityConfig struct {
+	// TokenFile holds the OIDC token (default: DefaultWorkloadIdentityTokenFile).
+	// It is read on every exchange, so rotated tokens are picked up.
+	TokenFile string
+
+	// TokenSource returns the OIDC token, overriding TokenFile
+	TokenSource func(ctx context.Context) (string, error)
+
+	// Scopes optionally restricts the issued credential
+	Scopes []string
+
+	// RefreshBefore is how long before expiry the credential is exchanged
+	// again (default: 1m, capped at half the credential's lifetime)
+	RefreshBefore time.Duration
+}
+
+// TokenExchangeRequest represents an OAuth 2.0 token exchange request (RFC 8693)
+type TokenExchangeRequest struct {
+	GrantType        string `json:"grant_type"`
+	SubjectToken     string `json:"subject_token"`
+	SubjectTokenType string `json:"subject_token_type"`
+	Scope            string `json:"scope,omitempty"`
+}
+
+// WorkloadCredential represents a short-lived credential issued for a workload identity
+type WorkloadCredential struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"` // seconds
+	Scope       string `json:"scope,omitempty"`
+	Subject     string `json:"subject,omitempty"` // Identity the credential was issued to
+}
+
+// workloadIdentity caches the exchanged credential and sets it on requests
+type workloadIdentity struct {
+	client *Client
+	config WorkloadIdentityConfig
+	now    func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	refreshAt time.Time // zero when the credential does not expire
+}
+
+func newWorkloadIdentity(client *Client, config *WorkloadIdentityConfig) *workloadIdentity {
+	w := &workloadIdentity{client: client, config: *config, now: time.Now}
+	if w.config.TokenFile == "" {
+		w.config.TokenFile = DefaultWorkloadIdentityTokenFile
+	}
+	if w.config.RefreshBefore <= 0 {
+		w.config.RefreshBefore = defaultWorkloadRefreshBefore
+	}
+	return w
+}
+
+// authenticate is a resty request middleware that sets the current credential,
+// exchanging a new one when none is cached or it is about to expire
+func (w *workloadIdentity) authenticate(_ *resty.Client, r *resty.Request) error {
+	token, err := w.credential(r.Context())
+	if err != nil {
+		return err
+	}
+	r.SetAuthToken(token)
+	return nil
+}
+
+// invalidate is a resty response middleware that drops a credential the API
+// rejected, so the next request exchanges a new one
+func (w *workloadIdentity) invalidate(_ *resty.Client, resp *resty.Response) error {
+	if resp.StatusCode() != http.StatusUnauthorized {
+		return nil
+	}
+
+	w.mu.Lock()
+	if resp.Request.Token == w.token {
+		w.token = ""
+	}
+	w.mu.Unlock()
+	return nil
+}
+
+// credential returns the cached credential, exchanging a new one when needed.
+// Concurrent callers wait for a single exchange.
+func (w *workloadIdentity) credential(ctx context.Context) (string, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.token != "" && (w.refreshAt.IsZero() || w.now().Before(w.refreshAt)) {
+		return w.token, nil
+	}
+
+	issued := w.now()
+	cred, err := w.exchange(ctx)
+	if err != nil {
+		return "", fmt.Errorf("workload identity token exchange failed: %w", err)
+	}
+
+	w.token = cred.AccessToken
+	w.refreshAt = time.Time{}
+	if cred.ExpiresIn > 0 {
+		lifetime := time.Duration(cred.ExpiresIn) * time.Second
+		refreshBefore := w.config.RefreshBefore
+		if refreshBefore > lifetime/2 {
+			refreshBefore = lifetime / 2
+		}
+		w.refreshAt = issued.Add(lifetime - refreshBefore)
+	}
+	return w.token, nil
+}
+
+// subjectToken returns the workload's OIDC token
+func (w *workloadIdentity) subjectToken(ctx context.Context) (string, error) {
+	if w.config.TokenSource != nil {
+		return w.config.TokenSource(ctx)
+	}
+
+	data, err := os.ReadFile(w.config.TokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// exchange trades the workload's OIDC token for a Nexmonyx credential. It uses
+// the underlying HTTP client directly so the request middleware is not re-entered.
+func (w *workloadIdentity) exchange(ctx context.Context) (*WorkloadCredential, error) {
+	subject, err := w.subjectToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if subject == "" {
+		return nil, fmt.Errorf("subject token is empty")
+	}
+
+	body, err := json.Marshal(&TokenExchangeRequest{
+		GrantType:        tokenExchangeGrantType,
+		SubjectToken:     subject,
+		SubjectTokenType: jwtTokenType,
+		Scope:            strings.Join(w.config.Scopes, " "),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	url := strings.TrimRight(w.client.config.BaseURL, "/") + TokenExchangePath
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+	httpReq.Header.Set("User-Agent", w.client.config.userAgent())
+
+	httpResp, err := w.client.client.GetClient().Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(httpResp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode >= http.StatusBadRequest {
+		return nil, w.client.errorFromResponse(httpResp.StatusCode, httpResp.Header, respBody)
+	}
+
+	var resp StandardResponse
+	cred := &WorkloadCredential{}
+	resp.Data = cred
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, err
+	}
+	if cred.AccessToken == "" {
+		return nil, fmt.Errorf("response did not include an access token")
+	}
+	return cred, nil
+}
@@ -0,0 +1,148 @@
+package nexmonyx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newWorkloadIdentityServer issues "cred-<n>" for every exchange and accepts
+// only the most recently issued credential
+func newWorkloadIdentityServer(t *testing.T, subjects *[]string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var exchanges atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == TokenExchangePath {
+			var req TokenExchangeRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, tokenExchangeGrantType, req.GrantType)
+			assert.Equal(t, jwtTokenType, req.SubjectTokenType)
+			assert.Empty(t, r.Header.Get("Authorization"))
+			*subjects = append(*subjects, req.SubjectToken)
+
+			n := exchanges.Add(1)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data": map[string]interface{}{
+					"access_token": "cred-" + string(rune('0'+n)),
+					"token_type":   "Bearer",
+					"expires_in":   600,
+				},
+			})
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer cred-"+string(rune('0'+exchanges.Load())) {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"status":"error","message":"credential expired"}`))
+			return
+		}
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &exchanges
+}
+
+func TestWorkloadIdentity(t *testing.T) {
+	var subjects []string
+	server, exchanges := newWorkloadIdentityServer(t, &subjects)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("oidc-1\n"), 0o600))
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth: AuthConfig{
+			Token:            "ignored",
+			WorkloadIdentity: &WorkloadIdentityConfig{TokenFile: tokenFile},
+		},
+	})
+	require.NoError(t, err)
+	now := time.Now()
+	client.workload.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		_, err := client.Do(ctx, &Request{Method: "GET", Path: "/v1/servers"})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), exchanges.Load(), "credential is cached")
+
+	// The kubelet rotates the projected token; the next exchange picks it up
+	require.NoError(t, os.WriteFile(tokenFile, []byte("oidc-2"), 0o600))
+	now = now.Add(9*time.Minute + time.Second)
+	_, err = client.Do(ctx, &Request{Method: "GET", Path: "/v1/servers"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), exchanges.Load(), "refreshed a minute before expiry")
+	assert.Equal(t, []string{"oidc-1", "oidc-2"}, subjects)
+}
+
+func TestWorkloadIdentity_Rejected(t *testing.T) {
+	var subjects []string
+	server, exchanges := newWorkloadIdentityServer(t, &subjects)
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth: AuthConfig{WorkloadIdentity: &WorkloadIdentityConfig{
+			TokenSource: func(ctx context.Context) (string, error) { return "oidc", nil },
+		}},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.Do(ctx, &Request{Method: "GET", Path: "/v1/servers"})
+	require.NoError(t, err)
+
+	// Revoke the credential server-side by issuing a newer one
+	exchanges.Add(1)
+	_, err = client.Do(ctx, &Request{Method: "GET", Path: "/v1/servers"})
+	var unauthorized *UnauthorizedError
+	require.True(t, errors.As(err, &unauthorized))
+
+	_, err = client.Do(ctx, &Request{Method: "GET", Path: "/v1/servers"})
+	assert.NoError(t, err, "a rejected credential is exchanged again")
+	assert.Len(t, subjects, 2)
+}
+
+func TestWorkloadIdentity_ExchangeFailure(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"status":"error","message":"unknown issuer"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth: AuthConfig{WorkloadIdentity: &WorkloadIdentityConfig{
+			TokenSource: func(ctx context.Context) (string, error) { return "oidc", nil },
+		}},
+	})
+	require.NoError(t, err)
+
+	_, err = client.Do(context.Background(), &Request{Method: "GET", Path: "/v1/servers"})
+	var forbidden *ForbiddenError
+	require.True(t, errors.As(err, &forbidden))
+	assert.Contains(t, err.Error(), "workload identity token exchange failed")
+	assert.Equal(t, int32(1), requests.Load(), "the API request is not sent without a credential")
+
+	_, err = NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    AuthConfig{WorkloadIdentity: &WorkloadIdentityConfig{TokenFile: filepath.Join(t.TempDir(), "missing")}},
+	})
+	require.NoError(t, err, "the token is only read when a request is made")
+}